@@ -22,7 +22,7 @@ import (
 
 // @title Accounting API
 // @version 1.0
-// @description A REST API for personal accounting management with support for users, accounts, and transactions
+// @description A REST API for personal accounting management with support for users, accounts, transactions, a double-entry journal and multi-currency conversion
 // @termsOfService http://swagger.io/terms/
 
 // @contact.name API Support
@@ -60,14 +60,34 @@ func main() {
 	userRepo := postgres.NewUserRepository(db)
 	accountRepo := postgres.NewAccountRepository(db)
 	transactionRepo := postgres.NewTransactionRepository(db)
+	journalRepo := postgres.NewJournalEntryRepository(db)
+	exchangeRateRepo := postgres.NewExchangeRateRepository(db)
+	reportRepo := postgres.NewReportRepository(db)
+	balanceRepo := postgres.NewBalanceRepository(db)
+	statementRepo := postgres.NewStatementRepository(db)
+	importProfileRepo := postgres.NewImportProfileRepository(db)
+	categoryRepo := postgres.NewCategoryRepository(db)
+	categoryRuleRepo := postgres.NewCategoryRuleRepository(db)
+	payeeRepo := postgres.NewPayeeRepository(db)
+	txManager := postgres.NewTxManager(db)
 
 	// Initialize services
 	userService := service.NewUserService(userRepo)
-	accountService := service.NewAccountService(accountRepo, userRepo)
-	transactionService := service.NewTransactionService(transactionRepo, accountRepo)
+	exchangeRateService := service.NewExchangeRateService(exchangeRateRepo, txManager)
+	accountService := service.NewAccountService(accountRepo, userRepo, txManager, exchangeRateService)
+	transactionService := service.NewTransactionService(transactionRepo, accountRepo, txManager, exchangeRateService, categoryRepo, categoryRuleRepo, payeeRepo)
+	transferService := service.NewTransferService(transactionRepo, accountRepo, txManager, exchangeRateService)
+	journalService := service.NewJournalService(journalRepo, accountRepo, txManager, transactionRepo)
+	balanceService := service.NewBalanceService(accountRepo, balanceRepo, txManager)
+	reportService := service.NewReportService(reportRepo, userRepo, accountRepo, categoryRepo, balanceService, exchangeRateService)
+	statementService := service.NewStatementService(statementRepo, accountRepo, userRepo)
+	importService := service.NewImportService(importProfileRepo, accountRepo, userRepo, transactionService, categoryRepo, categoryRuleRepo)
+	categoryRuleService := service.NewCategoryRuleService(categoryRuleRepo, userRepo, accountRepo, categoryRepo, transactionRepo, txManager)
+	categoryService := service.NewCategoryService(categoryRepo, userRepo, categoryRuleRepo)
+	payeeService := service.NewPayeeService(payeeRepo, userRepo, categoryRepo)
 
 	// Create router with all handlers
-	r := router.NewRouter(userService, accountService, transactionService)
+	r := router.NewRouter(userService, accountService, transactionService, transferService, journalService, exchangeRateService, reportService, balanceService, statementService, importService, categoryRuleService, categoryService, payeeService)
 
 	// Setup HTTP server
 	mux := http.NewServeMux()
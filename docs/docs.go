@@ -60,6 +60,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/common.ValidationProblem"
                         }
                     },
+                    "404": {
+                        "description": "User or parent account not found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -69,15 +75,136 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/accounts/{accountID}/transactions": {
+        "/api/v1/accounts/{accountID}/balance": {
+            "get": {
+                "description": "Compute an account's balance at the end of a day (UTC) from its transaction and journal history",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "balances"
+                ],
+                "summary": "Get account balance as of a date",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Account ID",
+                        "name": "accountID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Day to take the balance at the end of (YYYY-MM-DD), default today",
+                        "name": "as_of",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/balance.BalanceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "404": {
+                        "description": "Account not found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/accounts/{accountID}/balance/daily": {
             "get": {
-                "description": "Retrieve all transactions for a specific account",
+                "description": "Return an account's end-of-day balance (UTC) for every day of a range of at most 3660 days, for charting. Month-end balances are cached as snapshots, so long histories stay fast.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
+                "tags": [
+                    "balances"
+                ],
+                "summary": "Get daily account balances",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Account ID",
+                        "name": "accountID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day (YYYY-MM-DD), inclusive",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/balance.DailyBalancesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "404": {
+                        "description": "Account not found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/accounts/{accountID}/transactions": {
+            "get": {
+                "description": "Retrieve a page of an account's transactions, newest first unless sort says otherwise. Filters combine with AND. Pass next_cursor back as cursor, with the same filters, to fetch the following page.\nWith format=csv or an Accept header of text/csv, every matching transaction is streamed as CSV with a fixed column layout instead, and limit and cursor are ignored. CSV exports do not support running_balance.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/csv"
+                ],
                 "tags": [
                     "transactions"
                 ],
@@ -89,16 +216,124 @@ const docTemplate = `{
                         "name": "accountID",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Earliest transaction date (YYYY-MM-DD), inclusive",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Latest transaction date (YYYY-MM-DD), inclusive",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "INCOME",
+                            "EXPENSE",
+                            "TRANSFER"
+                        ],
+                        "type": "string",
+                        "description": "Transaction type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "IDs of the categories to include, with their subcategories; repeat the parameter or separate with commas",
+                        "name": "category_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Tags to filter by, ignoring case; repeat the parameter or separate with commas",
+                        "name": "tag",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "any",
+                            "all"
+                        ],
+                        "type": "string",
+                        "description": "Whether transactions need any or all of the tags (default any)",
+                        "name": "tag_match",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Smallest amount, inclusive, in the account currency",
+                        "name": "min_amount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Largest amount, inclusive, in the account currency",
+                        "name": "max_amount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Case-insensitive substring of the description",
+                        "name": "description",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "date_desc",
+                            "date_asc",
+                            "amount_desc",
+                            "amount_asc"
+                        ],
+                        "type": "string",
+                        "description": "Order of the listing (default date_desc)",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include each transaction's running_balance, computed over the account's whole history regardless of filters and pagination; not available with CSV",
+                        "name": "running_balance",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "csv"
+                        ],
+                        "type": "string",
+                        "description": "Response format, overriding the Accept header (default json)",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (1-200, default 50)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor by the previous page",
+                        "name": "cursor",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/transaction.TransactionResponse"
-                            }
+                            "$ref": "#/definitions/transaction.TransactionListResponse"
                         }
                     },
                     "400": {
@@ -172,7 +407,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Update an existing account with the specified details",
+                "description": "Update an existing account with the specified details. The currency, and the type between debit-normal and credit-normal (INCOME, EQUITY) types, can only change while the account has no transactions or journal postings.",
                 "consumes": [
                     "application/json"
                 ],
@@ -229,7 +464,7 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Delete an existing account by ID",
+                "description": "Delete an existing account by ID. Accounts with sub-accounts, transfers or journal postings cannot be deleted; the account's other transactions are deleted with it.",
                 "consumes": [
                     "application/json"
                 ],
@@ -274,9 +509,96 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/transactions": {
+        "/api/v1/accounts/{account_id}/imports": {
+            "post": {
+                "description": "Upload a bank statement and create a transaction in the account for each of its rows. Rows that cannot be imported are reported without stopping the others; the response gives the outcome of every row.\nCSV statements are read with a saved import profile or with a mapping given inline.\nOFX and QFX statements need no mapping. Transactions whose FITID was imported into the account before are skipped, so a statement can be imported again without duplicates.\nISO 20022 camt.053 and SWIFT MT940 statements need no mapping either. Transactions are dated on their booking date, or on their value date when the bank gives no booking date; pending camt.053 entries are not imported. Amounts in another currency than the account's are converted, and transactions whose bank reference was imported before are skipped.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "imports"
+                ],
+                "summary": "Import a bank statement",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Account ID (UUID)",
+                        "name": "account_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Statement, at most 10 MiB",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "csv",
+                            "ofx",
+                            "camt053",
+                            "mt940"
+                        ],
+                        "type": "string",
+                        "description": "Statement format; by default ofx for .ofx and .qfx files, camt053 for .xml files, mt940 for .sta, .mt940 and .940 files and csv otherwise",
+                        "name": "format",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSV only: ID of the saved import profile to read the file with",
+                        "name": "profile_id",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "CSV only: CSVMapping as JSON, when no profile_id is given",
+                        "name": "mapping",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/statementimport.ImportResultResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error or unreadable file",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "404": {
+                        "description": "Account or import profile not found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
+                    "413": {
+                        "description": "Statement too large",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/categories": {
             "post": {
-                "description": "Create a new transaction for an account",
+                "description": "Create a category for a user's transactions, optionally nested under another of the user's categories. Names are unique among the user's categories under the same parent, ignoring case, so different parents may have subcategories of the same name. A category restricted to INCOME or EXPENSE transactions only has subcategories of the same type.",
                 "consumes": [
                     "application/json"
                 ],
@@ -284,17 +606,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "transactions"
+                    "categories"
                 ],
-                "summary": "Create a new transaction",
+                "summary": "Create a category",
                 "parameters": [
                     {
-                        "description": "Transaction request",
-                        "name": "body",
+                        "description": "Category creation request",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/transaction.CreateTransactionRequest"
+                            "$ref": "#/definitions/category.CreateCategoryRequest"
                         }
                     }
                 ],
@@ -302,15 +624,21 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/transaction.TransactionResponse"
+                            "$ref": "#/definitions/category.CategoryResponse"
                         }
                     },
                     "400": {
-                        "description": "Validation error",
+                        "description": "Validation error or duplicate name",
                         "schema": {
                             "$ref": "#/definitions/common.ValidationProblem"
                         }
                     },
+                    "404": {
+                        "description": "User or parent category not found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -320,9 +648,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/transactions/{id}": {
+        "/api/v1/categories/{category_id}": {
             "get": {
-                "description": "Retrieve a transaction by ID",
+                "description": "Retrieve a category, archived or not",
                 "consumes": [
                     "application/json"
                 ],
@@ -330,14 +658,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "transactions"
+                    "categories"
                 ],
-                "summary": "Get a transaction",
+                "summary": "Get a category by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Transaction ID",
-                        "name": "id",
+                        "description": "Category ID (UUID)",
+                        "name": "category_id",
                         "in": "path",
                         "required": true
                     }
@@ -346,7 +674,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/transaction.TransactionResponse"
+                            "$ref": "#/definitions/category.CategoryResponse"
                         }
                     },
                     "400": {
@@ -356,7 +684,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Transaction not found",
+                        "description": "Category not found",
                         "schema": {
                             "$ref": "#/definitions/common.ProblemDetail"
                         }
@@ -370,7 +698,7 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Update an existing transaction",
+                "description": "Rename a category, move it under another parent or to the top level, change its type, or archive or restore it. Omitted fields keep their stored value. The name must stay unique under the category's parent, ignoring case. A category a rule sets cannot be archived.",
                 "consumes": [
                     "application/json"
                 ],
@@ -378,24 +706,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "transactions"
+                    "categories"
                 ],
-                "summary": "Update a transaction",
+                "summary": "Update a category",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Transaction ID",
-                        "name": "id",
+                        "description": "Category ID (UUID)",
+                        "name": "category_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Transaction update request",
-                        "name": "body",
+                        "description": "Category update request",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/transaction.UpdateTransactionRequest"
+                            "$ref": "#/definitions/category.UpdateCategoryRequest"
                         }
                     }
                 ],
@@ -403,17 +731,17 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/transaction.TransactionResponse"
+                            "$ref": "#/definitions/category.CategoryResponse"
                         }
                     },
                     "400": {
-                        "description": "Validation error",
+                        "description": "Validation error or duplicate name",
                         "schema": {
                             "$ref": "#/definitions/common.ValidationProblem"
                         }
                     },
                     "404": {
-                        "description": "Transaction not found",
+                        "description": "Category or parent category not found",
                         "schema": {
                             "$ref": "#/definitions/common.ProblemDetail"
                         }
@@ -427,7 +755,7 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Delete an existing transaction",
+                "description": "Delete a category without subcategories that no rule sets. Its transactions become uncategorized; archive the category instead to keep them in it.",
                 "consumes": [
                     "application/json"
                 ],
@@ -435,21 +763,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "transactions"
+                    "categories"
                 ],
-                "summary": "Delete a transaction",
+                "summary": "Delete a category",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Transaction ID",
-                        "name": "id",
+                        "description": "Category ID (UUID)",
+                        "name": "category_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "204": {
-                        "description": "Transaction deleted successfully"
+                        "description": "Category deleted successfully"
                     },
                     "400": {
                         "description": "Validation error",
@@ -458,7 +786,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Transaction not found",
+                        "description": "Category not found",
                         "schema": {
                             "$ref": "#/definitions/common.ProblemDetail"
                         }
@@ -472,9 +800,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/users": {
+        "/api/v1/category-rules": {
             "post": {
-                "description": "Create a new user with name and email",
+                "description": "Save a rule that categorizes a user's transactions. A transaction created or imported without a category takes the actions of the first rule it matches, trying rules by priority, then name. Tags a rule sets are added to those the transaction has. A rule needs at least one condition and one action.",
                 "consumes": [
                     "application/json"
                 ],
@@ -482,17 +810,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "category-rules"
                 ],
-                "summary": "Create a new user",
+                "summary": "Create a categorization rule",
                 "parameters": [
                     {
-                        "description": "User creation request",
-                        "name": "user",
+                        "description": "Categorization rule creation request",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/user.CreateUserRequest"
+                            "$ref": "#/definitions/categoryrule.CreateCategoryRuleRequest"
                         }
                     }
                 ],
@@ -500,17 +828,23 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/user.UserResponse"
+                            "$ref": "#/definitions/categoryrule.CategoryRuleResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Validation error",
                         "schema": {
                             "$ref": "#/definitions/common.ValidationProblem"
                         }
                     },
+                    "404": {
+                        "description": "User or account not found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/common.ProblemDetail"
                         }
@@ -518,22 +852,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/users/search": {
+        "/api/v1/category-rules/{rule_id}": {
             "get": {
-                "description": "Get a user's details by their email address",
+                "description": "Retrieve a categorization rule with its conditions and actions",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "category-rules"
                 ],
-                "summary": "Get user by email",
+                "summary": "Get a categorization rule by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User email address",
-                        "name": "email",
-                        "in": "query",
+                        "description": "Categorization rule ID (UUID)",
+                        "name": "rule_id",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -541,78 +878,88 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user.UserResponse"
+                            "$ref": "#/definitions/categoryrule.CategoryRuleResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Validation error",
                         "schema": {
                             "$ref": "#/definitions/common.ValidationProblem"
                         }
                     },
                     "404": {
-                        "description": "Not Found",
+                        "description": "Categorization rule not found",
                         "schema": {
                             "$ref": "#/definitions/common.ProblemDetail"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/common.ProblemDetail"
                         }
                     }
                 }
-            }
-        },
-        "/api/v1/users/{id}": {
-            "get": {
-                "description": "Get a user's details by their ID",
+            },
+            "put": {
+                "description": "Rename a rule, change its priority, or replace its conditions or actions. Omitted fields keep their stored value; given conditions or actions replace the stored ones as a whole. Transactions categorized before are not changed; apply the rules again for that.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "category-rules"
                 ],
-                "summary": "Get user by ID",
+                "summary": "Update a categorization rule",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID (UUID)",
-                        "name": "id",
+                        "description": "Categorization rule ID (UUID)",
+                        "name": "rule_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Categorization rule update request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/categoryrule.UpdateCategoryRuleRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user.UserResponse"
+                            "$ref": "#/definitions/categoryrule.CategoryRuleResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Validation error",
                         "schema": {
                             "$ref": "#/definitions/common.ValidationProblem"
                         }
                     },
                     "404": {
-                        "description": "Not Found",
+                        "description": "Categorization rule or account not found",
                         "schema": {
                             "$ref": "#/definitions/common.ProblemDetail"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/common.ProblemDetail"
                         }
                     }
                 }
             },
-            "put": {
-                "description": "Update user information by ID",
+            "delete": {
+                "description": "Delete a categorization rule. Transactions it categorized keep their category.",
                 "consumes": [
                     "application/json"
                 ],
@@ -620,81 +967,173 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "category-rules"
                 ],
-                "summary": "Update a user",
+                "summary": "Delete a categorization rule",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID (UUID)",
-                        "name": "id",
+                        "description": "Categorization rule ID (UUID)",
+                        "name": "rule_id",
                         "in": "path",
                         "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Categorization rule deleted successfully"
                     },
-                    {
-                        "description": "User update request",
-                        "name": "user",
-                        "in": "body",
-                        "required": true,
+                    "400": {
+                        "description": "Validation error",
                         "schema": {
-                            "$ref": "#/definitions/user.UpdateUserRequest"
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "404": {
+                        "description": "Categorization rule not found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
                         }
                     }
+                }
+            }
+        },
+        "/api/v1/currencies": {
+            "get": {
+                "description": "Retrieve the ISO 4217 currencies accepted by the API, ordered by code, with the number of decimal places each allows",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
                 ],
+                "tags": [
+                    "currency"
+                ],
+                "summary": "List supported currencies",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/user.UserResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/currency.CurrencyResponse"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/exchange-rates": {
+            "get": {
+                "description": "Retrieve the rate effective on a date for a currency pair: the latest rate dated on or before it.\nThe inverse of the opposite pair is used when only that one is known.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "exchange-rate"
+                ],
+                "summary": "Get an exchange rate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Base currency (e.g., EUR)",
+                        "name": "base",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Quote currency (e.g., USD)",
+                        "name": "quote",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date in YYYY-MM-DD format (default: today)",
+                        "name": "date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/exchangerate.ExchangeRateResponse"
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Validation error",
                         "schema": {
                             "$ref": "#/definitions/common.ValidationProblem"
                         }
                     },
                     "404": {
-                        "description": "Not Found",
+                        "description": "No rate known for the pair on that date",
                         "schema": {
                             "$ref": "#/definitions/common.ProblemDetail"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/common.ProblemDetail"
                         }
                     }
                 }
             },
-            "delete": {
-                "description": "Delete a user by ID",
+            "post": {
+                "description": "Store dated exchange rates, replacing rates already stored for the same pair and date.\nSend JSON, or a text/csv file with the columns date,base_currency,quote_currency,rate (header optional).\nThe batch is stored completely or not at all.",
+                "consumes": [
+                    "application/json",
+                    "text/csv"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "users"
+                    "exchange-rate"
                 ],
-                "summary": "Delete a user",
+                "summary": "Load exchange rates",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "User ID (UUID)",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Exchange rates",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/exchangerate.SetRatesRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/exchangerate.ExchangeRateResponse"
+                            }
+                        }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "Validation error",
                         "schema": {
                             "$ref": "#/definitions/common.ValidationProblem"
                         }
                     },
                     "500": {
-                        "description": "Internal Server Error",
+                        "description": "Internal server error",
                         "schema": {
                             "$ref": "#/definitions/common.ProblemDetail"
                         }
@@ -702,9 +1141,61 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/users/{user_id}/accounts": {
+        "/api/v1/import-profiles": {
+            "post": {
+                "description": "Save a CSV column mapping for a user under a name unique to the user, typically one per bank, to import statements with later",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "imports"
+                ],
+                "summary": "Create an import profile",
+                "parameters": [
+                    {
+                        "description": "Import profile creation request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/statementimport.CreateImportProfileRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/statementimport.ImportProfileResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error or duplicate name",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/import-profiles/{profile_id}": {
             "get": {
-                "description": "Retrieve all accounts associated with a specific user",
+                "description": "Retrieve a saved CSV column mapping",
                 "consumes": [
                     "application/json"
                 ],
@@ -712,14 +1203,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "account"
+                    "imports"
                 ],
-                "summary": "List all accounts for a user",
+                "summary": "Get an import profile by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "User ID (UUID)",
-                        "name": "user_id",
+                        "description": "Import profile ID (UUID)",
+                        "name": "profile_id",
                         "in": "path",
                         "required": true
                     }
@@ -728,10 +1219,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/account.AccountResponse"
-                            }
+                            "$ref": "#/definitions/statementimport.ImportProfileResponse"
                         }
                     },
                     "400": {
@@ -740,6 +1228,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/common.ValidationProblem"
                         }
                     },
+                    "404": {
+                        "description": "Import profile not found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -747,150 +1241,3459 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "account.AccountResponse": {
-            "type": "object",
-            "properties": {
-                "balance": {
-                    "type": "number"
-                },
-                "currency": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "type": {
-                    "$ref": "#/definitions/constant.AccountType"
-                },
-                "user_id": {
-                    "type": "string"
-                }
-            }
-        },
-        "account.CreateAccountRequest": {
-            "type": "object",
-            "properties": {
-                "currency": {
-                    "type": "string"
+            },
+            "put": {
+                "description": "Rename an import profile or replace its mapping. Omitted fields keep their stored value; a given mapping replaces the stored one as a whole.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "imports"
+                ],
+                "summary": "Update an import profile",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Import profile ID (UUID)",
+                        "name": "profile_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Import profile update request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/statementimport.UpdateImportProfileRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/statementimport.ImportProfileResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error or duplicate name",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "404": {
+                        "description": "Import profile not found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a saved CSV column mapping. Transactions imported with it are kept.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "imports"
+                ],
+                "summary": "Delete an import profile",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Import profile ID (UUID)",
+                        "name": "profile_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Import profile deleted successfully"
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "404": {
+                        "description": "Import profile not found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/journal-entries": {
+            "post": {
+                "description": "Record a double-entry journal entry. Debits must equal credits in every currency.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "journal"
+                ],
+                "summary": "Create a journal entry",
+                "parameters": [
+                    {
+                        "description": "Journal entry request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/journal.CreateJournalEntryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/journal.JournalEntryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "404": {
+                        "description": "Account not found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/journal-entries/{id}": {
+            "get": {
+                "description": "Retrieve a journal entry with its postings by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "journal"
+                ],
+                "summary": "Get a journal entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Journal entry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/journal.JournalEntryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "404": {
+                        "description": "Journal entry not found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a journal entry and reverse its postings on the account balances",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "journal"
+                ],
+                "summary": "Delete a journal entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Journal entry ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Journal entry deleted successfully"
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "404": {
+                        "description": "Journal entry not found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/payees": {
+            "post": {
+                "description": "Create a payee a user pays or is paid by. New transactions whose description contains the payee's name or one of its aliases, ignoring case, get the payee, and its default category when no other applies. Names are unique per user, and no name or alias may belong to two payees, ignoring case.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payees"
+                ],
+                "summary": "Create a payee",
+                "parameters": [
+                    {
+                        "description": "Payee creation request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payee.CreatePayeeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/payee.PayeeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error or duplicate name",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "404": {
+                        "description": "User or default category not found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/payees/{payee_id}": {
+            "get": {
+                "description": "Retrieve a payee with its aliases and default category",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payees"
+                ],
+                "summary": "Get a payee by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Payee ID (UUID)",
+                        "name": "payee_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/payee.PayeeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "404": {
+                        "description": "Payee not found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Rename a payee, replace its aliases or change its default category. Omitted fields keep their stored value; an empty list of aliases removes them and an empty default_category_id removes the default category. Transactions already recorded keep their payee and category.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payees"
+                ],
+                "summary": "Update a payee",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Payee ID (UUID)",
+                        "name": "payee_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Payee update request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/payee.UpdatePayeeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/payee.PayeeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error or duplicate name",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "404": {
+                        "description": "Payee or default category not found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a payee. Its transactions keep their category but no longer have a payee.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payees"
+                ],
+                "summary": "Delete a payee",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Payee ID (UUID)",
+                        "name": "payee_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Payee deleted successfully"
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "404": {
+                        "description": "Payee not found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/transactions": {
+            "post": {
+                "description": "Create a new transaction for an account. An amount in another currency is converted into the account currency at the rate effective on the transaction date, keeping the original amount and rate.\nSplits divide the transaction between categories in place of category_id: at least two lines in the currency of the amount, adding up to it. They are converted along with the amount.\nWithout payee_id, the user's payee whose name or alias the description contains, ignoring case, becomes the payee; the longest name or alias wins. A transaction without category_id or splits that no categorization rule categorizes gets its payee's default category.\nTags are stored in lower case; tags the user does not have yet are created.\nA transaction of the same type and amount with the same description, dated within three days on the same account, is taken for a duplicate: the request fails with 409 and the ID of the existing transaction in existing_id, unless allow_duplicate is set.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Create a new transaction",
+                "parameters": [
+                    {
+                        "description": "Transaction request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/transaction.CreateTransactionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/transaction.TransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "409": {
+                        "description": "Likely duplicate of an existing transaction",
+                        "schema": {
+                            "$ref": "#/definitions/common.ConflictProblem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/transactions/{id}": {
+            "get": {
+                "description": "Retrieve a transaction by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Get a transaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/transaction.TransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "404": {
+                        "description": "Transaction not found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update an existing transaction and recalculate the affected account balances. Setting account_id moves the transaction to another account. Setting payee_id gives a transaction without a category or splits the payee's default category. Tags, when given, replace the transaction's tags; an empty list removes them. Splits, when given, replace the transaction's splits and category, in the currency of the amount as entered; an empty list removes them. Splits must be given again when a new amount, date or account no longer converts to their total.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Update a transaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Transaction update request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/transaction.UpdateTransactionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/transaction.TransactionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "404": {
+                        "description": "Transaction not found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete an existing transaction and reverse its effect on the account balance",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Delete a transaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "Transaction deleted successfully"
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "404": {
+                        "description": "Transaction not found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/transactions/{transaction_id}/journal-entry": {
+            "get": {
+                "description": "Express an INCOME or EXPENSE transaction as the balanced journal entry it amounts to: income debits the transaction's account and credits an INCOME category account, expense debits an EXPENSE category account and credits the transaction's account.\nThe entry is not recorded and has no IDs, since the transaction already moved the account's balance.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "journal"
+                ],
+                "summary": "Preview a transaction as a journal entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transaction ID (UUID)",
+                        "name": "transaction_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID of the user's INCOME or EXPENSE account, in the transaction's currency",
+                        "name": "category_account_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/journal.JournalEntryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "404": {
+                        "description": "Transaction or account not found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/transfers": {
+            "post": {
+                "description": "Move money between two accounts of the same user as a linked pair of transactions. Each leg is converted into its account currency when needed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfers"
+                ],
+                "summary": "Create a transfer",
+                "parameters": [
+                    {
+                        "description": "Transfer request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/transfer.CreateTransferRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.TransferResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "404": {
+                        "description": "Account not found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/transfers/{id}": {
+            "get": {
+                "description": "Retrieve both legs of a transfer by transfer ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transfers"
+                ],
+                "summary": "Get a transfer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transfer ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/transfer.TransferResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "404": {
+                        "description": "Transfer not found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users": {
+            "post": {
+                "description": "Create a new user with name, email and an optional base currency (default USD)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Create a new user",
+                "parameters": [
+                    {
+                        "description": "User creation request",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user.CreateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/user.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/search": {
+            "get": {
+                "description": "Get a user's details by their email address",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get user by email",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User email address",
+                        "name": "email",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/{id}": {
+            "get": {
+                "description": "Get a user's details by their ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get user by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update user information by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Update a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "User update request",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user.UpdateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/user.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete a user by ID",
+                "tags": [
+                    "users"
+                ],
+                "summary": "Delete a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/{user_id}/accounts": {
+            "get": {
+                "description": "Retrieve a page of a user's accounts, newest first. Pass next_cursor back as cursor to fetch the following page.\nWith format=csv or an Accept header of text/csv, every account is streamed as CSV with a fixed column layout instead, and limit and cursor are ignored.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/csv"
+                ],
+                "tags": [
+                    "account"
+                ],
+                "summary": "List all accounts for a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "csv"
+                        ],
+                        "type": "string",
+                        "description": "Response format, overriding the Accept header (default json)",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Page size (1-200, default 50)",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Cursor returned as next_cursor by the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/account.AccountListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/{user_id}/accounts/tree": {
+            "get": {
+                "description": "Retrieve a user's accounts as a tree, each node with balances rolled up from its sub-accounts per currency.\nWith base_currency, each node is also totalled in that currency at today's exchange rates.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "account"
+                ],
+                "summary": "Get the chart of accounts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ISO 4217 currency to total each node in (e.g., USD)",
+                        "name": "base_currency",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/account.AccountTreeNodeResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/{user_id}/categories": {
+            "get": {
+                "description": "Retrieve a user's categories ordered by name, without archived ones unless asked. Each category names its parent; top-level categories have none.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "categories"
+                ],
+                "summary": "List a user's categories",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Include archived categories",
+                        "name": "include_archived",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/category.CategoryResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/{user_id}/category-rules": {
+            "get": {
+                "description": "Retrieve every categorization rule of a user in the order they are tried: by priority, then name",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "category-rules"
+                ],
+                "summary": "List a user's categorization rules",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/categoryrule.CategoryRuleResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/{user_id}/category-rules/apply": {
+            "post": {
+                "description": "Apply a user's categorization rules to their income and expense transactions, and report the transactions whose category, description or tags changed. Tags a rule sets are added to those the transaction has. A dry run previews the changes without saving them. Changes are saved in batches, so a failure keeps the batches saved before it. The request body is optional.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "category-rules"
+                ],
+                "summary": "Apply categorization rules to existing transactions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Options",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/categoryrule.ApplyCategoryRulesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/categoryrule.ApplyCategoryRulesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/{user_id}/import-profiles": {
+            "get": {
+                "description": "Retrieve every CSV column mapping a user has saved, ordered by name",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "imports"
+                ],
+                "summary": "List a user's import profiles",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/statementimport.ImportProfileResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/{user_id}/journal-entries": {
+            "get": {
+                "description": "Retrieve all journal entries of a user, newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "journal"
+                ],
+                "summary": "List journal entries for a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/journal.JournalEntryResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/{user_id}/net-worth": {
+            "get": {
+                "description": "Total a user's asset accounts (checking, savings, cash, investment) minus what is owed on liability accounts (credit cards), converted into the user's base currency. The current figure uses today's balances and rates; the history gives the net worth at each month end in the range, converted at the rates of that day. Income, expense and equity accounts are not included.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Get net worth",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day of the history (YYYY-MM-DD); defaults to the first day of the month eleven months before to",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day of the history (YYYY-MM-DD), inclusive; defaults to today",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/report.NetWorthResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error or missing exchange rate",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/{user_id}/payees": {
+            "get": {
+                "description": "Retrieve a user's payees ordered by name",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "payees"
+                ],
+                "summary": "List a user's payees",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/payee.PayeeResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/{user_id}/reports/payees": {
+            "get": {
+                "description": "Total a user's income and expense per payee, with the net cash flow of each, to see where the money goes. Transfers are excluded. Each payee is reported separately per currency, and only payees with activity are listed, followed by the transactions without a payee.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Get income and expense per payee",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day of the report (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day of the report (YYYY-MM-DD), inclusive",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/report.PayeeReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/{user_id}/reports/summary": {
+            "get": {
+                "description": "Total a user's income and expense per period and per category, with the net cash flow of each. Transfers between accounts are excluded. Each period is reported separately per currency, unless base_currency is given: then each period is totalled in that currency at the exchange rates effective on its last day. Only periods with activity are listed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Get income and expense summary",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day of the report (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day of the report (YYYY-MM-DD), inclusive",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "month",
+                            "quarter",
+                            "year"
+                        ],
+                        "type": "string",
+                        "description": "Period length (default month)",
+                        "name": "granularity",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ISO 4217 currency to total each period in (e.g., USD)",
+                        "name": "base_currency",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/report.SummaryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/{user_id}/reports/tags": {
+            "get": {
+                "description": "Total a user's income and expense per tag, with the net cash flow of each. A transaction with several tags counts towards each of them. Transfers are excluded. Each tag is reported separately per currency, and only tags with activity are listed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Get income and expense per tag",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day of the report (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day of the report (YYYY-MM-DD), inclusive",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/report.TagReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/{user_id}/statements/balance-sheet": {
+            "get": {
+                "description": "Draw up a user's assets, liabilities and equity at the end of a day (UTC), grouped by account type. Liabilities are shown as the amount owed. Equity holds the equity accounts, the retained earnings from all income and expenses to date and any imbalance left by transfers between currencies. Each currency forms its own section, where assets equal liabilities plus equity.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "statements"
+                ],
+                "summary": "Get balance sheet",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Day to draw up the balance sheet at the end of (YYYY-MM-DD), default today",
+                        "name": "as_of",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/statement.BalanceSheetResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/{user_id}/statements/income-statement": {
+            "get": {
+                "description": "Total a user's income and expenses for a period, listing the income and expense accounts followed by the transaction categories, with the net income. Transfers are left out. Each currency forms its own section.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "statements"
+                ],
+                "summary": "Get income statement",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "First day of the period (YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day of the period (YYYY-MM-DD), inclusive",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/statement.IncomeStatementResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v1/users/{user_id}/statements/trial-balance": {
+            "get": {
+                "description": "List the debit or credit balance of every account of a user at the end of a day (UTC), ordered by account type. Transaction categories appear as income and expense lines, standing in for the counterpart accounts that single-entry transactions leave implicit. Each currency forms its own section, whose debits and credits are equal.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "statements"
+                ],
+                "summary": "Get trial balance",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID (UUID)",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Day to draw up the trial balance at the end of (YYYY-MM-DD), default today",
+                        "name": "as_of",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/statement.TrialBalanceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Validation error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ValidationProblem"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/common.ProblemDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/health": {
+            "get": {
+                "description": "Check the health status of the API and its dependencies",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Health check",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/health.HealthResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/health.HealthResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "account.AccountListResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/account.AccountResponse"
+                    }
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "next_cursor": {
+                    "description": "NextCursor fetches the following page when passed as cursor; omitted on the last page",
+                    "type": "string"
+                }
+            }
+        },
+        "account.AccountResponse": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "string"
+                },
+                "class": {
+                    "$ref": "#/definitions/constant.AccountClass"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/constant.AccountType"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "account.AccountTreeNodeResponse": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "string"
+                },
+                "base_currency": {
+                    "type": "string"
+                },
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/account.AccountTreeNodeResponse"
+                    }
+                },
+                "class": {
+                    "$ref": "#/definitions/constant.AccountClass"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "rolled_up_balances": {
+                    "description": "RolledUpBalances maps each currency to the total balance of the account and its descendants",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "rolled_up_total": {
+                    "description": "RolledUpTotal is RolledUpBalances converted into BaseCurrency, present when a base currency was requested",
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/constant.AccountType"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "account.CreateAccountRequest": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/constant.AccountType"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "account.UpdateAccountRequest": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/constant.AccountType"
+                }
+            }
+        },
+        "balance.BalanceResponse": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "as_of": {
+                    "description": "AsOf is the day (YYYY-MM-DD) the balance was taken at the end of",
+                    "type": "string"
+                },
+                "balance": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                }
+            }
+        },
+        "balance.DailyBalanceResponse": {
+            "type": "object",
+            "properties": {
+                "balance": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                }
+            }
+        },
+        "balance.DailyBalancesResponse": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "balances": {
+                    "description": "Balances holds the end-of-day balance of every day in the range, oldest first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/balance.DailyBalanceResponse"
+                    }
+                },
+                "currency": {
+                    "type": "string"
+                }
+            }
+        },
+        "category.CategoryResponse": {
+            "type": "object",
+            "properties": {
+                "archived": {
+                    "type": "boolean"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/constant.TransactionType"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "category.CreateCategoryRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "description": "ParentID nests the category under another of the user's categories",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "Type restricts the category to INCOME or EXPENSE transactions; empty allows both",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/constant.TransactionType"
+                        }
+                    ]
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "category.UpdateCategoryRequest": {
+            "type": "object",
+            "properties": {
+                "archived": {
+                    "description": "Archived hides the category from lists and keeps it from being assigned\nto transactions; transactions already in it keep it",
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "parent_id": {
+                    "description": "ParentID moves the category under another one; an empty string makes it top-level",
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/constant.TransactionType"
+                }
+            }
+        },
+        "categoryrule.ApplyCategoryRulesRequest": {
+            "type": "object",
+            "properties": {
+                "dry_run": {
+                    "description": "DryRun previews the changes without saving them",
+                    "type": "boolean"
+                },
+                "uncategorized_only": {
+                    "description": "UncategorizedOnly leaves transactions that have a category alone",
+                    "type": "boolean"
+                }
+            }
+        },
+        "categoryrule.ApplyCategoryRulesResponse": {
+            "type": "object",
+            "properties": {
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/categoryrule.RuleChangeResponse"
+                    }
+                },
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "matched": {
+                    "description": "Matched counts the transactions that matched a rule, changed or not",
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "categoryrule.CategoryRuleResponse": {
+            "type": "object",
+            "properties": {
+                "actions": {
+                    "$ref": "#/definitions/categoryrule.RuleActions"
+                },
+                "conditions": {
+                    "$ref": "#/definitions/categoryrule.RuleConditions"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "priority": {
+                    "type": "integer"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "categoryrule.CreateCategoryRuleRequest": {
+            "type": "object",
+            "properties": {
+                "actions": {
+                    "$ref": "#/definitions/categoryrule.RuleActions"
+                },
+                "conditions": {
+                    "$ref": "#/definitions/categoryrule.RuleConditions"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "priority": {
+                    "description": "Priority orders the user's rules, lowest first; 0 when omitted",
+                    "type": "integer"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "categoryrule.RuleActions": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "description": "CategoryID is one of the user's categories; a category for INCOME or\nEXPENSE only restricts the rule to that type",
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "tags": {
+                    "description": "Tags are added to the transaction's tags, creating any the user does\nnot have yet; they are stored in lower case and sorted",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "categoryrule.RuleChangeResponse": {
+            "type": "object",
+            "properties": {
+                "category_id": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "previous_category_id": {
+                    "type": "string"
+                },
+                "previous_description": {
+                    "type": "string"
+                },
+                "previous_tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "rule_id": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "transaction_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "categoryrule.RuleConditions": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "currency": {
+                    "description": "Currency is required with an amount bound",
+                    "type": "string"
+                },
+                "description_contains": {
+                    "description": "DescriptionContains matches descriptions containing the text, ignoring case",
+                    "type": "string"
+                },
+                "description_pattern": {
+                    "description": "DescriptionPattern is a regular expression in RE2 syntax, e.g. (?i)^amzn mktp",
+                    "type": "string"
+                },
+                "max_amount": {
+                    "type": "string"
+                },
+                "min_amount": {
+                    "description": "MinAmount and MaxAmount bound the amount inclusively, in Currency",
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/constant.TransactionType"
+                }
+            }
+        },
+        "categoryrule.UpdateCategoryRuleRequest": {
+            "type": "object",
+            "properties": {
+                "actions": {
+                    "$ref": "#/definitions/categoryrule.RuleActions"
+                },
+                "conditions": {
+                    "description": "Conditions and Actions replace the stored ones as a whole when given",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/categoryrule.RuleConditions"
+                        }
+                    ]
+                },
+                "name": {
+                    "type": "string"
+                },
+                "priority": {
+                    "type": "integer"
+                }
+            }
+        },
+        "common.ConflictProblem": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string"
+                },
+                "existing_id": {
+                    "type": "string"
+                },
+                "instance": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "common.ProblemDetail": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string"
+                },
+                "instance": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "common.ValidationError": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "common.ValidationProblem": {
+            "type": "object",
+            "properties": {
+                "detail": {
+                    "type": "string"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/common.ValidationError"
+                    }
+                },
+                "instance": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "constant.AccountClass": {
+            "type": "string",
+            "enum": [
+                "ASSET",
+                "LIABILITY",
+                "EQUITY",
+                "INCOME",
+                "EXPENSE"
+            ],
+            "x-enum-varnames": [
+                "AccountClassAsset",
+                "AccountClassLiability",
+                "AccountClassEquity",
+                "AccountClassIncome",
+                "AccountClassExpense"
+            ]
+        },
+        "constant.AccountType": {
+            "type": "string",
+            "enum": [
+                "CHECKING",
+                "SAVINGS",
+                "CREDIT_CARD",
+                "CASH",
+                "INVESTMENT",
+                "INCOME",
+                "EXPENSE",
+                "EQUITY"
+            ],
+            "x-enum-varnames": [
+                "AccountTypeChecking",
+                "AccountTypeSavings",
+                "AccountTypeCreditCard",
+                "AccountTypeCash",
+                "AccountTypeInvestment",
+                "AccountTypeIncome",
+                "AccountTypeExpense",
+                "AccountTypeEquity"
+            ]
+        },
+        "constant.AmountSign": {
+            "type": "string",
+            "enum": [
+                "SIGNED",
+                "INVERTED",
+                "DEBIT_CREDIT"
+            ],
+            "x-enum-varnames": [
+                "AmountSignSigned",
+                "AmountSignInverted",
+                "AmountSignDebitCredit"
+            ]
+        },
+        "constant.Granularity": {
+            "type": "string",
+            "enum": [
+                "month",
+                "quarter",
+                "year"
+            ],
+            "x-enum-varnames": [
+                "GranularityMonth",
+                "GranularityQuarter",
+                "GranularityYear"
+            ]
+        },
+        "constant.ImportRowStatus": {
+            "type": "string",
+            "enum": [
+                "IMPORTED",
+                "FAILED",
+                "SKIPPED",
+                "DUPLICATE"
+            ],
+            "x-enum-varnames": [
+                "ImportRowStatusImported",
+                "ImportRowStatusFailed",
+                "ImportRowStatusSkipped",
+                "ImportRowStatusDuplicate"
+            ]
+        },
+        "constant.PostingDirection": {
+            "type": "string",
+            "enum": [
+                "DEBIT",
+                "CREDIT"
+            ],
+            "x-enum-varnames": [
+                "PostingDirectionDebit",
+                "PostingDirectionCredit"
+            ]
+        },
+        "constant.TransactionType": {
+            "type": "string",
+            "enum": [
+                "INCOME",
+                "EXPENSE",
+                "TRANSFER"
+            ],
+            "x-enum-varnames": [
+                "TransactionTypeIncome",
+                "TransactionTypeExpense",
+                "TransactionTypeTransfer"
+            ]
+        },
+        "constant.TransferDirection": {
+            "type": "string",
+            "enum": [
+                "OUT",
+                "IN"
+            ],
+            "x-enum-varnames": [
+                "TransferDirectionOut",
+                "TransferDirectionIn"
+            ]
+        },
+        "currency.CurrencyResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "minor_units": {
+                    "description": "MinorUnits is the number of decimal places amounts in this currency may have",
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "exchangerate.ExchangeRateResponse": {
+            "type": "object",
+            "properties": {
+                "base_currency": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "quote_currency": {
+                    "type": "string"
+                },
+                "rate": {
+                    "type": "string"
+                }
+            }
+        },
+        "exchangerate.RateRequest": {
+            "type": "object",
+            "properties": {
+                "base_currency": {
+                    "type": "string"
+                },
+                "date": {
+                    "description": "Date is the day from which the rate applies, in YYYY-MM-DD format",
+                    "type": "string"
+                },
+                "quote_currency": {
+                    "type": "string"
+                },
+                "rate": {
+                    "description": "Rate is the number of quote currency units per base currency unit, as a decimal string",
+                    "type": "string"
+                }
+            }
+        },
+        "exchangerate.SetRatesRequest": {
+            "type": "object",
+            "properties": {
+                "rates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/exchangerate.RateRequest"
+                    }
+                }
+            }
+        },
+        "health.ComponentHealth": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/health.Status"
+                }
+            }
+        },
+        "health.HealthResponse": {
+            "type": "object",
+            "properties": {
+                "components": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/health.ComponentHealth"
+                    }
+                },
+                "status": {
+                    "$ref": "#/definitions/health.Status"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "health.Status": {
+            "type": "string",
+            "enum": [
+                "healthy",
+                "unhealthy"
+            ],
+            "x-enum-varnames": [
+                "StatusHealthy",
+                "StatusUnhealthy"
+            ]
+        },
+        "journal.CreateJournalEntryRequest": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "postings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/journal.PostingRequest"
+                    }
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "journal.JournalEntryResponse": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "postings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/journal.PostingResponse"
+                    }
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "journal.PostingRequest": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "direction": {
+                    "$ref": "#/definitions/constant.PostingDirection"
+                },
+                "memo": {
+                    "type": "string"
+                }
+            }
+        },
+        "journal.PostingResponse": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "direction": {
+                    "$ref": "#/definitions/constant.PostingDirection"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "memo": {
+                    "type": "string"
+                }
+            }
+        },
+        "payee.CreatePayeeRequest": {
+            "type": "object",
+            "properties": {
+                "aliases": {
+                    "description": "Aliases are other names the payee goes by in transaction descriptions,\ne.g. \"AMZN Mktp\" for Amazon",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "default_category_id": {
+                    "description": "DefaultCategoryID is given to the payee's transactions that get no other category",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "payee.PayeeResponse": {
+            "type": "object",
+            "properties": {
+                "aliases": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "default_category_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "payee.UpdatePayeeRequest": {
+            "type": "object",
+            "properties": {
+                "aliases": {
+                    "description": "Aliases replace the payee's aliases unless null or absent; an empty\nlist removes them all",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "default_category_id": {
+                    "description": "DefaultCategoryID replaces the default category; an empty string removes it",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "report.CategorySummaryResponse": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "children": {
+                    "description": "Children breaks the totals down by subcategory",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/report.CategorySummaryResponse"
+                    }
+                },
+                "expense": {
+                    "type": "string"
+                },
+                "income": {
+                    "type": "string"
+                },
+                "net_cash_flow": {
+                    "type": "string"
+                }
+            }
+        },
+        "report.NetWorthAccountResponse": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "balance": {
+                    "description": "Balance is in the account's currency and ConvertedBalance in the base currency",
+                    "type": "string"
+                },
+                "converted_balance": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/constant.AccountType"
+                }
+            }
+        },
+        "report.NetWorthPointResponse": {
+            "type": "object",
+            "properties": {
+                "assets": {
+                    "type": "string"
+                },
+                "date": {
+                    "description": "Date is the day the net worth applies to, at its end (YYYY-MM-DD)",
+                    "type": "string"
+                },
+                "liabilities": {
+                    "description": "Liabilities is the amount owed, positive when money is owed",
+                    "type": "string"
+                },
+                "net_worth": {
+                    "type": "string"
+                }
+            }
+        },
+        "report.NetWorthResponse": {
+            "type": "object",
+            "properties": {
+                "accounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/report.NetWorthAccountResponse"
+                    }
+                },
+                "as_of": {
+                    "description": "AsOf, Assets, Liabilities and NetWorth describe today's position",
+                    "type": "string"
+                },
+                "assets": {
+                    "type": "string"
+                },
+                "currency": {
+                    "description": "Currency is the user's base currency, which every total is converted into",
+                    "type": "string"
+                },
+                "history": {
+                    "description": "History holds the net worth at each month end in the range, oldest first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/report.NetWorthPointResponse"
+                    }
+                },
+                "liabilities": {
+                    "type": "string"
+                },
+                "net_worth": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "report.PayeeReportResponse": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "description": "From and To are the requested dates (YYYY-MM-DD), both inclusive",
+                    "type": "string"
+                },
+                "payees": {
+                    "description": "Payees are sorted by payee name, then currency, with the transactions\nwithout a payee last",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/report.PayeeSummaryResponse"
+                    }
+                },
+                "to": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "report.PayeeSummaryResponse": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string"
+                },
+                "expense": {
+                    "type": "string"
+                },
+                "income": {
+                    "type": "string"
+                },
+                "net_cash_flow": {
+                    "type": "string"
+                },
+                "payee": {
+                    "type": "string"
+                },
+                "payee_id": {
+                    "description": "PayeeID and Payee, the payee's name, are empty for the transactions without a payee",
+                    "type": "string"
+                }
+            }
+        },
+        "report.PeriodSummaryResponse": {
+            "type": "object",
+            "properties": {
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/report.CategorySummaryResponse"
+                    }
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "expense": {
+                    "type": "string"
+                },
+                "income": {
+                    "type": "string"
+                },
+                "net_cash_flow": {
+                    "type": "string"
+                },
+                "period_end": {
+                    "type": "string"
+                },
+                "period_start": {
+                    "description": "PeriodStart is the first day of the period and PeriodEnd its last day (YYYY-MM-DD)",
+                    "type": "string"
+                }
+            }
+        },
+        "report.SummaryResponse": {
+            "type": "object",
+            "properties": {
+                "base_currency": {
+                    "description": "BaseCurrency is set when each period was totalled in it",
+                    "type": "string"
+                },
+                "from": {
+                    "description": "From and To are the requested dates (YYYY-MM-DD), both inclusive",
+                    "type": "string"
+                },
+                "granularity": {
+                    "$ref": "#/definitions/constant.Granularity"
+                },
+                "periods": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/report.PeriodSummaryResponse"
+                    }
+                },
+                "to": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "report.TagReportResponse": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "description": "From and To are the requested dates (YYYY-MM-DD), both inclusive",
+                    "type": "string"
+                },
+                "tags": {
+                    "description": "Tags are sorted by tag, then currency; a transaction with several tags\ncounts towards each",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/report.TagSummaryResponse"
+                    }
+                },
+                "to": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "report.TagSummaryResponse": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string"
+                },
+                "expense": {
+                    "type": "string"
+                },
+                "income": {
+                    "type": "string"
+                },
+                "net_cash_flow": {
+                    "type": "string"
+                },
+                "tag": {
+                    "type": "string"
+                }
+            }
+        },
+        "statement.BalanceSheetResponse": {
+            "type": "object",
+            "properties": {
+                "as_of": {
+                    "description": "AsOf is the day (YYYY-MM-DD) the balance sheet was drawn up at the end of",
+                    "type": "string"
+                },
+                "sections": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/statement.BalanceSheetSectionResponse"
+                    }
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "statement.BalanceSheetSectionResponse": {
+            "type": "object",
+            "properties": {
+                "assets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/statement.StatementGroupResponse"
+                    }
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "equity": {
+                    "$ref": "#/definitions/statement.StatementGroupResponse"
+                },
+                "liabilities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/statement.StatementGroupResponse"
+                    }
+                },
+                "total_assets": {
+                    "type": "string"
+                },
+                "total_equity": {
+                    "type": "string"
+                },
+                "total_liabilities": {
+                    "type": "string"
+                }
+            }
+        },
+        "statement.IncomeStatementResponse": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "description": "From and To are the requested dates (YYYY-MM-DD), both inclusive",
+                    "type": "string"
+                },
+                "sections": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/statement.IncomeStatementSectionResponse"
+                    }
+                },
+                "to": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "statement.IncomeStatementSectionResponse": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string"
+                },
+                "expenses": {
+                    "$ref": "#/definitions/statement.StatementGroupResponse"
+                },
+                "income": {
+                    "$ref": "#/definitions/statement.StatementGroupResponse"
+                },
+                "net_income": {
+                    "type": "string"
+                }
+            }
+        },
+        "statement.StatementGroupResponse": {
+            "type": "object",
+            "properties": {
+                "lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/statement.StatementLineResponse"
+                    }
+                },
+                "total": {
+                    "type": "string"
+                },
+                "type": {
+                    "$ref": "#/definitions/constant.AccountType"
+                }
+            }
+        },
+        "statement.StatementLineResponse": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "description": "AccountID and Code are omitted for categories and computed lines such as retained earnings",
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "string"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "statement.TrialBalanceLineResponse": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "description": "AccountID and Code are omitted for lines that stand for transaction categories",
+                    "type": "string"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "credit": {
+                    "type": "string"
+                },
+                "debit": {
+                    "type": "string"
                 },
                 "name": {
                     "type": "string"
                 },
                 "type": {
                     "$ref": "#/definitions/constant.AccountType"
+                }
+            }
+        },
+        "statement.TrialBalanceResponse": {
+            "type": "object",
+            "properties": {
+                "as_of": {
+                    "description": "AsOf is the day (YYYY-MM-DD) the balances were taken at the end of",
+                    "type": "string"
+                },
+                "sections": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/statement.TrialBalanceSectionResponse"
+                    }
                 },
                 "user_id": {
                     "type": "string"
                 }
             }
         },
-        "account.UpdateAccountRequest": {
+        "statement.TrialBalanceSectionResponse": {
             "type": "object",
             "properties": {
                 "currency": {
                     "type": "string"
                 },
-                "name": {
+                "lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/statement.TrialBalanceLineResponse"
+                    }
+                },
+                "total_credit": {
                     "type": "string"
                 },
-                "type": {
-                    "$ref": "#/definitions/constant.AccountType"
+                "total_debit": {
+                    "type": "string"
                 }
             }
         },
-        "common.ProblemDetail": {
+        "statementimport.CSVMapping": {
             "type": "object",
             "properties": {
-                "detail": {
+                "amount_column": {
+                    "description": "AmountColumn is required with the SIGNED and INVERTED amount signs",
                     "type": "string"
                 },
-                "instance": {
+                "amount_sign": {
+                    "$ref": "#/definitions/constant.AmountSign"
+                },
+                "category_column": {
+                    "description": "CategoryColumn names the category of each row, ignoring case. A name\nseveral of the user's categories share fails the row.",
                     "type": "string"
                 },
-                "status": {
+                "credit_column": {
+                    "type": "string"
+                },
+                "date_column": {
+                    "type": "string"
+                },
+                "date_format": {
+                    "description": "DateFormat spells the date with YYYY or YY, MM and DD, e.g. DD.MM.YYYY",
+                    "type": "string"
+                },
+                "debit_column": {
+                    "description": "DebitColumn and CreditColumn are required with the DEBIT_CREDIT amount sign",
+                    "type": "string"
+                },
+                "decimal_separator": {
+                    "description": "DecimalSeparator is \".\" (the default) or \",\"",
+                    "type": "string"
+                },
+                "delimiter": {
+                    "description": "Delimiter separates fields, \",\" when omitted",
+                    "type": "string"
+                },
+                "description_column": {
+                    "type": "string"
+                },
+                "skip_rows": {
+                    "description": "SkipRows is the number of lines before the header row",
                     "type": "integer"
+                }
+            }
+        },
+        "statementimport.CreateImportProfileRequest": {
+            "type": "object",
+            "properties": {
+                "mapping": {
+                    "$ref": "#/definitions/statementimport.CSVMapping"
                 },
-                "title": {
+                "name": {
                     "type": "string"
                 },
-                "type": {
+                "user_id": {
                     "type": "string"
                 }
             }
         },
-        "common.ValidationError": {
+        "statementimport.ImportProfileResponse": {
             "type": "object",
             "properties": {
-                "field": {
+                "created_at": {
                     "type": "string"
                 },
-                "message": {
+                "id": {
+                    "type": "string"
+                },
+                "mapping": {
+                    "$ref": "#/definitions/statementimport.CSVMapping"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updated_at": {
+                    "type": "string"
+                },
+                "user_id": {
                     "type": "string"
                 }
             }
         },
-        "common.ValidationProblem": {
+        "statementimport.ImportResultResponse": {
             "type": "object",
             "properties": {
-                "detail": {
+                "account_id": {
                     "type": "string"
                 },
-                "errors": {
+                "duplicates": {
+                    "description": "Duplicates counts the rows not imported because they look like a\ntransaction already recorded on the account",
+                    "type": "integer"
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "imported": {
+                    "type": "integer"
+                },
+                "rows": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/common.ValidationError"
+                        "$ref": "#/definitions/statementimport.ImportRowResponse"
                     }
                 },
-                "instance": {
+                "skipped": {
+                    "type": "integer"
+                }
+            }
+        },
+        "statementimport.ImportRowResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
                     "type": "string"
                 },
-                "status": {
+                "line": {
+                    "description": "Line is the 1-based line of the row in the uploaded file",
                     "type": "integer"
                 },
-                "title": {
-                    "type": "string"
+                "status": {
+                    "$ref": "#/definitions/constant.ImportRowStatus"
                 },
-                "type": {
+                "transaction_id": {
                     "type": "string"
                 }
             }
         },
-        "constant.AccountType": {
-            "type": "string",
-            "enum": [
-                "CHECKING",
-                "SAVINGS",
-                "CREDIT_CARD",
-                "CASH",
-                "INVESTMENT"
-            ],
-            "x-enum-varnames": [
-                "AccountTypeChecking",
-                "AccountTypeSavings",
-                "AccountTypeCreditCard",
-                "AccountTypeCash",
-                "AccountTypeInvestment"
-            ]
-        },
-        "constant.TransactionType": {
-            "type": "string",
-            "enum": [
-                "INCOME",
-                "EXPENSE",
-                "TRANSFER"
-            ],
-            "x-enum-varnames": [
-                "TransactionTypeIncome",
-                "TransactionTypeExpense",
-                "TransactionTypeTransfer"
-            ]
+        "statementimport.UpdateImportProfileRequest": {
+            "type": "object",
+            "properties": {
+                "mapping": {
+                    "description": "Mapping replaces the whole stored mapping when given",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/statementimport.CSVMapping"
+                        }
+                    ]
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
         },
         "transaction.CreateTransactionRequest": {
             "type": "object",
@@ -898,10 +4701,15 @@ const docTemplate = `{
                 "account_id": {
                     "type": "string"
                 },
+                "allow_duplicate": {
+                    "description": "AllowDuplicate records the transaction even when it looks like one\nalready on the account",
+                    "type": "boolean"
+                },
                 "amount": {
-                    "type": "number"
+                    "type": "string"
                 },
-                "category": {
+                "category_id": {
+                    "description": "CategoryID is one of the user's categories; without it the user's\ncategorization rules, then the payee's default category, may set one",
                     "type": "string"
                 },
                 "currency": {
@@ -913,11 +4721,89 @@ const docTemplate = `{
                 "description": {
                     "type": "string"
                 },
+                "payee_id": {
+                    "description": "PayeeID is one of the user's payees; without it the payee whose name or\nalias the description contains is set",
+                    "type": "string"
+                },
+                "splits": {
+                    "description": "Splits divide the transaction between categories instead of\ncategory_id; there must be at least two, adding up to the amount",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/transaction.SplitRequest"
+                    }
+                },
+                "tags": {
+                    "description": "Tags label the transaction, e.g. \"reimbursable\"; tags the user does not\nhave yet are created",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "type": {
                     "$ref": "#/definitions/constant.TransactionType"
                 }
             }
         },
+        "transaction.SplitRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string"
+                },
+                "category_id": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "memo": {
+                    "type": "string"
+                }
+            }
+        },
+        "transaction.SplitResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "description": "Amount is in the account's currency",
+                    "type": "string"
+                },
+                "category": {
+                    "type": "string"
+                },
+                "category_id": {
+                    "description": "CategoryID and Category, the category's name, are empty for an\nuncategorized split",
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "memo": {
+                    "type": "string"
+                }
+            }
+        },
+        "transaction.TransactionListResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/transaction.TransactionResponse"
+                    }
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "next_cursor": {
+                    "description": "NextCursor fetches the following page when passed as cursor; omitted on the last page",
+                    "type": "string"
+                }
+            }
+        },
         "transaction.TransactionResponse": {
             "type": "object",
             "properties": {
@@ -925,11 +4811,15 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "amount": {
-                    "type": "number"
+                    "type": "string"
                 },
                 "category": {
                     "type": "string"
                 },
+                "category_id": {
+                    "description": "CategoryID and Category, the category's name, are empty for\nuncategorized transactions, split transactions and transfer legs",
+                    "type": "string"
+                },
                 "currency": {
                     "type": "string"
                 },
@@ -939,9 +4829,54 @@ const docTemplate = `{
                 "description": {
                     "type": "string"
                 },
+                "exchange_rate": {
+                    "type": "string"
+                },
+                "external_id": {
+                    "description": "ExternalID is the bank's identifier of a transaction imported from a statement",
+                    "type": "string"
+                },
                 "id": {
                     "type": "string"
                 },
+                "original_amount": {
+                    "description": "OriginalAmount, OriginalCurrency and ExchangeRate are set when the amount\nwas entered in another currency and converted into the account's currency",
+                    "type": "string"
+                },
+                "original_currency": {
+                    "type": "string"
+                },
+                "payee": {
+                    "type": "string"
+                },
+                "payee_id": {
+                    "description": "PayeeID and Payee, the payee's name, are empty when the payee is unknown",
+                    "type": "string"
+                },
+                "running_balance": {
+                    "description": "RunningBalance is the account balance just after the transaction, set\nwhen the listing is requested with running_balance=true",
+                    "type": "string"
+                },
+                "splits": {
+                    "description": "Splits divide a split transaction between categories",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/transaction.SplitResponse"
+                    }
+                },
+                "tags": {
+                    "description": "Tags are in lower case and sorted",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "transfer_direction": {
+                    "$ref": "#/definitions/constant.TransferDirection"
+                },
+                "transfer_id": {
+                    "type": "string"
+                },
                 "type": {
                     "$ref": "#/definitions/constant.TransactionType"
                 }
@@ -950,10 +4885,13 @@ const docTemplate = `{
         "transaction.UpdateTransactionRequest": {
             "type": "object",
             "properties": {
+                "account_id": {
+                    "type": "string"
+                },
                 "amount": {
-                    "type": "number"
+                    "type": "string"
                 },
-                "category": {
+                "category_id": {
                     "type": "string"
                 },
                 "currency": {
@@ -965,14 +4903,72 @@ const docTemplate = `{
                 "description": {
                     "type": "string"
                 },
+                "payee_id": {
+                    "type": "string"
+                },
+                "splits": {
+                    "description": "Splits replace the transaction's splits and category unless null or\nabsent; an empty list removes them all",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/transaction.SplitRequest"
+                    }
+                },
+                "tags": {
+                    "description": "Tags replace the transaction's tags unless null or absent; an empty list\nremoves them all",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "type": {
                     "$ref": "#/definitions/constant.TransactionType"
                 }
             }
         },
+        "transfer.CreateTransferRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "from_account_id": {
+                    "type": "string"
+                },
+                "to_account_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "transfer.TransferResponse": {
+            "type": "object",
+            "properties": {
+                "credit": {
+                    "$ref": "#/definitions/transaction.TransactionResponse"
+                },
+                "debit": {
+                    "$ref": "#/definitions/transaction.TransactionResponse"
+                },
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
         "user.CreateUserRequest": {
             "type": "object",
             "properties": {
+                "base_currency": {
+                    "description": "BaseCurrency is the ISO 4217 code reports convert totals into; defaults to USD",
+                    "type": "string"
+                },
                 "email": {
                     "type": "string"
                 },
@@ -984,6 +4980,9 @@ const docTemplate = `{
         "user.UpdateUserRequest": {
             "type": "object",
             "properties": {
+                "base_currency": {
+                    "type": "string"
+                },
                 "email": {
                     "type": "string"
                 },
@@ -995,6 +4994,9 @@ const docTemplate = `{
         "user.UserResponse": {
             "type": "object",
             "properties": {
+                "base_currency": {
+                    "type": "string"
+                },
                 "email": {
                     "type": "string"
                 },
@@ -1016,7 +5018,7 @@ var SwaggerInfo = &swag.Spec{
 	BasePath:         "/",
 	Schemes:          []string{"http", "https"},
 	Title:            "Accounting API",
-	Description:      "A REST API for personal accounting management with support for users, accounts, and transactions",
+	Description:      "A REST API for personal accounting management with support for users, accounts, transactions, a double-entry journal and multi-currency conversion",
 	InfoInstanceName: "swagger",
 	SwaggerTemplate:  docTemplate,
 	LeftDelim:        "{{",
@@ -0,0 +1,13 @@
+package constant
+
+// AccountClass is the accounting class of an account, used to group accounts
+// in the chart of accounts and in financial statements.
+type AccountClass string
+
+const (
+	AccountClassAsset     AccountClass = "ASSET"
+	AccountClassLiability AccountClass = "LIABILITY"
+	AccountClassEquity    AccountClass = "EQUITY"
+	AccountClassIncome    AccountClass = "INCOME"
+	AccountClassExpense   AccountClass = "EXPENSE"
+)
@@ -8,4 +8,45 @@ const (
 	AccountTypeCreditCard AccountType = "CREDIT_CARD"
 	AccountTypeCash       AccountType = "CASH"
 	AccountTypeInvestment AccountType = "INVESTMENT"
+	// AccountTypeIncome and AccountTypeExpense are category accounts used as the
+	// counterpart of money accounts in journal entries.
+	AccountTypeIncome  AccountType = "INCOME"
+	AccountTypeExpense AccountType = "EXPENSE"
+	// AccountTypeEquity holds opening balances and retained earnings.
+	AccountTypeEquity AccountType = "EQUITY"
 )
+
+// AccountTypes lists every supported account type.
+var AccountTypes = []AccountType{
+	AccountTypeChecking,
+	AccountTypeSavings,
+	AccountTypeCreditCard,
+	AccountTypeCash,
+	AccountTypeInvestment,
+	AccountTypeIncome,
+	AccountTypeExpense,
+	AccountTypeEquity,
+}
+
+// Class returns the accounting class the account type belongs to.
+func (t AccountType) Class() AccountClass {
+	switch t {
+	case AccountTypeCreditCard:
+		return AccountClassLiability
+	case AccountTypeIncome:
+		return AccountClassIncome
+	case AccountTypeExpense:
+		return AccountClassExpense
+	case AccountTypeEquity:
+		return AccountClassEquity
+	default:
+		return AccountClassAsset
+	}
+}
+
+// IsCreditNormal reports whether credits, rather than debits, increase the
+// balance of accounts of this type. Money accounts, credit cards included,
+// keep a signed balance where a negative value means money owed.
+func (t AccountType) IsCreditNormal() bool {
+	return t == AccountTypeIncome || t == AccountTypeEquity
+}
@@ -0,0 +1,24 @@
+package constant
+
+// AmountSign is the convention a bank statement uses to tell money coming in
+// from money going out.
+type AmountSign string
+
+const (
+	// AmountSignSigned statements hold one amount column where negative
+	// amounts are expenses and positive amounts income.
+	AmountSignSigned AmountSign = "SIGNED"
+	// AmountSignInverted statements, typical of credit cards, hold one amount
+	// column where positive amounts are expenses and negative amounts income.
+	AmountSignInverted AmountSign = "INVERTED"
+	// AmountSignDebitCredit statements hold expenses in a debit column and
+	// income in a credit column, leaving the other one empty.
+	AmountSignDebitCredit AmountSign = "DEBIT_CREDIT"
+)
+
+// AmountSigns lists every supported amount sign convention.
+var AmountSigns = []AmountSign{
+	AmountSignSigned,
+	AmountSignInverted,
+	AmountSignDebitCredit,
+}
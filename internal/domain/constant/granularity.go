@@ -0,0 +1,31 @@
+package constant
+
+import "time"
+
+// Granularity is the length of the periods a report groups amounts into.
+type Granularity string
+
+const (
+	GranularityMonth   Granularity = "month"
+	GranularityQuarter Granularity = "quarter"
+	GranularityYear    Granularity = "year"
+)
+
+// Granularities lists every supported report granularity.
+var Granularities = []Granularity{
+	GranularityMonth,
+	GranularityQuarter,
+	GranularityYear,
+}
+
+// PeriodEnd returns the exclusive end of the period starting at start.
+func (g Granularity) PeriodEnd(start time.Time) time.Time {
+	switch g {
+	case GranularityQuarter:
+		return start.AddDate(0, 3, 0)
+	case GranularityYear:
+		return start.AddDate(1, 0, 0)
+	default:
+		return start.AddDate(0, 1, 0)
+	}
+}
@@ -0,0 +1,14 @@
+package constant
+
+// ImportRowStatus is the outcome of importing one row of a bank statement.
+type ImportRowStatus string
+
+const (
+	ImportRowStatusImported ImportRowStatus = "IMPORTED"
+	ImportRowStatusFailed   ImportRowStatus = "FAILED"
+	// ImportRowStatusSkipped marks a row already imported by an earlier import.
+	ImportRowStatusSkipped ImportRowStatus = "SKIPPED"
+	// ImportRowStatusDuplicate marks a row that was not imported because it
+	// looks like a transaction already recorded on the account.
+	ImportRowStatusDuplicate ImportRowStatus = "DUPLICATE"
+)
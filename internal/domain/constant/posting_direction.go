@@ -0,0 +1,9 @@
+package constant
+
+// PostingDirection tells whether a journal posting debits or credits its account.
+type PostingDirection string
+
+const (
+	PostingDirectionDebit  PostingDirection = "DEBIT"
+	PostingDirectionCredit PostingDirection = "CREDIT"
+)
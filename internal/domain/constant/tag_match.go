@@ -0,0 +1,17 @@
+package constant
+
+// TagMatch tells how a transaction listing filtered by several tags matches them.
+type TagMatch string
+
+const (
+	// TagMatchAny keeps transactions with at least one of the tags.
+	TagMatchAny TagMatch = "any"
+	// TagMatchAll keeps transactions with every one of the tags.
+	TagMatchAll TagMatch = "all"
+)
+
+// TagMatches lists every supported tag match; the first is the default.
+var TagMatches = []TagMatch{
+	TagMatchAny,
+	TagMatchAll,
+}
@@ -0,0 +1,30 @@
+package constant
+
+// TransactionSort selects the order of a transaction listing. Ties are broken
+// by insertion time, so every order is stable across pages.
+type TransactionSort string
+
+const (
+	TransactionSortDateDesc   TransactionSort = "date_desc"
+	TransactionSortDateAsc    TransactionSort = "date_asc"
+	TransactionSortAmountDesc TransactionSort = "amount_desc"
+	TransactionSortAmountAsc  TransactionSort = "amount_asc"
+)
+
+// TransactionSorts lists every supported transaction order; the first is the default.
+var TransactionSorts = []TransactionSort{
+	TransactionSortDateDesc,
+	TransactionSortDateAsc,
+	TransactionSortAmountDesc,
+	TransactionSortAmountAsc,
+}
+
+// Ascending reports whether the order lists the smallest date or amount first.
+func (s TransactionSort) Ascending() bool {
+	return s == TransactionSortDateAsc || s == TransactionSortAmountAsc
+}
+
+// ByAmount reports whether the order is primarily by amount rather than date.
+func (s TransactionSort) ByAmount() bool {
+	return s == TransactionSortAmountDesc || s == TransactionSortAmountAsc
+}
@@ -7,3 +7,10 @@ const (
 	TransactionTypeExpense  TransactionType = "EXPENSE"
 	TransactionTypeTransfer TransactionType = "TRANSFER"
 )
+
+// TransactionTypes lists every transaction type, transfer legs included.
+var TransactionTypes = []TransactionType{
+	TransactionTypeIncome,
+	TransactionTypeExpense,
+	TransactionTypeTransfer,
+}
@@ -0,0 +1,9 @@
+package constant
+
+// TransferDirection tells which side of a transfer a TRANSFER transaction records.
+type TransferDirection string
+
+const (
+	TransferDirectionOut TransferDirection = "OUT"
+	TransferDirectionIn  TransferDirection = "IN"
+)
@@ -2,6 +2,7 @@ package entity
 
 import (
 	"accounting/internal/domain/constant"
+	"accounting/internal/domain/money"
 )
 
 // Account represents a financial account belonging to a user.
@@ -10,12 +11,39 @@ type Account struct {
 	ID string
 	// UserID is the ID of the user who owns this account.
 	UserID string
+	// ParentID is the ID of the parent account in the chart of accounts, or empty for a root account.
+	ParentID string
+	// Code is the optional chart-of-accounts code (e.g., 1000, 4100.10), unique per user.
+	Code string
 	// Name is the display name of the account.
 	Name string
 	// Type is the account type (e.g., checking, savings, credit).
 	Type constant.AccountType
-	// Balance is the current balance of the account.
-	Balance float64
+	// Balance is the current balance of the account, denominated in Currency.
+	Balance money.Money
 	// Currency is the ISO 4217 currency code (e.g., USD, EUR).
 	Currency string
 }
+
+// AccountNode is an account in the chart-of-accounts tree.
+type AccountNode struct {
+	Account *Account
+	// Children are the direct sub-accounts, ordered by code and name.
+	Children []*AccountNode
+	// RolledUpBalances is the sum of the balances of the account and all its
+	// descendants, one amount per currency, ordered by currency code.
+	RolledUpBalances []money.Money
+	// RolledUpTotal is RolledUpBalances converted into a requested base
+	// currency and summed. It is the zero value when no base currency was given.
+	RolledUpTotal money.Money
+}
+
+// AccountActivity counts what is recorded against an account.
+type AccountActivity struct {
+	// Transactions counts the account's transactions, transfer legs included.
+	Transactions int
+	// TransferLegs counts the account's legs of transfers.
+	TransferLegs int
+	// Postings counts the journal postings to the account.
+	Postings int
+}
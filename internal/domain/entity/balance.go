@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"time"
+
+	"accounting/internal/domain/money"
+)
+
+// DailyBalance is an account's balance at the end of a calendar day (UTC).
+type DailyBalance struct {
+	// Date is the start of the day.
+	Date    time.Time
+	Balance money.Money
+}
+
+// BalanceSnapshot caches an account's balance at the end of a day, so that
+// historical balances only replay the history booked after it.
+type BalanceSnapshot struct {
+	AccountID string
+	// Date is the start of the day the balance was taken at the end of.
+	Date    time.Time
+	Balance money.Money
+}
+
+// DailyMovement is what was booked to an account on one calendar day.
+type DailyMovement struct {
+	// Date is the start of the day.
+	Date time.Time
+	// Net is the signed total of the day's transactions.
+	Net money.Money
+	// Debits and Credits total the day's journal postings to the account.
+	Debits  money.Money
+	Credits money.Money
+}
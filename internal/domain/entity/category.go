@@ -0,0 +1,33 @@
+package entity
+
+import (
+	"time"
+
+	"accounting/internal/domain/constant"
+)
+
+// Category groups a user's income and expense transactions, such as
+// Groceries, and may be nested under a parent category, such as Food.
+type Category struct {
+	// ID is the unique identifier for the category (UUID).
+	ID string
+	// UserID is the ID of the user who owns the category.
+	UserID string
+	// ParentID is the ID of the parent category, or empty for a top-level category.
+	ParentID string
+	// Name is unique per user, ignoring case.
+	Name string
+	// Type restricts the category to INCOME or EXPENSE transactions. It is
+	// empty for a category used for both.
+	Type constant.TransactionType
+	// Archived categories stay on the transactions they were given to, but
+	// cannot be given to other transactions or set by rules.
+	Archived  bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Allows tells whether transactions of the given type may be in the category.
+func (c *Category) Allows(transactionType constant.TransactionType) bool {
+	return c.Type == "" || c.Type == transactionType
+}
@@ -0,0 +1,80 @@
+package entity
+
+import (
+	"time"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/money"
+)
+
+// RuleConditions select the transactions a categorization rule applies to.
+// Every condition that is set must hold; unset conditions match anything.
+type RuleConditions struct {
+	// DescriptionContains matches descriptions containing the text, ignoring case.
+	DescriptionContains string
+	// DescriptionPattern is a regular expression in RE2 syntax the
+	// description must match, e.g. (?i)^amzn mktp.
+	DescriptionPattern string
+	// MinAmount and MaxAmount bound the amount inclusively. They share a
+	// currency and only match transactions whose amount, as stored or as
+	// entered, is in that currency. The zero value leaves a side unbounded.
+	MinAmount money.Money
+	MaxAmount money.Money
+	// AccountID restricts the rule to one of the user's accounts.
+	AccountID string
+	// Type restricts the rule to income or to expenses.
+	Type constant.TransactionType
+}
+
+// RuleActions are the changes a categorization rule makes to the
+// transactions it matches. Empty actions leave the field unchanged.
+type RuleActions struct {
+	// CategoryID is the ID of the category given to the transactions.
+	CategoryID  string
+	Description string
+	// Tags are added to the tags of the transactions, in lower case and sorted.
+	Tags []string
+}
+
+// CategoryRule categorizes a user's transactions automatically: a transaction
+// entered without a category takes the actions of the first rule it matches.
+type CategoryRule struct {
+	// ID is the unique identifier for the rule (UUID).
+	ID string
+	// UserID is the ID of the user who owns the rule.
+	UserID string
+	Name   string
+	// Priority orders the user's rules, lowest first; rules of the same
+	// priority are tried in order of name.
+	Priority   int
+	Conditions RuleConditions
+	Actions    RuleActions
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// RuleChange is a change that applying categorization rules makes, or would
+// make, to a transaction.
+type RuleChange struct {
+	TransactionID string
+	// RuleID is the ID of the rule the transaction matched.
+	RuleID              string
+	PreviousCategoryID  string
+	CategoryID          string
+	PreviousDescription string
+	Description         string
+	PreviousTags        []string
+	Tags                []string
+}
+
+// RuleApplication reports the outcome of applying a user's categorization
+// rules to their existing transactions.
+type RuleApplication struct {
+	UserID string
+	// DryRun tells that the changes were only previewed, not saved.
+	DryRun bool
+	// Matched counts the transactions that matched a rule, changed or not.
+	Matched int
+	// Changes lists the transactions a rule changed, oldest first.
+	Changes []*RuleChange
+}
@@ -0,0 +1,20 @@
+package entity
+
+import (
+	"time"
+
+	"accounting/internal/domain/money"
+)
+
+// ExchangeRate is the rate at which one unit of BaseCurrency converts into
+// QuoteCurrency, effective from Date until the next rate for the same pair.
+type ExchangeRate struct {
+	// BaseCurrency is the ISO 4217 code of the currency being converted from.
+	BaseCurrency string
+	// QuoteCurrency is the ISO 4217 code of the currency being converted to.
+	QuoteCurrency string
+	// Date is the day from which the rate applies.
+	Date time.Time
+	// Rate is the number of QuoteCurrency units per BaseCurrency unit.
+	Rate money.Rate
+}
@@ -0,0 +1,72 @@
+package entity
+
+import (
+	"time"
+
+	"accounting/internal/domain/constant"
+)
+
+// CSVMapping describes how the columns of a bank's CSV statement map onto
+// transactions. Columns are referred to by their header, matched without
+// regard to case or surrounding spaces.
+type CSVMapping struct {
+	// Delimiter separates the fields of a row, "," when empty.
+	Delimiter string
+	// SkipRows is the number of lines before the header row, such as a bank's
+	// preamble with the account holder and statement period.
+	SkipRows int
+	// DateColumn holds the transaction date, written in DateFormat.
+	DateColumn string
+	// DateFormat spells the date with the tokens YYYY, YY, MM and DD, e.g. DD.MM.YYYY.
+	DateFormat string
+	// AmountSign is the convention telling income from expenses.
+	AmountSign constant.AmountSign
+	// AmountColumn holds the amount for the SIGNED and INVERTED conventions.
+	AmountColumn string
+	// DebitColumn and CreditColumn hold the amount for the DEBIT_CREDIT convention.
+	DebitColumn  string
+	CreditColumn string
+	// DescriptionColumn and CategoryColumn are optional.
+	DescriptionColumn string
+	CategoryColumn    string
+	// DecimalSeparator is "." or ","; the other one is taken as a thousands separator.
+	DecimalSeparator string
+}
+
+// ImportProfile is a CSV mapping saved by a user under a name, typically one per bank.
+type ImportProfile struct {
+	// ID is the unique identifier for the profile (UUID).
+	ID string
+	// UserID is the ID of the user who owns the profile.
+	UserID string
+	// Name is unique per user.
+	Name      string
+	Mapping   CSVMapping
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ImportRow is the outcome of importing one row of a statement.
+type ImportRow struct {
+	// Line is the 1-based line of the row in the uploaded file.
+	Line   int
+	Status constant.ImportRowStatus
+	// TransactionID is set for imported rows, for skipped rows to the
+	// transaction imported earlier, and for duplicate rows to the transaction
+	// the row looks like.
+	TransactionID string
+	// Error explains why the row was not imported.
+	Error string
+}
+
+// ImportResult reports the outcome of importing a statement into an account,
+// row by row in file order.
+type ImportResult struct {
+	AccountID string
+	Rows      []*ImportRow
+	Imported  int
+	Failed    int
+	Skipped   int
+	// Duplicates counts the rows flagged as likely duplicates.
+	Duplicates int
+}
@@ -0,0 +1,37 @@
+package entity
+
+import (
+	"time"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/money"
+)
+
+// JournalEntry is a balanced double-entry record: for every currency, the sum
+// of its debit postings equals the sum of its credit postings.
+type JournalEntry struct {
+	// ID is the unique identifier for the entry (UUID).
+	ID string
+	// UserID is the ID of the user whose ledger the entry belongs to.
+	UserID string
+	// Date is the date when the entry takes effect.
+	Date time.Time
+	// Description is an optional description of the entry.
+	Description string
+	// Postings are the debit and credit lines of the entry, in order.
+	Postings []*Posting
+}
+
+// Posting is a single debit or credit line of a journal entry.
+type Posting struct {
+	// ID is the unique identifier for the posting (UUID).
+	ID string
+	// AccountID is the ID of the account the posting is made against.
+	AccountID string
+	// Direction tells whether the posting debits or credits the account.
+	Direction constant.PostingDirection
+	// Amount is the posted amount (always positive), in the account's currency.
+	Amount money.Money
+	// Memo is an optional note for this line.
+	Memo string
+}
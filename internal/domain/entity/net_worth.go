@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"time"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/money"
+)
+
+// NetWorthAccount is one asset or liability account's share of a user's net worth.
+type NetWorthAccount struct {
+	AccountID string
+	Name      string
+	Type      constant.AccountType
+	// Balance is in the account's currency and Converted is the same balance
+	// in the user's base currency.
+	Balance   money.Money
+	Converted money.Money
+}
+
+// NetWorthPoint is a user's net worth at the end of one day, in the user's
+// base currency.
+type NetWorthPoint struct {
+	Date time.Time
+	// Assets totals the asset accounts. Liabilities is the amount owed on
+	// liability accounts, positive when money is owed.
+	Assets      money.Money
+	Liabilities money.Money
+	// NetWorth is Assets minus Liabilities.
+	NetWorth money.Money
+}
+
+// NetWorth is what a user owns minus what they owe across their asset and
+// liability accounts. Income, expense and equity accounts are not included.
+type NetWorth struct {
+	UserID string
+	// Currency is the user's base currency, which every total is converted into.
+	Currency string
+	// Current is computed from today's balances at today's exchange rates.
+	Current NetWorthPoint
+	// Accounts breaks Current down by account.
+	Accounts []*NetWorthAccount
+	// History holds the net worth at each month end in the requested range,
+	// oldest first, at the rates of each date. The last point is the end of
+	// the range even when that is not a month end.
+	History []*NetWorthPoint
+}
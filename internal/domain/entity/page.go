@@ -0,0 +1,17 @@
+package entity
+
+const (
+	// DefaultPageLimit is the page size used when a listing does not ask for one.
+	DefaultPageLimit = 50
+	// MaxPageLimit is the largest page size a listing may ask for.
+	MaxPageLimit = 200
+)
+
+// PageRequest selects one page of a cursor-paginated listing.
+type PageRequest struct {
+	// Limit is the maximum number of items to return. Zero returns every
+	// remaining item and is meant for internal callers only.
+	Limit int
+	// Cursor is the opaque NextCursor of the previous page, or empty for the first page.
+	Cursor string
+}
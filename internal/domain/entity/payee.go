@@ -0,0 +1,22 @@
+package entity
+
+import "time"
+
+// Payee is someone a user pays or is paid by, such as a shop or an employer.
+type Payee struct {
+	// ID is the unique identifier for the payee (UUID).
+	ID string
+	// UserID is the ID of the user who owns the payee.
+	UserID string
+	// Name is unique per user, ignoring case.
+	Name string
+	// Aliases are other names the payee goes by in transaction descriptions,
+	// such as "AMZN Mktp" for Amazon. They are unique among the user's
+	// payees, ignoring case.
+	Aliases []string
+	// DefaultCategoryID is the ID of the category given to the payee's
+	// transactions that get no other, or empty for none.
+	DefaultCategoryID string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
@@ -0,0 +1,129 @@
+package entity
+
+import (
+	"time"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/money"
+)
+
+// CategoryTotal is the income and expense booked in one category during one
+// report period, in one currency.
+type CategoryTotal struct {
+	// PeriodStart is the first instant of the period.
+	PeriodStart time.Time
+	// CategoryID is empty for uncategorized transactions.
+	CategoryID string
+	Income     money.Money
+	Expense    money.Money
+}
+
+// CategorySummary is the cash flow of one category within a period, its
+// subcategories included.
+type CategorySummary struct {
+	// CategoryID and Category are empty for uncategorized transactions.
+	CategoryID string
+	Category   string
+	Income     money.Money
+	Expense    money.Money
+	// NetCashFlow is Income minus Expense.
+	NetCashFlow money.Money
+	// Children breaks the totals down by subcategory, sorted by name. They
+	// fall short of the totals by what was booked in the category itself.
+	Children []*CategorySummary
+}
+
+// PeriodSummary is the cash flow of a user's accounts during one period in one
+// currency. A period with activity in several currencies has one summary per currency.
+type PeriodSummary struct {
+	// PeriodStart and PeriodEnd bound the period; PeriodEnd is exclusive.
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	Currency    string
+	Income      money.Money
+	Expense     money.Money
+	// NetCashFlow is Income minus Expense.
+	NetCashFlow money.Money
+	// Categories breaks the totals down by top-level category, sorted by name.
+	Categories []*CategorySummary
+}
+
+// TagTotal is the income and expense of the transactions with one tag, in
+// one currency.
+type TagTotal struct {
+	Tag     string
+	Income  money.Money
+	Expense money.Money
+}
+
+// TagSummary is the cash flow of the transactions with one tag in one currency.
+type TagSummary struct {
+	Tag      string
+	Currency string
+	Income   money.Money
+	Expense  money.Money
+	// NetCashFlow is Income minus Expense.
+	NetCashFlow money.Money
+}
+
+// TagReport is a user's income and expense between two dates per tag. A
+// transaction with several tags counts towards each of them, so the totals
+// of different tags may overlap. Transfers are excluded.
+type TagReport struct {
+	UserID string
+	// From is inclusive and To exclusive.
+	From time.Time
+	To   time.Time
+	// Tags lists the tags with activity by name, then currency.
+	Tags []*TagSummary
+}
+
+// PayeeTotal is the income and expense of the transactions of one payee, in
+// one currency.
+type PayeeTotal struct {
+	// PayeeID is empty for the transactions without a payee.
+	PayeeID string
+	Payee   string
+	Income  money.Money
+	Expense money.Money
+}
+
+// PayeeSummary is the cash flow of the transactions of one payee in one currency.
+type PayeeSummary struct {
+	// PayeeID and Payee, the payee's name, are empty for the transactions
+	// without a payee.
+	PayeeID  string
+	Payee    string
+	Currency string
+	Income   money.Money
+	Expense  money.Money
+	// NetCashFlow is Income minus Expense.
+	NetCashFlow money.Money
+}
+
+// PayeeReport is a user's income and expense between two dates per payee.
+// Transfers are excluded.
+type PayeeReport struct {
+	UserID string
+	// From is inclusive and To exclusive.
+	From time.Time
+	To   time.Time
+	// Payees lists the payees with activity by name, then currency, followed
+	// by the transactions without a payee.
+	Payees []*PayeeSummary
+}
+
+// Summary is a user's income and expense between two dates, per period.
+// Transfers between the user's accounts are not cash flow and are excluded.
+type Summary struct {
+	UserID string
+	// From is inclusive and To exclusive.
+	From        time.Time
+	To          time.Time
+	Granularity constant.Granularity
+	// BaseCurrency is set when the amounts of each period were converted into
+	// it and totalled together, rather than reported per currency.
+	BaseCurrency string
+	// Periods lists the periods with activity, oldest first.
+	Periods []*PeriodSummary
+}
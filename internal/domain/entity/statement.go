@@ -0,0 +1,118 @@
+package entity
+
+import (
+	"time"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/money"
+)
+
+// AccountMovement is the net effect of an account's bookings over a period,
+// as debits minus credits in the account's currency. A transaction counts as
+// a debit when it increases a money account and as a credit when it
+// decreases it.
+type AccountMovement struct {
+	AccountID string
+	NetDebit  money.Money
+}
+
+// CategoryMovement totals a user's transactions of one type and category in
+// one currency over a period. Transfers have no category; their Amount is the
+// money transferred in minus the money transferred out, which is zero unless
+// money moved between currencies.
+type CategoryMovement struct {
+	Type     constant.TransactionType
+	Category string
+	Amount   money.Money
+}
+
+// TrialBalanceLine is the balance of one account, or of one transaction
+// category standing in for the income or expense account that single-entry
+// transactions leave implicit, as a debit or a credit.
+type TrialBalanceLine struct {
+	// AccountID and Code are empty for lines that are not accounts.
+	AccountID string
+	Code      string
+	Name      string
+	Type      constant.AccountType
+	Debit     money.Money
+	Credit    money.Money
+}
+
+// TrialBalanceSection is the part of a trial balance in one currency. Its
+// total debits and credits are equal.
+type TrialBalanceSection struct {
+	Currency    string
+	Lines       []*TrialBalanceLine
+	TotalDebit  money.Money
+	TotalCredit money.Money
+}
+
+// TrialBalance lists every non-zero account and category balance of a user
+// at the end of AsOf, ordered by account type.
+type TrialBalance struct {
+	UserID   string
+	AsOf     time.Time
+	Sections []*TrialBalanceSection
+}
+
+// StatementLine is one amount on a balance sheet or an income statement,
+// positive on the side that increases its group.
+type StatementLine struct {
+	// AccountID and Code are empty for categories and computed lines such as
+	// retained earnings.
+	AccountID string
+	Code      string
+	Name      string
+	Amount    money.Money
+}
+
+// StatementGroup is the lines of one account type on a statement.
+type StatementGroup struct {
+	Type  constant.AccountType
+	Lines []*StatementLine
+	Total money.Money
+}
+
+// BalanceSheetSection is the part of a balance sheet in one currency, where
+// TotalAssets equals TotalLiabilities plus TotalEquity.
+type BalanceSheetSection struct {
+	Currency string
+	// Assets and Liabilities hold one group per account type with a balance.
+	Assets      []*StatementGroup
+	Liabilities []*StatementGroup
+	// Equity holds the equity accounts, the retained earnings and any
+	// imbalance left by transfers between currencies.
+	Equity           *StatementGroup
+	TotalAssets      money.Money
+	TotalLiabilities money.Money
+	TotalEquity      money.Money
+}
+
+// BalanceSheet is what a user owns, owes and has accumulated at the end of AsOf.
+type BalanceSheet struct {
+	UserID   string
+	AsOf     time.Time
+	Sections []*BalanceSheetSection
+}
+
+// IncomeStatementSection is the part of an income statement in one currency.
+type IncomeStatementSection struct {
+	Currency string
+	// Income and Expenses list the income and expense accounts followed by
+	// the transaction categories.
+	Income   *StatementGroup
+	Expenses *StatementGroup
+	// NetIncome is the income total minus the expense total.
+	NetIncome money.Money
+}
+
+// IncomeStatement is a user's income and expenses between two dates.
+// Transfers are not income or expenses and are left out.
+type IncomeStatement struct {
+	UserID string
+	// From is inclusive and To exclusive.
+	From     time.Time
+	To       time.Time
+	Sections []*IncomeStatementSection
+}
@@ -2,6 +2,7 @@ package entity
 
 import (
 	"accounting/internal/domain/constant"
+	"accounting/internal/domain/money"
 	"time"
 )
 
@@ -11,16 +12,62 @@ type Transaction struct {
 	ID string
 	// AccountID is the ID of the account this transaction belongs to.
 	AccountID string
-	// Amount is the transaction amount (always positive).
-	Amount float64
-	// Currency is the ISO 4217 currency code (e.g., USD, EUR).
-	Currency string
+	// Amount is the transaction amount (always positive) in the account's currency.
+	Amount money.Money
+	// OriginalAmount is the amount as entered when it was in another currency
+	// and converted into Amount. It is the zero value otherwise.
+	OriginalAmount money.Money
+	// ExchangeRate is the rate used to convert OriginalAmount into Amount.
+	ExchangeRate money.Rate
 	// Description is an optional description of the transaction.
 	Description string
+	// PayeeID is the ID of the payee the transaction was paid to or received
+	// from, or empty if unknown.
+	PayeeID string
+	// Payee is the name of the payee. Reads fill it in; it is not stored with
+	// the transaction.
+	Payee string
 	// Date is the date when the transaction occurred.
 	Date time.Time
-	// Type indicates whether this is income or expense.
+	// Type indicates whether this is income, expense or one leg of a transfer.
 	Type constant.TransactionType
-	// Category is the transaction category (e.g., groceries, salary).
+	// CategoryID is the ID of the transaction's category. It is empty for
+	// uncategorized transactions, split transactions and transfer legs.
+	CategoryID string
+	// Category is the name of the category. Reads fill it in; it is not stored
+	// with the transaction.
 	Category string
+	// Splits divide the transaction between categories, in order. Their
+	// amounts add up to Amount. Most transactions have none.
+	Splits []*Split
+	// Tags are the names of the user's tags on the transaction, in lower case
+	// and sorted.
+	Tags []string
+	// TransferID links the two legs of a transfer. It is empty for other types.
+	TransferID string
+	// TransferDirection tells whether a transfer leg debits or credits the account.
+	TransferDirection constant.TransferDirection
+	// ExternalID is the identifier the bank gave the transaction in an imported
+	// statement, e.g. an OFX FITID. It is unique within the account and empty
+	// for transactions entered by hand.
+	ExternalID string
+	// RunningBalance is the account balance just after this transaction,
+	// counting journal postings too. Listings fill it only when asked to; it
+	// is the zero value otherwise.
+	RunningBalance money.Money
+}
+
+// Split is one line of a transaction divided between categories.
+type Split struct {
+	// ID is the unique identifier for the split (UUID).
+	ID string
+	// Amount is the part of the transaction's amount (always positive) on
+	// this line, in the account's currency.
+	Amount money.Money
+	// CategoryID is the ID of the line's category, or empty if uncategorized.
+	CategoryID string
+	// Category is the name of the category. Reads fill it in.
+	Category string
+	// Memo is an optional note for this line.
+	Memo string
 }
@@ -0,0 +1,39 @@
+package entity
+
+import (
+	"time"
+
+	"accounting/internal/domain/constant"
+)
+
+// TransactionFilter narrows and orders a transaction listing. Zero-valued
+// fields do not filter.
+type TransactionFilter struct {
+	// From is the inclusive lower bound of the transaction date.
+	From time.Time
+	// To is the exclusive upper bound of the transaction date.
+	To time.Time
+	// Type keeps only transactions of this type.
+	Type constant.TransactionType
+	// CategoryIDs keeps only transactions in any of these categories or
+	// their subcategories.
+	CategoryIDs []string
+	// Tags keeps only transactions with these tags, any or all of them as
+	// TagMatch tells.
+	Tags []string
+	// TagMatch is how Tags match; empty means constant.TagMatchAny.
+	TagMatch constant.TagMatch
+	// MinAmount and MaxAmount are inclusive decimal bounds on the amount, in
+	// the account's currency.
+	MinAmount string
+	MaxAmount string
+	// Description keeps only transactions whose description contains this
+	// text, ignoring case.
+	Description string
+	// Sort is the listing order; empty means constant.TransactionSortDateDesc.
+	Sort constant.TransactionSort
+	// RunningBalance asks for each transaction's RunningBalance to be filled.
+	// It is computed over the account's whole history, so it does not depend
+	// on the other fields or on pagination.
+	RunningBalance bool
+}
@@ -0,0 +1,12 @@
+package entity
+
+// Transfer represents money moved between two accounts, recorded as a linked
+// pair of TRANSFER transactions that share the transfer ID.
+type Transfer struct {
+	// ID is the unique identifier for the transfer (UUID), stored on both legs.
+	ID string
+	// Debit is the outgoing leg on the source account.
+	Debit *Transaction
+	// Credit is the incoming leg on the destination account.
+	Credit *Transaction
+}
@@ -12,6 +12,9 @@ type User struct {
 	Name string
 	// Email is the user's email address (must be unique).
 	Email string
+	// BaseCurrency is the ISO 4217 code that cross-account totals such as net
+	// worth are converted into.
+	BaseCurrency string
 	// CreatedAt is the timestamp when the user was created.
 	CreatedAt time.Time
 	// UpdatedAt is the timestamp when the user was last updated.
@@ -60,3 +60,85 @@ func (e *ErrDuplicateAccount) Error() string {
 func NewErrDuplicateAccount(userID, accountName string) *ErrDuplicateAccount {
 	return &ErrDuplicateAccount{UserID: userID, AccountName: accountName}
 }
+
+// ErrDuplicateAccountCode indicates that another account of the user already uses the code
+type ErrDuplicateAccountCode struct {
+	UserID string
+	Code   string
+}
+
+func (e *ErrDuplicateAccountCode) Error() string {
+	return fmt.Sprintf("account code %q already exists for user %s", e.Code, e.UserID)
+}
+
+// NewErrDuplicateAccountCode creates a new ErrDuplicateAccountCode
+func NewErrDuplicateAccountCode(userID, code string) *ErrDuplicateAccountCode {
+	return &ErrDuplicateAccountCode{UserID: userID, Code: code}
+}
+
+// ErrDuplicateImportProfile indicates that the user already has an import profile with the same name
+type ErrDuplicateImportProfile struct {
+	UserID string
+	Name   string
+}
+
+func (e *ErrDuplicateImportProfile) Error() string {
+	return fmt.Sprintf("import profile %q already exists for user %s", e.Name, e.UserID)
+}
+
+// NewErrDuplicateImportProfile creates a new ErrDuplicateImportProfile
+func NewErrDuplicateImportProfile(userID, name string) *ErrDuplicateImportProfile {
+	return &ErrDuplicateImportProfile{UserID: userID, Name: name}
+}
+
+// ErrDuplicateTransaction indicates that the transaction was already recorded
+// on the account: with the same external ID when ExternalID is set, and
+// otherwise likely so, with the same amount and description on a nearby date
+type ErrDuplicateTransaction struct {
+	AccountID  string
+	ExternalID string
+	// ExistingID is the ID of the transaction already recorded
+	ExistingID string
+}
+
+func (e *ErrDuplicateTransaction) Error() string {
+	if e.ExternalID == "" {
+		return fmt.Sprintf("transaction is likely a duplicate of %s on account %s", e.ExistingID, e.AccountID)
+	}
+	return fmt.Sprintf("transaction %q already exists on account %s: %s", e.ExternalID, e.AccountID, e.ExistingID)
+}
+
+// NewErrDuplicateTransaction creates a new ErrDuplicateTransaction
+func NewErrDuplicateTransaction(accountID, externalID, existingID string) *ErrDuplicateTransaction {
+	return &ErrDuplicateTransaction{AccountID: accountID, ExternalID: externalID, ExistingID: existingID}
+}
+
+// ErrDuplicateCategory indicates that the user already has a category with the same name under the same parent
+type ErrDuplicateCategory struct {
+	UserID string
+	Name   string
+}
+
+func (e *ErrDuplicateCategory) Error() string {
+	return fmt.Sprintf("category %q already exists for user %s", e.Name, e.UserID)
+}
+
+// NewErrDuplicateCategory creates a new ErrDuplicateCategory
+func NewErrDuplicateCategory(userID, name string) *ErrDuplicateCategory {
+	return &ErrDuplicateCategory{UserID: userID, Name: name}
+}
+
+// ErrDuplicatePayee indicates that the user already has a payee with the same name
+type ErrDuplicatePayee struct {
+	UserID string
+	Name   string
+}
+
+func (e *ErrDuplicatePayee) Error() string {
+	return fmt.Sprintf("payee %q already exists for user %s", e.Name, e.UserID)
+}
+
+// NewErrDuplicatePayee creates a new ErrDuplicatePayee
+func NewErrDuplicatePayee(userID, name string) *ErrDuplicatePayee {
+	return &ErrDuplicatePayee{UserID: userID, Name: name}
+}
@@ -8,7 +8,19 @@ import (
 type AccountRepository interface {
 	Create(ctx context.Context, account *entity.Account) error
 	GetByID(ctx context.Context, id string) (*entity.Account, error)
-	ListByUserID(ctx context.Context, userID string) ([]*entity.Account, error)
+	// GetByIDForUpdate is like GetByID but locks the row for the rest of the
+	// current database transaction.
+	GetByIDForUpdate(ctx context.Context, id string) (*entity.Account, error)
+	// GetByCode returns the user's account with the given chart-of-accounts code.
+	GetByCode(ctx context.Context, userID, code string) (*entity.Account, error)
+	// ListByUserID returns a page of the user's accounts, newest first, and
+	// the cursor of the next page, or "" on the last page.
+	ListByUserID(ctx context.Context, userID string, page entity.PageRequest) ([]*entity.Account, string, error)
+	// ListByParentID returns the direct sub-accounts of an account.
+	ListByParentID(ctx context.Context, parentID string) ([]*entity.Account, error)
+	// GetActivity counts the transactions, transfer legs and journal postings
+	// recorded against an account.
+	GetActivity(ctx context.Context, id string) (*entity.AccountActivity, error)
 	Update(ctx context.Context, account *entity.Account) error
 	Delete(ctx context.Context, id string) error
 }
@@ -9,17 +9,26 @@ import (
 
 // AccountService defines the interface for account business logic operations.
 type AccountService interface {
-	// CreateAccount creates a new account for a user.
-	CreateAccount(ctx context.Context, userID, name string, accountType constant.AccountType, currency string) (*entity.Account, error)
+	// CreateAccount creates a new account for a user. parentID and code are
+	// optional and place the account in the user's chart of accounts.
+	CreateAccount(ctx context.Context, userID, name string, accountType constant.AccountType, currency, parentID, code string) (*entity.Account, error)
 
 	// GetAccount retrieves an account by its ID.
 	GetAccount(ctx context.Context, id string) (*entity.Account, error)
 
-	// ListUserAccounts retrieves all accounts for a given user.
-	ListUserAccounts(ctx context.Context, userID string) ([]*entity.Account, error)
+	// ListUserAccounts retrieves a page of a user's accounts, newest first,
+	// and the cursor of the next page, or "" on the last page.
+	// A zero page.Limit uses entity.DefaultPageLimit.
+	ListUserAccounts(ctx context.Context, userID string, page entity.PageRequest) ([]*entity.Account, string, error)
+
+	// GetAccountTree returns the user's chart of accounts as a forest of root
+	// accounts, each node carrying the balances rolled up from its descendants.
+	// A non-empty baseCurrency also totals each node in that currency.
+	GetAccountTree(ctx context.Context, userID, baseCurrency string) ([]*entity.AccountNode, error)
 
 	// UpdateAccount updates an existing account's properties.
-	UpdateAccount(ctx context.Context, id, name string, accountType constant.AccountType, currency string) (*entity.Account, error)
+	// Empty values leave the stored value unchanged.
+	UpdateAccount(ctx context.Context, id, name string, accountType constant.AccountType, currency, parentID, code string) (*entity.Account, error)
 
 	// DeleteAccount removes an account by its ID.
 	DeleteAccount(ctx context.Context, id string) error
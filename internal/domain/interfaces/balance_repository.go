@@ -0,0 +1,20 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"accounting/internal/domain/entity"
+)
+
+type BalanceRepository interface {
+	// ListDailyMovements returns what was booked to the account per day for
+	// days in [from, to), oldest first, with amounts in currency. Days without
+	// activity are omitted. A zero from starts at the earliest booking.
+	ListDailyMovements(ctx context.Context, accountID, currency string, from, to time.Time) ([]*entity.DailyMovement, error)
+	// GetLatestSnapshot returns the account's latest snapshot dated on or
+	// before date, or nil if there is none.
+	GetLatestSnapshot(ctx context.Context, accountID, currency string, date time.Time) (*entity.BalanceSnapshot, error)
+	// UpsertSnapshot stores a snapshot, replacing any for the same account and day.
+	UpsertSnapshot(ctx context.Context, snapshot *entity.BalanceSnapshot) error
+}
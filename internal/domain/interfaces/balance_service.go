@@ -0,0 +1,19 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"accounting/internal/domain/entity"
+)
+
+// BalanceService defines the interface for historical balance operations.
+// Days are calendar days in UTC.
+type BalanceService interface {
+	// GetBalanceAsOf returns the account's balance at the end of the given day.
+	GetBalanceAsOf(ctx context.Context, accountID string, day time.Time) (*entity.DailyBalance, error)
+
+	// GetDailyBalances returns the account's end-of-day balance for every day
+	// from one day to another, both included.
+	GetDailyBalances(ctx context.Context, accountID string, from, to time.Time) ([]*entity.DailyBalance, error)
+}
@@ -0,0 +1,26 @@
+package interfaces
+
+import (
+	"context"
+
+	"accounting/internal/domain/entity"
+)
+
+type CategoryRepository interface {
+	Create(ctx context.Context, category *entity.Category) error
+	GetByID(ctx context.Context, id string) (*entity.Category, error)
+	// GetByName returns the user's category with the given name under the
+	// given parent, ignoring case. An empty parentID looks among the
+	// top-level categories.
+	GetByName(ctx context.Context, userID, parentID, name string) (*entity.Category, error)
+	// ListByName returns the user's categories with the given name under any
+	// parent, ignoring case.
+	ListByName(ctx context.Context, userID, name string) ([]*entity.Category, error)
+	// ListByUserID returns the user's categories ordered by name, leaving out
+	// archived ones unless includeArchived is set.
+	ListByUserID(ctx context.Context, userID string, includeArchived bool) ([]*entity.Category, error)
+	// ListByParentID returns the direct subcategories of a category.
+	ListByParentID(ctx context.Context, parentID string) ([]*entity.Category, error)
+	Update(ctx context.Context, category *entity.Category) error
+	Delete(ctx context.Context, id string) error
+}
@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"context"
+
+	"accounting/internal/domain/entity"
+)
+
+type CategoryRuleRepository interface {
+	Create(ctx context.Context, rule *entity.CategoryRule) error
+	GetByID(ctx context.Context, id string) (*entity.CategoryRule, error)
+	// ListByUserID returns the user's rules in the order they are tried:
+	// by priority, then by name.
+	ListByUserID(ctx context.Context, userID string) ([]*entity.CategoryRule, error)
+	Update(ctx context.Context, rule *entity.CategoryRule) error
+	Delete(ctx context.Context, id string) error
+}
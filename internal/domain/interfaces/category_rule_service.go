@@ -0,0 +1,36 @@
+package interfaces
+
+import (
+	"context"
+
+	"accounting/internal/domain/entity"
+)
+
+// CategoryRuleService defines the interface for managing categorization
+// rules and applying them to existing transactions.
+type CategoryRuleService interface {
+	// CreateRule saves a categorization rule for a user. A rule needs at
+	// least one condition and one action.
+	CreateRule(ctx context.Context, userID, name string, priority int, conditions entity.RuleConditions, actions entity.RuleActions) (*entity.CategoryRule, error)
+
+	// GetRule retrieves a categorization rule by its ID.
+	GetRule(ctx context.Context, id string) (*entity.CategoryRule, error)
+
+	// ListUserRules retrieves the user's rules in the order they are tried.
+	ListUserRules(ctx context.Context, userID string) ([]*entity.CategoryRule, error)
+
+	// UpdateRule renames a rule, changes its priority, or replaces its
+	// conditions or actions. An empty name or a nil value leaves the stored
+	// value unchanged.
+	UpdateRule(ctx context.Context, id, name string, priority *int, conditions *entity.RuleConditions, actions *entity.RuleActions) (*entity.CategoryRule, error)
+
+	// DeleteRule removes a categorization rule by its ID.
+	DeleteRule(ctx context.Context, id string) error
+
+	// ApplyRules applies the user's rules to their income and expense
+	// transactions, or only to those without a category when
+	// uncategorizedOnly is set, and reports what changed. A dry run
+	// reports the changes without saving them. Changes are saved a page of
+	// transactions at a time, so a failure keeps the pages saved before it.
+	ApplyRules(ctx context.Context, userID string, uncategorizedOnly, dryRun bool) (*entity.RuleApplication, error)
+}
@@ -0,0 +1,36 @@
+package interfaces
+
+import (
+	"context"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+)
+
+// CategoryService defines the interface for managing the categories users
+// give their transactions.
+type CategoryService interface {
+	// CreateCategory creates a category for a user, under the category
+	// parentID unless it is empty. categoryType restricts the category to
+	// INCOME or EXPENSE transactions; it is empty for a category used for both.
+	CreateCategory(ctx context.Context, userID, name, parentID string, categoryType constant.TransactionType) (*entity.Category, error)
+
+	// GetCategory retrieves a category by its ID.
+	GetCategory(ctx context.Context, id string) (*entity.Category, error)
+
+	// ListUserCategories retrieves the user's categories ordered by name,
+	// leaving out archived ones unless includeArchived is set.
+	ListUserCategories(ctx context.Context, userID string, includeArchived bool) ([]*entity.Category, error)
+
+	// UpdateCategory renames, moves, retypes, archives or restores a category.
+	// An empty name or a nil pointer leaves the stored value unchanged; an
+	// empty parentID makes the category a top-level one. A category set by a
+	// rule can neither be archived nor restricted to transactions the rule
+	// does not match.
+	UpdateCategory(ctx context.Context, id, name string, parentID *string, categoryType *constant.TransactionType, archived *bool) (*entity.Category, error)
+
+	// DeleteCategory removes a category by its ID. Its transactions become
+	// uncategorized; a category with subcategories or set by a rule cannot be
+	// deleted.
+	DeleteCategory(ctx context.Context, id string) error
+}
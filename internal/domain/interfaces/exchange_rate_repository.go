@@ -0,0 +1,16 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"accounting/internal/domain/entity"
+)
+
+type ExchangeRateRepository interface {
+	// Upsert stores a rate, replacing any rate for the same pair and date.
+	Upsert(ctx context.Context, rate *entity.ExchangeRate) error
+	// GetEffective returns the latest rate for the pair dated on or before date,
+	// or nil if there is none.
+	GetEffective(ctx context.Context, baseCurrency, quoteCurrency string, date time.Time) (*entity.ExchangeRate, error)
+}
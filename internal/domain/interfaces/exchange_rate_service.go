@@ -0,0 +1,29 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"accounting/internal/domain/entity"
+	"accounting/internal/domain/money"
+)
+
+// CurrencyConverter converts amounts between currencies using dated exchange rates.
+type CurrencyConverter interface {
+	// Convert returns amount expressed in currency using the rate effective on
+	// date, together with the rate that was applied.
+	Convert(ctx context.Context, amount money.Money, currency string, date time.Time) (money.Money, money.Rate, error)
+}
+
+// ExchangeRateService defines the interface for exchange rate business logic operations.
+type ExchangeRateService interface {
+	CurrencyConverter
+
+	// SetRates stores the given rates atomically, replacing rates already
+	// stored for the same pair and date.
+	SetRates(ctx context.Context, rates []*entity.ExchangeRate) error
+
+	// GetRate returns the rate effective on date for the pair, falling back to
+	// the inverse of the opposite pair. It returns nil if no rate is known.
+	GetRate(ctx context.Context, baseCurrency, quoteCurrency string, date time.Time) (*entity.ExchangeRate, error)
+}
@@ -0,0 +1,18 @@
+package interfaces
+
+import (
+	"context"
+
+	"accounting/internal/domain/entity"
+)
+
+type ImportProfileRepository interface {
+	Create(ctx context.Context, profile *entity.ImportProfile) error
+	GetByID(ctx context.Context, id string) (*entity.ImportProfile, error)
+	// GetByName returns the user's import profile with the given name.
+	GetByName(ctx context.Context, userID, name string) (*entity.ImportProfile, error)
+	// ListByUserID returns the user's import profiles ordered by name.
+	ListByUserID(ctx context.Context, userID string) ([]*entity.ImportProfile, error)
+	Update(ctx context.Context, profile *entity.ImportProfile) error
+	Delete(ctx context.Context, id string) error
+}
@@ -0,0 +1,54 @@
+package interfaces
+
+import (
+	"context"
+	"io"
+
+	"accounting/internal/domain/entity"
+)
+
+// ImportService defines the interface for importing bank statements and
+// managing the mapping profiles used to read them.
+type ImportService interface {
+	// CreateProfile saves a CSV mapping for a user under a name unique to the user.
+	CreateProfile(ctx context.Context, userID, name string, mapping entity.CSVMapping) (*entity.ImportProfile, error)
+
+	// GetProfile retrieves an import profile by its ID.
+	GetProfile(ctx context.Context, id string) (*entity.ImportProfile, error)
+
+	// ListUserProfiles retrieves the user's import profiles ordered by name.
+	ListUserProfiles(ctx context.Context, userID string) ([]*entity.ImportProfile, error)
+
+	// UpdateProfile renames a profile and replaces its mapping.
+	// An empty name or a nil mapping leaves the stored value unchanged.
+	UpdateProfile(ctx context.Context, id, name string, mapping *entity.CSVMapping) (*entity.ImportProfile, error)
+
+	// DeleteProfile removes an import profile by its ID.
+	DeleteProfile(ctx context.Context, id string) error
+
+	// ImportCSV reads a CSV statement with the saved profile profileID, or
+	// with mapping when profileID is empty, and creates a transaction in the
+	// account for every row. A row that cannot be imported does not stop the
+	// others; the result reports the outcome of each row. Rows that look like
+	// a transaction already recorded on the account are flagged as duplicates
+	// rather than imported.
+	ImportCSV(ctx context.Context, accountID, profileID string, mapping *entity.CSVMapping, data io.Reader) (*entity.ImportResult, error)
+
+	// ImportOFX reads an OFX or QFX statement and creates a transaction in the
+	// account for every statement transaction. Transactions whose FITID was
+	// imported into the account before are skipped, so the same statement can
+	// be imported again safely.
+	ImportOFX(ctx context.Context, accountID string, data io.Reader) (*entity.ImportResult, error)
+
+	// ImportCamt053 reads an ISO 20022 camt.053 statement and creates a
+	// transaction in the account for every booked entry, dated on its booking
+	// date. Entries in another currency than the account's are converted.
+	// Entries whose bank reference was imported before are skipped.
+	ImportCamt053(ctx context.Context, accountID string, data io.Reader) (*entity.ImportResult, error)
+
+	// ImportMT940 reads a SWIFT MT940 statement and creates a transaction in
+	// the account for every statement line, dated on its entry date. Amounts
+	// in another currency than the account's are converted. Statement lines
+	// whose bank reference was imported before are skipped.
+	ImportMT940(ctx context.Context, accountID string, data io.Reader) (*entity.ImportResult, error)
+}
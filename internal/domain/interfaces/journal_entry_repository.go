@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"context"
+
+	"accounting/internal/domain/entity"
+)
+
+type JournalEntryRepository interface {
+	Create(ctx context.Context, entry *entity.JournalEntry) error
+	GetByID(ctx context.Context, id string) (*entity.JournalEntry, error)
+	ListByUserID(ctx context.Context, userID string) ([]*entity.JournalEntry, error)
+	Delete(ctx context.Context, id string) error
+}
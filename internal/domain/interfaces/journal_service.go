@@ -0,0 +1,29 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"accounting/internal/domain/entity"
+)
+
+// JournalService defines the interface for double-entry journal operations.
+type JournalService interface {
+	// CreateEntry records a balanced journal entry and applies its postings to
+	// the account balances atomically.
+	CreateEntry(ctx context.Context, userID string, date time.Time, description string, postings []*entity.Posting) (*entity.JournalEntry, error)
+
+	// GetEntry retrieves a journal entry with its postings by ID.
+	GetEntry(ctx context.Context, id string) (*entity.JournalEntry, error)
+
+	// ListUserEntries retrieves all journal entries of a user, newest first.
+	ListUserEntries(ctx context.Context, userID string) ([]*entity.JournalEntry, error)
+
+	// DeleteEntry removes a journal entry and reverses its postings.
+	DeleteEntry(ctx context.Context, id string) error
+
+	// PreviewTransactionEntry returns the journal entry an INCOME or EXPENSE
+	// transaction amounts to against the user's income or expense category
+	// account, without recording it.
+	PreviewTransactionEntry(ctx context.Context, transactionID, categoryAccountID string) (*entity.JournalEntry, error)
+}
@@ -0,0 +1,18 @@
+package interfaces
+
+import (
+	"context"
+
+	"accounting/internal/domain/entity"
+)
+
+type PayeeRepository interface {
+	Create(ctx context.Context, payee *entity.Payee) error
+	GetByID(ctx context.Context, id string) (*entity.Payee, error)
+	// GetByName returns the user's payee with the given name, ignoring case.
+	GetByName(ctx context.Context, userID, name string) (*entity.Payee, error)
+	// ListByUserID returns the user's payees ordered by name.
+	ListByUserID(ctx context.Context, userID string) ([]*entity.Payee, error)
+	Update(ctx context.Context, payee *entity.Payee) error
+	Delete(ctx context.Context, id string) error
+}
@@ -0,0 +1,32 @@
+package interfaces
+
+import (
+	"context"
+
+	"accounting/internal/domain/entity"
+)
+
+// PayeeService defines the interface for managing the payees users pay or
+// are paid by.
+type PayeeService interface {
+	// CreatePayee creates a payee for a user. Aliases are other names the
+	// payee goes by in transaction descriptions; defaultCategoryID, unless
+	// empty, is the category given to its transactions that get no other.
+	CreatePayee(ctx context.Context, userID, name string, aliases []string, defaultCategoryID string) (*entity.Payee, error)
+
+	// GetPayee retrieves a payee by its ID.
+	GetPayee(ctx context.Context, id string) (*entity.Payee, error)
+
+	// ListUserPayees retrieves the user's payees ordered by name.
+	ListUserPayees(ctx context.Context, userID string) ([]*entity.Payee, error)
+
+	// UpdatePayee renames a payee, replaces its aliases or changes its
+	// default category. An empty name, nil aliases or a nil defaultCategoryID
+	// leave the stored value unchanged; empty aliases remove them all and an
+	// empty defaultCategoryID removes the default category.
+	UpdatePayee(ctx context.Context, id, name string, aliases []string, defaultCategoryID *string) (*entity.Payee, error)
+
+	// DeletePayee removes a payee by its ID. Its transactions keep their
+	// category but no longer have a payee.
+	DeletePayee(ctx context.Context, id string) error
+}
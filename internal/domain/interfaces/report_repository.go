@@ -0,0 +1,28 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+)
+
+type ReportRepository interface {
+	// SumByCategory totals the income and expense of the user's transactions
+	// dated in [from, to) per period, currency and category, ordered by period,
+	// then currency, then category ID. Uncategorized transactions are totalled
+	// under an empty category ID, and split transactions under the category of
+	// each split.
+	SumByCategory(ctx context.Context, userID string, from, to time.Time, granularity constant.Granularity) ([]*entity.CategoryTotal, error)
+
+	// SumByTag totals the income and expense of the user's tagged transactions
+	// dated in [from, to) per tag and currency, ordered by tag, then currency.
+	SumByTag(ctx context.Context, userID string, from, to time.Time) ([]*entity.TagTotal, error)
+
+	// SumByPayee totals the income and expense of the user's transactions
+	// dated in [from, to) per payee and currency, ordered by payee name, then
+	// currency. Transactions without a payee are totalled last, under an
+	// empty payee ID.
+	SumByPayee(ctx context.Context, userID string, from, to time.Time) ([]*entity.PayeeTotal, error)
+}
@@ -0,0 +1,30 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+)
+
+// ReportService defines the interface for reporting business logic operations.
+type ReportService interface {
+	// GetSummary returns the user's income, expense and net cash flow per
+	// period and category for transactions dated in [from, to). With a
+	// baseCurrency, each period is totalled in it at the exchange rates
+	// effective on the period's last day instead of per currency.
+	GetSummary(ctx context.Context, userID string, from, to time.Time, granularity constant.Granularity, baseCurrency string) (*entity.Summary, error)
+
+	// GetTagReport returns the user's income, expense and net cash flow per
+	// tag for transactions dated in [from, to).
+	GetTagReport(ctx context.Context, userID string, from, to time.Time) (*entity.TagReport, error)
+
+	// GetPayeeReport returns the user's income, expense and net cash flow per
+	// payee for transactions dated in [from, to).
+	GetPayeeReport(ctx context.Context, userID string, from, to time.Time) (*entity.PayeeReport, error)
+
+	// GetNetWorth returns the user's net worth today in their base currency,
+	// with its month-end history for the days in [from, to].
+	GetNetWorth(ctx context.Context, userID string, from, to time.Time) (*entity.NetWorth, error)
+}
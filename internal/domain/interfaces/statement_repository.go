@@ -0,0 +1,19 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"accounting/internal/domain/entity"
+)
+
+type StatementRepository interface {
+	// SumAccountMovements returns the net movement of each of the user's
+	// accounts with transactions or journal postings dated in [from, to).
+	SumAccountMovements(ctx context.Context, userID string, from, to time.Time) ([]*entity.AccountMovement, error)
+
+	// SumCategoryMovements totals the user's transactions dated in [from, to)
+	// per currency, type and category, in that order. Split transactions are
+	// totalled under the category of each split.
+	SumCategoryMovements(ctx context.Context, userID string, from, to time.Time) ([]*entity.CategoryMovement, error)
+}
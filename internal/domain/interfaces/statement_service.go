@@ -0,0 +1,21 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"accounting/internal/domain/entity"
+)
+
+// StatementService defines the interface for financial statement operations.
+type StatementService interface {
+	// GetTrialBalance returns the user's trial balance at the end of asOf.
+	GetTrialBalance(ctx context.Context, userID string, asOf time.Time) (*entity.TrialBalance, error)
+
+	// GetBalanceSheet returns the user's balance sheet at the end of asOf.
+	GetBalanceSheet(ctx context.Context, userID string, asOf time.Time) (*entity.BalanceSheet, error)
+
+	// GetIncomeStatement returns the user's income statement for bookings
+	// dated in [from, to).
+	GetIncomeStatement(ctx context.Context, userID string, from, to time.Time) (*entity.IncomeStatement, error)
+}
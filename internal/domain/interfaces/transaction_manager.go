@@ -7,5 +7,6 @@ type TransactionManager interface {
 	// WithTx executes the given function within a database transaction.
 	// If the function returns an error, the transaction is rolled back.
 	// If the function returns nil, the transaction is committed.
+	// Calls made with a context that already carries a transaction join it.
 	WithTx(ctx context.Context, fn func(ctx context.Context) error) error
 }
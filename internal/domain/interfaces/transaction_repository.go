@@ -1,14 +1,35 @@
 package interfaces
 
 import (
-	"accounting/internal/domain/entity"
 	"context"
+	"time"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	"accounting/internal/domain/money"
 )
 
 type TransactionRepository interface {
 	Create(ctx context.Context, transaction *entity.Transaction) error
 	GetByID(ctx context.Context, id string) (*entity.Transaction, error)
-	ListByAccountID(ctx context.Context, accountID string) ([]*entity.Transaction, error)
+	// GetByExternalID returns the account's transaction with the given
+	// external ID, or nil if there is none.
+	GetByExternalID(ctx context.Context, accountID, externalID string) (*entity.Transaction, error)
+	// ListSimilar returns the account's transactions of the given type dated
+	// from from to to inclusive whose amount, or original amount when it was
+	// entered in another currency, equals amount, oldest first.
+	ListSimilar(ctx context.Context, accountID string, transactionType constant.TransactionType, amount money.Money, from, to time.Time) ([]*entity.Transaction, error)
+	// ListByAccountID returns a page of the account's transactions matching
+	// the filter, in the filter's order, and the cursor of the next page, or
+	// "" on the last page.
+	ListByAccountID(ctx context.Context, accountID string, filter entity.TransactionFilter, page entity.PageRequest) ([]*entity.Transaction, string, error)
+	// ListRuleCandidates returns a page of the transactions on the user's
+	// accounts that categorization rules may change, oldest first, and the
+	// cursor of the next page, or "" on the last page. Transfer legs and split
+	// transactions are left out, and so are categorized transactions when
+	// uncategorizedOnly is set.
+	ListRuleCandidates(ctx context.Context, userID string, uncategorizedOnly bool, page entity.PageRequest) ([]*entity.Transaction, string, error)
+	ListByTransferID(ctx context.Context, transferID string) ([]*entity.Transaction, error)
 	Update(ctx context.Context, transaction *entity.Transaction) error
 	Delete(ctx context.Context, id string) error
 }
@@ -6,22 +6,60 @@ import (
 
 	"accounting/internal/domain/constant"
 	"accounting/internal/domain/entity"
+	"accounting/internal/domain/money"
 )
 
 // TransactionService defines the interface for transaction business logic operations.
 type TransactionService interface {
-	// CreateTransaction creates a new transaction for an account.
-	CreateTransaction(ctx context.Context, accountID string, amount float64, currency, description, category string, transactionType constant.TransactionType, date time.Time) (*entity.Transaction, error)
+	// CreateTransaction creates a new transaction for an account. The
+	// category must be one of the user's categories that is not archived and
+	// allows the transaction's type. Splits, if any, divide the transaction
+	// between categories in the same way instead: at least two of them, in the
+	// currency of amount, adding up to it. Without a category or splits, the
+	// first of the user's categorization rules it matches fills in its
+	// category and description. The payee must be one of the user's payees;
+	// without one, the payee whose name or alias the description contains is
+	// set, and a transaction still without a category or splits gets the
+	// payee's default category if it suits it. Tags are stored in lower case,
+	// creating any the user does not have yet. Unless allowDuplicate is set, it returns an
+	// ErrDuplicateTransaction without an external ID if the transaction is
+	// likely a duplicate of one already on the account, as FindDuplicates tells.
+	CreateTransaction(ctx context.Context, accountID string, amount money.Money, description, payeeID, categoryID string, tags []string, splits []*entity.Split, transactionType constant.TransactionType, date time.Time, allowDuplicate bool) (*entity.Transaction, error)
+
+	// CreateImportedTransaction creates a transaction read from a bank
+	// statement, recording the identifier the bank gave it. It returns an
+	// ErrDuplicateTransaction if the account already has a transaction with
+	// that external ID, or, unless allowDuplicate is set, one that is likely
+	// a duplicate.
+	CreateImportedTransaction(ctx context.Context, accountID, externalID string, amount money.Money, description, payeeID, categoryID string, tags []string, splits []*entity.Split, transactionType constant.TransactionType, date time.Time, allowDuplicate bool) (*entity.Transaction, error)
+
+	// FindDuplicates returns the account's transactions that are likely
+	// duplicates of the given one, oldest first: of the same type and amount
+	// as entered, dated within a few days of each other, with the same
+	// description ignoring case, punctuation and spacing. Transactions that
+	// both have an external ID are never likely duplicates.
+	FindDuplicates(ctx context.Context, accountID, externalID string, amount money.Money, description string, transactionType constant.TransactionType, date time.Time) ([]*entity.Transaction, error)
 
 	// GetTransaction retrieves a transaction by its ID.
 	GetTransaction(ctx context.Context, id string) (*entity.Transaction, error)
 
-	// ListAccountTransactions retrieves all transactions for a given account.
-	ListAccountTransactions(ctx context.Context, accountID string) ([]*entity.Transaction, error)
+	// ListAccountTransactions retrieves a page of an account's transactions
+	// matching the filter, whose tags match ignoring case, newest first unless the filter sorts otherwise,
+	// and the cursor of the next page, or "" on the last page.
+	// A zero page.Limit uses entity.DefaultPageLimit.
+	ListAccountTransactions(ctx context.Context, accountID string, filter entity.TransactionFilter, page entity.PageRequest) ([]*entity.Transaction, string, error)
 
-	// UpdateTransaction updates an existing transaction's properties.
-	UpdateTransaction(ctx context.Context, id string, amount float64, currency, description, category string, transactionType constant.TransactionType, date time.Time) (*entity.Transaction, error)
+	// UpdateTransaction updates an existing transaction's properties and
+	// recalculates the balances of the affected accounts.
+	// Empty strings or a zero-value amount leave the stored value unchanged.
+	// Nil tags or splits leave them unchanged, while an empty slice removes
+	// them all. Splits are given in the currency of the amount as entered and
+	// replace the category; they must be given again when a change of amount,
+	// date or account makes the stored ones no longer add up. Edits never
+	// match the transaction to a payee again, but a payee given to a
+	// transaction without a category or splits gives it its default category.
+	UpdateTransaction(ctx context.Context, id, accountID string, amount money.Money, description, payeeID, categoryID string, tags []string, splits []*entity.Split, transactionType constant.TransactionType, date time.Time) (*entity.Transaction, error)
 
-	// DeleteTransaction removes a transaction by its ID.
+	// DeleteTransaction removes a transaction by its ID and reverses its effect on the account balance.
 	DeleteTransaction(ctx context.Context, id string) error
 }
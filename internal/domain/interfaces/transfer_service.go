@@ -0,0 +1,19 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"accounting/internal/domain/entity"
+	"accounting/internal/domain/money"
+)
+
+// TransferService defines the interface for transfer business logic operations.
+type TransferService interface {
+	// CreateTransfer moves amount from one account to another, debiting the
+	// source and crediting the destination atomically.
+	CreateTransfer(ctx context.Context, fromAccountID, toAccountID string, amount money.Money, description string, date time.Time) (*entity.Transfer, error)
+
+	// GetTransfer retrieves both legs of a transfer by the transfer ID.
+	GetTransfer(ctx context.Context, id string) (*entity.Transfer, error)
+}
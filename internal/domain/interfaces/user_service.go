@@ -8,8 +8,9 @@ import (
 
 // UserService defines the interface for user business logic operations.
 type UserService interface {
-	// CreateUser creates a new user with the given name and email.
-	CreateUser(ctx context.Context, name, email string) (*entity.User, error)
+	// CreateUser creates a new user with the given name, email and base
+	// currency. An empty base currency defaults to USD.
+	CreateUser(ctx context.Context, name, email, baseCurrency string) (*entity.User, error)
 
 	// GetUser retrieves a user by their ID.
 	GetUser(ctx context.Context, id string) (*entity.User, error)
@@ -17,8 +18,8 @@ type UserService interface {
 	// GetUserByEmail retrieves a user by their email address.
 	GetUserByEmail(ctx context.Context, email string) (*entity.User, error)
 
-	// UpdateUser updates an existing user's name and/or email.
-	UpdateUser(ctx context.Context, id, name, email string) (*entity.User, error)
+	// UpdateUser updates an existing user's name, email and/or base currency.
+	UpdateUser(ctx context.Context, id, name, email, baseCurrency string) (*entity.User, error)
 
 	// DeleteUser removes a user by their ID.
 	DeleteUser(ctx context.Context, id string) error
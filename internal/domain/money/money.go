@@ -0,0 +1,230 @@
+// Package money provides an exact monetary value type backed by integer minor units.
+package money
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"accounting/internal/pkg/iso4217"
+)
+
+var (
+	// ErrCurrencyMismatch is returned when combining amounts in different currencies.
+	ErrCurrencyMismatch = errors.New("currency mismatch")
+	// ErrInvalidAmount is returned when a decimal string cannot be parsed as an amount.
+	ErrInvalidAmount = errors.New("invalid amount")
+	// ErrTooManyDecimals is returned when an amount has more decimals than its currency allows.
+	ErrTooManyDecimals = errors.New("too many decimal places")
+	// ErrOverflow is returned when an amount does not fit in 64-bit minor units.
+	ErrOverflow = errors.New("amount overflows")
+)
+
+// defaultExponent is the number of minor-unit digits assumed for codes that
+// are not in the ISO 4217 registry.
+const defaultExponent = 2
+
+// Money is an immutable monetary amount expressed in the minor units of its currency
+// (e.g. cents for USD). The zero value has no currency and represents an unset amount.
+type Money struct {
+	amount   int64
+	currency string
+}
+
+// New creates a Money from an amount in minor units.
+func New(minorUnits int64, currency string) Money {
+	return Money{amount: minorUnits, currency: currency}
+}
+
+// Zero returns a zero amount in the given currency.
+func Zero(currency string) Money {
+	return Money{currency: currency}
+}
+
+// Exponent returns the number of minor-unit digits for a currency
+// (e.g. 2 for USD, 0 for JPY, 3 for KWD).
+func Exponent(currency string) int {
+	if units, ok := iso4217.MinorUnits(currency); ok {
+		return units
+	}
+	return defaultExponent
+}
+
+// Parse converts a decimal string such as "-12.34" into Money.
+// The value must not have more decimal places than the currency allows.
+func Parse(value, currency string) (Money, error) {
+	s := strings.TrimSpace(value)
+	negative := false
+	switch {
+	case strings.HasPrefix(s, "-"):
+		negative = true
+		s = s[1:]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+
+	whole, frac, hasPoint := strings.Cut(s, ".")
+	if whole == "" && frac == "" || hasPoint && frac == "" || !isDigits(whole) || !isDigits(frac) {
+		return Money{}, fmt.Errorf("%w: %q", ErrInvalidAmount, value)
+	}
+
+	exp := Exponent(currency)
+	if len(frac) > exp {
+		return Money{}, fmt.Errorf("%w: %q allows at most %d for %s", ErrTooManyDecimals, value, exp, currency)
+	}
+	frac += strings.Repeat("0", exp-len(frac))
+
+	digits := strings.TrimLeft(whole+frac, "0")
+	if digits == "" {
+		return Zero(currency), nil
+	}
+	minor, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil {
+		return Money{}, fmt.Errorf("%w: %q", ErrOverflow, value)
+	}
+	if negative {
+		minor = -minor
+	}
+
+	return New(minor, currency), nil
+}
+
+// MustParse is like Parse but panics on error. It is intended for tests and constants.
+func MustParse(value, currency string) Money {
+	m, err := Parse(value, currency)
+	if err != nil {
+		panic(err)
+	}
+	return m
+}
+
+// Amount returns the amount in minor units.
+func (m Money) Amount() int64 {
+	return m.amount
+}
+
+// Currency returns the ISO 4217 currency code.
+func (m Money) Currency() string {
+	return m.currency
+}
+
+// IsZero reports whether the amount is zero.
+func (m Money) IsZero() bool {
+	return m.amount == 0
+}
+
+// IsPositive reports whether the amount is greater than zero.
+func (m Money) IsPositive() bool {
+	return m.amount > 0
+}
+
+// IsNegative reports whether the amount is less than zero.
+func (m Money) IsNegative() bool {
+	return m.amount < 0
+}
+
+// Add returns m + other. Both amounts must share the same currency.
+func (m Money) Add(other Money) (Money, error) {
+	if err := m.assertSameCurrency(other); err != nil {
+		return Money{}, err
+	}
+	sum := m.amount + other.amount
+	if (other.amount > 0 && sum < m.amount) || (other.amount < 0 && sum > m.amount) {
+		return Money{}, ErrOverflow
+	}
+	return New(sum, m.currency), nil
+}
+
+// Sub returns m - other. Both amounts must share the same currency.
+func (m Money) Sub(other Money) (Money, error) {
+	return m.Add(other.Negate())
+}
+
+// Sum adds a list of amounts that share the given currency.
+func Sum(currency string, amounts ...Money) (Money, error) {
+	total := Zero(currency)
+	for _, a := range amounts {
+		var err error
+		if total, err = total.Add(a); err != nil {
+			return Money{}, err
+		}
+	}
+	return total, nil
+}
+
+// Negate returns -m.
+func (m Money) Negate() Money {
+	return New(-m.amount, m.currency)
+}
+
+// Abs returns the absolute value of m.
+func (m Money) Abs() Money {
+	if m.amount < 0 {
+		return m.Negate()
+	}
+	return m
+}
+
+// Cmp compares m and other and returns -1, 0 or +1.
+// Both amounts must share the same currency.
+func (m Money) Cmp(other Money) (int, error) {
+	if err := m.assertSameCurrency(other); err != nil {
+		return 0, err
+	}
+	switch {
+	case m.amount < other.amount:
+		return -1, nil
+	case m.amount > other.amount:
+		return 1, nil
+	default:
+		return 0, nil
+	}
+}
+
+// WithCurrency re-expresses the same decimal value in another currency.
+// It fails if the target currency cannot represent the value exactly.
+func (m Money) WithCurrency(currency string) (Money, error) {
+	return Parse(m.String(), currency)
+}
+
+// String formats the amount as a plain decimal string, e.g. "-12.34".
+func (m Money) String() string {
+	exp := Exponent(m.currency)
+	sign := ""
+	abs := uint64(m.amount)
+	if m.amount < 0 {
+		sign = "-"
+		abs = uint64(-(m.amount + 1)) + 1
+	}
+	digits := strconv.FormatUint(abs, 10)
+	if exp == 0 {
+		return sign + digits
+	}
+	if len(digits) <= exp {
+		digits = strings.Repeat("0", exp-len(digits)+1) + digits
+	}
+	cut := len(digits) - exp
+	return sign + digits[:cut] + "." + digits[cut:]
+}
+
+// MarshalJSON encodes the amount as a decimal string so no precision is lost.
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(m.String())), nil
+}
+
+func (m Money) assertSameCurrency(other Money) error {
+	if m.currency != other.currency {
+		return fmt.Errorf("%w: %s and %s", ErrCurrencyMismatch, m.currency, other.currency)
+	}
+	return nil
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,166 @@
+package money
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    int64
+		wantErr error
+	}{
+		{"12.34", 1234, nil},
+		{"12.3", 1230, nil},
+		{"12", 1200, nil},
+		{"0.01", 1, nil},
+		{".5", 50, nil},
+		{"-7.05", -705, nil},
+		{"+1.00", 100, nil},
+		{"0", 0, nil},
+		{"12.345", 0, ErrTooManyDecimals},
+		{"", 0, ErrInvalidAmount},
+		{"12.", 0, ErrInvalidAmount},
+		{"1e3", 0, ErrInvalidAmount},
+		{"1,50", 0, ErrInvalidAmount},
+		{"abc", 0, ErrInvalidAmount},
+		{"92233720368547758.08", 0, ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := Parse(tt.value, "USD")
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Parse(%q) error = %v, want %v", tt.value, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse(%q) unexpected error: %v", tt.value, err)
+			}
+			if got.Amount() != tt.want {
+				t.Errorf("Parse(%q) = %d, want %d", tt.value, got.Amount(), tt.want)
+			}
+			if got.Currency() != "USD" {
+				t.Errorf("Parse(%q) currency = %q, want USD", tt.value, got.Currency())
+			}
+		})
+	}
+}
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		minor int64
+		want  string
+	}{
+		{1234, "12.34"},
+		{5, "0.05"},
+		{-5, "-0.05"},
+		{-120000, "-1200.00"},
+		{0, "0.00"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := New(tt.minor, "USD").String(); got != tt.want {
+				t.Errorf("New(%d).String() = %q, want %q", tt.minor, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMinorUnitsFollowCurrency(t *testing.T) {
+	tests := []struct {
+		value    string
+		currency string
+		want     string
+		wantErr  error
+	}{
+		{"1500", "JPY", "1500", nil},
+		{"1500.5", "JPY", "", ErrTooManyDecimals},
+		{"1.234", "KWD", "1.234", nil},
+		{"1.2345", "KWD", "", ErrTooManyDecimals},
+		{"1.2", "KWD", "1.200", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.currency+" "+tt.value, func(t *testing.T) {
+			got, err := Parse(tt.value, tt.currency)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Parse(%q, %s) error = %v, want %v", tt.value, tt.currency, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse(%q, %s) unexpected error: %v", tt.value, tt.currency, err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("Parse(%q, %s) = %s, want %s", tt.value, tt.currency, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddIsExact(t *testing.T) {
+	total := Zero("USD")
+	cent := MustParse("0.10", "USD")
+	for i := 0; i < 10000; i++ {
+		var err error
+		if total, err = total.Add(cent); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if total.String() != "1000.00" {
+		t.Errorf("expected 1000.00, got %s", total)
+	}
+}
+
+func TestAddCurrencyMismatch(t *testing.T) {
+	_, err := New(100, "USD").Add(New(100, "EUR"))
+	if !errors.Is(err, ErrCurrencyMismatch) {
+		t.Errorf("expected ErrCurrencyMismatch, got %v", err)
+	}
+}
+
+func TestSub(t *testing.T) {
+	got, err := MustParse("50.00", "USD").Sub(MustParse("75.25", "USD"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.String() != "-25.25" {
+		t.Errorf("expected -25.25, got %s", got)
+	}
+}
+
+func TestCmp(t *testing.T) {
+	a := MustParse("1.00", "USD")
+	b := MustParse("2.00", "USD")
+
+	if c, _ := a.Cmp(b); c != -1 {
+		t.Errorf("expected -1, got %d", c)
+	}
+	if c, _ := b.Cmp(a); c != 1 {
+		t.Errorf("expected 1, got %d", c)
+	}
+	if c, _ := a.Cmp(a); c != 0 {
+		t.Errorf("expected 0, got %d", c)
+	}
+}
+
+func TestMarshalJSON(t *testing.T) {
+	data, err := json.Marshal(struct {
+		Amount Money `json:"amount"`
+	}{Amount: MustParse("1234.50", "USD")})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if string(data) != `{"amount":"1234.50"}` {
+		t.Errorf("unexpected JSON: %s", data)
+	}
+}
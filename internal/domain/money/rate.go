@@ -0,0 +1,129 @@
+package money
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// RateScale is the number of decimal places kept for exchange rates.
+// It matches the NUMERIC(20, 10) scale of the exchange rate columns.
+const RateScale = 10
+
+// ErrInvalidRate is returned when a decimal string is not a positive exchange rate.
+var ErrInvalidRate = errors.New("invalid exchange rate")
+
+// Rate is an exact, positive exchange rate: one unit of a base currency buys
+// Rate units of a quote currency. The zero value represents an unset rate.
+type Rate struct {
+	value *big.Rat
+}
+
+// OneRate returns the identity rate used between equal currencies.
+func OneRate() Rate {
+	return Rate{value: big.NewRat(1, 1)}
+}
+
+// ParseRate converts a decimal string such as "1.0845" into a Rate.
+func ParseRate(value string) (Rate, error) {
+	s := strings.TrimSpace(value)
+	whole, frac, hasPoint := strings.Cut(s, ".")
+	if whole == "" && frac == "" || hasPoint && frac == "" || !isDigits(whole) || !isDigits(frac) {
+		return Rate{}, fmt.Errorf("%w: %q", ErrInvalidRate, value)
+	}
+	if len(frac) > RateScale {
+		return Rate{}, fmt.Errorf("%w: %q has more than %d decimal places", ErrInvalidRate, value, RateScale)
+	}
+
+	r, ok := new(big.Rat).SetString(s)
+	if !ok || r.Sign() <= 0 {
+		return Rate{}, fmt.Errorf("%w: %q", ErrInvalidRate, value)
+	}
+	return Rate{value: r}, nil
+}
+
+// MustParseRate is like ParseRate but panics on error. Intended for constants and tests.
+func MustParseRate(value string) Rate {
+	r, err := ParseRate(value)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// IsZero reports whether the rate is unset.
+func (r Rate) IsZero() bool {
+	return r.value == nil
+}
+
+// Equal reports whether both rates have the same value.
+func (r Rate) Equal(other Rate) bool {
+	if r.value == nil || other.value == nil {
+		return r.value == other.value
+	}
+	return r.value.Cmp(other.value) == 0
+}
+
+// Inverse returns the rate for the opposite direction, rounded to RateScale decimals.
+func (r Rate) Inverse() Rate {
+	if r.value == nil {
+		return Rate{}
+	}
+	inverse := new(big.Rat).Inv(r.value)
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(RateScale), nil)
+	scaled := roundHalfAway(new(big.Rat).Mul(inverse, new(big.Rat).SetInt(scale)))
+	return Rate{value: new(big.Rat).SetFrac(scaled, scale)}
+}
+
+// String formats the rate as a decimal without trailing zeros, e.g. "1.0845".
+func (r Rate) String() string {
+	if r.value == nil {
+		return ""
+	}
+	s := r.value.FloatString(RateScale)
+	s = strings.TrimRight(s, "0")
+	return strings.TrimSuffix(s, ".")
+}
+
+// Convert multiplies the amount by the rate and returns it in the quote currency,
+// rounded half away from zero to the quote currency's minor units.
+func (m Money) Convert(rate Rate, currency string) (Money, error) {
+	if rate.value == nil {
+		return Money{}, ErrInvalidRate
+	}
+
+	v := new(big.Rat).SetInt64(m.amount)
+	v.Mul(v, rate.value)
+	if shift := Exponent(currency) - Exponent(m.currency); shift != 0 {
+		factor := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(shift))), nil))
+		if shift > 0 {
+			v.Mul(v, factor)
+		} else {
+			v.Quo(v, factor)
+		}
+	}
+
+	minor := roundHalfAway(v)
+	if !minor.IsInt64() {
+		return Money{}, fmt.Errorf("%w: converting %s %s to %s", ErrOverflow, m, m.currency, currency)
+	}
+	return New(minor.Int64(), currency), nil
+}
+
+// roundHalfAway rounds a rational to the nearest integer, halves away from zero.
+func roundHalfAway(v *big.Rat) *big.Int {
+	num, denom := v.Num(), v.Denom()
+	quo, rem := new(big.Int).QuoRem(num, denom, new(big.Int))
+	if new(big.Int).Mul(new(big.Int).Abs(rem), big.NewInt(2)).Cmp(denom) >= 0 {
+		quo.Add(quo, big.NewInt(int64(num.Sign())))
+	}
+	return quo
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
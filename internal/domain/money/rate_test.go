@@ -0,0 +1,101 @@
+package money
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseRate(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{"1.0845", "1.0845", false},
+		{"1", "1", false},
+		{"0.0000000001", "0.0000000001", false},
+		{"150.250", "150.25", false},
+		{"0", "", true},
+		{"-1.2", "", true},
+		{"1.", "", true},
+		{"abc", "", true},
+		{"0.00000000001", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseRate(tt.value)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidRate) {
+					t.Fatalf("ParseRate(%q) error = %v, want ErrInvalidRate", tt.value, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseRate(%q) unexpected error: %v", tt.value, err)
+			}
+			if got.String() != tt.want {
+				t.Errorf("ParseRate(%q) = %s, want %s", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertRoundsHalfAwayFromZero(t *testing.T) {
+	tests := []struct {
+		amount string
+		rate   string
+		want   string
+	}{
+		{"100.00", "1.0845", "108.45"},
+		{"0.05", "1.5", "0.08"},
+		{"-0.05", "1.5", "-0.08"},
+		{"0.01", "0.4", "0.00"},
+		{"10.00", "0.3333333333", "3.33"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.amount+"x"+tt.rate, func(t *testing.T) {
+			got, err := MustParse(tt.amount, "EUR").Convert(MustParseRate(tt.rate), "USD")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.String() != tt.want || got.Currency() != "USD" {
+				t.Errorf("Convert = %s %s, want %s USD", got, got.Currency(), tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertAcrossMinorUnits(t *testing.T) {
+	got, err := MustParse("10.00", "USD").Convert(MustParseRate("151.237"), "JPY")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.String() != "1512" {
+		t.Errorf("expected 1512 JPY, got %s", got)
+	}
+
+	got, err = MustParse("1512", "JPY").Convert(MustParseRate("0.0066"), "USD")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.String() != "9.98" {
+		t.Errorf("expected 9.98 USD, got %s", got)
+	}
+}
+
+func TestRateInverse(t *testing.T) {
+	if got := MustParseRate("1.25").Inverse().String(); got != "0.8" {
+		t.Errorf("expected 0.8, got %s", got)
+	}
+	if got := MustParseRate("3").Inverse().String(); got != "0.3333333333" {
+		t.Errorf("expected 0.3333333333, got %s", got)
+	}
+}
+
+func TestConvertWithoutRate(t *testing.T) {
+	if _, err := MustParse("1.00", "EUR").Convert(Rate{}, "USD"); !errors.Is(err, ErrInvalidRate) {
+		t.Errorf("expected ErrInvalidRate, got %v", err)
+	}
+}
@@ -27,6 +27,7 @@ func NewCreateAccountHandler(service interfaces.AccountService) *CreateAccountHa
 // @Param request body CreateAccountRequest true "Account creation request"
 // @Success 201 {object} AccountResponse
 // @Failure 400 {object} common.ValidationProblem "Validation error"
+// @Failure 404 {object} common.ProblemDetail "User or parent account not found"
 // @Failure 500 {object} common.ProblemDetail "Internal server error"
 // @Router /api/v1/accounts [post]
 func (h *CreateAccountHandler) Handle(w http.ResponseWriter, r *http.Request) {
@@ -50,22 +51,37 @@ func (h *CreateAccountHandler) Handle(w http.ResponseWriter, r *http.Request) {
 		common.ValidateUUID(req.UserID, "user_id"),
 		common.ValidateRequired(req.Name, "name"),
 		common.ValidateStringLength(req.Name, "name", 1, 100),
-		common.ValidateEnum(string(req.Type), []string{"CHECKING", "SAVINGS", "CREDIT_CARD", "CASH", "INVESTMENT"}, "type"),
+		common.ValidateEnum(string(req.Type), accountTypeNames(), "type"),
 		common.ValidateCurrency(req.Currency, "currency"),
 	)
+	if req.ParentID != "" {
+		validationErrors = append(validationErrors, common.CollectErrors(
+			common.ValidateUUID(req.ParentID, "parent_id"),
+		)...)
+	}
+	if req.Code != "" {
+		validationErrors = append(validationErrors, common.CollectErrors(
+			common.ValidateStringLength(req.Code, "code", 1, 20),
+		)...)
+	}
 
 	if len(validationErrors) > 0 {
 		common.WriteProblem(w, common.NewValidationProblemWithErrors(r.RequestURI, validationErrors))
 		return
 	}
 
-	account, err := h.service.CreateAccount(r.Context(), req.UserID, req.Name, req.Type, req.Currency)
+	account, err := h.service.CreateAccount(r.Context(), req.UserID, req.Name, req.Type, req.Currency, req.ParentID, req.Code)
 	if err != nil {
 		var dupErr *domainerrors.ErrDuplicateAccount
 		if errors.As(err, &dupErr) {
 			common.WriteProblem(w, common.NewValidationProblem(err.Error(), r.RequestURI))
 			return
 		}
+		var dupCodeErr *domainerrors.ErrDuplicateAccountCode
+		if errors.As(err, &dupCodeErr) {
+			common.WriteProblem(w, common.NewValidationProblem(err.Error(), r.RequestURI))
+			return
+		}
 		var notFoundErr *domainerrors.ErrNotFound
 		if errors.As(err, &notFoundErr) {
 			common.WriteProblem(w, common.NewNotFoundProblem(err.Error(), r.RequestURI))
@@ -123,3 +123,89 @@ func TestCreateAccountHandlerUserNotFound(t *testing.T) {
 		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
 	}
 }
+
+func TestCreateAccountHandlerWithParentAndCode(t *testing.T) {
+	mockService := &httptesting.MockAccountService{}
+	handler := NewCreateAccountHandler(mockService)
+
+	reqBody := CreateAccountRequest{
+		UserID:   "123e4567-e89b-12d3-a456-426614174000",
+		Name:     "Groceries",
+		Type:     constant.AccountTypeExpense,
+		Currency: "USD",
+		ParentID: "123e4567-e89b-12d3-a456-426614174001",
+		Code:     "5100",
+	}
+
+	req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/accounts", reqBody)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Errorf("expected status %d, got %d", http.StatusCreated, w.Code)
+	}
+
+	var response AccountResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if response.ParentID != reqBody.ParentID || response.Code != "5100" {
+		t.Errorf("expected parent %q and code 5100, got %q and %q", reqBody.ParentID, response.ParentID, response.Code)
+	}
+
+	if response.Class != constant.AccountClassExpense {
+		t.Errorf("expected class %q, got %q", constant.AccountClassExpense, response.Class)
+	}
+}
+
+func TestCreateAccountHandlerInvalidParentID(t *testing.T) {
+	mockService := &httptesting.MockAccountService{}
+	handler := NewCreateAccountHandler(mockService)
+
+	reqBody := CreateAccountRequest{
+		UserID:   "123e4567-e89b-12d3-a456-426614174000",
+		Name:     "Groceries",
+		Type:     constant.AccountTypeExpense,
+		Currency: "USD",
+		ParentID: "not-a-uuid",
+	}
+
+	req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/accounts", reqBody)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+
+	if mockService.CreateAccountCalls != 0 {
+		t.Errorf("expected 0 createAccount calls, got %d", mockService.CreateAccountCalls)
+	}
+}
+
+func TestCreateAccountHandlerDuplicateCode(t *testing.T) {
+	mockService := &httptesting.MockAccountService{
+		LastCreateAccountErr: errors.NewErrDuplicateAccountCode("123e4567-e89b-12d3-a456-426614174000", "5100"),
+	}
+	handler := NewCreateAccountHandler(mockService)
+
+	reqBody := CreateAccountRequest{
+		UserID:   "123e4567-e89b-12d3-a456-426614174000",
+		Name:     "Groceries",
+		Type:     constant.AccountTypeExpense,
+		Currency: "USD",
+		Code:     "5100",
+	}
+
+	req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/accounts", reqBody)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
@@ -1,8 +1,10 @@
 package account
 
 import (
+	"errors"
 	"net/http"
 
+	domainerrors "accounting/internal/domain/errors"
 	"accounting/internal/domain/interfaces"
 	"accounting/internal/handler/http/common"
 )
@@ -17,7 +19,7 @@ func NewDeleteAccountHandler(service interfaces.AccountService) *DeleteAccountHa
 
 // DeleteAccount godoc
 // @Summary Delete an account
-// @Description Delete an existing account by ID
+// @Description Delete an existing account by ID. Accounts with sub-accounts, transfers or journal postings cannot be deleted; the account's other transactions are deleted with it.
 // @Tags account
 // @Accept json
 // @Produce json
@@ -50,6 +52,16 @@ func (h *DeleteAccountHandler) Handle(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err := h.service.DeleteAccount(r.Context(), id); err != nil {
+		var notFoundErr *domainerrors.ErrNotFound
+		if errors.As(err, &notFoundErr) {
+			common.WriteProblem(w, common.NewNotFoundProblem(err.Error(), r.RequestURI))
+			return
+		}
+		var invalidErr *domainerrors.ErrInvalidInput
+		if errors.As(err, &invalidErr) {
+			common.WriteProblem(w, common.NewValidationProblem(err.Error(), r.RequestURI))
+			return
+		}
 		common.WriteProblem(w, common.NewInternalErrorProblem(r.RequestURI))
 		return
 	}
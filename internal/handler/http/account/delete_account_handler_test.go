@@ -5,6 +5,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"accounting/internal/domain/errors"
 	httptesting "accounting/internal/handler/http"
 )
 
@@ -65,3 +66,23 @@ func TestDeleteAccountHandlerInvalidMethod(t *testing.T) {
 		t.Errorf("expected status %d, got %d", http.StatusMethodNotAllowed, w.Code)
 	}
 }
+
+func TestDeleteAccountHandlerHasSubAccounts(t *testing.T) {
+	mockService := &httptesting.MockAccountService{
+		LastDeleteAccountErr: errors.NewErrInvalidInput("id", "account has sub-accounts; move or delete them first"),
+	}
+	handler := NewDeleteAccountHandler(mockService)
+
+	req, _ := http.NewRequest(
+		http.MethodDelete,
+		"/api/v1/accounts/123e4567-e89b-12d3-a456-426614174000",
+		nil,
+	)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
@@ -7,19 +7,57 @@ type CreateAccountRequest struct {
 	Name     string               `json:"name"`
 	Type     constant.AccountType `json:"type"`
 	Currency string               `json:"currency"`
+	ParentID string               `json:"parent_id,omitempty"`
+	Code     string               `json:"code,omitempty"`
 }
 
 type UpdateAccountRequest struct {
 	Name     string               `json:"name,omitempty"`
 	Type     constant.AccountType `json:"type,omitempty"`
 	Currency string               `json:"currency,omitempty"`
+	ParentID string               `json:"parent_id,omitempty"`
+	Code     string               `json:"code,omitempty"`
 }
 
 type AccountResponse struct {
-	ID       string               `json:"id"`
-	UserID   string               `json:"user_id"`
-	Name     string               `json:"name"`
-	Type     constant.AccountType `json:"type"`
-	Balance  float64              `json:"balance"`
-	Currency string               `json:"currency"`
+	ID       string                `json:"id"`
+	UserID   string                `json:"user_id"`
+	ParentID string                `json:"parent_id,omitempty"`
+	Code     string                `json:"code,omitempty"`
+	Name     string                `json:"name"`
+	Type     constant.AccountType  `json:"type"`
+	Class    constant.AccountClass `json:"class"`
+	Balance  string                `json:"balance"`
+	Currency string                `json:"currency"`
+}
+
+type AccountListResponse struct {
+	Items []*AccountResponse `json:"items"`
+	Limit int                `json:"limit"`
+	// NextCursor fetches the following page when passed as cursor; omitted on the last page
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+type AccountTreeNodeResponse struct {
+	AccountResponse
+	// RolledUpBalances maps each currency to the total balance of the account and its descendants
+	RolledUpBalances map[string]string `json:"rolled_up_balances"`
+	// RolledUpTotal is RolledUpBalances converted into BaseCurrency, present when a base currency was requested
+	RolledUpTotal string                     `json:"rolled_up_total,omitempty"`
+	BaseCurrency  string                     `json:"base_currency,omitempty"`
+	Children      []*AccountTreeNodeResponse `json:"children"`
+}
+
+// accountCSVHeader is the column layout of the CSV export. Columns are only
+// ever appended so that spreadsheets built on the export keep working.
+var accountCSVHeader = []string{
+	"id",
+	"user_id",
+	"parent_id",
+	"code",
+	"name",
+	"type",
+	"class",
+	"currency",
+	"balance",
 }
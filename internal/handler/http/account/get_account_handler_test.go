@@ -9,6 +9,7 @@ import (
 	"accounting/internal/domain/constant"
 	"accounting/internal/domain/entity"
 	"accounting/internal/domain/errors"
+	"accounting/internal/domain/money"
 	httptesting "accounting/internal/handler/http"
 )
 
@@ -18,7 +19,7 @@ func TestGetAccountHandlerSuccess(t *testing.T) {
 		UserID:   "user-123",
 		Name:     "Checking",
 		Type:     constant.AccountTypeChecking,
-		Balance:  1000.00,
+		Balance:  money.MustParse("1000.00", "USD"),
 		Currency: "USD",
 	}
 	mockService := &httptesting.MockAccountService{
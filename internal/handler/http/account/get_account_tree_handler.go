@@ -0,0 +1,69 @@
+package account
+
+import (
+	"errors"
+	"net/http"
+
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type GetAccountTreeHandler struct {
+	service interfaces.AccountService
+}
+
+func NewGetAccountTreeHandler(service interfaces.AccountService) *GetAccountTreeHandler {
+	return &GetAccountTreeHandler{service: service}
+}
+
+// GetAccountTree godoc
+// @Summary Get the chart of accounts
+// @Description Retrieve a user's accounts as a tree, each node with balances rolled up from its sub-accounts per currency.
+// @Description With base_currency, each node is also totalled in that currency at today's exchange rates.
+// @Tags account
+// @Accept json
+// @Produce json
+// @Param user_id path string true "User ID (UUID)"
+// @Param base_currency query string false "ISO 4217 currency to total each node in (e.g., USD)"
+// @Success 200 {array} AccountTreeNodeResponse
+// @Failure 400 {object} common.ValidationProblem "Validation error"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/users/{user_id}/accounts/tree [get]
+func (h *GetAccountTreeHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		common.WriteProblem(w, common.NewMethodNotAllowedProblem(r.RequestURI))
+		return
+	}
+
+	userID := extractID(r.URL.Path, "/api/v1/users/")
+	baseCurrency := r.URL.Query().Get("base_currency")
+	validationErrors := common.CollectErrors(common.ValidateUUID(userID, "user_id"))
+	if baseCurrency != "" {
+		validationErrors = append(validationErrors, common.CollectErrors(
+			common.ValidateCurrency(baseCurrency, "base_currency"),
+		)...)
+	}
+	if len(validationErrors) > 0 {
+		common.WriteProblem(w, common.NewValidationProblemWithErrors(r.RequestURI, validationErrors))
+		return
+	}
+
+	roots, err := h.service.GetAccountTree(r.Context(), userID, baseCurrency)
+	if err != nil {
+		var invalidErr *domainerrors.ErrInvalidInput
+		if errors.As(err, &invalidErr) {
+			common.WriteProblem(w, common.NewValidationProblem(err.Error(), r.RequestURI))
+			return
+		}
+		common.WriteProblem(w, common.NewInternalErrorProblem(r.RequestURI))
+		return
+	}
+
+	response := make([]*AccountTreeNodeResponse, 0, len(roots))
+	for _, root := range roots {
+		response = append(response, toAccountTreeNodeResponse(root))
+	}
+
+	common.WriteJSON(w, http.StatusOK, response)
+}
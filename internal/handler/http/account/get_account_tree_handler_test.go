@@ -0,0 +1,136 @@
+package account
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	"accounting/internal/domain/money"
+	httptesting "accounting/internal/handler/http"
+)
+
+func TestGetAccountTreeHandlerSuccess(t *testing.T) {
+	expenses := &entity.Account{ID: "expenses", Code: "5000", Name: "Expenses", Type: constant.AccountTypeExpense, Balance: money.Zero("USD"), Currency: "USD"}
+	groceries := &entity.Account{ID: "groceries", ParentID: "expenses", Code: "5100", Name: "Groceries", Type: constant.AccountTypeExpense, Balance: money.MustParse("120.50", "USD"), Currency: "USD"}
+	mockService := &httptesting.MockAccountService{
+		AccountTreeToReturn: []*entity.AccountNode{
+			{
+				Account:          expenses,
+				RolledUpBalances: []money.Money{money.MustParse("120.50", "USD")},
+				Children: []*entity.AccountNode{
+					{Account: groceries, RolledUpBalances: []money.Money{money.MustParse("120.50", "USD")}},
+				},
+			},
+		},
+	}
+	handler := NewGetAccountTreeHandler(mockService)
+
+	req, _ := http.NewRequest(
+		http.MethodGet,
+		"/api/v1/users/123e4567-e89b-12d3-a456-426614174000/accounts/tree",
+		nil,
+	)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var response []AccountTreeNodeResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if len(response) != 1 || response[0].Code != "5000" {
+		t.Fatalf("expected a single root with code 5000, got %+v", response)
+	}
+
+	if response[0].RolledUpBalances["USD"] != "120.50" {
+		t.Errorf("expected rolled-up USD balance 120.50, got %q", response[0].RolledUpBalances["USD"])
+	}
+
+	if len(response[0].Children) != 1 || response[0].Children[0].ParentID != "expenses" {
+		t.Errorf("expected groceries under expenses, got %+v", response[0].Children)
+	}
+
+	if mockService.GetAccountTreeCalls != 1 {
+		t.Errorf("expected 1 getAccountTree call, got %d", mockService.GetAccountTreeCalls)
+	}
+}
+
+func TestGetAccountTreeHandlerInvalidUserID(t *testing.T) {
+	mockService := &httptesting.MockAccountService{}
+	handler := NewGetAccountTreeHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/users/not-a-uuid/accounts/tree", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestGetAccountTreeHandlerBaseCurrency(t *testing.T) {
+	checking := &entity.Account{ID: "checking", Name: "Checking", Type: constant.AccountTypeChecking, Balance: money.MustParse("100.00", "EUR"), Currency: "EUR"}
+	mockService := &httptesting.MockAccountService{
+		AccountTreeToReturn: []*entity.AccountNode{
+			{
+				Account:          checking,
+				RolledUpBalances: []money.Money{money.MustParse("100.00", "EUR")},
+				RolledUpTotal:    money.MustParse("110.00", "USD"),
+			},
+		},
+	}
+	handler := NewGetAccountTreeHandler(mockService)
+
+	req, _ := http.NewRequest(
+		http.MethodGet,
+		"/api/v1/users/123e4567-e89b-12d3-a456-426614174000/accounts/tree?base_currency=USD",
+		nil,
+	)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var response []AccountTreeNodeResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if response[0].RolledUpTotal != "110.00" || response[0].BaseCurrency != "USD" {
+		t.Errorf("expected total 110.00 USD, got %q %q", response[0].RolledUpTotal, response[0].BaseCurrency)
+	}
+}
+
+func TestGetAccountTreeHandlerInvalidBaseCurrency(t *testing.T) {
+	mockService := &httptesting.MockAccountService{}
+	handler := NewGetAccountTreeHandler(mockService)
+
+	req, _ := http.NewRequest(
+		http.MethodGet,
+		"/api/v1/users/123e4567-e89b-12d3-a456-426614174000/accounts/tree?base_currency=usd",
+		nil,
+	)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+
+	if mockService.GetAccountTreeCalls != 0 {
+		t.Errorf("expected 0 getAccountTree calls, got %d", mockService.GetAccountTreeCalls)
+	}
+}
@@ -3,20 +3,69 @@ package account
 import (
 	"strings"
 
+	"accounting/internal/domain/constant"
 	"accounting/internal/domain/entity"
+	"accounting/internal/handler/http/common"
 )
 
 func toAccountResponse(account *entity.Account) *AccountResponse {
 	return &AccountResponse{
 		ID:       account.ID,
 		UserID:   account.UserID,
+		ParentID: account.ParentID,
+		Code:     account.Code,
 		Name:     account.Name,
 		Type:     account.Type,
-		Balance:  account.Balance,
+		Class:    account.Type.Class(),
+		Balance:  account.Balance.String(),
 		Currency: account.Currency,
 	}
 }
 
+// toAccountCSVRecord maps a domain account to a row of the CSV export, in the
+// order of accountCSVHeader
+func toAccountCSVRecord(account *entity.Account) []string {
+	return []string{
+		account.ID,
+		account.UserID,
+		account.ParentID,
+		common.CSVText(account.Code),
+		common.CSVText(account.Name),
+		string(account.Type),
+		string(account.Type.Class()),
+		account.Currency,
+		account.Balance.String(),
+	}
+}
+
+func toAccountTreeNodeResponse(node *entity.AccountNode) *AccountTreeNodeResponse {
+	response := &AccountTreeNodeResponse{
+		AccountResponse:  *toAccountResponse(node.Account),
+		RolledUpBalances: make(map[string]string, len(node.RolledUpBalances)),
+		Children:         make([]*AccountTreeNodeResponse, 0, len(node.Children)),
+	}
+	for _, balance := range node.RolledUpBalances {
+		response.RolledUpBalances[balance.Currency()] = balance.String()
+	}
+	if node.RolledUpTotal.Currency() != "" {
+		response.RolledUpTotal = node.RolledUpTotal.String()
+		response.BaseCurrency = node.RolledUpTotal.Currency()
+	}
+	for _, child := range node.Children {
+		response.Children = append(response.Children, toAccountTreeNodeResponse(child))
+	}
+	return response
+}
+
+// accountTypeNames returns the accepted values of the account type field.
+func accountTypeNames() []string {
+	names := make([]string, 0, len(constant.AccountTypes))
+	for _, accountType := range constant.AccountTypes {
+		names = append(names, string(accountType))
+	}
+	return names
+}
+
 func extractID(path, prefix string) string {
 	path = strings.TrimPrefix(path, prefix)
 	parts := strings.Split(path, "/")
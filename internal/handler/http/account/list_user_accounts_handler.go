@@ -4,6 +4,7 @@ import (
 	"errors"
 	"net/http"
 
+	"accounting/internal/domain/entity"
 	domainerrors "accounting/internal/domain/errors"
 	"accounting/internal/domain/interfaces"
 	"accounting/internal/handler/http/common"
@@ -19,12 +20,17 @@ func NewListUserAccountsHandler(service interfaces.AccountService) *ListUserAcco
 
 // ListUserAccounts godoc
 // @Summary List all accounts for a user
-// @Description Retrieve all accounts associated with a specific user
+// @Description Retrieve a page of a user's accounts, newest first. Pass next_cursor back as cursor to fetch the following page.
+// @Description With format=csv or an Accept header of text/csv, every account is streamed as CSV with a fixed column layout instead, and limit and cursor are ignored.
 // @Tags account
 // @Accept json
 // @Produce json
+// @Produce text/csv
 // @Param user_id path string true "User ID (UUID)"
-// @Success 200 {array} AccountResponse
+// @Param format query string false "Response format, overriding the Accept header (default json)" Enums(json, csv)
+// @Param limit query int false "Page size (1-200, default 50)"
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Success 200 {object} AccountListResponse
 // @Failure 400 {object} common.ValidationProblem "Validation error"
 // @Failure 500 {object} common.ProblemDetail "Internal server error"
 // @Router /api/v1/users/{user_id}/accounts [get]
@@ -50,21 +56,74 @@ func (h *ListUserAccountsHandler) Handle(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	accounts, err := h.service.ListUserAccounts(r.Context(), userID)
+	csvFormat, formatErr := common.WantsCSV(r)
+	page, validationErrors := common.ParsePageRequest(r.URL.Query())
+	validationErrors = append(validationErrors, common.CollectErrors(formatErr)...)
+	if len(validationErrors) > 0 {
+		common.WriteProblem(w, common.NewValidationProblemWithErrors(r.RequestURI, validationErrors))
+		return
+	}
+
+	if csvFormat {
+		h.writeCSV(w, r, userID)
+		return
+	}
+
+	accounts, nextCursor, err := h.service.ListUserAccounts(r.Context(), userID, page)
 	if err != nil {
-		var notFoundErr *domainerrors.ErrNotFound
-		if errors.As(err, &notFoundErr) {
-			common.WriteProblem(w, common.NewNotFoundProblem(err.Error(), r.RequestURI))
-			return
-		}
-		common.WriteProblem(w, common.NewInternalErrorProblem(r.RequestURI))
+		writeListError(w, r, err)
 		return
 	}
 
-	response := make([]*AccountResponse, 0, len(accounts))
+	response := &AccountListResponse{
+		Items:      make([]*AccountResponse, 0, len(accounts)),
+		Limit:      common.PageLimit(page),
+		NextCursor: nextCursor,
+	}
 	for _, acc := range accounts {
-		response = append(response, toAccountResponse(acc))
+		response.Items = append(response.Items, toAccountResponse(acc))
 	}
 
 	common.WriteJSON(w, http.StatusOK, response)
 }
+
+// writeCSV streams all of the user's accounts as CSV, fetching the largest
+// page the service allows at a time.
+func (h *ListUserAccountsHandler) writeCSV(w http.ResponseWriter, r *http.Request, userID string) {
+	stream := common.NewCSVStream(w, "accounts-"+userID+".csv", accountCSVHeader)
+	page := entity.PageRequest{Limit: entity.MaxPageLimit}
+	for {
+		accounts, nextCursor, err := h.service.ListUserAccounts(r.Context(), userID, page)
+		if err != nil {
+			// Once rows are sent the status can no longer change, so the export is cut short
+			if page.Cursor == "" {
+				writeListError(w, r, err)
+			}
+			return
+		}
+		for _, acc := range accounts {
+			if err := stream.Write(toAccountCSVRecord(acc)); err != nil {
+				return
+			}
+		}
+		if err := stream.Flush(); err != nil || nextCursor == "" {
+			return
+		}
+		page.Cursor = nextCursor
+	}
+}
+
+// writeListError maps account listing errors to problem responses.
+func writeListError(w http.ResponseWriter, r *http.Request, err error) {
+	var notFoundErr *domainerrors.ErrNotFound
+	if errors.As(err, &notFoundErr) {
+		common.WriteProblem(w, common.NewNotFoundProblem(err.Error(), r.RequestURI))
+		return
+	}
+	var invalidErr *domainerrors.ErrInvalidInput
+	if errors.As(err, &invalidErr) {
+		common.WriteProblem(w, common.NewValidationProblem(err.Error(), r.RequestURI))
+		return
+	}
+	common.WriteProblem(w, common.NewInternalErrorProblem(r.RequestURI))
+}
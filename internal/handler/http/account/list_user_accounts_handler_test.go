@@ -1,13 +1,16 @@
 package account
 
 import (
+	"encoding/csv"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"accounting/internal/domain/constant"
 	"accounting/internal/domain/entity"
+	"accounting/internal/domain/money"
 	httptesting "accounting/internal/handler/http"
 )
 
@@ -18,7 +21,7 @@ func TestListUserAccountsHandlerSuccess(t *testing.T) {
 			UserID:   "123e4567-e89b-12d3-a456-426614174000",
 			Name:     "Checking",
 			Type:     constant.AccountTypeChecking,
-			Balance:  1000.00,
+			Balance:  money.MustParse("1000.00", "USD"),
 			Currency: "USD",
 		},
 		{
@@ -26,12 +29,13 @@ func TestListUserAccountsHandlerSuccess(t *testing.T) {
 			UserID:   "123e4567-e89b-12d3-a456-426614174000",
 			Name:     "Savings",
 			Type:     constant.AccountTypeSavings,
-			Balance:  5000.00,
+			Balance:  money.MustParse("5000.00", "USD"),
 			Currency: "USD",
 		},
 	}
 	mockService := &httptesting.MockAccountService{
-		AccountsToReturn: accounts,
+		AccountsToReturn:   accounts,
+		NextCursorToReturn: "next-page",
 	}
 	handler := NewListUserAccountsHandler(mockService)
 
@@ -48,17 +52,25 @@ func TestListUserAccountsHandlerSuccess(t *testing.T) {
 		t.Errorf("expected status %d, got %d", http.StatusOK, w.Code)
 	}
 
-	var response []AccountResponse
+	var response AccountListResponse
 	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
 		t.Fatalf("failed to decode response: %v", err)
 	}
 
-	if len(response) != 2 {
-		t.Errorf("expected 2 accounts, got %d", len(response))
+	if len(response.Items) != 2 {
+		t.Fatalf("expected 2 accounts, got %d", len(response.Items))
 	}
 
-	if response[0].Name != "Checking" {
-		t.Errorf("expected first account name %q, got %q", "Checking", response[0].Name)
+	if response.Items[0].Name != "Checking" {
+		t.Errorf("expected first account name %q, got %q", "Checking", response.Items[0].Name)
+	}
+
+	if response.Limit != entity.DefaultPageLimit {
+		t.Errorf("expected limit %d, got %d", entity.DefaultPageLimit, response.Limit)
+	}
+
+	if response.NextCursor != "next-page" {
+		t.Errorf("expected next cursor %q, got %q", "next-page", response.NextCursor)
 	}
 
 	if mockService.ListUserAccountsCalls != 1 {
@@ -85,13 +97,57 @@ func TestListUserAccountsHandlerEmpty(t *testing.T) {
 		t.Errorf("expected status %d, got %d", http.StatusOK, w.Code)
 	}
 
-	var response []AccountResponse
+	var response AccountListResponse
 	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
 		t.Fatalf("failed to decode response: %v", err)
 	}
 
-	if len(response) != 0 {
-		t.Errorf("expected 0 accounts, got %d", len(response))
+	if len(response.Items) != 0 {
+		t.Errorf("expected 0 accounts, got %d", len(response.Items))
+	}
+}
+
+func TestListUserAccountsHandlerPageParams(t *testing.T) {
+	mockService := &httptesting.MockAccountService{}
+	handler := NewListUserAccountsHandler(mockService)
+
+	req, _ := http.NewRequest(
+		http.MethodGet,
+		"/api/v1/users/123e4567-e89b-12d3-a456-426614174000/accounts?limit=25&cursor=abc",
+		nil,
+	)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	if mockService.LastPage.Limit != 25 || mockService.LastPage.Cursor != "abc" {
+		t.Errorf("expected page {25 abc}, got %+v", mockService.LastPage)
+	}
+}
+
+func TestListUserAccountsHandlerInvalidLimit(t *testing.T) {
+	mockService := &httptesting.MockAccountService{}
+	handler := NewListUserAccountsHandler(mockService)
+
+	req, _ := http.NewRequest(
+		http.MethodGet,
+		"/api/v1/users/123e4567-e89b-12d3-a456-426614174000/accounts?limit=500",
+		nil,
+	)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+
+	if mockService.ListUserAccountsCalls != 0 {
+		t.Errorf("expected no service call, got %d", mockService.ListUserAccountsCalls)
 	}
 }
 
@@ -130,3 +186,94 @@ func TestListUserAccountsHandlerInvalidMethod(t *testing.T) {
 		t.Errorf("expected status %d, got %d", http.StatusMethodNotAllowed, w.Code)
 	}
 }
+
+func TestListUserAccountsHandlerCSV(t *testing.T) {
+	mockService := &httptesting.MockAccountService{
+		AccountPagesToReturn: [][]*entity.Account{
+			{
+				{
+					ID:       "account-1",
+					UserID:   "123e4567-e89b-12d3-a456-426614174000",
+					Code:     "1000",
+					Name:     "Checking",
+					Type:     constant.AccountTypeChecking,
+					Balance:  money.MustParse("100.50", "USD"),
+					Currency: "USD",
+				},
+			},
+			{
+				{
+					ID:       "account-2",
+					UserID:   "123e4567-e89b-12d3-a456-426614174000",
+					ParentID: "account-1",
+					Name:     "Card",
+					Type:     constant.AccountTypeCreditCard,
+					Balance:  money.MustParse("-20", "EUR"),
+					Currency: "EUR",
+				},
+			},
+		},
+	}
+	handler := NewListUserAccountsHandler(mockService)
+
+	req, _ := http.NewRequest(
+		http.MethodGet,
+		"/api/v1/users/123e4567-e89b-12d3-a456-426614174000/accounts?format=csv",
+		nil,
+	)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	if contentType := w.Header().Get("Content-Type"); !strings.HasPrefix(contentType, "text/csv") {
+		t.Errorf("expected a text/csv response, got %q", contentType)
+	}
+	if disposition := w.Header().Get("Content-Disposition"); !strings.HasPrefix(disposition, "attachment") {
+		t.Errorf("expected an attachment, got %q", disposition)
+	}
+	if mockService.ListUserAccountsCalls != 2 {
+		t.Errorf("expected both pages to be fetched, got %d calls", mockService.ListUserAccountsCalls)
+	}
+
+	records, err := csv.NewReader(w.Body).ReadAll()
+	if err != nil {
+		t.Fatalf("failed to parse CSV: %v", err)
+	}
+	expected := [][]string{
+		accountCSVHeader,
+		{"account-1", "123e4567-e89b-12d3-a456-426614174000", "", "1000", "Checking", "CHECKING", "ASSET", "USD", "100.50"},
+		{"account-2", "123e4567-e89b-12d3-a456-426614174000", "account-1", "", "Card", "CREDIT_CARD", "LIABILITY", "EUR", "-20.00"},
+	}
+	if len(records) != len(expected) {
+		t.Fatalf("expected %d records, got %d", len(expected), len(records))
+	}
+	for i := range expected {
+		if strings.Join(records[i], ",") != strings.Join(expected[i], ",") {
+			t.Errorf("record %d: expected %v, got %v", i, expected[i], records[i])
+		}
+	}
+}
+
+func TestListUserAccountsHandlerCSVInvalidFormat(t *testing.T) {
+	mockService := &httptesting.MockAccountService{}
+	handler := NewListUserAccountsHandler(mockService)
+
+	req, _ := http.NewRequest(
+		http.MethodGet,
+		"/api/v1/users/123e4567-e89b-12d3-a456-426614174000/accounts?format=xlsx",
+		nil,
+	)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+	if mockService.ListUserAccountsCalls != 0 {
+		t.Errorf("expected no service call, got %d", mockService.ListUserAccountsCalls)
+	}
+}
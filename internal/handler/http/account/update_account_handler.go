@@ -20,7 +20,7 @@ func NewUpdateAccountHandler(service interfaces.AccountService) *UpdateAccountHa
 
 // UpdateAccount godoc
 // @Summary Update an account
-// @Description Update an existing account with the specified details
+// @Description Update an existing account with the specified details. The currency, and the type between debit-normal and credit-normal (INCOME, EQUITY) types, can only change while the account has no transactions or journal postings.
 // @Tags account
 // @Accept json
 // @Produce json
@@ -74,7 +74,7 @@ func (h *UpdateAccountHandler) Handle(w http.ResponseWriter, r *http.Request) {
 
 	if req.Type != "" {
 		validationErrors = append(validationErrors, common.CollectErrors(
-			common.ValidateEnum(string(req.Type), []string{"CHECKING", "SAVINGS", "CREDIT_CARD", "CASH", "INVESTMENT"}, "type"),
+			common.ValidateEnum(string(req.Type), accountTypeNames(), "type"),
 		)...)
 	}
 
@@ -84,18 +84,35 @@ func (h *UpdateAccountHandler) Handle(w http.ResponseWriter, r *http.Request) {
 		)...)
 	}
 
+	if req.ParentID != "" {
+		validationErrors = append(validationErrors, common.CollectErrors(
+			common.ValidateUUID(req.ParentID, "parent_id"),
+		)...)
+	}
+
+	if req.Code != "" {
+		validationErrors = append(validationErrors, common.CollectErrors(
+			common.ValidateStringLength(req.Code, "code", 1, 20),
+		)...)
+	}
+
 	if len(validationErrors) > 0 {
 		common.WriteProblem(w, common.NewValidationProblemWithErrors(r.RequestURI, validationErrors))
 		return
 	}
 
-	account, err := h.service.UpdateAccount(r.Context(), id, req.Name, req.Type, req.Currency)
+	account, err := h.service.UpdateAccount(r.Context(), id, req.Name, req.Type, req.Currency, req.ParentID, req.Code)
 	if err != nil {
 		var notFoundErr *domainerrors.ErrNotFound
 		if errors.As(err, &notFoundErr) {
 			common.WriteProblem(w, common.NewNotFoundProblem(err.Error(), r.RequestURI))
 			return
 		}
+		var dupCodeErr *domainerrors.ErrDuplicateAccountCode
+		if errors.As(err, &dupCodeErr) {
+			common.WriteProblem(w, common.NewValidationProblem(err.Error(), r.RequestURI))
+			return
+		}
 		var invalidErr *domainerrors.ErrInvalidInput
 		if errors.As(err, &invalidErr) {
 			common.WriteProblem(w, common.NewValidationProblem(err.Error(), r.RequestURI))
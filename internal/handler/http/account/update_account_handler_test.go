@@ -9,6 +9,7 @@ import (
 	"accounting/internal/domain/constant"
 	"accounting/internal/domain/entity"
 	"accounting/internal/domain/errors"
+	"accounting/internal/domain/money"
 	httptesting "accounting/internal/handler/http"
 )
 
@@ -18,7 +19,7 @@ func TestUpdateAccountHandlerSuccess(t *testing.T) {
 		UserID:   "user-123",
 		Name:     "Updated Checking",
 		Type:     constant.AccountTypeSavings,
-		Balance:  1000.00,
+		Balance:  money.MustParse("1000.00", "EUR"),
 		Currency: "EUR",
 	}
 	mockService := &httptesting.MockAccountService{
@@ -61,7 +62,7 @@ func TestUpdateAccountHandlerPartialUpdate(t *testing.T) {
 		UserID:   "user-123",
 		Name:     "Updated Name",
 		Type:     constant.AccountTypeChecking,
-		Balance:  1000.00,
+		Balance:  money.MustParse("1000.00", "USD"),
 		Currency: "USD",
 	}
 	mockService := &httptesting.MockAccountService{
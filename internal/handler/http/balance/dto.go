@@ -0,0 +1,21 @@
+package balance
+
+type BalanceResponse struct {
+	AccountID string `json:"account_id"`
+	// AsOf is the day (YYYY-MM-DD) the balance was taken at the end of
+	AsOf     string `json:"as_of"`
+	Balance  string `json:"balance"`
+	Currency string `json:"currency"`
+}
+
+type DailyBalanceResponse struct {
+	Date    string `json:"date"`
+	Balance string `json:"balance"`
+}
+
+type DailyBalancesResponse struct {
+	AccountID string `json:"account_id"`
+	Currency  string `json:"currency"`
+	// Balances holds the end-of-day balance of every day in the range, oldest first
+	Balances []*DailyBalanceResponse `json:"balances"`
+}
@@ -0,0 +1,81 @@
+package balance
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type GetBalanceHandler struct {
+	service interfaces.BalanceService
+}
+
+func NewGetBalanceHandler(service interfaces.BalanceService) *GetBalanceHandler {
+	return &GetBalanceHandler{service: service}
+}
+
+// @Summary Get account balance as of a date
+// @Description Compute an account's balance at the end of a day (UTC) from its transaction and journal history
+// @Tags balances
+// @Accept json
+// @Produce json
+// @Param accountID path string true "Account ID"
+// @Param as_of query string false "Day to take the balance at the end of (YYYY-MM-DD), default today"
+// @Success 200 {object} BalanceResponse
+// @Failure 400 {object} common.ValidationProblem "Validation error"
+// @Failure 404 {object} common.ProblemDetail "Account not found"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/accounts/{accountID}/balance [get]
+func (h *GetBalanceHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		common.WriteProblem(w, common.NewMethodNotAllowedProblem(r.RequestURI))
+		return
+	}
+
+	accountID := extractID(r.URL.Path, "/api/v1/accounts/")
+	asOfParam := r.URL.Query().Get("as_of")
+
+	checks := []*common.ValidationError{
+		common.ValidateUUID(accountID, "accountID"),
+	}
+	if asOfParam != "" {
+		checks = append(checks, common.ValidateDate(asOfParam, "as_of"))
+	}
+	validationErrors := common.CollectErrors(checks...)
+	if len(validationErrors) > 0 {
+		common.WriteProblem(w, common.NewValidationProblemWithErrors(r.RequestURI, validationErrors))
+		return
+	}
+
+	asOf := time.Now()
+	if asOfParam != "" {
+		asOf, _ = time.Parse(time.DateOnly, asOfParam)
+	}
+
+	balance, err := h.service.GetBalanceAsOf(r.Context(), accountID, asOf)
+	if err != nil {
+		writeBalanceError(w, r, err)
+		return
+	}
+
+	common.WriteJSON(w, http.StatusOK, toBalanceResponse(accountID, balance))
+}
+
+// writeBalanceError maps balance service errors to problem responses.
+func writeBalanceError(w http.ResponseWriter, r *http.Request, err error) {
+	var notFoundErr *domainerrors.ErrNotFound
+	if errors.As(err, &notFoundErr) {
+		common.WriteProblem(w, common.NewNotFoundProblem(err.Error(), r.RequestURI))
+		return
+	}
+	var invalidErr *domainerrors.ErrInvalidInput
+	if errors.As(err, &invalidErr) {
+		common.WriteProblem(w, common.NewValidationProblem(err.Error(), r.RequestURI))
+		return
+	}
+	common.WriteProblem(w, common.NewInternalErrorProblem(r.RequestURI))
+}
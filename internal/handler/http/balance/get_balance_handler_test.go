@@ -0,0 +1,125 @@
+package balance
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/money"
+	httptesting "accounting/internal/handler/http"
+)
+
+const balancePath = "/api/v1/accounts/123e4567-e89b-12d3-a456-426614174000/balance"
+
+func TestGetBalanceHandlerSuccess(t *testing.T) {
+	mockService := &httptesting.MockBalanceService{
+		BalanceToReturn: &entity.DailyBalance{
+			Date:    time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC),
+			Balance: money.MustParse("1234.56", "EUR"),
+		},
+	}
+	handler := NewGetBalanceHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, balancePath+"?as_of=2023-12-31", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	if !mockService.LastDay.Equal(time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected day 2023-12-31, got %s", mockService.LastDay)
+	}
+
+	var response BalanceResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if response.AsOf != "2023-12-31" || response.Balance != "1234.56" || response.Currency != "EUR" {
+		t.Errorf("expected 1234.56 EUR as of 2023-12-31, got %+v", response)
+	}
+}
+
+func TestGetBalanceHandlerDefaultsToToday(t *testing.T) {
+	mockService := &httptesting.MockBalanceService{}
+	handler := NewGetBalanceHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, balancePath, nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	if time.Since(mockService.LastDay) > time.Minute {
+		t.Errorf("expected today, got %s", mockService.LastDay)
+	}
+}
+
+func TestGetBalanceHandlerValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+	}{
+		{"invalid account ID", "/api/v1/accounts/not-a-uuid/balance"},
+		{"invalid as_of", balancePath + "?as_of=31-12-2023"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &httptesting.MockBalanceService{}
+			handler := NewGetBalanceHandler(mockService)
+
+			req, _ := http.NewRequest(http.MethodGet, tt.path, nil)
+			w := httptest.NewRecorder()
+
+			handler.Handle(w, req)
+
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+			}
+			if mockService.GetBalanceAsOfCalls != 0 {
+				t.Errorf("expected no service call, got %d", mockService.GetBalanceAsOfCalls)
+			}
+		})
+	}
+}
+
+func TestGetBalanceHandlerAccountNotFound(t *testing.T) {
+	mockService := &httptesting.MockBalanceService{
+		LastGetBalanceAsOfErr: domainerrors.NewErrNotFound("account", "123e4567-e89b-12d3-a456-426614174000"),
+	}
+	handler := NewGetBalanceHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, balancePath, nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestGetBalanceHandlerInvalidMethod(t *testing.T) {
+	mockService := &httptesting.MockBalanceService{}
+	handler := NewGetBalanceHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodPost, balancePath, nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected status %d, got %d", http.StatusMethodNotAllowed, w.Code)
+	}
+}
@@ -0,0 +1,61 @@
+package balance
+
+import (
+	"net/http"
+	"time"
+
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type GetDailyBalancesHandler struct {
+	service interfaces.BalanceService
+}
+
+func NewGetDailyBalancesHandler(service interfaces.BalanceService) *GetDailyBalancesHandler {
+	return &GetDailyBalancesHandler{service: service}
+}
+
+// @Summary Get daily account balances
+// @Description Return an account's end-of-day balance (UTC) for every day of a range of at most 3660 days, for charting. Month-end balances are cached as snapshots, so long histories stay fast.
+// @Tags balances
+// @Accept json
+// @Produce json
+// @Param accountID path string true "Account ID"
+// @Param from query string true "First day (YYYY-MM-DD)"
+// @Param to query string true "Last day (YYYY-MM-DD), inclusive"
+// @Success 200 {object} DailyBalancesResponse
+// @Failure 400 {object} common.ValidationProblem "Validation error"
+// @Failure 404 {object} common.ProblemDetail "Account not found"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/accounts/{accountID}/balance/daily [get]
+func (h *GetDailyBalancesHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		common.WriteProblem(w, common.NewMethodNotAllowedProblem(r.RequestURI))
+		return
+	}
+
+	accountID := extractID(r.URL.Path, "/api/v1/accounts/")
+	fromParam, toParam := r.URL.Query().Get("from"), r.URL.Query().Get("to")
+
+	validationErrors := common.CollectErrors(
+		common.ValidateUUID(accountID, "accountID"),
+		common.ValidateDate(fromParam, "from"),
+		common.ValidateDate(toParam, "to"),
+	)
+	if len(validationErrors) > 0 {
+		common.WriteProblem(w, common.NewValidationProblemWithErrors(r.RequestURI, validationErrors))
+		return
+	}
+
+	from, _ := time.Parse(time.DateOnly, fromParam)
+	to, _ := time.Parse(time.DateOnly, toParam)
+
+	balances, err := h.service.GetDailyBalances(r.Context(), accountID, from, to)
+	if err != nil {
+		writeBalanceError(w, r, err)
+		return
+	}
+
+	common.WriteJSON(w, http.StatusOK, toDailyBalancesResponse(accountID, balances))
+}
@@ -0,0 +1,96 @@
+package balance
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/money"
+	httptesting "accounting/internal/handler/http"
+)
+
+const dailyBalancesPath = "/api/v1/accounts/123e4567-e89b-12d3-a456-426614174000/balance/daily"
+
+func TestGetDailyBalancesHandlerSuccess(t *testing.T) {
+	mockService := &httptesting.MockBalanceService{
+		BalancesToReturn: []*entity.DailyBalance{
+			{Date: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), Balance: money.MustParse("100", "USD")},
+			{Date: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), Balance: money.MustParse("75.25", "USD")},
+		},
+	}
+	handler := NewGetDailyBalancesHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, dailyBalancesPath+"?from=2024-01-01&to=2024-01-02", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var response DailyBalancesResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if response.Currency != "USD" {
+		t.Errorf("expected currency USD, got %q", response.Currency)
+	}
+	if len(response.Balances) != 2 {
+		t.Fatalf("expected 2 balances, got %d", len(response.Balances))
+	}
+	if response.Balances[1].Date != "2024-01-02" || response.Balances[1].Balance != "75.25" {
+		t.Errorf("expected 75.25 on 2024-01-02, got %+v", response.Balances[1])
+	}
+}
+
+func TestGetDailyBalancesHandlerValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+	}{
+		{"invalid account ID", "/api/v1/accounts/not-a-uuid/balance/daily?from=2024-01-01&to=2024-01-02"},
+		{"missing from", dailyBalancesPath + "?to=2024-01-02"},
+		{"missing to", dailyBalancesPath + "?from=2024-01-01"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &httptesting.MockBalanceService{}
+			handler := NewGetDailyBalancesHandler(mockService)
+
+			req, _ := http.NewRequest(http.MethodGet, tt.path, nil)
+			w := httptest.NewRecorder()
+
+			handler.Handle(w, req)
+
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+			}
+			if mockService.GetDailyBalancesCalls != 0 {
+				t.Errorf("expected no service call, got %d", mockService.GetDailyBalancesCalls)
+			}
+		})
+	}
+}
+
+func TestGetDailyBalancesHandlerRangeTooLong(t *testing.T) {
+	mockService := &httptesting.MockBalanceService{
+		LastGetDailyBalancesErr: domainerrors.NewErrInvalidInput("to", "the range may span at most 3660 days"),
+	}
+	handler := NewGetDailyBalancesHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, dailyBalancesPath+"?from=2000-01-01&to=2024-01-01", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
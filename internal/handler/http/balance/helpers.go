@@ -0,0 +1,41 @@
+package balance
+
+import (
+	"strings"
+	"time"
+
+	"accounting/internal/domain/entity"
+)
+
+func toBalanceResponse(accountID string, balance *entity.DailyBalance) *BalanceResponse {
+	return &BalanceResponse{
+		AccountID: accountID,
+		AsOf:      balance.Date.Format(time.DateOnly),
+		Balance:   balance.Balance.String(),
+		Currency:  balance.Balance.Currency(),
+	}
+}
+
+func toDailyBalancesResponse(accountID string, balances []*entity.DailyBalance) *DailyBalancesResponse {
+	response := &DailyBalancesResponse{
+		AccountID: accountID,
+		Balances:  make([]*DailyBalanceResponse, 0, len(balances)),
+	}
+	for _, balance := range balances {
+		response.Currency = balance.Balance.Currency()
+		response.Balances = append(response.Balances, &DailyBalanceResponse{
+			Date:    balance.Date.Format(time.DateOnly),
+			Balance: balance.Balance.String(),
+		})
+	}
+	return response
+}
+
+func extractID(path, prefix string) string {
+	path = strings.TrimPrefix(path, prefix)
+	parts := strings.Split(path, "/")
+	if len(parts) > 0 {
+		return parts[0]
+	}
+	return ""
+}
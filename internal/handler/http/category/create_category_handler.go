@@ -0,0 +1,74 @@
+package category
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type CreateCategoryHandler struct {
+	service interfaces.CategoryService
+}
+
+func NewCreateCategoryHandler(service interfaces.CategoryService) *CreateCategoryHandler {
+	return &CreateCategoryHandler{service: service}
+}
+
+// CreateCategory godoc
+// @Summary Create a category
+// @Description Create a category for a user's transactions, optionally nested under another of the user's categories. Names are unique among the user's categories under the same parent, ignoring case, so different parents may have subcategories of the same name. A category restricted to INCOME or EXPENSE transactions only has subcategories of the same type.
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param request body CreateCategoryRequest true "Category creation request"
+// @Success 201 {object} CategoryResponse
+// @Failure 400 {object} common.ValidationProblem "Validation error or duplicate name"
+// @Failure 404 {object} common.ProblemDetail "User or parent category not found"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/categories [post]
+func (h *CreateCategoryHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		common.WriteProblem(w, common.NewMethodNotAllowedProblem(r.RequestURI))
+		return
+	}
+
+	var req CreateCategoryRequest
+	if r.Body == nil {
+		common.WriteProblem(w, common.NewBadRequestProblem("invalid request body", r.RequestURI))
+		return
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		common.WriteProblem(w, common.NewBadRequestProblem("invalid request body", r.RequestURI))
+		return
+	}
+
+	validationErrors := common.CollectErrors(
+		common.ValidateUUID(req.UserID, "user_id"),
+		common.ValidateRequired(req.Name, "name"),
+		common.ValidateStringLength(req.Name, "name", 1, 100),
+	)
+	if req.ParentID != "" {
+		validationErrors = append(validationErrors, common.CollectErrors(
+			common.ValidateUUID(req.ParentID, "parent_id"),
+		)...)
+	}
+	if req.Type != "" {
+		validationErrors = append(validationErrors, common.CollectErrors(
+			validateCategoryType(req.Type),
+		)...)
+	}
+	if len(validationErrors) > 0 {
+		common.WriteProblem(w, common.NewValidationProblemWithErrors(r.RequestURI, validationErrors))
+		return
+	}
+
+	category, err := h.service.CreateCategory(r.Context(), req.UserID, req.Name, req.ParentID, req.Type)
+	if err != nil {
+		writeCategoryError(w, r, err)
+		return
+	}
+
+	common.WriteJSON(w, http.StatusCreated, toCategoryResponse(category))
+}
@@ -0,0 +1,116 @@
+package category
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/errors"
+	httptesting "accounting/internal/handler/http"
+	"accounting/internal/handler/http/common"
+)
+
+const (
+	testUserID     = "123e4567-e89b-12d3-a456-426614174000"
+	testCategoryID = "323e4567-e89b-12d3-a456-426614174000"
+	testParentID   = "323e4567-e89b-12d3-a456-426614174001"
+)
+
+func TestCreateCategoryHandlerSuccess(t *testing.T) {
+	mockService := &httptesting.MockCategoryService{}
+	handler := NewCreateCategoryHandler(mockService)
+
+	req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/categories", CreateCategoryRequest{
+		UserID:   testUserID,
+		Name:     "Groceries",
+		ParentID: testParentID,
+		Type:     constant.TransactionTypeExpense,
+	})
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+
+	var response CategoryResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if response.Name != "Groceries" || response.ParentID != testParentID || response.Type != constant.TransactionTypeExpense || response.Archived {
+		t.Errorf("unexpected category %+v", response)
+	}
+}
+
+func TestCreateCategoryHandlerValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		request CreateCategoryRequest
+		field   string
+	}{
+		{"invalid user ID", CreateCategoryRequest{UserID: "not-a-uuid", Name: "Food"}, "user_id"},
+		{"missing name", CreateCategoryRequest{UserID: testUserID}, "name"},
+		{"invalid parent ID", CreateCategoryRequest{UserID: testUserID, Name: "Groceries", ParentID: "Food"}, "parent_id"},
+		{"transfers", CreateCategoryRequest{UserID: testUserID, Name: "Savings", Type: constant.TransactionTypeTransfer}, "type"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &httptesting.MockCategoryService{}
+			handler := NewCreateCategoryHandler(mockService)
+
+			req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/categories", tt.request)
+			w := httptest.NewRecorder()
+
+			handler.Handle(w, req)
+
+			if w.Code != http.StatusBadRequest {
+				t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+			}
+			var problem common.ValidationProblem
+			if err := json.NewDecoder(w.Body).Decode(&problem); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if len(problem.Errors) == 0 || problem.Errors[0].Field != tt.field {
+				t.Errorf("expected an error on %s, got %+v", tt.field, problem.Errors)
+			}
+			if mockService.CreateCategoryCalls != 0 {
+				t.Error("expected the service not to be called")
+			}
+		})
+	}
+}
+
+func TestCreateCategoryHandlerServiceErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{"duplicate name", errors.NewErrDuplicateCategory(testUserID, "Food"), http.StatusBadRequest},
+		{"parent not found", errors.NewErrNotFound("category", testParentID), http.StatusNotFound},
+		{"archived parent", errors.NewErrInvalidInput("parent_id", `parent category "Food" is archived`), http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewCreateCategoryHandler(&httptesting.MockCategoryService{LastCreateCategoryErr: tt.err})
+
+			req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/categories", CreateCategoryRequest{
+				UserID:   testUserID,
+				Name:     "Food",
+				ParentID: testParentID,
+			})
+			w := httptest.NewRecorder()
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.expected {
+				t.Errorf("expected status %d, got %d", tt.expected, w.Code)
+			}
+		})
+	}
+}
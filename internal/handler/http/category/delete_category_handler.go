@@ -0,0 +1,47 @@
+package category
+
+import (
+	"net/http"
+
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type DeleteCategoryHandler struct {
+	service interfaces.CategoryService
+}
+
+func NewDeleteCategoryHandler(service interfaces.CategoryService) *DeleteCategoryHandler {
+	return &DeleteCategoryHandler{service: service}
+}
+
+// DeleteCategory godoc
+// @Summary Delete a category
+// @Description Delete a category without subcategories that no rule sets. Its transactions become uncategorized; archive the category instead to keep them in it.
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param category_id path string true "Category ID (UUID)"
+// @Success 204 "Category deleted successfully"
+// @Failure 400 {object} common.ValidationProblem "Validation error"
+// @Failure 404 {object} common.ProblemDetail "Category not found"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/categories/{category_id} [delete]
+func (h *DeleteCategoryHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		common.WriteProblem(w, common.NewMethodNotAllowedProblem(r.RequestURI))
+		return
+	}
+
+	id, ok := extractCategoryID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteCategory(r.Context(), id); err != nil {
+		writeCategoryError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
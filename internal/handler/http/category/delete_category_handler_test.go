@@ -0,0 +1,57 @@
+package category
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"accounting/internal/domain/errors"
+	httptesting "accounting/internal/handler/http"
+)
+
+func TestDeleteCategoryHandlerSuccess(t *testing.T) {
+	mockService := &httptesting.MockCategoryService{}
+	handler := NewDeleteCategoryHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodDelete, "/api/v1/categories/"+testCategoryID, nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusNoContent {
+		t.Errorf("expected status %d, got %d", http.StatusNoContent, w.Code)
+	}
+	if mockService.DeleteCategoryCalls != 1 {
+		t.Errorf("expected 1 deleteCategory call, got %d", mockService.DeleteCategoryCalls)
+	}
+}
+
+func TestDeleteCategoryHandlerWithSubcategories(t *testing.T) {
+	handler := NewDeleteCategoryHandler(&httptesting.MockCategoryService{
+		LastDeleteCategoryErr: errors.NewErrInvalidInput("id", "category has subcategories; move or delete them first"),
+	})
+
+	req, _ := http.NewRequest(http.MethodDelete, "/api/v1/categories/"+testCategoryID, nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestDeleteCategoryHandlerNotFound(t *testing.T) {
+	handler := NewDeleteCategoryHandler(&httptesting.MockCategoryService{
+		LastDeleteCategoryErr: errors.NewErrNotFound("category", testCategoryID),
+	})
+
+	req, _ := http.NewRequest(http.MethodDelete, "/api/v1/categories/"+testCategoryID, nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
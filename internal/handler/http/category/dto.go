@@ -0,0 +1,37 @@
+package category
+
+import (
+	"time"
+
+	"accounting/internal/domain/constant"
+)
+
+type CreateCategoryRequest struct {
+	UserID string `json:"user_id"`
+	Name   string `json:"name"`
+	// ParentID nests the category under another of the user's categories
+	ParentID string `json:"parent_id,omitempty"`
+	// Type restricts the category to INCOME or EXPENSE transactions; empty allows both
+	Type constant.TransactionType `json:"type,omitempty"`
+}
+
+type UpdateCategoryRequest struct {
+	Name string `json:"name,omitempty"`
+	// ParentID moves the category under another one; an empty string makes it top-level
+	ParentID *string                   `json:"parent_id,omitempty"`
+	Type     *constant.TransactionType `json:"type,omitempty"`
+	// Archived hides the category from lists and keeps it from being assigned
+	// to transactions; transactions already in it keep it
+	Archived *bool `json:"archived,omitempty"`
+}
+
+type CategoryResponse struct {
+	ID        string                   `json:"id"`
+	UserID    string                   `json:"user_id"`
+	ParentID  string                   `json:"parent_id,omitempty"`
+	Name      string                   `json:"name"`
+	Type      constant.TransactionType `json:"type,omitempty"`
+	Archived  bool                     `json:"archived"`
+	CreatedAt time.Time                `json:"created_at"`
+	UpdatedAt time.Time                `json:"updated_at"`
+}
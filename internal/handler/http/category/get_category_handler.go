@@ -0,0 +1,52 @@
+package category
+
+import (
+	"net/http"
+
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type GetCategoryHandler struct {
+	service interfaces.CategoryService
+}
+
+func NewGetCategoryHandler(service interfaces.CategoryService) *GetCategoryHandler {
+	return &GetCategoryHandler{service: service}
+}
+
+// GetCategory godoc
+// @Summary Get a category by ID
+// @Description Retrieve a category, archived or not
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param category_id path string true "Category ID (UUID)"
+// @Success 200 {object} CategoryResponse
+// @Failure 400 {object} common.ValidationProblem "Validation error"
+// @Failure 404 {object} common.ProblemDetail "Category not found"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/categories/{category_id} [get]
+func (h *GetCategoryHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		common.WriteProblem(w, common.NewMethodNotAllowedProblem(r.RequestURI))
+		return
+	}
+
+	id, ok := extractCategoryID(w, r)
+	if !ok {
+		return
+	}
+
+	category, err := h.service.GetCategory(r.Context(), id)
+	if err != nil {
+		writeCategoryError(w, r, err)
+		return
+	}
+	if category == nil {
+		common.WriteProblem(w, common.NewNotFoundProblem("category not found", r.RequestURI))
+		return
+	}
+
+	common.WriteJSON(w, http.StatusOK, toCategoryResponse(category))
+}
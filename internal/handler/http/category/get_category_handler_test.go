@@ -0,0 +1,64 @@
+package category
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"accounting/internal/domain/entity"
+	httptesting "accounting/internal/handler/http"
+)
+
+func TestGetCategoryHandlerSuccess(t *testing.T) {
+	handler := NewGetCategoryHandler(&httptesting.MockCategoryService{
+		CategoryToReturn: &entity.Category{ID: testCategoryID, UserID: testUserID, ParentID: testParentID, Name: "Groceries", Archived: true},
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/categories/"+testCategoryID, nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var response CategoryResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if response.ID != testCategoryID || response.ParentID != testParentID || !response.Archived {
+		t.Errorf("unexpected category %+v", response)
+	}
+}
+
+func TestGetCategoryHandlerNotFound(t *testing.T) {
+	handler := NewGetCategoryHandler(&httptesting.MockCategoryService{})
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/categories/"+testCategoryID, nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestGetCategoryHandlerInvalidID(t *testing.T) {
+	mockService := &httptesting.MockCategoryService{}
+	handler := NewGetCategoryHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/categories/groceries", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+	if mockService.GetCategoryCalls != 0 {
+		t.Error("expected the service not to be called")
+	}
+}
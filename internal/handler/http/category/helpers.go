@@ -0,0 +1,71 @@
+package category
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/handler/http/common"
+)
+
+func toCategoryResponse(category *entity.Category) *CategoryResponse {
+	return &CategoryResponse{
+		ID:        category.ID,
+		UserID:    category.UserID,
+		ParentID:  category.ParentID,
+		Name:      category.Name,
+		Type:      category.Type,
+		Archived:  category.Archived,
+		CreatedAt: category.CreatedAt,
+		UpdatedAt: category.UpdatedAt,
+	}
+}
+
+// validateCategoryType checks that a category type is INCOME or EXPENSE;
+// callers skip empty types, which allow both.
+func validateCategoryType(categoryType constant.TransactionType) *common.ValidationError {
+	return common.ValidateEnum(string(categoryType), []string{string(constant.TransactionTypeIncome), string(constant.TransactionTypeExpense)}, "type")
+}
+
+// writeCategoryError maps category service errors to problem responses.
+func writeCategoryError(w http.ResponseWriter, r *http.Request, err error) {
+	var dupErr *domainerrors.ErrDuplicateCategory
+	if errors.As(err, &dupErr) {
+		common.WriteProblem(w, common.NewValidationProblem(err.Error(), r.RequestURI))
+		return
+	}
+	var notFoundErr *domainerrors.ErrNotFound
+	if errors.As(err, &notFoundErr) {
+		common.WriteProblem(w, common.NewNotFoundProblem(err.Error(), r.RequestURI))
+		return
+	}
+	var invalidErr *domainerrors.ErrInvalidInput
+	if errors.As(err, &invalidErr) {
+		common.WriteProblem(w, common.NewValidationProblem(err.Error(), r.RequestURI))
+		return
+	}
+	common.WriteProblem(w, common.NewInternalErrorProblem(r.RequestURI))
+}
+
+// extractCategoryID reads and validates the category ID of an
+// /api/v1/categories/{id} request, writing a problem if it is invalid.
+func extractCategoryID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	id := extractID(r.URL.Path, "/api/v1/categories/")
+	if err := common.ValidateUUID(id, "category_id"); err != nil {
+		common.WriteProblem(w, common.NewValidationProblemWithErrors(r.RequestURI, common.CollectErrors(err)))
+		return "", false
+	}
+	return id, true
+}
+
+func extractID(path, prefix string) string {
+	path = strings.TrimPrefix(path, prefix)
+	parts := strings.Split(path, "/")
+	if len(parts) > 0 {
+		return parts[0]
+	}
+	return ""
+}
@@ -0,0 +1,64 @@
+package category
+
+import (
+	"net/http"
+	"strconv"
+
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type ListUserCategoriesHandler struct {
+	service interfaces.CategoryService
+}
+
+func NewListUserCategoriesHandler(service interfaces.CategoryService) *ListUserCategoriesHandler {
+	return &ListUserCategoriesHandler{service: service}
+}
+
+// ListUserCategories godoc
+// @Summary List a user's categories
+// @Description Retrieve a user's categories ordered by name, without archived ones unless asked. Each category names its parent; top-level categories have none.
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param user_id path string true "User ID (UUID)"
+// @Param include_archived query bool false "Include archived categories"
+// @Success 200 {array} CategoryResponse
+// @Failure 400 {object} common.ValidationProblem "Validation error"
+// @Failure 404 {object} common.ProblemDetail "User not found"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/users/{user_id}/categories [get]
+func (h *ListUserCategoriesHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		common.WriteProblem(w, common.NewMethodNotAllowedProblem(r.RequestURI))
+		return
+	}
+
+	userID := extractID(r.URL.Path, "/api/v1/users/")
+	validationErrors := common.CollectErrors(common.ValidateUUID(userID, "user_id"))
+	var includeArchived bool
+	if value := r.URL.Query().Get("include_archived"); value != "" {
+		validationErrors = append(validationErrors, common.CollectErrors(
+			common.ValidateBool(value, "include_archived"),
+		)...)
+		includeArchived, _ = strconv.ParseBool(value)
+	}
+	if len(validationErrors) > 0 {
+		common.WriteProblem(w, common.NewValidationProblemWithErrors(r.RequestURI, validationErrors))
+		return
+	}
+
+	categories, err := h.service.ListUserCategories(r.Context(), userID, includeArchived)
+	if err != nil {
+		writeCategoryError(w, r, err)
+		return
+	}
+
+	response := make([]*CategoryResponse, 0, len(categories))
+	for _, category := range categories {
+		response = append(response, toCategoryResponse(category))
+	}
+
+	common.WriteJSON(w, http.StatusOK, response)
+}
@@ -0,0 +1,106 @@
+package category
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"accounting/internal/domain/entity"
+	"accounting/internal/domain/errors"
+	httptesting "accounting/internal/handler/http"
+)
+
+func TestListUserCategoriesHandlerSuccess(t *testing.T) {
+	mockService := &httptesting.MockCategoryService{
+		CategoriesToReturn: []*entity.Category{
+			{ID: testParentID, UserID: testUserID, Name: "Food"},
+			{ID: testCategoryID, UserID: testUserID, ParentID: testParentID, Name: "Groceries"},
+		},
+	}
+	handler := NewListUserCategoriesHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/users/"+testUserID+"/categories?include_archived=true", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var response []*CategoryResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(response) != 2 || response[0].ParentID != "" || response[1].ParentID != testParentID {
+		t.Errorf("unexpected categories %+v", response)
+	}
+	if !mockService.LastIncludeArchived {
+		t.Error("expected archived categories to be asked for")
+	}
+}
+
+func TestListUserCategoriesHandlerEmpty(t *testing.T) {
+	mockService := &httptesting.MockCategoryService{}
+	handler := NewListUserCategoriesHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/users/"+testUserID+"/categories", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if body := w.Body.String(); body != "[]\n" {
+		t.Errorf("expected an empty array, got %q", body)
+	}
+	if mockService.LastIncludeArchived {
+		t.Error("expected archived categories to be left out by default")
+	}
+}
+
+func TestListUserCategoriesHandlerValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+	}{
+		{"invalid user ID", "/api/v1/users/someone/categories"},
+		{"invalid include_archived", "/api/v1/users/" + testUserID + "/categories?include_archived=sometimes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &httptesting.MockCategoryService{}
+			handler := NewListUserCategoriesHandler(mockService)
+
+			req, _ := http.NewRequest(http.MethodGet, tt.path, nil)
+			w := httptest.NewRecorder()
+
+			handler.Handle(w, req)
+
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+			}
+			if mockService.ListUserCategoriesCalls != 0 {
+				t.Error("expected the service not to be called")
+			}
+		})
+	}
+}
+
+func TestListUserCategoriesHandlerUserNotFound(t *testing.T) {
+	handler := NewListUserCategoriesHandler(&httptesting.MockCategoryService{
+		LastListUserCategoriesErr: errors.NewErrNotFound("user", testUserID),
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/users/"+testUserID+"/categories", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
@@ -0,0 +1,81 @@
+package category
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type UpdateCategoryHandler struct {
+	service interfaces.CategoryService
+}
+
+func NewUpdateCategoryHandler(service interfaces.CategoryService) *UpdateCategoryHandler {
+	return &UpdateCategoryHandler{service: service}
+}
+
+// UpdateCategory godoc
+// @Summary Update a category
+// @Description Rename a category, move it under another parent or to the top level, change its type, or archive or restore it. Omitted fields keep their stored value. The name must stay unique under the category's parent, ignoring case. A category a rule sets cannot be archived.
+// @Tags categories
+// @Accept json
+// @Produce json
+// @Param category_id path string true "Category ID (UUID)"
+// @Param request body UpdateCategoryRequest true "Category update request"
+// @Success 200 {object} CategoryResponse
+// @Failure 400 {object} common.ValidationProblem "Validation error or duplicate name"
+// @Failure 404 {object} common.ProblemDetail "Category or parent category not found"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/categories/{category_id} [put]
+func (h *UpdateCategoryHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		common.WriteProblem(w, common.NewMethodNotAllowedProblem(r.RequestURI))
+		return
+	}
+
+	id, ok := extractCategoryID(w, r)
+	if !ok {
+		return
+	}
+
+	var req UpdateCategoryRequest
+	if r.Body == nil {
+		common.WriteProblem(w, common.NewBadRequestProblem("invalid request body", r.RequestURI))
+		return
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		common.WriteProblem(w, common.NewBadRequestProblem("invalid request body", r.RequestURI))
+		return
+	}
+
+	var validationErrors []common.ValidationError
+	if req.Name != "" {
+		validationErrors = append(validationErrors, common.CollectErrors(
+			common.ValidateStringLength(req.Name, "name", 1, 100),
+		)...)
+	}
+	if req.ParentID != nil && *req.ParentID != "" {
+		validationErrors = append(validationErrors, common.CollectErrors(
+			common.ValidateUUID(*req.ParentID, "parent_id"),
+		)...)
+	}
+	if req.Type != nil && *req.Type != "" {
+		validationErrors = append(validationErrors, common.CollectErrors(
+			validateCategoryType(*req.Type),
+		)...)
+	}
+	if len(validationErrors) > 0 {
+		common.WriteProblem(w, common.NewValidationProblemWithErrors(r.RequestURI, validationErrors))
+		return
+	}
+
+	category, err := h.service.UpdateCategory(r.Context(), id, req.Name, req.ParentID, req.Type, req.Archived)
+	if err != nil {
+		writeCategoryError(w, r, err)
+		return
+	}
+
+	common.WriteJSON(w, http.StatusOK, toCategoryResponse(category))
+}
@@ -0,0 +1,90 @@
+package category
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/errors"
+	httptesting "accounting/internal/handler/http"
+)
+
+func TestUpdateCategoryHandlerSuccess(t *testing.T) {
+	mockService := &httptesting.MockCategoryService{}
+	handler := NewUpdateCategoryHandler(mockService)
+
+	topLevel := ""
+	archived := true
+	req := httptesting.NewTestRequest(http.MethodPut, "/api/v1/categories/"+testCategoryID, UpdateCategoryRequest{
+		Name:     "Supermarket",
+		ParentID: &topLevel,
+		Archived: &archived,
+	})
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+
+	var response CategoryResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if response.ID != testCategoryID || response.Name != "Supermarket" || !response.Archived {
+		t.Errorf("unexpected category %+v", response)
+	}
+	if mockService.LastParentID == nil || *mockService.LastParentID != "" || mockService.LastType != nil {
+		t.Errorf("expected the category to be moved to the top level and its type kept, got %v %v", mockService.LastParentID, mockService.LastType)
+	}
+}
+
+func TestUpdateCategoryHandlerValidation(t *testing.T) {
+	parentID := "Food"
+	transfer := constant.TransactionTypeTransfer
+
+	tests := []struct {
+		name    string
+		request UpdateCategoryRequest
+	}{
+		{"invalid parent ID", UpdateCategoryRequest{ParentID: &parentID}},
+		{"transfers", UpdateCategoryRequest{Type: &transfer}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &httptesting.MockCategoryService{}
+			handler := NewUpdateCategoryHandler(mockService)
+
+			req := httptesting.NewTestRequest(http.MethodPut, "/api/v1/categories/"+testCategoryID, tt.request)
+			w := httptest.NewRecorder()
+
+			handler.Handle(w, req)
+
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+			}
+			if mockService.UpdateCategoryCalls != 0 {
+				t.Error("expected the service not to be called")
+			}
+		})
+	}
+}
+
+func TestUpdateCategoryHandlerNotFound(t *testing.T) {
+	handler := NewUpdateCategoryHandler(&httptesting.MockCategoryService{
+		LastUpdateCategoryErr: errors.NewErrNotFound("category", testCategoryID),
+	})
+
+	req := httptesting.NewTestRequest(http.MethodPut, "/api/v1/categories/"+testCategoryID, UpdateCategoryRequest{Name: "Food"})
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
@@ -0,0 +1,61 @@
+package categoryrule
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type ApplyCategoryRulesHandler struct {
+	service interfaces.CategoryRuleService
+}
+
+func NewApplyCategoryRulesHandler(service interfaces.CategoryRuleService) *ApplyCategoryRulesHandler {
+	return &ApplyCategoryRulesHandler{service: service}
+}
+
+// ApplyCategoryRules godoc
+// @Summary Apply categorization rules to existing transactions
+// @Description Apply a user's categorization rules to their income and expense transactions, and report the transactions whose category, description or tags changed. Tags a rule sets are added to those the transaction has. A dry run previews the changes without saving them. Changes are saved in batches, so a failure keeps the batches saved before it. The request body is optional.
+// @Tags category-rules
+// @Accept json
+// @Produce json
+// @Param user_id path string true "User ID (UUID)"
+// @Param request body ApplyCategoryRulesRequest false "Options"
+// @Success 200 {object} ApplyCategoryRulesResponse
+// @Failure 400 {object} common.ValidationProblem "Validation error"
+// @Failure 404 {object} common.ProblemDetail "User not found"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/users/{user_id}/category-rules/apply [post]
+func (h *ApplyCategoryRulesHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		common.WriteProblem(w, common.NewMethodNotAllowedProblem(r.RequestURI))
+		return
+	}
+
+	userID := extractID(r.URL.Path, "/api/v1/users/")
+	if err := common.ValidateUUID(userID, "user_id"); err != nil {
+		common.WriteProblem(w, common.NewValidationProblemWithErrors(r.RequestURI, common.CollectErrors(err)))
+		return
+	}
+
+	var req ApplyCategoryRulesRequest
+	if r.Body != nil {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+			common.WriteProblem(w, common.NewBadRequestProblem("invalid request body", r.RequestURI))
+			return
+		}
+	}
+
+	result, err := h.service.ApplyRules(r.Context(), userID, req.UncategorizedOnly, req.DryRun)
+	if err != nil {
+		writeRuleError(w, r, err)
+		return
+	}
+
+	common.WriteJSON(w, http.StatusOK, toApplyCategoryRulesResponse(result))
+}
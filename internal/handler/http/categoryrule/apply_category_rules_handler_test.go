@@ -0,0 +1,92 @@
+package categoryrule
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"accounting/internal/domain/entity"
+	httptesting "accounting/internal/handler/http"
+)
+
+func TestApplyCategoryRulesHandlerDryRun(t *testing.T) {
+	mockService := &httptesting.MockCategoryRuleService{
+		RuleApplicationToReturn: &entity.RuleApplication{
+			UserID:  testUserID,
+			DryRun:  true,
+			Matched: 2,
+			Changes: []*entity.RuleChange{{
+				TransactionID:       "transaction-1",
+				RuleID:              "rule-1",
+				CategoryID:          testCategoryID,
+				PreviousDescription: "STARBUCKS #1234",
+				Description:         "Starbucks",
+				Tags:                []string{"coffee"},
+			}},
+		},
+	}
+	handler := NewApplyCategoryRulesHandler(mockService)
+
+	req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/users/"+testUserID+"/category-rules/apply", ApplyCategoryRulesRequest{
+		DryRun:            true,
+		UncategorizedOnly: true,
+	})
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	if !mockService.LastDryRun || !mockService.LastUncategorizedOnly {
+		t.Error("expected the options to be passed to the service")
+	}
+
+	var response ApplyCategoryRulesResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if !response.DryRun || response.Matched != 2 || len(response.Changes) != 1 {
+		t.Fatalf("unexpected result %+v", response)
+	}
+	if change := response.Changes[0]; change.TransactionID != "transaction-1" || change.CategoryID != testCategoryID || change.Description != "Starbucks" ||
+		change.PreviousTags == nil || len(change.Tags) != 1 || change.Tags[0] != "coffee" {
+		t.Errorf("unexpected change %+v", change)
+	}
+}
+
+func TestApplyCategoryRulesHandlerWithoutBody(t *testing.T) {
+	mockService := &httptesting.MockCategoryRuleService{}
+	handler := NewApplyCategoryRulesHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/users/"+testUserID+"/category-rules/apply", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if mockService.ApplyRulesCalls != 1 || mockService.LastDryRun {
+		t.Error("expected the rules to be applied")
+	}
+}
+
+func TestApplyCategoryRulesHandlerInvalidBody(t *testing.T) {
+	mockService := &httptesting.MockCategoryRuleService{}
+	handler := NewApplyCategoryRulesHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/users/"+testUserID+"/category-rules/apply", strings.NewReader(`{"dry_run": "yes"}`))
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+	if mockService.ApplyRulesCalls != 0 {
+		t.Error("expected the service not to be called")
+	}
+}
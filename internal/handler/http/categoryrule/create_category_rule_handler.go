@@ -0,0 +1,67 @@
+package categoryrule
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type CreateCategoryRuleHandler struct {
+	service interfaces.CategoryRuleService
+}
+
+func NewCreateCategoryRuleHandler(service interfaces.CategoryRuleService) *CreateCategoryRuleHandler {
+	return &CreateCategoryRuleHandler{service: service}
+}
+
+// CreateCategoryRule godoc
+// @Summary Create a categorization rule
+// @Description Save a rule that categorizes a user's transactions. A transaction created or imported without a category takes the actions of the first rule it matches, trying rules by priority, then name. Tags a rule sets are added to those the transaction has. A rule needs at least one condition and one action.
+// @Tags category-rules
+// @Accept json
+// @Produce json
+// @Param request body CreateCategoryRuleRequest true "Categorization rule creation request"
+// @Success 201 {object} CategoryRuleResponse
+// @Failure 400 {object} common.ValidationProblem "Validation error"
+// @Failure 404 {object} common.ProblemDetail "User or account not found"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/category-rules [post]
+func (h *CreateCategoryRuleHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		common.WriteProblem(w, common.NewMethodNotAllowedProblem(r.RequestURI))
+		return
+	}
+
+	var req CreateCategoryRuleRequest
+	if r.Body == nil {
+		common.WriteProblem(w, common.NewBadRequestProblem("invalid request body", r.RequestURI))
+		return
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		common.WriteProblem(w, common.NewBadRequestProblem("invalid request body", r.RequestURI))
+		return
+	}
+
+	validationErrors := common.CollectErrors(
+		common.ValidateUUID(req.UserID, "user_id"),
+		common.ValidateRequired(req.Name, "name"),
+		common.ValidateStringLength(req.Name, "name", 1, 100),
+	)
+	conditions, conditionErrors := toDomainRuleConditions(req.Conditions)
+	actions, actionErrors := toDomainRuleActions(req.Actions)
+	validationErrors = append(append(validationErrors, conditionErrors...), actionErrors...)
+	if len(validationErrors) > 0 {
+		common.WriteProblem(w, common.NewValidationProblemWithErrors(r.RequestURI, validationErrors))
+		return
+	}
+
+	rule, err := h.service.CreateRule(r.Context(), req.UserID, req.Name, req.Priority, conditions, actions)
+	if err != nil {
+		writeRuleError(w, r, err)
+		return
+	}
+
+	common.WriteJSON(w, http.StatusCreated, toCategoryRuleResponse(rule))
+}
@@ -0,0 +1,119 @@
+package categoryrule
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/errors"
+	"accounting/internal/domain/money"
+	httptesting "accounting/internal/handler/http"
+	"accounting/internal/handler/http/common"
+)
+
+const (
+	testUserID     = "123e4567-e89b-12d3-a456-426614174000"
+	testCategoryID = "323e4567-e89b-12d3-a456-426614174000"
+)
+
+func TestCreateCategoryRuleHandlerSuccess(t *testing.T) {
+	mockService := &httptesting.MockCategoryRuleService{}
+	handler := NewCreateCategoryRuleHandler(mockService)
+
+	req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/category-rules", CreateCategoryRuleRequest{
+		UserID:   testUserID,
+		Name:     "Large expenses",
+		Priority: 10,
+		Conditions: RuleConditions{
+			DescriptionPattern: "(?i)^amzn",
+			MinAmount:          "500.00",
+			Currency:           "USD",
+			Type:               constant.TransactionTypeExpense,
+		},
+		Actions: RuleActions{CategoryID: testCategoryID, Description: "Amazon", Tags: []string{"online"}},
+	})
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+
+	var response CategoryRuleResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if response.Name != "Large expenses" || response.Priority != 10 || response.Actions.CategoryID != testCategoryID || len(response.Actions.Tags) != 1 {
+		t.Errorf("unexpected rule %+v", response)
+	}
+	if response.Conditions.MinAmount != "500.00" || response.Conditions.MaxAmount != "" || response.Conditions.Currency != "USD" {
+		t.Errorf("unexpected amount conditions %+v", response.Conditions)
+	}
+	if mockService.LastConditions.MinAmount != money.MustParse("500", "USD") || mockService.LastConditions.MaxAmount.Currency() != "" {
+		t.Errorf("unexpected conditions passed to the service %+v", mockService.LastConditions)
+	}
+}
+
+func TestCreateCategoryRuleHandlerValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		request CreateCategoryRuleRequest
+		field   string
+	}{
+		{"invalid user ID", CreateCategoryRuleRequest{UserID: "not-a-uuid", Name: "Coffee"}, "user_id"},
+		{"missing name", CreateCategoryRuleRequest{UserID: testUserID}, "name"},
+		{"amount without currency", CreateCategoryRuleRequest{UserID: testUserID, Name: "Coffee", Conditions: RuleConditions{MaxAmount: "10"}}, "conditions.currency"},
+		{"invalid amount", CreateCategoryRuleRequest{UserID: testUserID, Name: "Coffee", Conditions: RuleConditions{MaxAmount: "10.001", Currency: "USD"}}, "conditions.max_amount"},
+		{"invalid account ID", CreateCategoryRuleRequest{UserID: testUserID, Name: "Coffee", Conditions: RuleConditions{AccountID: "checking"}}, "conditions.account_id"},
+		{"transfers", CreateCategoryRuleRequest{UserID: testUserID, Name: "Coffee", Conditions: RuleConditions{Type: constant.TransactionTypeTransfer}}, "conditions.type"},
+		{"invalid category ID", CreateCategoryRuleRequest{UserID: testUserID, Name: "Coffee", Actions: RuleActions{CategoryID: "Coffee"}}, "actions.category_id"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &httptesting.MockCategoryRuleService{}
+			handler := NewCreateCategoryRuleHandler(mockService)
+
+			req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/category-rules", tt.request)
+			w := httptest.NewRecorder()
+
+			handler.Handle(w, req)
+
+			if w.Code != http.StatusBadRequest {
+				t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+			}
+			var problem common.ValidationProblem
+			if err := json.NewDecoder(w.Body).Decode(&problem); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if len(problem.Errors) == 0 || problem.Errors[0].Field != tt.field {
+				t.Errorf("expected an error on %s, got %+v", tt.field, problem.Errors)
+			}
+			if mockService.CreateRuleCalls != 0 {
+				t.Error("expected the service not to be called")
+			}
+		})
+	}
+}
+
+func TestCreateCategoryRuleHandlerServiceError(t *testing.T) {
+	handler := NewCreateCategoryRuleHandler(&httptesting.MockCategoryRuleService{
+		LastCreateRuleErr: errors.NewErrInvalidInput("conditions", "a rule needs at least one condition"),
+	})
+
+	req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/category-rules", CreateCategoryRuleRequest{
+		UserID:  testUserID,
+		Name:    "Everything",
+		Actions: RuleActions{CategoryID: testCategoryID},
+	})
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
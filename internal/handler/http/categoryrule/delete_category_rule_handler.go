@@ -0,0 +1,47 @@
+package categoryrule
+
+import (
+	"net/http"
+
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type DeleteCategoryRuleHandler struct {
+	service interfaces.CategoryRuleService
+}
+
+func NewDeleteCategoryRuleHandler(service interfaces.CategoryRuleService) *DeleteCategoryRuleHandler {
+	return &DeleteCategoryRuleHandler{service: service}
+}
+
+// DeleteCategoryRule godoc
+// @Summary Delete a categorization rule
+// @Description Delete a categorization rule. Transactions it categorized keep their category.
+// @Tags category-rules
+// @Accept json
+// @Produce json
+// @Param rule_id path string true "Categorization rule ID (UUID)"
+// @Success 204 "Categorization rule deleted successfully"
+// @Failure 400 {object} common.ValidationProblem "Validation error"
+// @Failure 404 {object} common.ProblemDetail "Categorization rule not found"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/category-rules/{rule_id} [delete]
+func (h *DeleteCategoryRuleHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		common.WriteProblem(w, common.NewMethodNotAllowedProblem(r.RequestURI))
+		return
+	}
+
+	id, ok := extractRuleID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteRule(r.Context(), id); err != nil {
+		writeRuleError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
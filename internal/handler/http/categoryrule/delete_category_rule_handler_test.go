@@ -0,0 +1,42 @@
+package categoryrule
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"accounting/internal/domain/errors"
+	httptesting "accounting/internal/handler/http"
+)
+
+func TestDeleteCategoryRuleHandlerSuccess(t *testing.T) {
+	mockService := &httptesting.MockCategoryRuleService{}
+	handler := NewDeleteCategoryRuleHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodDelete, "/api/v1/category-rules/"+testRuleID, nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusNoContent {
+		t.Errorf("expected status %d, got %d", http.StatusNoContent, w.Code)
+	}
+	if mockService.DeleteRuleCalls != 1 {
+		t.Errorf("expected 1 deleteRule call, got %d", mockService.DeleteRuleCalls)
+	}
+}
+
+func TestDeleteCategoryRuleHandlerNotFound(t *testing.T) {
+	handler := NewDeleteCategoryRuleHandler(&httptesting.MockCategoryRuleService{
+		LastDeleteRuleErr: errors.NewErrNotFound("category rule", testRuleID),
+	})
+
+	req, _ := http.NewRequest(http.MethodDelete, "/api/v1/category-rules/"+testRuleID, nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
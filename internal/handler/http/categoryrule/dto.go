@@ -0,0 +1,88 @@
+package categoryrule
+
+import (
+	"time"
+
+	"accounting/internal/domain/constant"
+)
+
+// RuleConditions select the transactions a rule applies to; every condition
+// given must hold.
+type RuleConditions struct {
+	// DescriptionContains matches descriptions containing the text, ignoring case
+	DescriptionContains string `json:"description_contains,omitempty"`
+	// DescriptionPattern is a regular expression in RE2 syntax, e.g. (?i)^amzn mktp
+	DescriptionPattern string `json:"description_pattern,omitempty"`
+	// MinAmount and MaxAmount bound the amount inclusively, in Currency
+	MinAmount string `json:"min_amount,omitempty"`
+	MaxAmount string `json:"max_amount,omitempty"`
+	// Currency is required with an amount bound
+	Currency  string                   `json:"currency,omitempty"`
+	AccountID string                   `json:"account_id,omitempty"`
+	Type      constant.TransactionType `json:"type,omitempty"`
+}
+
+// RuleActions are the changes a rule makes to the transactions it matches.
+type RuleActions struct {
+	// CategoryID is one of the user's categories; a category for INCOME or
+	// EXPENSE only restricts the rule to that type
+	CategoryID  string `json:"category_id,omitempty"`
+	Description string `json:"description,omitempty"`
+	// Tags are added to the transaction's tags, creating any the user does
+	// not have yet; they are stored in lower case and sorted
+	Tags []string `json:"tags,omitempty"`
+}
+
+type CreateCategoryRuleRequest struct {
+	UserID string `json:"user_id"`
+	Name   string `json:"name"`
+	// Priority orders the user's rules, lowest first; 0 when omitted
+	Priority   int            `json:"priority,omitempty"`
+	Conditions RuleConditions `json:"conditions"`
+	Actions    RuleActions    `json:"actions"`
+}
+
+type UpdateCategoryRuleRequest struct {
+	Name     string `json:"name,omitempty"`
+	Priority *int   `json:"priority,omitempty"`
+	// Conditions and Actions replace the stored ones as a whole when given
+	Conditions *RuleConditions `json:"conditions,omitempty"`
+	Actions    *RuleActions    `json:"actions,omitempty"`
+}
+
+type CategoryRuleResponse struct {
+	ID         string         `json:"id"`
+	UserID     string         `json:"user_id"`
+	Name       string         `json:"name"`
+	Priority   int            `json:"priority"`
+	Conditions RuleConditions `json:"conditions"`
+	Actions    RuleActions    `json:"actions"`
+	CreatedAt  time.Time      `json:"created_at"`
+	UpdatedAt  time.Time      `json:"updated_at"`
+}
+
+type ApplyCategoryRulesRequest struct {
+	// DryRun previews the changes without saving them
+	DryRun bool `json:"dry_run,omitempty"`
+	// UncategorizedOnly leaves transactions that have a category alone
+	UncategorizedOnly bool `json:"uncategorized_only,omitempty"`
+}
+
+type RuleChangeResponse struct {
+	TransactionID       string   `json:"transaction_id"`
+	RuleID              string   `json:"rule_id"`
+	PreviousCategoryID  string   `json:"previous_category_id"`
+	CategoryID          string   `json:"category_id"`
+	PreviousDescription string   `json:"previous_description"`
+	Description         string   `json:"description"`
+	PreviousTags        []string `json:"previous_tags"`
+	Tags                []string `json:"tags"`
+}
+
+type ApplyCategoryRulesResponse struct {
+	UserID string `json:"user_id"`
+	DryRun bool   `json:"dry_run"`
+	// Matched counts the transactions that matched a rule, changed or not
+	Matched int                   `json:"matched"`
+	Changes []*RuleChangeResponse `json:"changes"`
+}
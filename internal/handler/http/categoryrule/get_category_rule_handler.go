@@ -0,0 +1,52 @@
+package categoryrule
+
+import (
+	"net/http"
+
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type GetCategoryRuleHandler struct {
+	service interfaces.CategoryRuleService
+}
+
+func NewGetCategoryRuleHandler(service interfaces.CategoryRuleService) *GetCategoryRuleHandler {
+	return &GetCategoryRuleHandler{service: service}
+}
+
+// GetCategoryRule godoc
+// @Summary Get a categorization rule by ID
+// @Description Retrieve a categorization rule with its conditions and actions
+// @Tags category-rules
+// @Accept json
+// @Produce json
+// @Param rule_id path string true "Categorization rule ID (UUID)"
+// @Success 200 {object} CategoryRuleResponse
+// @Failure 400 {object} common.ValidationProblem "Validation error"
+// @Failure 404 {object} common.ProblemDetail "Categorization rule not found"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/category-rules/{rule_id} [get]
+func (h *GetCategoryRuleHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		common.WriteProblem(w, common.NewMethodNotAllowedProblem(r.RequestURI))
+		return
+	}
+
+	id, ok := extractRuleID(w, r)
+	if !ok {
+		return
+	}
+
+	rule, err := h.service.GetRule(r.Context(), id)
+	if err != nil {
+		writeRuleError(w, r, err)
+		return
+	}
+	if rule == nil {
+		common.WriteProblem(w, common.NewNotFoundProblem("category rule not found", r.RequestURI))
+		return
+	}
+
+	common.WriteJSON(w, http.StatusOK, toCategoryRuleResponse(rule))
+}
@@ -0,0 +1,78 @@
+package categoryrule
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"accounting/internal/domain/entity"
+	"accounting/internal/domain/money"
+	httptesting "accounting/internal/handler/http"
+)
+
+const testRuleID = "223e4567-e89b-12d3-a456-426614174000"
+
+func TestGetCategoryRuleHandlerSuccess(t *testing.T) {
+	mockService := &httptesting.MockCategoryRuleService{
+		RuleToReturn: &entity.CategoryRule{
+			ID:     testRuleID,
+			UserID: testUserID,
+			Name:   "Small coffee",
+			Conditions: entity.RuleConditions{
+				DescriptionContains: "starbucks",
+				MaxAmount:           money.MustParse("10", "EUR"),
+			},
+			Actions: entity.RuleActions{CategoryID: testCategoryID},
+		},
+	}
+	handler := NewGetCategoryRuleHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/category-rules/"+testRuleID, nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var response CategoryRuleResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	expected := RuleConditions{DescriptionContains: "starbucks", MaxAmount: "10.00", Currency: "EUR"}
+	if response.ID != testRuleID || response.Conditions != expected || response.Actions.CategoryID != testCategoryID {
+		t.Errorf("unexpected rule %+v", response)
+	}
+}
+
+func TestGetCategoryRuleHandlerNotFound(t *testing.T) {
+	handler := NewGetCategoryRuleHandler(&httptesting.MockCategoryRuleService{})
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/category-rules/"+testRuleID, nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestGetCategoryRuleHandlerInvalidID(t *testing.T) {
+	mockService := &httptesting.MockCategoryRuleService{}
+	handler := NewGetCategoryRuleHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/category-rules/coffee", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+	if mockService.GetRuleCalls != 0 {
+		t.Error("expected the service not to be called")
+	}
+}
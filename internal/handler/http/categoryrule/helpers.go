@@ -0,0 +1,177 @@
+package categoryrule
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/money"
+	"accounting/internal/handler/http/common"
+)
+
+func toCategoryRuleResponse(rule *entity.CategoryRule) *CategoryRuleResponse {
+	return &CategoryRuleResponse{
+		ID:         rule.ID,
+		UserID:     rule.UserID,
+		Name:       rule.Name,
+		Priority:   rule.Priority,
+		Conditions: toRuleConditionsResponse(rule.Conditions),
+		Actions: RuleActions{
+			CategoryID:  rule.Actions.CategoryID,
+			Description: rule.Actions.Description,
+			Tags:        rule.Actions.Tags,
+		},
+		CreatedAt: rule.CreatedAt,
+		UpdatedAt: rule.UpdatedAt,
+	}
+}
+
+func toRuleConditionsResponse(conditions entity.RuleConditions) RuleConditions {
+	response := RuleConditions{
+		DescriptionContains: conditions.DescriptionContains,
+		DescriptionPattern:  conditions.DescriptionPattern,
+		AccountID:           conditions.AccountID,
+		Type:                conditions.Type,
+	}
+	if currency := conditions.MinAmount.Currency(); currency != "" {
+		response.MinAmount, response.Currency = conditions.MinAmount.String(), currency
+	}
+	if currency := conditions.MaxAmount.Currency(); currency != "" {
+		response.MaxAmount, response.Currency = conditions.MaxAmount.String(), currency
+	}
+	return response
+}
+
+func toApplyCategoryRulesResponse(result *entity.RuleApplication) *ApplyCategoryRulesResponse {
+	response := &ApplyCategoryRulesResponse{
+		UserID:  result.UserID,
+		DryRun:  result.DryRun,
+		Matched: result.Matched,
+		Changes: make([]*RuleChangeResponse, 0, len(result.Changes)),
+	}
+	for _, change := range result.Changes {
+		response.Changes = append(response.Changes, &RuleChangeResponse{
+			TransactionID:       change.TransactionID,
+			RuleID:              change.RuleID,
+			PreviousCategoryID:  change.PreviousCategoryID,
+			CategoryID:          change.CategoryID,
+			PreviousDescription: change.PreviousDescription,
+			Description:         change.Description,
+			PreviousTags:        nonNilTags(change.PreviousTags),
+			Tags:                nonNilTags(change.Tags),
+		})
+	}
+	return response
+}
+
+// toDomainRuleConditions reads the conditions of a rule, returning the
+// validation errors of the fields that are malformed; the service checks the rest.
+func toDomainRuleConditions(conditions RuleConditions) (entity.RuleConditions, []common.ValidationError) {
+	var errs []*common.ValidationError
+	if conditions.DescriptionContains != "" {
+		errs = append(errs, common.ValidateStringLength(conditions.DescriptionContains, "conditions.description_contains", 1, 255))
+	}
+	if conditions.DescriptionPattern != "" {
+		errs = append(errs, common.ValidateStringLength(conditions.DescriptionPattern, "conditions.description_pattern", 1, 255))
+	}
+	if conditions.AccountID != "" {
+		errs = append(errs, common.ValidateUUID(conditions.AccountID, "conditions.account_id"))
+	}
+	if conditions.Type != "" {
+		errs = append(errs, common.ValidateEnum(string(conditions.Type), []string{string(constant.TransactionTypeIncome), string(constant.TransactionTypeExpense)}, "conditions.type"))
+	}
+	if conditions.MinAmount != "" || conditions.MaxAmount != "" || conditions.Currency != "" {
+		currencyErr := common.ValidateCurrency(conditions.Currency, "conditions.currency")
+		errs = append(errs, currencyErr)
+		if currencyErr == nil {
+			if conditions.MinAmount != "" {
+				errs = append(errs, common.ValidateAmount(conditions.MinAmount, conditions.Currency, "conditions.min_amount"))
+			}
+			if conditions.MaxAmount != "" {
+				errs = append(errs, common.ValidateAmount(conditions.MaxAmount, conditions.Currency, "conditions.max_amount"))
+			}
+		}
+	}
+	if validationErrors := common.CollectErrors(errs...); len(validationErrors) > 0 {
+		return entity.RuleConditions{}, validationErrors
+	}
+
+	domainConditions := entity.RuleConditions{
+		DescriptionContains: conditions.DescriptionContains,
+		DescriptionPattern:  conditions.DescriptionPattern,
+		AccountID:           conditions.AccountID,
+		Type:                conditions.Type,
+	}
+	// The amounts were validated above, so they parse
+	if conditions.MinAmount != "" {
+		domainConditions.MinAmount, _ = money.Parse(conditions.MinAmount, conditions.Currency)
+	}
+	if conditions.MaxAmount != "" {
+		domainConditions.MaxAmount, _ = money.Parse(conditions.MaxAmount, conditions.Currency)
+	}
+	return domainConditions, nil
+}
+
+// toDomainRuleActions reads the actions of a rule, returning the validation
+// errors of the fields that are malformed; the service checks the tags.
+func toDomainRuleActions(actions RuleActions) (entity.RuleActions, []common.ValidationError) {
+	var errs []*common.ValidationError
+	if actions.CategoryID != "" {
+		errs = append(errs, common.ValidateUUID(actions.CategoryID, "actions.category_id"))
+	}
+	if actions.Description != "" {
+		errs = append(errs, common.ValidateStringLength(actions.Description, "actions.description", 1, 255))
+	}
+	return entity.RuleActions{
+		CategoryID:  actions.CategoryID,
+		Description: actions.Description,
+		Tags:        actions.Tags,
+	}, common.CollectErrors(errs...)
+}
+
+// nonNilTags returns tags, or an empty list for none, so that they are
+// rendered as [] rather than null.
+func nonNilTags(tags []string) []string {
+	if tags == nil {
+		return []string{}
+	}
+	return tags
+}
+
+// writeRuleError maps category rule service errors to problem responses.
+func writeRuleError(w http.ResponseWriter, r *http.Request, err error) {
+	var notFoundErr *domainerrors.ErrNotFound
+	if errors.As(err, &notFoundErr) {
+		common.WriteProblem(w, common.NewNotFoundProblem(err.Error(), r.RequestURI))
+		return
+	}
+	var invalidErr *domainerrors.ErrInvalidInput
+	if errors.As(err, &invalidErr) {
+		common.WriteProblem(w, common.NewValidationProblem(err.Error(), r.RequestURI))
+		return
+	}
+	common.WriteProblem(w, common.NewInternalErrorProblem(r.RequestURI))
+}
+
+// extractRuleID reads and validates the rule ID of an
+// /api/v1/category-rules/{id} request, writing a problem if it is invalid.
+func extractRuleID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	id := extractID(r.URL.Path, "/api/v1/category-rules/")
+	if err := common.ValidateUUID(id, "rule_id"); err != nil {
+		common.WriteProblem(w, common.NewValidationProblemWithErrors(r.RequestURI, common.CollectErrors(err)))
+		return "", false
+	}
+	return id, true
+}
+
+func extractID(path, prefix string) string {
+	path = strings.TrimPrefix(path, prefix)
+	parts := strings.Split(path, "/")
+	if len(parts) > 0 {
+		return parts[0]
+	}
+	return ""
+}
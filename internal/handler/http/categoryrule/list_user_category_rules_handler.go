@@ -0,0 +1,54 @@
+package categoryrule
+
+import (
+	"net/http"
+
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type ListUserCategoryRulesHandler struct {
+	service interfaces.CategoryRuleService
+}
+
+func NewListUserCategoryRulesHandler(service interfaces.CategoryRuleService) *ListUserCategoryRulesHandler {
+	return &ListUserCategoryRulesHandler{service: service}
+}
+
+// ListUserCategoryRules godoc
+// @Summary List a user's categorization rules
+// @Description Retrieve every categorization rule of a user in the order they are tried: by priority, then name
+// @Tags category-rules
+// @Accept json
+// @Produce json
+// @Param user_id path string true "User ID (UUID)"
+// @Success 200 {array} CategoryRuleResponse
+// @Failure 400 {object} common.ValidationProblem "Validation error"
+// @Failure 404 {object} common.ProblemDetail "User not found"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/users/{user_id}/category-rules [get]
+func (h *ListUserCategoryRulesHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		common.WriteProblem(w, common.NewMethodNotAllowedProblem(r.RequestURI))
+		return
+	}
+
+	userID := extractID(r.URL.Path, "/api/v1/users/")
+	if err := common.ValidateUUID(userID, "user_id"); err != nil {
+		common.WriteProblem(w, common.NewValidationProblemWithErrors(r.RequestURI, common.CollectErrors(err)))
+		return
+	}
+
+	rules, err := h.service.ListUserRules(r.Context(), userID)
+	if err != nil {
+		writeRuleError(w, r, err)
+		return
+	}
+
+	response := make([]*CategoryRuleResponse, 0, len(rules))
+	for _, rule := range rules {
+		response = append(response, toCategoryRuleResponse(rule))
+	}
+
+	common.WriteJSON(w, http.StatusOK, response)
+}
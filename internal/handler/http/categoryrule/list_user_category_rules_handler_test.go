@@ -0,0 +1,70 @@
+package categoryrule
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"accounting/internal/domain/entity"
+	"accounting/internal/domain/errors"
+	httptesting "accounting/internal/handler/http"
+)
+
+func TestListUserCategoryRulesHandlerSuccess(t *testing.T) {
+	mockService := &httptesting.MockCategoryRuleService{
+		RulesToReturn: []*entity.CategoryRule{
+			{ID: "rule-1", UserID: testUserID, Name: "Coffee"},
+			{ID: "rule-2", UserID: testUserID, Name: "Rent", Priority: 5},
+		},
+	}
+	handler := NewListUserCategoryRulesHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/users/"+testUserID+"/category-rules", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var response []*CategoryRuleResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(response) != 2 || response[0].Name != "Coffee" || response[1].Priority != 5 {
+		t.Errorf("unexpected rules %+v", response)
+	}
+}
+
+func TestListUserCategoryRulesHandlerEmpty(t *testing.T) {
+	handler := NewListUserCategoryRulesHandler(&httptesting.MockCategoryRuleService{})
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/users/"+testUserID+"/category-rules", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if body := w.Body.String(); body != "[]\n" {
+		t.Errorf("expected an empty array, got %q", body)
+	}
+}
+
+func TestListUserCategoryRulesHandlerUserNotFound(t *testing.T) {
+	handler := NewListUserCategoryRulesHandler(&httptesting.MockCategoryRuleService{
+		LastListUserRulesErr: errors.NewErrNotFound("user", testUserID),
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/users/"+testUserID+"/category-rules", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
@@ -0,0 +1,84 @@
+package categoryrule
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"accounting/internal/domain/entity"
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type UpdateCategoryRuleHandler struct {
+	service interfaces.CategoryRuleService
+}
+
+func NewUpdateCategoryRuleHandler(service interfaces.CategoryRuleService) *UpdateCategoryRuleHandler {
+	return &UpdateCategoryRuleHandler{service: service}
+}
+
+// UpdateCategoryRule godoc
+// @Summary Update a categorization rule
+// @Description Rename a rule, change its priority, or replace its conditions or actions. Omitted fields keep their stored value; given conditions or actions replace the stored ones as a whole. Transactions categorized before are not changed; apply the rules again for that.
+// @Tags category-rules
+// @Accept json
+// @Produce json
+// @Param rule_id path string true "Categorization rule ID (UUID)"
+// @Param request body UpdateCategoryRuleRequest true "Categorization rule update request"
+// @Success 200 {object} CategoryRuleResponse
+// @Failure 400 {object} common.ValidationProblem "Validation error"
+// @Failure 404 {object} common.ProblemDetail "Categorization rule or account not found"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/category-rules/{rule_id} [put]
+func (h *UpdateCategoryRuleHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		common.WriteProblem(w, common.NewMethodNotAllowedProblem(r.RequestURI))
+		return
+	}
+
+	id, ok := extractRuleID(w, r)
+	if !ok {
+		return
+	}
+
+	var req UpdateCategoryRuleRequest
+	if r.Body == nil {
+		common.WriteProblem(w, common.NewBadRequestProblem("invalid request body", r.RequestURI))
+		return
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		common.WriteProblem(w, common.NewBadRequestProblem("invalid request body", r.RequestURI))
+		return
+	}
+
+	var validationErrors []common.ValidationError
+	if req.Name != "" {
+		validationErrors = append(validationErrors, common.CollectErrors(
+			common.ValidateStringLength(req.Name, "name", 1, 100),
+		)...)
+	}
+	var conditions *entity.RuleConditions
+	if req.Conditions != nil {
+		domainConditions, errs := toDomainRuleConditions(*req.Conditions)
+		validationErrors = append(validationErrors, errs...)
+		conditions = &domainConditions
+	}
+	var actions *entity.RuleActions
+	if req.Actions != nil {
+		domainActions, errs := toDomainRuleActions(*req.Actions)
+		validationErrors = append(validationErrors, errs...)
+		actions = &domainActions
+	}
+	if len(validationErrors) > 0 {
+		common.WriteProblem(w, common.NewValidationProblemWithErrors(r.RequestURI, validationErrors))
+		return
+	}
+
+	rule, err := h.service.UpdateRule(r.Context(), id, req.Name, req.Priority, conditions, actions)
+	if err != nil {
+		writeRuleError(w, r, err)
+		return
+	}
+
+	common.WriteJSON(w, http.StatusOK, toCategoryRuleResponse(rule))
+}
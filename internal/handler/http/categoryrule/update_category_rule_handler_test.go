@@ -0,0 +1,77 @@
+package categoryrule
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"accounting/internal/domain/errors"
+	httptesting "accounting/internal/handler/http"
+)
+
+func TestUpdateCategoryRuleHandlerSuccess(t *testing.T) {
+	mockService := &httptesting.MockCategoryRuleService{}
+	handler := NewUpdateCategoryRuleHandler(mockService)
+
+	priority := 0
+	req := httptesting.NewTestRequest(http.MethodPut, "/api/v1/category-rules/"+testRuleID, UpdateCategoryRuleRequest{
+		Priority: &priority,
+		Actions:  &RuleActions{CategoryID: testCategoryID},
+	})
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+
+	var response CategoryRuleResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if response.ID != testRuleID || response.Actions.CategoryID != testCategoryID {
+		t.Errorf("unexpected rule %+v", response)
+	}
+	if mockService.LastPriority == nil || *mockService.LastPriority != 0 {
+		t.Errorf("expected priority 0 to be passed, got %v", mockService.LastPriority)
+	}
+	if mockService.LastConditions != nil {
+		t.Errorf("expected omitted conditions to be kept, got %+v", mockService.LastConditions)
+	}
+}
+
+func TestUpdateCategoryRuleHandlerValidation(t *testing.T) {
+	mockService := &httptesting.MockCategoryRuleService{}
+	handler := NewUpdateCategoryRuleHandler(mockService)
+
+	req := httptesting.NewTestRequest(http.MethodPut, "/api/v1/category-rules/"+testRuleID, UpdateCategoryRuleRequest{
+		Conditions: &RuleConditions{MinAmount: "-5", Currency: "USD"},
+	})
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+	if mockService.UpdateRuleCalls != 0 {
+		t.Error("expected the service not to be called")
+	}
+}
+
+func TestUpdateCategoryRuleHandlerNotFound(t *testing.T) {
+	handler := NewUpdateCategoryRuleHandler(&httptesting.MockCategoryRuleService{
+		LastUpdateRuleErr: errors.NewErrNotFound("category rule", testRuleID),
+	})
+
+	req := httptesting.NewTestRequest(http.MethodPut, "/api/v1/category-rules/"+testRuleID, UpdateCategoryRuleRequest{Name: "Coffee"})
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
@@ -0,0 +1,105 @@
+package common
+
+import (
+	"encoding/csv"
+	"errors"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// Response formats a list endpoint can be asked for with the format parameter
+const (
+	FormatJSON = "json"
+	FormatCSV  = "csv"
+)
+
+// WantsCSV reports whether the request asks for a CSV response, either with
+// format=csv or with an Accept header listing text/csv. An explicit format
+// takes precedence over the Accept header.
+func WantsCSV(r *http.Request) (bool, *ValidationError) {
+	if format := r.URL.Query().Get("format"); format != "" {
+		if err := ValidateEnum(format, []string{FormatJSON, FormatCSV}, "format"); err != nil {
+			return false, err
+		}
+		return format == FormatCSV, nil
+	}
+
+	for _, accept := range r.Header.Values("Accept") {
+		for _, mediaRange := range strings.Split(accept, ",") {
+			mediaType, _, err := mime.ParseMediaType(mediaRange)
+			if err == nil && mediaType == "text/csv" {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// CSVStream writes a CSV response a batch of rows at a time, flushing each
+// batch to the client so that large exports are never held in memory.
+type CSVStream struct {
+	w          http.ResponseWriter
+	csv        *csv.Writer
+	controller *http.ResponseController
+	filename   string
+	header     []string
+	started    bool
+}
+
+// NewCSVStream prepares a CSV response served as an attachment named filename
+// with the given header row. Nothing is written until the first row, so the
+// caller can still answer with a problem if the first batch fails.
+func NewCSVStream(w http.ResponseWriter, filename string, header []string) *CSVStream {
+	return &CSVStream{
+		w:          w,
+		csv:        csv.NewWriter(w),
+		controller: http.NewResponseController(w),
+		filename:   filename,
+		header:     header,
+	}
+}
+
+// Write writes one row, preceded by the headers and the header row on the first call.
+func (s *CSVStream) Write(record []string) error {
+	if err := s.start(); err != nil {
+		return err
+	}
+	return s.csv.Write(record)
+}
+
+// Flush sends the rows written so far to the client. It also starts the
+// response, so an export without rows still carries the header row.
+func (s *CSVStream) Flush() error {
+	if err := s.start(); err != nil {
+		return err
+	}
+	s.csv.Flush()
+	if err := s.csv.Error(); err != nil {
+		return err
+	}
+	if err := s.controller.Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+	return nil
+}
+
+func (s *CSVStream) start() error {
+	if s.started {
+		return nil
+	}
+	s.started = true
+	s.w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	s.w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": s.filename}))
+	s.w.WriteHeader(http.StatusOK)
+	return s.csv.Write(s.header)
+}
+
+// CSVText neutralises free text that a spreadsheet would otherwise evaluate as
+// a formula by prefixing it with an apostrophe.
+func CSVText(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
@@ -0,0 +1,35 @@
+package common
+
+import (
+	"net/url"
+	"strconv"
+
+	"accounting/internal/domain/entity"
+)
+
+// ParsePageRequest reads the limit and cursor query parameters of a list
+// endpoint. An absent limit is left at zero so the service applies its default.
+func ParsePageRequest(query url.Values) (entity.PageRequest, []ValidationError) {
+	page := entity.PageRequest{Cursor: query.Get("cursor")}
+
+	if value := query.Get("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 1 || limit > entity.MaxPageLimit {
+			return page, []ValidationError{{
+				Field:   "limit",
+				Message: "limit must be an integer between 1 and " + strconv.Itoa(entity.MaxPageLimit),
+			}}
+		}
+		page.Limit = limit
+	}
+
+	return page, nil
+}
+
+// PageLimit returns the page size a list response reports for the request.
+func PageLimit(page entity.PageRequest) int {
+	if page.Limit == 0 {
+		return entity.DefaultPageLimit
+	}
+	return page.Limit
+}
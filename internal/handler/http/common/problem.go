@@ -18,6 +18,8 @@ const (
 	TypeInternalError    = "https://api.accounting.app/problems/internal-error"
 	TypeMethodNotAllowed = "https://api.accounting.app/problems/method-not-allowed"
 	TypeBadRequest       = "https://api.accounting.app/problems/bad-request"
+	TypeTooLarge         = "https://api.accounting.app/problems/too-large"
+	TypeConflict         = "https://api.accounting.app/problems/conflict"
 )
 
 // NewValidationProblem creates a validation error problem detail
@@ -75,6 +77,38 @@ func NewBadRequestProblem(detail, instance string) *ProblemDetail {
 	}
 }
 
+// NewTooLargeProblem creates a request entity too large problem detail
+func NewTooLargeProblem(detail, instance string) *ProblemDetail {
+	return &ProblemDetail{
+		Type:     TypeTooLarge,
+		Title:    "Request Entity Too Large",
+		Status:   413,
+		Detail:   detail,
+		Instance: instance,
+	}
+}
+
+// ConflictProblem extends ProblemDetail with the existing resource the
+// request conflicts with
+type ConflictProblem struct {
+	ProblemDetail
+	ExistingID string `json:"existing_id"`
+}
+
+// NewConflictProblem creates a conflict problem detail
+func NewConflictProblem(detail, existingID, instance string) *ConflictProblem {
+	return &ConflictProblem{
+		ProblemDetail: ProblemDetail{
+			Type:     TypeConflict,
+			Title:    "Conflict",
+			Status:   409,
+			Detail:   detail,
+			Instance: instance,
+		},
+		ExistingID: existingID,
+	}
+}
+
 // ValidationError represents a field-level validation error
 type ValidationError struct {
 	Field   string `json:"field"`
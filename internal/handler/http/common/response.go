@@ -31,6 +31,8 @@ func WriteProblem(w http.ResponseWriter, problem interface{}) {
 		status = p.Status
 	} else if p, ok := problem.(*ValidationProblem); ok {
 		status = p.Status
+	} else if p, ok := problem.(*ConflictProblem); ok {
+		status = p.Status
 	}
 
 	w.WriteHeader(status)
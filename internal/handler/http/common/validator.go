@@ -1,9 +1,14 @@
 package common
 
 import (
+	"errors"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
+
+	"accounting/internal/domain/money"
+	"accounting/internal/pkg/iso4217"
 
 	"github.com/google/uuid"
 )
@@ -73,7 +78,7 @@ func ValidateUUID(value, fieldName string) *ValidationError {
 	return nil
 }
 
-// ValidateCurrency checks if a string is a valid 3-character currency code
+// ValidateCurrency checks if a string is a known ISO 4217 currency code
 func ValidateCurrency(currency, fieldName string) *ValidationError {
 	if currency == "" {
 		return &ValidationError{
@@ -96,12 +101,37 @@ func ValidateCurrency(currency, fieldName string) *ValidationError {
 			}
 		}
 	}
+	if !iso4217.IsValid(currency) {
+		return &ValidationError{
+			Field:   fieldName,
+			Message: fieldName + " must be a supported ISO 4217 currency code; see GET /api/v1/currencies",
+		}
+	}
 	return nil
 }
 
-// ValidatePositive checks if a float value is greater than zero
-func ValidatePositive(value float64, fieldName string) *ValidationError {
-	if value <= 0 {
+// ValidateAmount checks if a string is a positive decimal amount representable in the currency
+func ValidateAmount(value, currency, fieldName string) *ValidationError {
+	if value == "" {
+		return &ValidationError{
+			Field:   fieldName,
+			Message: fieldName + " is required",
+		}
+	}
+	amount, err := money.Parse(value, currency)
+	if errors.Is(err, money.ErrTooManyDecimals) {
+		return &ValidationError{
+			Field:   fieldName,
+			Message: fieldName + " has more decimal places than " + currency + " allows",
+		}
+	}
+	if err != nil {
+		return &ValidationError{
+			Field:   fieldName,
+			Message: fieldName + " must be a decimal string such as \"12.34\"",
+		}
+	}
+	if !amount.IsPositive() {
 		return &ValidationError{
 			Field:   fieldName,
 			Message: fieldName + " must be greater than zero",
@@ -110,6 +140,51 @@ func ValidatePositive(value float64, fieldName string) *ValidationError {
 	return nil
 }
 
+// ValidateRate checks if a string is a positive decimal exchange rate
+func ValidateRate(value, fieldName string) *ValidationError {
+	if value == "" {
+		return &ValidationError{
+			Field:   fieldName,
+			Message: fieldName + " is required",
+		}
+	}
+	if _, err := money.ParseRate(value); err != nil {
+		return &ValidationError{
+			Field:   fieldName,
+			Message: fieldName + " must be a positive decimal with at most " + strconv.Itoa(money.RateScale) + " decimal places",
+		}
+	}
+	return nil
+}
+
+// ValidateDate checks if a string is a calendar date in YYYY-MM-DD format
+func ValidateDate(value, fieldName string) *ValidationError {
+	if value == "" {
+		return &ValidationError{
+			Field:   fieldName,
+			Message: fieldName + " is required",
+		}
+	}
+	if _, err := time.Parse(time.DateOnly, value); err != nil {
+		return &ValidationError{
+			Field:   fieldName,
+			Message: fieldName + " must be a date in YYYY-MM-DD format",
+		}
+	}
+	return nil
+}
+
+// ValidateBool checks if a string is a boolean such as true, false, 1 or 0
+func ValidateBool(value, fieldName string) *ValidationError {
+	if _, err := strconv.ParseBool(value); err != nil {
+		return &ValidationError{
+			Field:   fieldName,
+			Message: fieldName + " must be true or false",
+		}
+	}
+	return nil
+}
+
 // ValidateEnum checks if a value is in a list of allowed values
 func ValidateEnum(value string, allowed []string, fieldName string) *ValidationError {
 	if value == "" {
@@ -0,0 +1,8 @@
+package currency
+
+type CurrencyResponse struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+	// MinorUnits is the number of decimal places amounts in this currency may have
+	MinorUnits int `json:"minor_units"`
+}
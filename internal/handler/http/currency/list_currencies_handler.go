@@ -0,0 +1,41 @@
+package currency
+
+import (
+	"net/http"
+
+	"accounting/internal/handler/http/common"
+	"accounting/internal/pkg/iso4217"
+)
+
+type ListCurrenciesHandler struct{}
+
+func NewListCurrenciesHandler() *ListCurrenciesHandler {
+	return &ListCurrenciesHandler{}
+}
+
+// @Summary List supported currencies
+// @Description Retrieve the ISO 4217 currencies accepted by the API, ordered by code, with the number of decimal places each allows
+// @Tags currency
+// @Accept json
+// @Produce json
+// @Success 200 {array} CurrencyResponse
+// @Router /api/v1/currencies [get]
+func (h *ListCurrenciesHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		problem := common.NewMethodNotAllowedProblem(r.RequestURI)
+		common.WriteProblem(w, problem)
+		return
+	}
+
+	currencies := iso4217.All()
+	response := make([]*CurrencyResponse, 0, len(currencies))
+	for _, c := range currencies {
+		response = append(response, &CurrencyResponse{
+			Code:       c.Code,
+			Name:       c.Name,
+			MinorUnits: c.MinorUnits,
+		})
+	}
+
+	common.WriteJSON(w, http.StatusOK, response)
+}
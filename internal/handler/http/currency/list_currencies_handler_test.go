@@ -0,0 +1,52 @@
+package currency
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestListCurrenciesHandlerSuccess(t *testing.T) {
+	handler := NewListCurrenciesHandler()
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/currencies", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var response []CurrencyResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	minorUnits := make(map[string]int, len(response))
+	for _, c := range response {
+		minorUnits[c.Code] = c.MinorUnits
+	}
+
+	if units, ok := minorUnits["JPY"]; !ok || units != 0 {
+		t.Errorf("expected JPY with 0 minor units, got %d (present: %v)", units, ok)
+	}
+
+	if units, ok := minorUnits["USD"]; !ok || units != 2 {
+		t.Errorf("expected USD with 2 minor units, got %d (present: %v)", units, ok)
+	}
+}
+
+func TestListCurrenciesHandlerInvalidMethod(t *testing.T) {
+	handler := NewListCurrenciesHandler()
+
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/currencies", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected status %d, got %d", http.StatusMethodNotAllowed, w.Code)
+	}
+}
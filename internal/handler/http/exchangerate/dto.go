@@ -0,0 +1,21 @@
+package exchangerate
+
+type RateRequest struct {
+	BaseCurrency  string `json:"base_currency"`
+	QuoteCurrency string `json:"quote_currency"`
+	// Date is the day from which the rate applies, in YYYY-MM-DD format
+	Date string `json:"date"`
+	// Rate is the number of quote currency units per base currency unit, as a decimal string
+	Rate string `json:"rate"`
+}
+
+type SetRatesRequest struct {
+	Rates []RateRequest `json:"rates"`
+}
+
+type ExchangeRateResponse struct {
+	BaseCurrency  string `json:"base_currency"`
+	QuoteCurrency string `json:"quote_currency"`
+	Date          string `json:"date"`
+	Rate          string `json:"rate"`
+}
@@ -0,0 +1,75 @@
+package exchangerate
+
+import (
+	"net/http"
+	"time"
+
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type GetExchangeRateHandler struct {
+	service interfaces.ExchangeRateService
+}
+
+func NewGetExchangeRateHandler(service interfaces.ExchangeRateService) *GetExchangeRateHandler {
+	return &GetExchangeRateHandler{service: service}
+}
+
+// @Summary Get an exchange rate
+// @Description Retrieve the rate effective on a date for a currency pair: the latest rate dated on or before it.
+// @Description The inverse of the opposite pair is used when only that one is known.
+// @Tags exchange-rate
+// @Accept json
+// @Produce json
+// @Param base query string true "Base currency (e.g., EUR)"
+// @Param quote query string true "Quote currency (e.g., USD)"
+// @Param date query string false "Date in YYYY-MM-DD format (default: today)"
+// @Success 200 {object} ExchangeRateResponse
+// @Failure 400 {object} common.ValidationProblem "Validation error"
+// @Failure 404 {object} common.ProblemDetail "No rate known for the pair on that date"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/exchange-rates [get]
+func (h *GetExchangeRateHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		problem := common.NewMethodNotAllowedProblem(r.RequestURI)
+		common.WriteProblem(w, problem)
+		return
+	}
+
+	query := r.URL.Query()
+	base, quote, dateParam := query.Get("base"), query.Get("quote"), query.Get("date")
+
+	checks := []*common.ValidationError{
+		common.ValidateCurrency(base, "base"),
+		common.ValidateCurrency(quote, "quote"),
+	}
+	if dateParam != "" {
+		checks = append(checks, common.ValidateDate(dateParam, "date"))
+	}
+	validationErrors := common.CollectErrors(checks...)
+	if len(validationErrors) > 0 {
+		problem := common.NewValidationProblemWithErrors(r.RequestURI, validationErrors)
+		common.WriteProblem(w, problem)
+		return
+	}
+
+	date := time.Now()
+	if dateParam != "" {
+		date, _ = time.Parse(time.DateOnly, dateParam)
+	}
+
+	rate, err := h.service.GetRate(r.Context(), base, quote, date)
+	if err != nil {
+		problem := common.NewInternalErrorProblem(r.RequestURI)
+		common.WriteProblem(w, problem)
+		return
+	}
+	if rate == nil {
+		problem := common.NewNotFoundProblem("no exchange rate from "+base+" to "+quote+" on that date", r.RequestURI)
+		common.WriteProblem(w, problem)
+		return
+	}
+
+	common.WriteJSON(w, http.StatusOK, toExchangeRateResponse(rate))
+}
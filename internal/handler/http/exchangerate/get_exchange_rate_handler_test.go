@@ -0,0 +1,75 @@
+package exchangerate
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"accounting/internal/domain/entity"
+	"accounting/internal/domain/money"
+	httptesting "accounting/internal/handler/http"
+)
+
+func TestGetExchangeRateHandlerSuccess(t *testing.T) {
+	mockService := &httptesting.MockExchangeRateService{
+		RateToReturn: &entity.ExchangeRate{
+			BaseCurrency:  "EUR",
+			QuoteCurrency: "USD",
+			Date:          time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			Rate:          money.MustParseRate("1.0845"),
+		},
+	}
+	handler := NewGetExchangeRateHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/exchange-rates?base=EUR&quote=USD&date=2024-03-05", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var response ExchangeRateResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if response.Rate != "1.0845" || response.Date != "2024-03-01" {
+		t.Errorf("unexpected response: %+v", response)
+	}
+}
+
+func TestGetExchangeRateHandlerNotFound(t *testing.T) {
+	mockService := &httptesting.MockExchangeRateService{}
+	handler := NewGetExchangeRateHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/exchange-rates?base=EUR&quote=JPY", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestGetExchangeRateHandlerMissingQuote(t *testing.T) {
+	mockService := &httptesting.MockExchangeRateService{}
+	handler := NewGetExchangeRateHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/exchange-rates?base=EUR", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+
+	if mockService.GetRateCalls != 0 {
+		t.Errorf("expected 0 getRate calls, got %d", mockService.GetRateCalls)
+	}
+}
@@ -0,0 +1,70 @@
+package exchangerate
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"accounting/internal/domain/entity"
+	"accounting/internal/domain/money"
+)
+
+// csvHeader is the column layout of a rates file; the header line itself is optional.
+var csvHeader = []string{"date", "base_currency", "quote_currency", "rate"}
+
+func toExchangeRateResponse(rate *entity.ExchangeRate) *ExchangeRateResponse {
+	return &ExchangeRateResponse{
+		BaseCurrency:  rate.BaseCurrency,
+		QuoteCurrency: rate.QuoteCurrency,
+		Date:          rate.Date.Format(time.DateOnly),
+		Rate:          rate.Rate.String(),
+	}
+}
+
+// toExchangeRate converts a validated rate request into a domain entity.
+func toExchangeRate(req RateRequest) (*entity.ExchangeRate, error) {
+	date, err := time.Parse(time.DateOnly, req.Date)
+	if err != nil {
+		return nil, err
+	}
+	rate, err := money.ParseRate(req.Rate)
+	if err != nil {
+		return nil, err
+	}
+	return &entity.ExchangeRate{
+		BaseCurrency:  req.BaseCurrency,
+		QuoteCurrency: req.QuoteCurrency,
+		Date:          date,
+		Rate:          rate,
+	}, nil
+}
+
+// parseRatesCSV reads rates from a file with the columns in csvHeader.
+func parseRatesCSV(r io.Reader) ([]RateRequest, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = len(csvHeader)
+	reader.TrimLeadingSpace = true
+
+	var rates []RateRequest
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rates, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading rates file: %w", err)
+		}
+		if line == 1 && strings.EqualFold(record[0], csvHeader[0]) {
+			continue
+		}
+		rates = append(rates, RateRequest{
+			Date:          record[0],
+			BaseCurrency:  strings.ToUpper(record[1]),
+			QuoteCurrency: strings.ToUpper(record[2]),
+			Rate:          record[3],
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package exchangerate
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type SetExchangeRatesHandler struct {
+	service interfaces.ExchangeRateService
+}
+
+func NewSetExchangeRatesHandler(service interfaces.ExchangeRateService) *SetExchangeRatesHandler {
+	return &SetExchangeRatesHandler{service: service}
+}
+
+// @Summary Load exchange rates
+// @Description Store dated exchange rates, replacing rates already stored for the same pair and date.
+// @Description Send JSON, or a text/csv file with the columns date,base_currency,quote_currency,rate (header optional).
+// @Description The batch is stored completely or not at all.
+// @Tags exchange-rate
+// @Accept json
+// @Accept text/csv
+// @Produce json
+// @Param body body SetRatesRequest true "Exchange rates"
+// @Success 201 {array} ExchangeRateResponse
+// @Failure 400 {object} common.ValidationProblem "Validation error"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/exchange-rates [post]
+func (h *SetExchangeRatesHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		problem := common.NewMethodNotAllowedProblem(r.RequestURI)
+		common.WriteProblem(w, problem)
+		return
+	}
+
+	if r.Body == nil {
+		problem := common.NewBadRequestProblem("invalid request body", r.RequestURI)
+		common.WriteProblem(w, problem)
+		return
+	}
+
+	var req SetRatesRequest
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType == "text/csv" {
+		rates, err := parseRatesCSV(r.Body)
+		if err != nil {
+			problem := common.NewBadRequestProblem(err.Error(), r.RequestURI)
+			common.WriteProblem(w, problem)
+			return
+		}
+		req.Rates = rates
+	} else if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		problem := common.NewBadRequestProblem("invalid request body", r.RequestURI)
+		common.WriteProblem(w, problem)
+		return
+	}
+
+	// Validate input
+	var checks []*common.ValidationError
+	if len(req.Rates) == 0 {
+		checks = append(checks, &common.ValidationError{Field: "rates", Message: "at least one rate is required"})
+	}
+	for i, rate := range req.Rates {
+		field := fmt.Sprintf("rates[%d].", i)
+		checks = append(checks,
+			common.ValidateCurrency(rate.BaseCurrency, field+"base_currency"),
+			common.ValidateCurrency(rate.QuoteCurrency, field+"quote_currency"),
+			common.ValidateDate(rate.Date, field+"date"),
+			common.ValidateRate(rate.Rate, field+"rate"),
+		)
+	}
+	validationErrors := common.CollectErrors(checks...)
+	if len(validationErrors) > 0 {
+		problem := common.NewValidationProblemWithErrors(r.RequestURI, validationErrors)
+		common.WriteProblem(w, problem)
+		return
+	}
+
+	rates := make([]*entity.ExchangeRate, 0, len(req.Rates))
+	for _, rateReq := range req.Rates {
+		rate, err := toExchangeRate(rateReq)
+		if err != nil {
+			problem := common.NewValidationProblem(err.Error(), r.RequestURI)
+			common.WriteProblem(w, problem)
+			return
+		}
+		rates = append(rates, rate)
+	}
+
+	if err := h.service.SetRates(r.Context(), rates); err != nil {
+		var invalidErr *domainerrors.ErrInvalidInput
+		if errors.As(err, &invalidErr) {
+			problem := common.NewValidationProblem(err.Error(), r.RequestURI)
+			common.WriteProblem(w, problem)
+			return
+		}
+		problem := common.NewInternalErrorProblem(r.RequestURI)
+		common.WriteProblem(w, problem)
+		return
+	}
+
+	response := make([]*ExchangeRateResponse, 0, len(rates))
+	for _, rate := range rates {
+		response = append(response, toExchangeRateResponse(rate))
+	}
+
+	common.WriteJSON(w, http.StatusCreated, response)
+}
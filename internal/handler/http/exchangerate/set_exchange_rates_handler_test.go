@@ -0,0 +1,125 @@
+package exchangerate
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"accounting/internal/domain/errors"
+	httptesting "accounting/internal/handler/http"
+)
+
+func TestSetExchangeRatesHandlerJSON(t *testing.T) {
+	mockService := &httptesting.MockExchangeRateService{}
+	handler := NewSetExchangeRatesHandler(mockService)
+
+	reqBody := SetRatesRequest{Rates: []RateRequest{
+		{BaseCurrency: "EUR", QuoteCurrency: "USD", Date: "2024-03-01", Rate: "1.0845"},
+	}}
+	req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/exchange-rates", reqBody)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d", http.StatusCreated, w.Code)
+	}
+
+	var response []ExchangeRateResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if len(response) != 1 || response[0].Rate != "1.0845" || response[0].Date != "2024-03-01" {
+		t.Errorf("unexpected response: %+v", response)
+	}
+
+	if mockService.SetRatesCalls != 1 {
+		t.Errorf("expected 1 setRates call, got %d", mockService.SetRatesCalls)
+	}
+}
+
+func TestSetExchangeRatesHandlerCSV(t *testing.T) {
+	mockService := &httptesting.MockExchangeRateService{}
+	handler := NewSetExchangeRatesHandler(mockService)
+
+	body := "date,base_currency,quote_currency,rate\n2024-03-01,EUR,USD,1.0845\n2024-03-01,gbp,USD,1.27\n"
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/exchange-rates", strings.NewReader(body))
+	req.Header.Set("Content-Type", "text/csv; charset=utf-8")
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d", http.StatusCreated, w.Code)
+	}
+
+	if len(mockService.LastRates) != 2 {
+		t.Fatalf("expected 2 rates, got %d", len(mockService.LastRates))
+	}
+
+	if mockService.LastRates[1].BaseCurrency != "GBP" || mockService.LastRates[1].Rate.String() != "1.27" {
+		t.Errorf("unexpected second rate: %+v", mockService.LastRates[1])
+	}
+}
+
+func TestSetExchangeRatesHandlerMalformedCSV(t *testing.T) {
+	mockService := &httptesting.MockExchangeRateService{}
+	handler := NewSetExchangeRatesHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodPost, "/api/v1/exchange-rates", strings.NewReader("2024-03-01,EUR,USD\n"))
+	req.Header.Set("Content-Type", "text/csv")
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+
+	if mockService.SetRatesCalls != 0 {
+		t.Errorf("expected 0 setRates calls, got %d", mockService.SetRatesCalls)
+	}
+}
+
+func TestSetExchangeRatesHandlerInvalidRate(t *testing.T) {
+	mockService := &httptesting.MockExchangeRateService{}
+	handler := NewSetExchangeRatesHandler(mockService)
+
+	reqBody := SetRatesRequest{Rates: []RateRequest{
+		{BaseCurrency: "EUR", QuoteCurrency: "USD", Date: "01/03/2024", Rate: "-1"},
+	}}
+	req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/exchange-rates", reqBody)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+
+	if mockService.SetRatesCalls != 0 {
+		t.Errorf("expected 0 setRates calls, got %d", mockService.SetRatesCalls)
+	}
+}
+
+func TestSetExchangeRatesHandlerServiceValidationError(t *testing.T) {
+	mockService := &httptesting.MockExchangeRateService{
+		LastSetRatesErr: errors.NewErrInvalidInput("rates[0]", "base and quote currencies must differ"),
+	}
+	handler := NewSetExchangeRatesHandler(mockService)
+
+	reqBody := SetRatesRequest{Rates: []RateRequest{
+		{BaseCurrency: "USD", QuoteCurrency: "USD", Date: "2024-03-01", Rate: "1"},
+	}}
+	req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/exchange-rates", reqBody)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
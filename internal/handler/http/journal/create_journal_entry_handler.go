@@ -0,0 +1,117 @@
+package journal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/domain/money"
+	"accounting/internal/handler/http/common"
+)
+
+type CreateJournalEntryHandler struct {
+	service interfaces.JournalService
+}
+
+func NewCreateJournalEntryHandler(service interfaces.JournalService) *CreateJournalEntryHandler {
+	return &CreateJournalEntryHandler{service: service}
+}
+
+// @Summary Create a journal entry
+// @Description Record a double-entry journal entry. Debits must equal credits in every currency.
+// @Tags journal
+// @Accept json
+// @Produce json
+// @Param body body CreateJournalEntryRequest true "Journal entry request"
+// @Success 201 {object} JournalEntryResponse
+// @Failure 400 {object} common.ValidationProblem "Validation error"
+// @Failure 404 {object} common.ProblemDetail "Account not found"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/journal-entries [post]
+func (h *CreateJournalEntryHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		problem := common.NewMethodNotAllowedProblem(r.RequestURI)
+		common.WriteProblem(w, problem)
+		return
+	}
+
+	var req CreateJournalEntryRequest
+	if r.Body == nil {
+		problem := common.NewBadRequestProblem("invalid request body", r.RequestURI)
+		common.WriteProblem(w, problem)
+		return
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		problem := common.NewBadRequestProblem("invalid request body", r.RequestURI)
+		common.WriteProblem(w, problem)
+		return
+	}
+
+	// Validate input
+	checks := []*common.ValidationError{common.ValidateUUID(req.UserID, "user_id")}
+	if len(req.Postings) < 2 {
+		checks = append(checks, &common.ValidationError{Field: "postings", Message: "at least two postings are required"})
+	}
+	for i, posting := range req.Postings {
+		field := fmt.Sprintf("postings[%d].", i)
+		checks = append(checks,
+			common.ValidateUUID(posting.AccountID, field+"account_id"),
+			common.ValidateEnum(string(posting.Direction), []string{"DEBIT", "CREDIT"}, field+"direction"),
+			common.ValidateCurrency(posting.Currency, field+"currency"),
+			common.ValidateAmount(posting.Amount, posting.Currency, field+"amount"),
+		)
+	}
+	validationErrors := common.CollectErrors(checks...)
+	if len(validationErrors) > 0 {
+		problem := common.NewValidationProblemWithErrors(r.RequestURI, validationErrors)
+		common.WriteProblem(w, problem)
+		return
+	}
+
+	postings := make([]*entity.Posting, 0, len(req.Postings))
+	for _, posting := range req.Postings {
+		amount, err := money.Parse(posting.Amount, posting.Currency)
+		if err != nil {
+			problem := common.NewValidationProblem(err.Error(), r.RequestURI)
+			common.WriteProblem(w, problem)
+			return
+		}
+		postings = append(postings, &entity.Posting{
+			AccountID: posting.AccountID,
+			Direction: posting.Direction,
+			Amount:    amount,
+			Memo:      posting.Memo,
+		})
+	}
+
+	var date time.Time
+	if req.Date != nil {
+		date = *req.Date
+	}
+
+	entry, err := h.service.CreateEntry(r.Context(), req.UserID, date, req.Description, postings)
+	if err != nil {
+		var notFoundErr *domainerrors.ErrNotFound
+		if errors.As(err, &notFoundErr) {
+			problem := common.NewNotFoundProblem(err.Error(), r.RequestURI)
+			common.WriteProblem(w, problem)
+			return
+		}
+		var invalidErr *domainerrors.ErrInvalidInput
+		if errors.As(err, &invalidErr) {
+			problem := common.NewValidationProblem(err.Error(), r.RequestURI)
+			common.WriteProblem(w, problem)
+			return
+		}
+		problem := common.NewInternalErrorProblem(r.RequestURI)
+		common.WriteProblem(w, problem)
+		return
+	}
+
+	common.WriteJSON(w, http.StatusCreated, toJournalEntryResponse(entry))
+}
@@ -0,0 +1,142 @@
+package journal
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/errors"
+	httptesting "accounting/internal/handler/http"
+)
+
+func newTestCreateRequest() CreateJournalEntryRequest {
+	return CreateJournalEntryRequest{
+		UserID:      "123e4567-e89b-12d3-a456-426614174000",
+		Description: "Weekly shopping",
+		Postings: []PostingRequest{
+			{AccountID: "123e4567-e89b-12d3-a456-426614174001", Direction: constant.PostingDirectionDebit, Amount: "80.00", Currency: "USD"},
+			{AccountID: "123e4567-e89b-12d3-a456-426614174002", Direction: constant.PostingDirectionCredit, Amount: "80.00", Currency: "USD"},
+		},
+	}
+}
+
+func TestCreateJournalEntryHandlerSuccess(t *testing.T) {
+	mockService := &httptesting.MockJournalService{}
+	handler := NewCreateJournalEntryHandler(mockService)
+
+	req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/journal-entries", newTestCreateRequest())
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Errorf("expected status %d, got %d", http.StatusCreated, w.Code)
+	}
+
+	var response JournalEntryResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if len(response.Postings) != 2 {
+		t.Fatalf("expected 2 postings, got %d", len(response.Postings))
+	}
+
+	if response.Postings[0].Direction != constant.PostingDirectionDebit || response.Postings[0].Amount != "80.00" {
+		t.Errorf("unexpected first posting: %+v", response.Postings[0])
+	}
+
+	if mockService.CreateEntryCalls != 1 {
+		t.Errorf("expected 1 createEntry call, got %d", mockService.CreateEntryCalls)
+	}
+}
+
+func TestCreateJournalEntryHandlerSinglePosting(t *testing.T) {
+	mockService := &httptesting.MockJournalService{}
+	handler := NewCreateJournalEntryHandler(mockService)
+
+	reqBody := newTestCreateRequest()
+	reqBody.Postings = reqBody.Postings[:1]
+
+	req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/journal-entries", reqBody)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+
+	if mockService.CreateEntryCalls != 0 {
+		t.Errorf("expected 0 createEntry calls, got %d", mockService.CreateEntryCalls)
+	}
+}
+
+func TestCreateJournalEntryHandlerInvalidPosting(t *testing.T) {
+	mockService := &httptesting.MockJournalService{}
+	handler := NewCreateJournalEntryHandler(mockService)
+
+	reqBody := newTestCreateRequest()
+	reqBody.Postings[1].Direction = "SIDEWAYS"
+
+	req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/journal-entries", reqBody)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+
+	if mockService.CreateEntryCalls != 0 {
+		t.Errorf("expected 0 createEntry calls, got %d", mockService.CreateEntryCalls)
+	}
+}
+
+func TestCreateJournalEntryHandlerUnbalanced(t *testing.T) {
+	mockService := &httptesting.MockJournalService{
+		LastCreateEntryErr: errors.NewErrInvalidInput("postings", "debits and credits differ by 0.01 USD"),
+	}
+	handler := NewCreateJournalEntryHandler(mockService)
+
+	req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/journal-entries", newTestCreateRequest())
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestCreateJournalEntryHandlerAccountNotFound(t *testing.T) {
+	mockService := &httptesting.MockJournalService{
+		LastCreateEntryErr: errors.NewErrNotFound("account", "123e4567-e89b-12d3-a456-426614174001"),
+	}
+	handler := NewCreateJournalEntryHandler(mockService)
+
+	req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/journal-entries", newTestCreateRequest())
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestCreateJournalEntryHandlerInvalidMethod(t *testing.T) {
+	mockService := &httptesting.MockJournalService{}
+	handler := NewCreateJournalEntryHandler(mockService)
+
+	req := httptesting.NewTestRequest(http.MethodGet, "/api/v1/journal-entries", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected status %d, got %d", http.StatusMethodNotAllowed, w.Code)
+	}
+}
@@ -0,0 +1,68 @@
+package journal
+
+import (
+	"errors"
+	"net/http"
+
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type DeleteJournalEntryHandler struct {
+	service interfaces.JournalService
+}
+
+func NewDeleteJournalEntryHandler(service interfaces.JournalService) *DeleteJournalEntryHandler {
+	return &DeleteJournalEntryHandler{service: service}
+}
+
+// @Summary Delete a journal entry
+// @Description Delete a journal entry and reverse its postings on the account balances
+// @Tags journal
+// @Accept json
+// @Produce json
+// @Param id path string true "Journal entry ID"
+// @Success 204 "Journal entry deleted successfully"
+// @Failure 400 {object} common.ValidationProblem "Validation error"
+// @Failure 404 {object} common.ProblemDetail "Journal entry not found"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/journal-entries/{id} [delete]
+func (h *DeleteJournalEntryHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		problem := common.NewMethodNotAllowedProblem(r.RequestURI)
+		common.WriteProblem(w, problem)
+		return
+	}
+
+	id := extractID(r.URL.Path, "/api/v1/journal-entries/")
+	if id == "" {
+		validationErrors := []common.ValidationError{
+			{Field: "id", Message: "journal entry ID is required"},
+		}
+		problem := common.NewValidationProblemWithErrors(r.RequestURI, validationErrors)
+		common.WriteProblem(w, problem)
+		return
+	}
+
+	// Validate ID is a valid UUID
+	if validationErr := common.ValidateUUID(id, "id"); validationErr != nil {
+		problem := common.NewValidationProblemWithErrors(r.RequestURI, []common.ValidationError{*validationErr})
+		common.WriteProblem(w, problem)
+		return
+	}
+
+	if err := h.service.DeleteEntry(r.Context(), id); err != nil {
+		var notFoundErr *domainerrors.ErrNotFound
+		if errors.As(err, &notFoundErr) {
+			problem := common.NewNotFoundProblem(err.Error(), r.RequestURI)
+			common.WriteProblem(w, problem)
+			return
+		}
+		problem := common.NewInternalErrorProblem(r.RequestURI)
+		common.WriteProblem(w, problem)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,44 @@
+package journal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"accounting/internal/domain/errors"
+	httptesting "accounting/internal/handler/http"
+)
+
+func TestDeleteJournalEntryHandlerSuccess(t *testing.T) {
+	mockService := &httptesting.MockJournalService{}
+	handler := NewDeleteJournalEntryHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodDelete, "/api/v1/journal-entries/123e4567-e89b-12d3-a456-426614174000", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusNoContent {
+		t.Errorf("expected status %d, got %d", http.StatusNoContent, w.Code)
+	}
+
+	if mockService.DeleteEntryCalls != 1 {
+		t.Errorf("expected 1 deleteEntry call, got %d", mockService.DeleteEntryCalls)
+	}
+}
+
+func TestDeleteJournalEntryHandlerNotFound(t *testing.T) {
+	mockService := &httptesting.MockJournalService{
+		LastDeleteEntryErr: errors.NewErrNotFound("journal entry", "123e4567-e89b-12d3-a456-426614174000"),
+	}
+	handler := NewDeleteJournalEntryHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodDelete, "/api/v1/journal-entries/123e4567-e89b-12d3-a456-426614174000", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
@@ -0,0 +1,39 @@
+package journal
+
+import (
+	"time"
+
+	"accounting/internal/domain/constant"
+)
+
+type PostingRequest struct {
+	AccountID string                    `json:"account_id"`
+	Direction constant.PostingDirection `json:"direction"`
+	Amount    string                    `json:"amount"`
+	Currency  string                    `json:"currency"`
+	Memo      string                    `json:"memo,omitempty"`
+}
+
+type CreateJournalEntryRequest struct {
+	UserID      string           `json:"user_id"`
+	Description string           `json:"description,omitempty"`
+	Date        *time.Time       `json:"date,omitempty"`
+	Postings    []PostingRequest `json:"postings"`
+}
+
+type PostingResponse struct {
+	ID        string                    `json:"id"`
+	AccountID string                    `json:"account_id"`
+	Direction constant.PostingDirection `json:"direction"`
+	Amount    string                    `json:"amount"`
+	Currency  string                    `json:"currency"`
+	Memo      string                    `json:"memo,omitempty"`
+}
+
+type JournalEntryResponse struct {
+	ID          string             `json:"id"`
+	UserID      string             `json:"user_id"`
+	Date        time.Time          `json:"date"`
+	Description string             `json:"description"`
+	Postings    []*PostingResponse `json:"postings"`
+}
@@ -0,0 +1,66 @@
+package journal
+
+import (
+	"net/http"
+
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type GetJournalEntryHandler struct {
+	service interfaces.JournalService
+}
+
+func NewGetJournalEntryHandler(service interfaces.JournalService) *GetJournalEntryHandler {
+	return &GetJournalEntryHandler{service: service}
+}
+
+// @Summary Get a journal entry
+// @Description Retrieve a journal entry with its postings by ID
+// @Tags journal
+// @Accept json
+// @Produce json
+// @Param id path string true "Journal entry ID"
+// @Success 200 {object} JournalEntryResponse
+// @Failure 400 {object} common.ValidationProblem "Validation error"
+// @Failure 404 {object} common.ProblemDetail "Journal entry not found"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/journal-entries/{id} [get]
+func (h *GetJournalEntryHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		problem := common.NewMethodNotAllowedProblem(r.RequestURI)
+		common.WriteProblem(w, problem)
+		return
+	}
+
+	id := extractID(r.URL.Path, "/api/v1/journal-entries/")
+	if id == "" {
+		validationErrors := []common.ValidationError{
+			{Field: "id", Message: "journal entry ID is required"},
+		}
+		problem := common.NewValidationProblemWithErrors(r.RequestURI, validationErrors)
+		common.WriteProblem(w, problem)
+		return
+	}
+
+	// Validate ID is a valid UUID
+	if validationErr := common.ValidateUUID(id, "id"); validationErr != nil {
+		problem := common.NewValidationProblemWithErrors(r.RequestURI, []common.ValidationError{*validationErr})
+		common.WriteProblem(w, problem)
+		return
+	}
+
+	entry, err := h.service.GetEntry(r.Context(), id)
+	if err != nil {
+		problem := common.NewInternalErrorProblem(r.RequestURI)
+		common.WriteProblem(w, problem)
+		return
+	}
+	if entry == nil {
+		problem := common.NewNotFoundProblem("journal entry not found", r.RequestURI)
+		common.WriteProblem(w, problem)
+		return
+	}
+
+	common.WriteJSON(w, http.StatusOK, toJournalEntryResponse(entry))
+}
@@ -0,0 +1,78 @@
+package journal
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	"accounting/internal/domain/money"
+	httptesting "accounting/internal/handler/http"
+)
+
+func TestGetJournalEntryHandlerSuccess(t *testing.T) {
+	testEntry := &entity.JournalEntry{
+		ID:     "123e4567-e89b-12d3-a456-426614174000",
+		UserID: "user-123",
+		Date:   time.Now(),
+		Postings: []*entity.Posting{
+			{ID: "p1", AccountID: "a1", Direction: constant.PostingDirectionDebit, Amount: money.MustParse("5.00", "USD")},
+			{ID: "p2", AccountID: "a2", Direction: constant.PostingDirectionCredit, Amount: money.MustParse("5.00", "USD")},
+		},
+	}
+	mockService := &httptesting.MockJournalService{EntryToReturn: testEntry}
+	handler := NewGetJournalEntryHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/journal-entries/123e4567-e89b-12d3-a456-426614174000", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var response JournalEntryResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if response.ID != testEntry.ID || len(response.Postings) != 2 {
+		t.Errorf("unexpected response: %+v", response)
+	}
+
+	if response.Postings[1].Currency != "USD" {
+		t.Errorf("expected currency USD, got %q", response.Postings[1].Currency)
+	}
+}
+
+func TestGetJournalEntryHandlerNotFound(t *testing.T) {
+	mockService := &httptesting.MockJournalService{}
+	handler := NewGetJournalEntryHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/journal-entries/123e4567-e89b-12d3-a456-426614174000", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestGetJournalEntryHandlerInvalidID(t *testing.T) {
+	mockService := &httptesting.MockJournalService{}
+	handler := NewGetJournalEntryHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/journal-entries/not-a-uuid", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
@@ -0,0 +1,38 @@
+package journal
+
+import (
+	"strings"
+
+	"accounting/internal/domain/entity"
+)
+
+func toJournalEntryResponse(entry *entity.JournalEntry) *JournalEntryResponse {
+	postings := make([]*PostingResponse, 0, len(entry.Postings))
+	for _, posting := range entry.Postings {
+		postings = append(postings, &PostingResponse{
+			ID:        posting.ID,
+			AccountID: posting.AccountID,
+			Direction: posting.Direction,
+			Amount:    posting.Amount.String(),
+			Currency:  posting.Amount.Currency(),
+			Memo:      posting.Memo,
+		})
+	}
+
+	return &JournalEntryResponse{
+		ID:          entry.ID,
+		UserID:      entry.UserID,
+		Date:        entry.Date,
+		Description: entry.Description,
+		Postings:    postings,
+	}
+}
+
+func extractID(path, prefix string) string {
+	path = strings.TrimPrefix(path, prefix)
+	parts := strings.Split(path, "/")
+	if len(parts) > 0 {
+		return parts[0]
+	}
+	return ""
+}
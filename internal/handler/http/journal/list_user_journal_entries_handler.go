@@ -0,0 +1,53 @@
+package journal
+
+import (
+	"net/http"
+
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type ListUserJournalEntriesHandler struct {
+	service interfaces.JournalService
+}
+
+func NewListUserJournalEntriesHandler(service interfaces.JournalService) *ListUserJournalEntriesHandler {
+	return &ListUserJournalEntriesHandler{service: service}
+}
+
+// @Summary List journal entries for a user
+// @Description Retrieve all journal entries of a user, newest first
+// @Tags journal
+// @Accept json
+// @Produce json
+// @Param user_id path string true "User ID (UUID)"
+// @Success 200 {array} JournalEntryResponse
+// @Failure 400 {object} common.ValidationProblem "Validation error"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/users/{user_id}/journal-entries [get]
+func (h *ListUserJournalEntriesHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		common.WriteProblem(w, common.NewMethodNotAllowedProblem(r.RequestURI))
+		return
+	}
+
+	userID := extractID(r.URL.Path, "/api/v1/users/")
+	if err := common.ValidateUUID(userID, "user_id"); err != nil {
+		validationErrors := common.CollectErrors(err)
+		common.WriteProblem(w, common.NewValidationProblemWithErrors(r.RequestURI, validationErrors))
+		return
+	}
+
+	entries, err := h.service.ListUserEntries(r.Context(), userID)
+	if err != nil {
+		common.WriteProblem(w, common.NewInternalErrorProblem(r.RequestURI))
+		return
+	}
+
+	response := make([]*JournalEntryResponse, 0, len(entries))
+	for _, entry := range entries {
+		response = append(response, toJournalEntryResponse(entry))
+	}
+
+	common.WriteJSON(w, http.StatusOK, response)
+}
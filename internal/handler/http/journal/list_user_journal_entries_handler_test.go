@@ -0,0 +1,64 @@
+package journal
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"accounting/internal/domain/entity"
+	httptesting "accounting/internal/handler/http"
+)
+
+func TestListUserJournalEntriesHandlerSuccess(t *testing.T) {
+	mockService := &httptesting.MockJournalService{
+		EntriesToReturn: []*entity.JournalEntry{{ID: "entry-1"}, {ID: "entry-2"}},
+	}
+	handler := NewListUserJournalEntriesHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/users/123e4567-e89b-12d3-a456-426614174000/journal-entries", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var response []JournalEntryResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if len(response) != 2 {
+		t.Errorf("expected 2 entries, got %d", len(response))
+	}
+}
+
+func TestListUserJournalEntriesHandlerEmpty(t *testing.T) {
+	mockService := &httptesting.MockJournalService{}
+	handler := NewListUserJournalEntriesHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/users/123e4567-e89b-12d3-a456-426614174000/journal-entries", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if body := w.Body.String(); body != "[]\n" && body != "[]" {
+		t.Errorf("expected empty JSON array, got %q", body)
+	}
+}
+
+func TestListUserJournalEntriesHandlerInvalidUserID(t *testing.T) {
+	mockService := &httptesting.MockJournalService{}
+	handler := NewListUserJournalEntriesHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/users/not-a-uuid/journal-entries", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
@@ -0,0 +1,72 @@
+package journal
+
+import (
+	"errors"
+	"net/http"
+
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type PreviewTransactionJournalEntryHandler struct {
+	service interfaces.JournalService
+}
+
+func NewPreviewTransactionJournalEntryHandler(service interfaces.JournalService) *PreviewTransactionJournalEntryHandler {
+	return &PreviewTransactionJournalEntryHandler{service: service}
+}
+
+// @Summary Preview a transaction as a journal entry
+// @Description Express an INCOME or EXPENSE transaction as the balanced journal entry it amounts to: income debits the transaction's account and credits an INCOME category account, expense debits an EXPENSE category account and credits the transaction's account.
+// @Description The entry is not recorded and has no IDs, since the transaction already moved the account's balance.
+// @Tags journal
+// @Accept json
+// @Produce json
+// @Param transaction_id path string true "Transaction ID (UUID)"
+// @Param category_account_id query string true "ID of the user's INCOME or EXPENSE account, in the transaction's currency"
+// @Success 200 {object} JournalEntryResponse
+// @Failure 400 {object} common.ValidationProblem "Validation error"
+// @Failure 404 {object} common.ProblemDetail "Transaction or account not found"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/transactions/{transaction_id}/journal-entry [get]
+func (h *PreviewTransactionJournalEntryHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		problem := common.NewMethodNotAllowedProblem(r.RequestURI)
+		common.WriteProblem(w, problem)
+		return
+	}
+
+	transactionID := extractID(r.URL.Path, "/api/v1/transactions/")
+	categoryAccountID := r.URL.Query().Get("category_account_id")
+	validationErrors := common.CollectErrors(
+		common.ValidateUUID(transactionID, "transaction_id"),
+		common.ValidateUUID(categoryAccountID, "category_account_id"),
+	)
+	if len(validationErrors) > 0 {
+		problem := common.NewValidationProblemWithErrors(r.RequestURI, validationErrors)
+		common.WriteProblem(w, problem)
+		return
+	}
+
+	entry, err := h.service.PreviewTransactionEntry(r.Context(), transactionID, categoryAccountID)
+	if err != nil {
+		var notFoundErr *domainerrors.ErrNotFound
+		if errors.As(err, &notFoundErr) {
+			problem := common.NewNotFoundProblem(err.Error(), r.RequestURI)
+			common.WriteProblem(w, problem)
+			return
+		}
+		var invalidErr *domainerrors.ErrInvalidInput
+		if errors.As(err, &invalidErr) {
+			problem := common.NewValidationProblem(err.Error(), r.RequestURI)
+			common.WriteProblem(w, problem)
+			return
+		}
+		problem := common.NewInternalErrorProblem(r.RequestURI)
+		common.WriteProblem(w, problem)
+		return
+	}
+
+	common.WriteJSON(w, http.StatusOK, toJournalEntryResponse(entry))
+}
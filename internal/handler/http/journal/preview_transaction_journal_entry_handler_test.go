@@ -0,0 +1,112 @@
+package journal
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/money"
+	httptesting "accounting/internal/handler/http"
+)
+
+const (
+	testTransactionID     = "123e4567-e89b-12d3-a456-426614174000"
+	testCategoryAccountID = "123e4567-e89b-12d3-a456-426614174001"
+	previewPath           = "/api/v1/transactions/" + testTransactionID + "/journal-entry"
+)
+
+func TestPreviewTransactionJournalEntryHandlerSuccess(t *testing.T) {
+	mockService := &httptesting.MockJournalService{
+		EntryToReturn: &entity.JournalEntry{
+			UserID:      "user-123",
+			Date:        time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+			Description: "Groceries",
+			Postings: []*entity.Posting{
+				{AccountID: testCategoryAccountID, Direction: constant.PostingDirectionDebit, Amount: money.MustParse("42.10", "USD")},
+				{AccountID: "checking", Direction: constant.PostingDirectionCredit, Amount: money.MustParse("42.10", "USD")},
+			},
+		},
+	}
+	handler := NewPreviewTransactionJournalEntryHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, previewPath+"?category_account_id="+testCategoryAccountID, nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	if mockService.LastCategoryAccountID != testCategoryAccountID {
+		t.Errorf("expected category account %s, got %q", testCategoryAccountID, mockService.LastCategoryAccountID)
+	}
+
+	var response JournalEntryResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(response.Postings) != 2 || response.Postings[0].Direction != constant.PostingDirectionDebit || response.Postings[0].Amount != "42.10" {
+		t.Errorf("unexpected postings %+v", response.Postings)
+	}
+}
+
+func TestPreviewTransactionJournalEntryHandlerValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+	}{
+		{"missing category account", previewPath},
+		{"invalid category account", previewPath + "?category_account_id=groceries"},
+		{"invalid transaction ID", "/api/v1/transactions/abc/journal-entry?category_account_id=" + testCategoryAccountID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &httptesting.MockJournalService{}
+			handler := NewPreviewTransactionJournalEntryHandler(mockService)
+
+			req, _ := http.NewRequest(http.MethodGet, tt.path, nil)
+			w := httptest.NewRecorder()
+
+			handler.Handle(w, req)
+
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+			}
+			if mockService.PreviewEntryCalls != 0 {
+				t.Error("expected the service not to be called")
+			}
+		})
+	}
+}
+
+func TestPreviewTransactionJournalEntryHandlerServiceErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{"transaction not found", domainerrors.NewErrNotFound("transaction", testTransactionID), http.StatusNotFound},
+		{"wrong account type", domainerrors.NewErrInvalidInput("category_account_id", "a EXPENSE transaction needs an EXPENSE account, not SAVINGS"), http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewPreviewTransactionJournalEntryHandler(&httptesting.MockJournalService{LastPreviewEntryErr: tt.err})
+
+			req, _ := http.NewRequest(http.MethodGet, previewPath+"?category_account_id="+testCategoryAccountID, nil)
+			w := httptest.NewRecorder()
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.expected {
+				t.Errorf("expected status %d, got %d", tt.expected, w.Code)
+			}
+		})
+	}
+}
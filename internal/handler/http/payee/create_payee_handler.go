@@ -0,0 +1,69 @@
+package payee
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type CreatePayeeHandler struct {
+	service interfaces.PayeeService
+}
+
+func NewCreatePayeeHandler(service interfaces.PayeeService) *CreatePayeeHandler {
+	return &CreatePayeeHandler{service: service}
+}
+
+// CreatePayee godoc
+// @Summary Create a payee
+// @Description Create a payee a user pays or is paid by. New transactions whose description contains the payee's name or one of its aliases, ignoring case, get the payee, and its default category when no other applies. Names are unique per user, and no name or alias may belong to two payees, ignoring case.
+// @Tags payees
+// @Accept json
+// @Produce json
+// @Param request body CreatePayeeRequest true "Payee creation request"
+// @Success 201 {object} PayeeResponse
+// @Failure 400 {object} common.ValidationProblem "Validation error or duplicate name"
+// @Failure 404 {object} common.ProblemDetail "User or default category not found"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/payees [post]
+func (h *CreatePayeeHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		common.WriteProblem(w, common.NewMethodNotAllowedProblem(r.RequestURI))
+		return
+	}
+
+	var req CreatePayeeRequest
+	if r.Body == nil {
+		common.WriteProblem(w, common.NewBadRequestProblem("invalid request body", r.RequestURI))
+		return
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		common.WriteProblem(w, common.NewBadRequestProblem("invalid request body", r.RequestURI))
+		return
+	}
+
+	validationErrors := common.CollectErrors(
+		common.ValidateUUID(req.UserID, "user_id"),
+		common.ValidateRequired(req.Name, "name"),
+		common.ValidateStringLength(req.Name, "name", 1, 100),
+	)
+	if req.DefaultCategoryID != "" {
+		validationErrors = append(validationErrors, common.CollectErrors(
+			common.ValidateUUID(req.DefaultCategoryID, "default_category_id"),
+		)...)
+	}
+	if len(validationErrors) > 0 {
+		common.WriteProblem(w, common.NewValidationProblemWithErrors(r.RequestURI, validationErrors))
+		return
+	}
+
+	payee, err := h.service.CreatePayee(r.Context(), req.UserID, req.Name, req.Aliases, req.DefaultCategoryID)
+	if err != nil {
+		writePayeeError(w, r, err)
+		return
+	}
+
+	common.WriteJSON(w, http.StatusCreated, toPayeeResponse(payee))
+}
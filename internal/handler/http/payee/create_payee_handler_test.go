@@ -0,0 +1,132 @@
+package payee
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"accounting/internal/domain/errors"
+	httptesting "accounting/internal/handler/http"
+	"accounting/internal/handler/http/common"
+)
+
+const (
+	testUserID     = "123e4567-e89b-12d3-a456-426614174000"
+	testPayeeID    = "423e4567-e89b-12d3-a456-426614174000"
+	testCategoryID = "323e4567-e89b-12d3-a456-426614174000"
+)
+
+func TestCreatePayeeHandlerSuccess(t *testing.T) {
+	mockService := &httptesting.MockPayeeService{}
+	handler := NewCreatePayeeHandler(mockService)
+
+	req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/payees", CreatePayeeRequest{
+		UserID:            testUserID,
+		Name:              "Amazon",
+		Aliases:           []string{"AMZN Mktp", "Amazon.com"},
+		DefaultCategoryID: testCategoryID,
+	})
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+
+	var response PayeeResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if response.Name != "Amazon" || response.DefaultCategoryID != testCategoryID || !slices.Equal(response.Aliases, []string{"AMZN Mktp", "Amazon.com"}) {
+		t.Errorf("unexpected payee %+v", response)
+	}
+}
+
+func TestCreatePayeeHandlerWithoutAliases(t *testing.T) {
+	handler := NewCreatePayeeHandler(&httptesting.MockPayeeService{})
+
+	req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/payees", CreatePayeeRequest{UserID: testUserID, Name: "Landlord"})
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	var response map[string]any
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if aliases, ok := response["aliases"].([]any); !ok || len(aliases) != 0 {
+		t.Errorf("expected an empty list of aliases, got %v", response["aliases"])
+	}
+}
+
+func TestCreatePayeeHandlerValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		request CreatePayeeRequest
+		field   string
+	}{
+		{"invalid user ID", CreatePayeeRequest{UserID: "not-a-uuid", Name: "Amazon"}, "user_id"},
+		{"missing name", CreatePayeeRequest{UserID: testUserID}, "name"},
+		{"invalid default category ID", CreatePayeeRequest{UserID: testUserID, Name: "Amazon", DefaultCategoryID: "Shopping"}, "default_category_id"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &httptesting.MockPayeeService{}
+			handler := NewCreatePayeeHandler(mockService)
+
+			req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/payees", tt.request)
+			w := httptest.NewRecorder()
+
+			handler.Handle(w, req)
+
+			if w.Code != http.StatusBadRequest {
+				t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+			}
+			var problem common.ValidationProblem
+			if err := json.NewDecoder(w.Body).Decode(&problem); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if len(problem.Errors) == 0 || problem.Errors[0].Field != tt.field {
+				t.Errorf("expected an error on %s, got %+v", tt.field, problem.Errors)
+			}
+			if mockService.CreatePayeeCalls != 0 {
+				t.Error("expected the service not to be called")
+			}
+		})
+	}
+}
+
+func TestCreatePayeeHandlerServiceErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{"duplicate name", errors.NewErrDuplicatePayee(testUserID, "Amazon"), http.StatusBadRequest},
+		{"alias of another payee", errors.NewErrInvalidInput("aliases", `alias "AMZN" already names payee "Amazon"`), http.StatusBadRequest},
+		{"default category not found", errors.NewErrNotFound("category", testCategoryID), http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewCreatePayeeHandler(&httptesting.MockPayeeService{LastCreatePayeeErr: tt.err})
+
+			req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/payees", CreatePayeeRequest{
+				UserID:            testUserID,
+				Name:              "Amazon",
+				DefaultCategoryID: testCategoryID,
+			})
+			w := httptest.NewRecorder()
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.expected {
+				t.Errorf("expected status %d, got %d", tt.expected, w.Code)
+			}
+		})
+	}
+}
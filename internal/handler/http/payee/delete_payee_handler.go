@@ -0,0 +1,47 @@
+package payee
+
+import (
+	"net/http"
+
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type DeletePayeeHandler struct {
+	service interfaces.PayeeService
+}
+
+func NewDeletePayeeHandler(service interfaces.PayeeService) *DeletePayeeHandler {
+	return &DeletePayeeHandler{service: service}
+}
+
+// DeletePayee godoc
+// @Summary Delete a payee
+// @Description Delete a payee. Its transactions keep their category but no longer have a payee.
+// @Tags payees
+// @Accept json
+// @Produce json
+// @Param payee_id path string true "Payee ID (UUID)"
+// @Success 204 "Payee deleted successfully"
+// @Failure 400 {object} common.ValidationProblem "Validation error"
+// @Failure 404 {object} common.ProblemDetail "Payee not found"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/payees/{payee_id} [delete]
+func (h *DeletePayeeHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		common.WriteProblem(w, common.NewMethodNotAllowedProblem(r.RequestURI))
+		return
+	}
+
+	id, ok := extractPayeeID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeletePayee(r.Context(), id); err != nil {
+		writePayeeError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
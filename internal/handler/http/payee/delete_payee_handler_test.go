@@ -0,0 +1,42 @@
+package payee
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"accounting/internal/domain/errors"
+	httptesting "accounting/internal/handler/http"
+)
+
+func TestDeletePayeeHandlerSuccess(t *testing.T) {
+	mockService := &httptesting.MockPayeeService{}
+	handler := NewDeletePayeeHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodDelete, "/api/v1/payees/"+testPayeeID, nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusNoContent {
+		t.Errorf("expected status %d, got %d", http.StatusNoContent, w.Code)
+	}
+	if mockService.DeletePayeeCalls != 1 {
+		t.Errorf("expected 1 deletePayee call, got %d", mockService.DeletePayeeCalls)
+	}
+}
+
+func TestDeletePayeeHandlerNotFound(t *testing.T) {
+	handler := NewDeletePayeeHandler(&httptesting.MockPayeeService{
+		LastDeletePayeeErr: errors.NewErrNotFound("payee", testPayeeID),
+	})
+
+	req, _ := http.NewRequest(http.MethodDelete, "/api/v1/payees/"+testPayeeID, nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
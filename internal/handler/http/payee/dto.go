@@ -0,0 +1,32 @@
+package payee
+
+import "time"
+
+type CreatePayeeRequest struct {
+	UserID string `json:"user_id"`
+	Name   string `json:"name"`
+	// Aliases are other names the payee goes by in transaction descriptions,
+	// e.g. "AMZN Mktp" for Amazon
+	Aliases []string `json:"aliases,omitempty"`
+	// DefaultCategoryID is given to the payee's transactions that get no other category
+	DefaultCategoryID string `json:"default_category_id,omitempty"`
+}
+
+type UpdatePayeeRequest struct {
+	Name string `json:"name,omitempty"`
+	// Aliases replace the payee's aliases unless null or absent; an empty
+	// list removes them all
+	Aliases []string `json:"aliases"`
+	// DefaultCategoryID replaces the default category; an empty string removes it
+	DefaultCategoryID *string `json:"default_category_id,omitempty"`
+}
+
+type PayeeResponse struct {
+	ID                string    `json:"id"`
+	UserID            string    `json:"user_id"`
+	Name              string    `json:"name"`
+	Aliases           []string  `json:"aliases"`
+	DefaultCategoryID string    `json:"default_category_id,omitempty"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
@@ -0,0 +1,52 @@
+package payee
+
+import (
+	"net/http"
+
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type GetPayeeHandler struct {
+	service interfaces.PayeeService
+}
+
+func NewGetPayeeHandler(service interfaces.PayeeService) *GetPayeeHandler {
+	return &GetPayeeHandler{service: service}
+}
+
+// GetPayee godoc
+// @Summary Get a payee by ID
+// @Description Retrieve a payee with its aliases and default category
+// @Tags payees
+// @Accept json
+// @Produce json
+// @Param payee_id path string true "Payee ID (UUID)"
+// @Success 200 {object} PayeeResponse
+// @Failure 400 {object} common.ValidationProblem "Validation error"
+// @Failure 404 {object} common.ProblemDetail "Payee not found"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/payees/{payee_id} [get]
+func (h *GetPayeeHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		common.WriteProblem(w, common.NewMethodNotAllowedProblem(r.RequestURI))
+		return
+	}
+
+	id, ok := extractPayeeID(w, r)
+	if !ok {
+		return
+	}
+
+	payee, err := h.service.GetPayee(r.Context(), id)
+	if err != nil {
+		writePayeeError(w, r, err)
+		return
+	}
+	if payee == nil {
+		common.WriteProblem(w, common.NewNotFoundProblem("payee not found", r.RequestURI))
+		return
+	}
+
+	common.WriteJSON(w, http.StatusOK, toPayeeResponse(payee))
+}
@@ -0,0 +1,64 @@
+package payee
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"accounting/internal/domain/entity"
+	httptesting "accounting/internal/handler/http"
+)
+
+func TestGetPayeeHandlerSuccess(t *testing.T) {
+	handler := NewGetPayeeHandler(&httptesting.MockPayeeService{
+		PayeeToReturn: &entity.Payee{ID: testPayeeID, UserID: testUserID, Name: "Tesco", Aliases: []string{"TESCO STORES"}, DefaultCategoryID: testCategoryID},
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/payees/"+testPayeeID, nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var response PayeeResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if response.ID != testPayeeID || len(response.Aliases) != 1 || response.DefaultCategoryID != testCategoryID {
+		t.Errorf("unexpected payee %+v", response)
+	}
+}
+
+func TestGetPayeeHandlerNotFound(t *testing.T) {
+	handler := NewGetPayeeHandler(&httptesting.MockPayeeService{})
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/payees/"+testPayeeID, nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestGetPayeeHandlerInvalidID(t *testing.T) {
+	mockService := &httptesting.MockPayeeService{}
+	handler := NewGetPayeeHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/payees/tesco", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+	if mockService.GetPayeeCalls != 0 {
+		t.Error("expected the service not to be called")
+	}
+}
@@ -0,0 +1,67 @@
+package payee
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/handler/http/common"
+)
+
+func toPayeeResponse(payee *entity.Payee) *PayeeResponse {
+	aliases := payee.Aliases
+	if aliases == nil {
+		aliases = []string{}
+	}
+	return &PayeeResponse{
+		ID:                payee.ID,
+		UserID:            payee.UserID,
+		Name:              payee.Name,
+		Aliases:           aliases,
+		DefaultCategoryID: payee.DefaultCategoryID,
+		CreatedAt:         payee.CreatedAt,
+		UpdatedAt:         payee.UpdatedAt,
+	}
+}
+
+// writePayeeError maps payee service errors to problem responses.
+func writePayeeError(w http.ResponseWriter, r *http.Request, err error) {
+	var dupErr *domainerrors.ErrDuplicatePayee
+	if errors.As(err, &dupErr) {
+		common.WriteProblem(w, common.NewValidationProblem(err.Error(), r.RequestURI))
+		return
+	}
+	var notFoundErr *domainerrors.ErrNotFound
+	if errors.As(err, &notFoundErr) {
+		common.WriteProblem(w, common.NewNotFoundProblem(err.Error(), r.RequestURI))
+		return
+	}
+	var invalidErr *domainerrors.ErrInvalidInput
+	if errors.As(err, &invalidErr) {
+		common.WriteProblem(w, common.NewValidationProblem(err.Error(), r.RequestURI))
+		return
+	}
+	common.WriteProblem(w, common.NewInternalErrorProblem(r.RequestURI))
+}
+
+// extractPayeeID reads and validates the payee ID of an
+// /api/v1/payees/{id} request, writing a problem if it is invalid.
+func extractPayeeID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	id := extractID(r.URL.Path, "/api/v1/payees/")
+	if err := common.ValidateUUID(id, "payee_id"); err != nil {
+		common.WriteProblem(w, common.NewValidationProblemWithErrors(r.RequestURI, common.CollectErrors(err)))
+		return "", false
+	}
+	return id, true
+}
+
+func extractID(path, prefix string) string {
+	path = strings.TrimPrefix(path, prefix)
+	parts := strings.Split(path, "/")
+	if len(parts) > 0 {
+		return parts[0]
+	}
+	return ""
+}
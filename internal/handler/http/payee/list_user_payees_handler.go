@@ -0,0 +1,55 @@
+package payee
+
+import (
+	"net/http"
+
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type ListUserPayeesHandler struct {
+	service interfaces.PayeeService
+}
+
+func NewListUserPayeesHandler(service interfaces.PayeeService) *ListUserPayeesHandler {
+	return &ListUserPayeesHandler{service: service}
+}
+
+// ListUserPayees godoc
+// @Summary List a user's payees
+// @Description Retrieve a user's payees ordered by name
+// @Tags payees
+// @Accept json
+// @Produce json
+// @Param user_id path string true "User ID (UUID)"
+// @Success 200 {array} PayeeResponse
+// @Failure 400 {object} common.ValidationProblem "Validation error"
+// @Failure 404 {object} common.ProblemDetail "User not found"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/users/{user_id}/payees [get]
+func (h *ListUserPayeesHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		common.WriteProblem(w, common.NewMethodNotAllowedProblem(r.RequestURI))
+		return
+	}
+
+	userID := extractID(r.URL.Path, "/api/v1/users/")
+	validationErrors := common.CollectErrors(common.ValidateUUID(userID, "user_id"))
+	if len(validationErrors) > 0 {
+		common.WriteProblem(w, common.NewValidationProblemWithErrors(r.RequestURI, validationErrors))
+		return
+	}
+
+	payees, err := h.service.ListUserPayees(r.Context(), userID)
+	if err != nil {
+		writePayeeError(w, r, err)
+		return
+	}
+
+	response := make([]*PayeeResponse, 0, len(payees))
+	for _, payee := range payees {
+		response = append(response, toPayeeResponse(payee))
+	}
+
+	common.WriteJSON(w, http.StatusOK, response)
+}
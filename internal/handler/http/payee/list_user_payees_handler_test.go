@@ -0,0 +1,86 @@
+package payee
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"accounting/internal/domain/entity"
+	"accounting/internal/domain/errors"
+	httptesting "accounting/internal/handler/http"
+)
+
+func TestListUserPayeesHandlerSuccess(t *testing.T) {
+	handler := NewListUserPayeesHandler(&httptesting.MockPayeeService{
+		PayeesToReturn: []*entity.Payee{
+			{ID: testPayeeID, UserID: testUserID, Name: "Starbucks", Aliases: []string{"SBUX"}},
+			{ID: "423e4567-e89b-12d3-a456-426614174001", UserID: testUserID, Name: "Tesco"},
+		},
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/users/"+testUserID+"/payees", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var response []*PayeeResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(response) != 2 || response[0].Name != "Starbucks" || response[1].Aliases == nil {
+		t.Errorf("unexpected payees %+v", response)
+	}
+}
+
+func TestListUserPayeesHandlerEmpty(t *testing.T) {
+	handler := NewListUserPayeesHandler(&httptesting.MockPayeeService{})
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/users/"+testUserID+"/payees", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if body := w.Body.String(); body != "[]\n" {
+		t.Errorf("expected an empty array, got %q", body)
+	}
+}
+
+func TestListUserPayeesHandlerInvalidUserID(t *testing.T) {
+	mockService := &httptesting.MockPayeeService{}
+	handler := NewListUserPayeesHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/users/someone/payees", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+	if mockService.ListUserPayeesCalls != 0 {
+		t.Error("expected the service not to be called")
+	}
+}
+
+func TestListUserPayeesHandlerUserNotFound(t *testing.T) {
+	handler := NewListUserPayeesHandler(&httptesting.MockPayeeService{
+		LastListUserPayeesErr: errors.NewErrNotFound("user", testUserID),
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/users/"+testUserID+"/payees", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
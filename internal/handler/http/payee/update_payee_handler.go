@@ -0,0 +1,76 @@
+package payee
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type UpdatePayeeHandler struct {
+	service interfaces.PayeeService
+}
+
+func NewUpdatePayeeHandler(service interfaces.PayeeService) *UpdatePayeeHandler {
+	return &UpdatePayeeHandler{service: service}
+}
+
+// UpdatePayee godoc
+// @Summary Update a payee
+// @Description Rename a payee, replace its aliases or change its default category. Omitted fields keep their stored value; an empty list of aliases removes them and an empty default_category_id removes the default category. Transactions already recorded keep their payee and category.
+// @Tags payees
+// @Accept json
+// @Produce json
+// @Param payee_id path string true "Payee ID (UUID)"
+// @Param request body UpdatePayeeRequest true "Payee update request"
+// @Success 200 {object} PayeeResponse
+// @Failure 400 {object} common.ValidationProblem "Validation error or duplicate name"
+// @Failure 404 {object} common.ProblemDetail "Payee or default category not found"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/payees/{payee_id} [put]
+func (h *UpdatePayeeHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		common.WriteProblem(w, common.NewMethodNotAllowedProblem(r.RequestURI))
+		return
+	}
+
+	id, ok := extractPayeeID(w, r)
+	if !ok {
+		return
+	}
+
+	var req UpdatePayeeRequest
+	if r.Body == nil {
+		common.WriteProblem(w, common.NewBadRequestProblem("invalid request body", r.RequestURI))
+		return
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		common.WriteProblem(w, common.NewBadRequestProblem("invalid request body", r.RequestURI))
+		return
+	}
+
+	var validationErrors []common.ValidationError
+	if req.Name != "" {
+		validationErrors = append(validationErrors, common.CollectErrors(
+			common.ValidateStringLength(req.Name, "name", 1, 100),
+		)...)
+	}
+	if req.DefaultCategoryID != nil && *req.DefaultCategoryID != "" {
+		validationErrors = append(validationErrors, common.CollectErrors(
+			common.ValidateUUID(*req.DefaultCategoryID, "default_category_id"),
+		)...)
+	}
+	if len(validationErrors) > 0 {
+		common.WriteProblem(w, common.NewValidationProblemWithErrors(r.RequestURI, validationErrors))
+		return
+	}
+
+	payee, err := h.service.UpdatePayee(r.Context(), id, req.Name, req.Aliases, req.DefaultCategoryID)
+	if err != nil {
+		writePayeeError(w, r, err)
+		return
+	}
+
+	common.WriteJSON(w, http.StatusOK, toPayeeResponse(payee))
+}
@@ -0,0 +1,79 @@
+package payee
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"accounting/internal/domain/errors"
+	httptesting "accounting/internal/handler/http"
+)
+
+func TestUpdatePayeeHandlerSuccess(t *testing.T) {
+	tests := []struct {
+		name              string
+		body              string
+		aliases           []string
+		defaultCategoryID *string
+	}{
+		{"aliases and category left out", `{"name": "Tesco"}`, nil, nil},
+		{"aliases removed", `{"aliases": []}`, []string{}, nil},
+		{"default category removed", `{"aliases": ["TESCO STORES"], "default_category_id": ""}`, []string{"TESCO STORES"}, new(string)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &httptesting.MockPayeeService{}
+			handler := NewUpdatePayeeHandler(mockService)
+
+			req, _ := http.NewRequest(http.MethodPut, "/api/v1/payees/"+testPayeeID, strings.NewReader(tt.body))
+			w := httptest.NewRecorder()
+
+			handler.Handle(w, req)
+
+			if w.Code != http.StatusOK {
+				t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+			}
+			if (mockService.LastAliases == nil) != (tt.aliases == nil) || len(mockService.LastAliases) != len(tt.aliases) {
+				t.Errorf("expected aliases %q, got %q", tt.aliases, mockService.LastAliases)
+			}
+			if (mockService.LastDefaultCategoryID == nil) != (tt.defaultCategoryID == nil) {
+				t.Errorf("expected default category %v, got %v", tt.defaultCategoryID, mockService.LastDefaultCategoryID)
+			}
+		})
+	}
+}
+
+func TestUpdatePayeeHandlerInvalidDefaultCategoryID(t *testing.T) {
+	mockService := &httptesting.MockPayeeService{}
+	handler := NewUpdatePayeeHandler(mockService)
+
+	defaultCategoryID := "Groceries"
+	req := httptesting.NewTestRequest(http.MethodPut, "/api/v1/payees/"+testPayeeID, UpdatePayeeRequest{DefaultCategoryID: &defaultCategoryID})
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+	if mockService.UpdatePayeeCalls != 0 {
+		t.Error("expected the service not to be called")
+	}
+}
+
+func TestUpdatePayeeHandlerNotFound(t *testing.T) {
+	handler := NewUpdatePayeeHandler(&httptesting.MockPayeeService{
+		LastUpdatePayeeErr: errors.NewErrNotFound("payee", testPayeeID),
+	})
+
+	req := httptesting.NewTestRequest(http.MethodPut, "/api/v1/payees/"+testPayeeID, UpdatePayeeRequest{Name: "Tesco"})
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
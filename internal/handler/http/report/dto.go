@@ -0,0 +1,110 @@
+package report
+
+import "accounting/internal/domain/constant"
+
+// CategorySummaryResponse totals a category with its subcategories; both
+// CategoryID and Category are empty for uncategorized transactions.
+type CategorySummaryResponse struct {
+	CategoryID  string `json:"category_id"`
+	Category    string `json:"category"`
+	Income      string `json:"income"`
+	Expense     string `json:"expense"`
+	NetCashFlow string `json:"net_cash_flow"`
+	// Children breaks the totals down by subcategory
+	Children []*CategorySummaryResponse `json:"children,omitempty"`
+}
+
+type PeriodSummaryResponse struct {
+	// PeriodStart is the first day of the period and PeriodEnd its last day (YYYY-MM-DD)
+	PeriodStart string                     `json:"period_start"`
+	PeriodEnd   string                     `json:"period_end"`
+	Currency    string                     `json:"currency"`
+	Income      string                     `json:"income"`
+	Expense     string                     `json:"expense"`
+	NetCashFlow string                     `json:"net_cash_flow"`
+	Categories  []*CategorySummaryResponse `json:"categories"`
+}
+
+type SummaryResponse struct {
+	UserID string `json:"user_id"`
+	// From and To are the requested dates (YYYY-MM-DD), both inclusive
+	From        string               `json:"from"`
+	To          string               `json:"to"`
+	Granularity constant.Granularity `json:"granularity"`
+	// BaseCurrency is set when each period was totalled in it
+	BaseCurrency string                   `json:"base_currency,omitempty"`
+	Periods      []*PeriodSummaryResponse `json:"periods"`
+}
+
+// TagSummaryResponse totals the transactions with a tag in one currency
+type TagSummaryResponse struct {
+	Tag         string `json:"tag"`
+	Currency    string `json:"currency"`
+	Income      string `json:"income"`
+	Expense     string `json:"expense"`
+	NetCashFlow string `json:"net_cash_flow"`
+}
+
+type TagReportResponse struct {
+	UserID string `json:"user_id"`
+	// From and To are the requested dates (YYYY-MM-DD), both inclusive
+	From string `json:"from"`
+	To   string `json:"to"`
+	// Tags are sorted by tag, then currency; a transaction with several tags
+	// counts towards each
+	Tags []*TagSummaryResponse `json:"tags"`
+}
+
+// PayeeSummaryResponse totals the transactions of a payee in one currency
+type PayeeSummaryResponse struct {
+	// PayeeID and Payee, the payee's name, are empty for the transactions without a payee
+	PayeeID     string `json:"payee_id"`
+	Payee       string `json:"payee"`
+	Currency    string `json:"currency"`
+	Income      string `json:"income"`
+	Expense     string `json:"expense"`
+	NetCashFlow string `json:"net_cash_flow"`
+}
+
+type PayeeReportResponse struct {
+	UserID string `json:"user_id"`
+	// From and To are the requested dates (YYYY-MM-DD), both inclusive
+	From string `json:"from"`
+	To   string `json:"to"`
+	// Payees are sorted by payee name, then currency, with the transactions
+	// without a payee last
+	Payees []*PayeeSummaryResponse `json:"payees"`
+}
+
+type NetWorthAccountResponse struct {
+	AccountID string               `json:"account_id"`
+	Name      string               `json:"name"`
+	Type      constant.AccountType `json:"type"`
+	// Balance is in the account's currency and ConvertedBalance in the base currency
+	Balance          string `json:"balance"`
+	Currency         string `json:"currency"`
+	ConvertedBalance string `json:"converted_balance"`
+}
+
+type NetWorthPointResponse struct {
+	// Date is the day the net worth applies to, at its end (YYYY-MM-DD)
+	Date   string `json:"date"`
+	Assets string `json:"assets"`
+	// Liabilities is the amount owed, positive when money is owed
+	Liabilities string `json:"liabilities"`
+	NetWorth    string `json:"net_worth"`
+}
+
+type NetWorthResponse struct {
+	UserID string `json:"user_id"`
+	// Currency is the user's base currency, which every total is converted into
+	Currency string `json:"currency"`
+	// AsOf, Assets, Liabilities and NetWorth describe today's position
+	AsOf        string                     `json:"as_of"`
+	Assets      string                     `json:"assets"`
+	Liabilities string                     `json:"liabilities"`
+	NetWorth    string                     `json:"net_worth"`
+	Accounts    []*NetWorthAccountResponse `json:"accounts"`
+	// History holds the net worth at each month end in the range, oldest first
+	History []*NetWorthPointResponse `json:"history"`
+}
@@ -0,0 +1,84 @@
+package report
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type GetNetWorthHandler struct {
+	service interfaces.ReportService
+}
+
+func NewGetNetWorthHandler(service interfaces.ReportService) *GetNetWorthHandler {
+	return &GetNetWorthHandler{service: service}
+}
+
+// @Summary Get net worth
+// @Description Total a user's asset accounts (checking, savings, cash, investment) minus what is owed on liability accounts (credit cards), converted into the user's base currency. The current figure uses today's balances and rates; the history gives the net worth at each month end in the range, converted at the rates of that day. Income, expense and equity accounts are not included.
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Param user_id path string true "User ID (UUID)"
+// @Param from query string false "First day of the history (YYYY-MM-DD); defaults to the first day of the month eleven months before to"
+// @Param to query string false "Last day of the history (YYYY-MM-DD), inclusive; defaults to today"
+// @Success 200 {object} NetWorthResponse
+// @Failure 400 {object} common.ValidationProblem "Validation error or missing exchange rate"
+// @Failure 404 {object} common.ProblemDetail "User not found"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/users/{user_id}/net-worth [get]
+func (h *GetNetWorthHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		common.WriteProblem(w, common.NewMethodNotAllowedProblem(r.RequestURI))
+		return
+	}
+
+	userID := extractID(r.URL.Path, "/api/v1/users/")
+	query := r.URL.Query()
+	fromParam, toParam := query.Get("from"), query.Get("to")
+
+	checks := []*common.ValidationError{common.ValidateUUID(userID, "user_id")}
+	if fromParam != "" {
+		checks = append(checks, common.ValidateDate(fromParam, "from"))
+	}
+	if toParam != "" {
+		checks = append(checks, common.ValidateDate(toParam, "to"))
+	}
+	validationErrors := common.CollectErrors(checks...)
+	if len(validationErrors) > 0 {
+		common.WriteProblem(w, common.NewValidationProblemWithErrors(r.RequestURI, validationErrors))
+		return
+	}
+
+	// Default to the twelve months up to today
+	to := time.Now().UTC()
+	if toParam != "" {
+		to, _ = time.Parse(time.DateOnly, toParam)
+	}
+	from := time.Date(to.Year(), to.Month()-11, 1, 0, 0, 0, 0, time.UTC)
+	if fromParam != "" {
+		from, _ = time.Parse(time.DateOnly, fromParam)
+	}
+
+	netWorth, err := h.service.GetNetWorth(r.Context(), userID, from, to)
+	if err != nil {
+		var notFoundErr *domainerrors.ErrNotFound
+		if errors.As(err, &notFoundErr) {
+			common.WriteProblem(w, common.NewNotFoundProblem(err.Error(), r.RequestURI))
+			return
+		}
+		var invalidErr *domainerrors.ErrInvalidInput
+		if errors.As(err, &invalidErr) {
+			common.WriteProblem(w, common.NewValidationProblem(err.Error(), r.RequestURI))
+			return
+		}
+		common.WriteProblem(w, common.NewInternalErrorProblem(r.RequestURI))
+		return
+	}
+
+	common.WriteJSON(w, http.StatusOK, toNetWorthResponse(netWorth))
+}
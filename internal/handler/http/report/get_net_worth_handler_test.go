@@ -0,0 +1,170 @@
+package report
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/money"
+	httptesting "accounting/internal/handler/http"
+)
+
+const netWorthPath = "/api/v1/users/123e4567-e89b-12d3-a456-426614174000/net-worth"
+
+func TestGetNetWorthHandlerSuccess(t *testing.T) {
+	today := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+	mockService := &httptesting.MockReportService{
+		NetWorthToReturn: &entity.NetWorth{
+			UserID:   "123e4567-e89b-12d3-a456-426614174000",
+			Currency: "USD",
+			Current: entity.NetWorthPoint{
+				Date:        today,
+				Assets:      money.MustParse("1110", "USD"),
+				Liabilities: money.MustParse("250", "USD"),
+				NetWorth:    money.MustParse("860", "USD"),
+			},
+			Accounts: []*entity.NetWorthAccount{
+				{
+					AccountID: "savings",
+					Name:      "Savings",
+					Type:      constant.AccountTypeSavings,
+					Balance:   money.MustParse("100", "EUR"),
+					Converted: money.MustParse("110", "USD"),
+				},
+			},
+			History: []*entity.NetWorthPoint{
+				{
+					Date:        time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+					Assets:      money.MustParse("610", "USD"),
+					Liabilities: money.Zero("USD"),
+					NetWorth:    money.MustParse("610", "USD"),
+				},
+			},
+		},
+	}
+	handler := NewGetNetWorthHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, netWorthPath+"?from=2024-01-15&to=2024-03-10", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	if !mockService.LastFrom.Equal(time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)) || !mockService.LastTo.Equal(today) {
+		t.Errorf("expected range 2024-01-15..2024-03-10, got %s..%s", mockService.LastFrom, mockService.LastTo)
+	}
+
+	var response NetWorthResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if response.AsOf != "2024-03-10" || response.NetWorth != "860.00" || response.Liabilities != "250.00" {
+		t.Errorf("expected 860.00 net worth with 250.00 owed on 2024-03-10, got %+v", response)
+	}
+	if len(response.Accounts) != 1 || response.Accounts[0].Currency != "EUR" || response.Accounts[0].ConvertedBalance != "110.00" {
+		t.Errorf("expected the savings account converted to 110.00, got %+v", response.Accounts)
+	}
+	if len(response.History) != 1 || response.History[0].Date != "2024-01-31" || response.History[0].NetWorth != "610.00" {
+		t.Errorf("expected one month-end point of 610.00, got %+v", response.History)
+	}
+}
+
+func TestGetNetWorthHandlerDefaultRange(t *testing.T) {
+	mockService := &httptesting.MockReportService{}
+	handler := NewGetNetWorthHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, netWorthPath+"?to=2024-03-10", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	if !mockService.LastFrom.Equal(time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected the history to start on 2023-04-01, got %s", mockService.LastFrom)
+	}
+}
+
+func TestGetNetWorthHandlerValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+	}{
+		{"invalid user ID", "/api/v1/users/not-a-uuid/net-worth"},
+		{"bad from", netWorthPath + "?from=2024-13-01"},
+		{"bad to", netWorthPath + "?to=yesterday"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &httptesting.MockReportService{}
+			handler := NewGetNetWorthHandler(mockService)
+
+			req, _ := http.NewRequest(http.MethodGet, tt.path, nil)
+			w := httptest.NewRecorder()
+
+			handler.Handle(w, req)
+
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+			}
+			if mockService.GetNetWorthCalls != 0 {
+				t.Errorf("expected no service call, got %d", mockService.GetNetWorthCalls)
+			}
+		})
+	}
+}
+
+func TestGetNetWorthHandlerMissingRate(t *testing.T) {
+	mockService := &httptesting.MockReportService{
+		LastGetNetWorthErr: domainerrors.NewErrInvalidInput("currency", "no exchange rate from EUR to USD on 2024-03-10"),
+	}
+	handler := NewGetNetWorthHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, netWorthPath, nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestGetNetWorthHandlerUserNotFound(t *testing.T) {
+	mockService := &httptesting.MockReportService{
+		LastGetNetWorthErr: domainerrors.NewErrNotFound("user", "123e4567-e89b-12d3-a456-426614174000"),
+	}
+	handler := NewGetNetWorthHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, netWorthPath, nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestGetNetWorthHandlerInvalidMethod(t *testing.T) {
+	mockService := &httptesting.MockReportService{}
+	handler := NewGetNetWorthHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodPost, netWorthPath, nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected status %d, got %d", http.StatusMethodNotAllowed, w.Code)
+	}
+}
@@ -0,0 +1,75 @@
+package report
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type GetPayeeReportHandler struct {
+	service interfaces.ReportService
+}
+
+func NewGetPayeeReportHandler(service interfaces.ReportService) *GetPayeeReportHandler {
+	return &GetPayeeReportHandler{service: service}
+}
+
+// @Summary Get income and expense per payee
+// @Description Total a user's income and expense per payee, with the net cash flow of each, to see where the money goes. Transfers are excluded. Each payee is reported separately per currency, and only payees with activity are listed, followed by the transactions without a payee.
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Param user_id path string true "User ID (UUID)"
+// @Param from query string true "First day of the report (YYYY-MM-DD)"
+// @Param to query string true "Last day of the report (YYYY-MM-DD), inclusive"
+// @Success 200 {object} PayeeReportResponse
+// @Failure 400 {object} common.ValidationProblem "Validation error"
+// @Failure 404 {object} common.ProblemDetail "User not found"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/users/{user_id}/reports/payees [get]
+func (h *GetPayeeReportHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		common.WriteProblem(w, common.NewMethodNotAllowedProblem(r.RequestURI))
+		return
+	}
+
+	userID := extractID(r.URL.Path, "/api/v1/users/")
+	query := r.URL.Query()
+	fromParam, toParam := query.Get("from"), query.Get("to")
+
+	validationErrors := common.CollectErrors(
+		common.ValidateUUID(userID, "user_id"),
+		common.ValidateDate(fromParam, "from"),
+		common.ValidateDate(toParam, "to"),
+	)
+	if len(validationErrors) > 0 {
+		common.WriteProblem(w, common.NewValidationProblemWithErrors(r.RequestURI, validationErrors))
+		return
+	}
+
+	from, _ := time.Parse(time.DateOnly, fromParam)
+	to, _ := time.Parse(time.DateOnly, toParam)
+
+	// The service takes an exclusive end, so include the whole of the last day
+	report, err := h.service.GetPayeeReport(r.Context(), userID, from, to.AddDate(0, 0, 1))
+	if err != nil {
+		var notFoundErr *domainerrors.ErrNotFound
+		if errors.As(err, &notFoundErr) {
+			common.WriteProblem(w, common.NewNotFoundProblem(err.Error(), r.RequestURI))
+			return
+		}
+		var invalidErr *domainerrors.ErrInvalidInput
+		if errors.As(err, &invalidErr) {
+			common.WriteProblem(w, common.NewValidationProblem(err.Error(), r.RequestURI))
+			return
+		}
+		common.WriteProblem(w, common.NewInternalErrorProblem(r.RequestURI))
+		return
+	}
+
+	common.WriteJSON(w, http.StatusOK, toPayeeReportResponse(report))
+}
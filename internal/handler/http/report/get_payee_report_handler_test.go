@@ -0,0 +1,120 @@
+package report
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/money"
+	httptesting "accounting/internal/handler/http"
+)
+
+const payeeReportPath = "/api/v1/users/123e4567-e89b-12d3-a456-426614174000/reports/payees"
+
+func TestGetPayeeReportHandlerSuccess(t *testing.T) {
+	mockService := &httptesting.MockReportService{
+		PayeeReportToReturn: &entity.PayeeReport{
+			UserID: "123e4567-e89b-12d3-a456-426614174000",
+			From:   time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			To:     time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+			Payees: []*entity.PayeeSummary{
+				{
+					PayeeID:     "423e4567-e89b-12d3-a456-426614174000",
+					Payee:       "Tesco",
+					Currency:    "USD",
+					Income:      money.MustParse("12", "USD"),
+					Expense:     money.MustParse("410.75", "USD"),
+					NetCashFlow: money.MustParse("-398.75", "USD"),
+				},
+				{
+					Currency:    "USD",
+					Income:      money.MustParse("3000", "USD"),
+					Expense:     money.MustParse("95", "USD"),
+					NetCashFlow: money.MustParse("2905", "USD"),
+				},
+			},
+		},
+	}
+	handler := NewGetPayeeReportHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, payeeReportPath+"?from=2026-01-01&to=2026-01-31", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+
+	if !mockService.LastTo.Equal(time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected exclusive end 2026-02-01, got %s", mockService.LastTo)
+	}
+
+	var response PayeeReportResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if response.From != "2026-01-01" || response.To != "2026-01-31" {
+		t.Errorf("expected range 2026-01-01..2026-01-31, got %s..%s", response.From, response.To)
+	}
+	if len(response.Payees) != 2 {
+		t.Fatalf("expected 2 payees, got %d", len(response.Payees))
+	}
+	if payee := response.Payees[0]; payee.Payee != "Tesco" || payee.Expense != "410.75" || payee.NetCashFlow != "-398.75" {
+		t.Errorf("expected Tesco expense 410.75 and net -398.75, got %+v", payee)
+	}
+	if payee := response.Payees[1]; payee.PayeeID != "" || payee.Payee != "" || payee.NetCashFlow != "2905.00" {
+		t.Errorf("expected the transactions without a payee to net 2905.00, got %+v", payee)
+	}
+}
+
+func TestGetPayeeReportHandlerValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+	}{
+		{"invalid user ID", "/api/v1/users/not-a-uuid/reports/payees?from=2026-01-01&to=2026-01-31"},
+		{"missing from", payeeReportPath + "?to=2026-01-31"},
+		{"bad date", payeeReportPath + "?from=2026-01-01&to=2026-02-30"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &httptesting.MockReportService{}
+			handler := NewGetPayeeReportHandler(mockService)
+
+			req, _ := http.NewRequest(http.MethodGet, tt.path, nil)
+			w := httptest.NewRecorder()
+
+			handler.Handle(w, req)
+
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+			}
+			if mockService.GetPayeeReportCalls != 0 {
+				t.Errorf("expected no service call, got %d", mockService.GetPayeeReportCalls)
+			}
+		})
+	}
+}
+
+func TestGetPayeeReportHandlerUserNotFound(t *testing.T) {
+	mockService := &httptesting.MockReportService{
+		LastGetPayeeReportErr: domainerrors.NewErrNotFound("user", "123e4567-e89b-12d3-a456-426614174000"),
+	}
+	handler := NewGetPayeeReportHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, payeeReportPath+"?from=2026-01-01&to=2026-01-31", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
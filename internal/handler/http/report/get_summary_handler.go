@@ -0,0 +1,87 @@
+package report
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"accounting/internal/domain/constant"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type GetSummaryHandler struct {
+	service interfaces.ReportService
+}
+
+func NewGetSummaryHandler(service interfaces.ReportService) *GetSummaryHandler {
+	return &GetSummaryHandler{service: service}
+}
+
+// @Summary Get income and expense summary
+// @Description Total a user's income and expense per period and per category, with the net cash flow of each. Transfers between accounts are excluded. Each period is reported separately per currency, unless base_currency is given: then each period is totalled in that currency at the exchange rates effective on its last day. Only periods with activity are listed.
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Param user_id path string true "User ID (UUID)"
+// @Param from query string true "First day of the report (YYYY-MM-DD)"
+// @Param to query string true "Last day of the report (YYYY-MM-DD), inclusive"
+// @Param granularity query string false "Period length (default month)" Enums(month, quarter, year)
+// @Param base_currency query string false "ISO 4217 currency to total each period in (e.g., USD)"
+// @Success 200 {object} SummaryResponse
+// @Failure 400 {object} common.ValidationProblem "Validation error"
+// @Failure 404 {object} common.ProblemDetail "User not found"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/users/{user_id}/reports/summary [get]
+func (h *GetSummaryHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		common.WriteProblem(w, common.NewMethodNotAllowedProblem(r.RequestURI))
+		return
+	}
+
+	userID := extractID(r.URL.Path, "/api/v1/users/")
+	query := r.URL.Query()
+	fromParam, toParam := query.Get("from"), query.Get("to")
+	granularity := constant.Granularity(query.Get("granularity"))
+	baseCurrency := query.Get("base_currency")
+
+	checks := []*common.ValidationError{
+		common.ValidateUUID(userID, "user_id"),
+		common.ValidateDate(fromParam, "from"),
+		common.ValidateDate(toParam, "to"),
+	}
+	if granularity != "" {
+		checks = append(checks, common.ValidateEnum(string(granularity), granularityNames(), "granularity"))
+	}
+	if baseCurrency != "" {
+		checks = append(checks, common.ValidateCurrency(baseCurrency, "base_currency"))
+	}
+	validationErrors := common.CollectErrors(checks...)
+	if len(validationErrors) > 0 {
+		common.WriteProblem(w, common.NewValidationProblemWithErrors(r.RequestURI, validationErrors))
+		return
+	}
+
+	from, _ := time.Parse(time.DateOnly, fromParam)
+	to, _ := time.Parse(time.DateOnly, toParam)
+
+	// The service takes an exclusive end, so include the whole of the last day
+	summary, err := h.service.GetSummary(r.Context(), userID, from, to.AddDate(0, 0, 1), granularity, baseCurrency)
+	if err != nil {
+		var notFoundErr *domainerrors.ErrNotFound
+		if errors.As(err, &notFoundErr) {
+			common.WriteProblem(w, common.NewNotFoundProblem(err.Error(), r.RequestURI))
+			return
+		}
+		var invalidErr *domainerrors.ErrInvalidInput
+		if errors.As(err, &invalidErr) {
+			common.WriteProblem(w, common.NewValidationProblem(err.Error(), r.RequestURI))
+			return
+		}
+		common.WriteProblem(w, common.NewInternalErrorProblem(r.RequestURI))
+		return
+	}
+
+	common.WriteJSON(w, http.StatusOK, toSummaryResponse(summary))
+}
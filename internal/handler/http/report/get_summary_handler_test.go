@@ -0,0 +1,202 @@
+package report
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/money"
+	httptesting "accounting/internal/handler/http"
+)
+
+const summaryPath = "/api/v1/users/123e4567-e89b-12d3-a456-426614174000/reports/summary"
+
+func TestGetSummaryHandlerSuccess(t *testing.T) {
+	january := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockService := &httptesting.MockReportService{
+		SummaryToReturn: &entity.Summary{
+			UserID:      "123e4567-e89b-12d3-a456-426614174000",
+			From:        january,
+			To:          time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			Granularity: constant.GranularityMonth,
+			Periods: []*entity.PeriodSummary{
+				{
+					PeriodStart: january,
+					PeriodEnd:   time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+					Currency:    "USD",
+					Income:      money.MustParse("3000", "USD"),
+					Expense:     money.MustParse("120.50", "USD"),
+					NetCashFlow: money.MustParse("2879.50", "USD"),
+					Categories: []*entity.CategorySummary{
+						{
+							CategoryID:  "category-food",
+							Category:    "Food",
+							Income:      money.Zero("USD"),
+							Expense:     money.MustParse("120.50", "USD"),
+							NetCashFlow: money.MustParse("-120.50", "USD"),
+							Children: []*entity.CategorySummary{
+								{
+									CategoryID:  "category-groceries",
+									Category:    "Groceries",
+									Income:      money.Zero("USD"),
+									Expense:     money.MustParse("100.50", "USD"),
+									NetCashFlow: money.MustParse("-100.50", "USD"),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	handler := NewGetSummaryHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, summaryPath+"?from=2024-01-01&to=2024-02-29&granularity=month", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+
+	if !mockService.LastTo.Equal(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected exclusive end 2024-03-01, got %s", mockService.LastTo)
+	}
+
+	var response SummaryResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if response.From != "2024-01-01" || response.To != "2024-02-29" {
+		t.Errorf("expected range 2024-01-01..2024-02-29, got %s..%s", response.From, response.To)
+	}
+
+	if len(response.Periods) != 1 {
+		t.Fatalf("expected 1 period, got %d", len(response.Periods))
+	}
+
+	period := response.Periods[0]
+	if period.PeriodStart != "2024-01-01" || period.PeriodEnd != "2024-01-31" {
+		t.Errorf("expected period 2024-01-01..2024-01-31, got %s..%s", period.PeriodStart, period.PeriodEnd)
+	}
+	if period.NetCashFlow != "2879.50" {
+		t.Errorf("expected net cash flow 2879.50, got %s", period.NetCashFlow)
+	}
+	if len(period.Categories) != 1 || period.Categories[0].NetCashFlow != "-120.50" {
+		t.Fatalf("expected food net -120.50, got %+v", period.Categories)
+	}
+	if children := period.Categories[0].Children; len(children) != 1 || children[0].CategoryID != "category-groceries" || children[0].NetCashFlow != "-100.50" {
+		t.Errorf("expected groceries net -100.50 under food, got %+v", children)
+	}
+}
+
+func TestGetSummaryHandlerValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+	}{
+		{"invalid user ID", "/api/v1/users/not-a-uuid/reports/summary?from=2024-01-01&to=2024-01-31"},
+		{"missing from", summaryPath + "?to=2024-01-31"},
+		{"missing to", summaryPath + "?from=2024-01-01"},
+		{"bad date", summaryPath + "?from=2024-13-01&to=2024-01-31"},
+		{"unknown granularity", summaryPath + "?from=2024-01-01&to=2024-01-31&granularity=week"},
+		{"invalid base currency", summaryPath + "?from=2024-01-01&to=2024-01-31&base_currency=usd"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &httptesting.MockReportService{}
+			handler := NewGetSummaryHandler(mockService)
+
+			req, _ := http.NewRequest(http.MethodGet, tt.path, nil)
+			w := httptest.NewRecorder()
+
+			handler.Handle(w, req)
+
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+			}
+			if mockService.GetSummaryCalls != 0 {
+				t.Errorf("expected no service call, got %d", mockService.GetSummaryCalls)
+			}
+		})
+	}
+}
+
+func TestGetSummaryHandlerBaseCurrency(t *testing.T) {
+	mockService := &httptesting.MockReportService{}
+	handler := NewGetSummaryHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, summaryPath+"?from=2024-01-01&to=2024-01-31&base_currency=EUR", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	if mockService.LastBaseCurrency != "EUR" {
+		t.Errorf("expected base currency EUR, got %q", mockService.LastBaseCurrency)
+	}
+
+	var response SummaryResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if response.BaseCurrency != "EUR" {
+		t.Errorf("expected base currency EUR in the response, got %q", response.BaseCurrency)
+	}
+}
+
+func TestGetSummaryHandlerInvalidRange(t *testing.T) {
+	mockService := &httptesting.MockReportService{
+		LastGetSummaryErr: domainerrors.NewErrInvalidInput("to", "to must be after from"),
+	}
+	handler := NewGetSummaryHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, summaryPath+"?from=2024-02-01&to=2024-01-01", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestGetSummaryHandlerUserNotFound(t *testing.T) {
+	mockService := &httptesting.MockReportService{
+		LastGetSummaryErr: domainerrors.NewErrNotFound("user", "123e4567-e89b-12d3-a456-426614174000"),
+	}
+	handler := NewGetSummaryHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, summaryPath+"?from=2024-01-01&to=2024-01-31", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestGetSummaryHandlerInvalidMethod(t *testing.T) {
+	mockService := &httptesting.MockReportService{}
+	handler := NewGetSummaryHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodPost, summaryPath, nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected status %d, got %d", http.StatusMethodNotAllowed, w.Code)
+	}
+}
@@ -0,0 +1,75 @@
+package report
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type GetTagReportHandler struct {
+	service interfaces.ReportService
+}
+
+func NewGetTagReportHandler(service interfaces.ReportService) *GetTagReportHandler {
+	return &GetTagReportHandler{service: service}
+}
+
+// @Summary Get income and expense per tag
+// @Description Total a user's income and expense per tag, with the net cash flow of each. A transaction with several tags counts towards each of them. Transfers are excluded. Each tag is reported separately per currency, and only tags with activity are listed.
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Param user_id path string true "User ID (UUID)"
+// @Param from query string true "First day of the report (YYYY-MM-DD)"
+// @Param to query string true "Last day of the report (YYYY-MM-DD), inclusive"
+// @Success 200 {object} TagReportResponse
+// @Failure 400 {object} common.ValidationProblem "Validation error"
+// @Failure 404 {object} common.ProblemDetail "User not found"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/users/{user_id}/reports/tags [get]
+func (h *GetTagReportHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		common.WriteProblem(w, common.NewMethodNotAllowedProblem(r.RequestURI))
+		return
+	}
+
+	userID := extractID(r.URL.Path, "/api/v1/users/")
+	query := r.URL.Query()
+	fromParam, toParam := query.Get("from"), query.Get("to")
+
+	validationErrors := common.CollectErrors(
+		common.ValidateUUID(userID, "user_id"),
+		common.ValidateDate(fromParam, "from"),
+		common.ValidateDate(toParam, "to"),
+	)
+	if len(validationErrors) > 0 {
+		common.WriteProblem(w, common.NewValidationProblemWithErrors(r.RequestURI, validationErrors))
+		return
+	}
+
+	from, _ := time.Parse(time.DateOnly, fromParam)
+	to, _ := time.Parse(time.DateOnly, toParam)
+
+	// The service takes an exclusive end, so include the whole of the last day
+	report, err := h.service.GetTagReport(r.Context(), userID, from, to.AddDate(0, 0, 1))
+	if err != nil {
+		var notFoundErr *domainerrors.ErrNotFound
+		if errors.As(err, &notFoundErr) {
+			common.WriteProblem(w, common.NewNotFoundProblem(err.Error(), r.RequestURI))
+			return
+		}
+		var invalidErr *domainerrors.ErrInvalidInput
+		if errors.As(err, &invalidErr) {
+			common.WriteProblem(w, common.NewValidationProblem(err.Error(), r.RequestURI))
+			return
+		}
+		common.WriteProblem(w, common.NewInternalErrorProblem(r.RequestURI))
+		return
+	}
+
+	common.WriteJSON(w, http.StatusOK, toTagReportResponse(report))
+}
@@ -0,0 +1,135 @@
+package report
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/money"
+	httptesting "accounting/internal/handler/http"
+)
+
+const tagReportPath = "/api/v1/users/123e4567-e89b-12d3-a456-426614174000/reports/tags"
+
+func TestGetTagReportHandlerSuccess(t *testing.T) {
+	mockService := &httptesting.MockReportService{
+		TagReportToReturn: &entity.TagReport{
+			UserID: "123e4567-e89b-12d3-a456-426614174000",
+			From:   time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			To:     time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC),
+			Tags: []*entity.TagSummary{
+				{
+					Tag:         "reimbursable",
+					Currency:    "USD",
+					Income:      money.MustParse("80", "USD"),
+					Expense:     money.MustParse("120.50", "USD"),
+					NetCashFlow: money.MustParse("-40.50", "USD"),
+				},
+				{
+					Tag:         "vacation-2026",
+					Currency:    "EUR",
+					Income:      money.Zero("EUR"),
+					Expense:     money.MustParse("950", "EUR"),
+					NetCashFlow: money.MustParse("-950", "EUR"),
+				},
+			},
+		},
+	}
+	handler := NewGetTagReportHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, tagReportPath+"?from=2026-01-01&to=2026-12-31", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+
+	if !mockService.LastTo.Equal(time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected exclusive end 2027-01-01, got %s", mockService.LastTo)
+	}
+
+	var response TagReportResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if response.From != "2026-01-01" || response.To != "2026-12-31" {
+		t.Errorf("expected range 2026-01-01..2026-12-31, got %s..%s", response.From, response.To)
+	}
+	if len(response.Tags) != 2 {
+		t.Fatalf("expected 2 tags, got %d", len(response.Tags))
+	}
+	if tag := response.Tags[0]; tag.Tag != "reimbursable" || tag.Expense != "120.50" || tag.NetCashFlow != "-40.50" {
+		t.Errorf("expected reimbursable expense 120.50 and net -40.50, got %+v", tag)
+	}
+	if tag := response.Tags[1]; tag.Tag != "vacation-2026" || tag.Currency != "EUR" || tag.Expense != "950.00" {
+		t.Errorf("expected vacation-2026 expense 950.00 EUR, got %+v", tag)
+	}
+}
+
+func TestGetTagReportHandlerValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+	}{
+		{"invalid user ID", "/api/v1/users/not-a-uuid/reports/tags?from=2026-01-01&to=2026-01-31"},
+		{"missing from", tagReportPath + "?to=2026-01-31"},
+		{"missing to", tagReportPath + "?from=2026-01-01"},
+		{"bad date", tagReportPath + "?from=2026-13-01&to=2026-01-31"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &httptesting.MockReportService{}
+			handler := NewGetTagReportHandler(mockService)
+
+			req, _ := http.NewRequest(http.MethodGet, tt.path, nil)
+			w := httptest.NewRecorder()
+
+			handler.Handle(w, req)
+
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+			}
+			if mockService.GetTagReportCalls != 0 {
+				t.Errorf("expected no service call, got %d", mockService.GetTagReportCalls)
+			}
+		})
+	}
+}
+
+func TestGetTagReportHandlerUserNotFound(t *testing.T) {
+	mockService := &httptesting.MockReportService{
+		LastGetTagReportErr: domainerrors.NewErrNotFound("user", "123e4567-e89b-12d3-a456-426614174000"),
+	}
+	handler := NewGetTagReportHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, tagReportPath+"?from=2026-01-01&to=2026-01-31", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestGetTagReportHandlerInvalidMethod(t *testing.T) {
+	mockService := &httptesting.MockReportService{}
+	handler := NewGetTagReportHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodPost, tagReportPath, nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected status %d, got %d", http.StatusMethodNotAllowed, w.Code)
+	}
+}
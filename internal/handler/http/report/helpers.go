@@ -0,0 +1,154 @@
+package report
+
+import (
+	"strings"
+	"time"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+)
+
+func toSummaryResponse(summary *entity.Summary) *SummaryResponse {
+	periods := make([]*PeriodSummaryResponse, 0, len(summary.Periods))
+	for _, period := range summary.Periods {
+		periods = append(periods, &PeriodSummaryResponse{
+			PeriodStart: period.PeriodStart.Format(time.DateOnly),
+			PeriodEnd:   lastDay(period.PeriodEnd),
+			Currency:    period.Currency,
+			Income:      period.Income.String(),
+			Expense:     period.Expense.String(),
+			NetCashFlow: period.NetCashFlow.String(),
+			Categories:  toCategorySummaryResponses(period.Categories),
+		})
+	}
+
+	return &SummaryResponse{
+		UserID:       summary.UserID,
+		From:         summary.From.Format(time.DateOnly),
+		To:           lastDay(summary.To),
+		Granularity:  summary.Granularity,
+		BaseCurrency: summary.BaseCurrency,
+		Periods:      periods,
+	}
+}
+
+func toCategorySummaryResponses(categories []*entity.CategorySummary) []*CategorySummaryResponse {
+	responses := make([]*CategorySummaryResponse, 0, len(categories))
+	for _, category := range categories {
+		response := &CategorySummaryResponse{
+			CategoryID:  category.CategoryID,
+			Category:    category.Category,
+			Income:      category.Income.String(),
+			Expense:     category.Expense.String(),
+			NetCashFlow: category.NetCashFlow.String(),
+		}
+		if len(category.Children) > 0 {
+			response.Children = toCategorySummaryResponses(category.Children)
+		}
+		responses = append(responses, response)
+	}
+	return responses
+}
+
+func toTagReportResponse(report *entity.TagReport) *TagReportResponse {
+	tags := make([]*TagSummaryResponse, 0, len(report.Tags))
+	for _, tag := range report.Tags {
+		tags = append(tags, &TagSummaryResponse{
+			Tag:         tag.Tag,
+			Currency:    tag.Currency,
+			Income:      tag.Income.String(),
+			Expense:     tag.Expense.String(),
+			NetCashFlow: tag.NetCashFlow.String(),
+		})
+	}
+
+	return &TagReportResponse{
+		UserID: report.UserID,
+		From:   report.From.Format(time.DateOnly),
+		To:     lastDay(report.To),
+		Tags:   tags,
+	}
+}
+
+func toPayeeReportResponse(report *entity.PayeeReport) *PayeeReportResponse {
+	payees := make([]*PayeeSummaryResponse, 0, len(report.Payees))
+	for _, payee := range report.Payees {
+		payees = append(payees, &PayeeSummaryResponse{
+			PayeeID:     payee.PayeeID,
+			Payee:       payee.Payee,
+			Currency:    payee.Currency,
+			Income:      payee.Income.String(),
+			Expense:     payee.Expense.String(),
+			NetCashFlow: payee.NetCashFlow.String(),
+		})
+	}
+
+	return &PayeeReportResponse{
+		UserID: report.UserID,
+		From:   report.From.Format(time.DateOnly),
+		To:     lastDay(report.To),
+		Payees: payees,
+	}
+}
+
+func toNetWorthResponse(netWorth *entity.NetWorth) *NetWorthResponse {
+	accounts := make([]*NetWorthAccountResponse, 0, len(netWorth.Accounts))
+	for _, account := range netWorth.Accounts {
+		accounts = append(accounts, &NetWorthAccountResponse{
+			AccountID:        account.AccountID,
+			Name:             account.Name,
+			Type:             account.Type,
+			Balance:          account.Balance.String(),
+			Currency:         account.Balance.Currency(),
+			ConvertedBalance: account.Converted.String(),
+		})
+	}
+	history := make([]*NetWorthPointResponse, 0, len(netWorth.History))
+	for _, point := range netWorth.History {
+		history = append(history, toNetWorthPointResponse(point))
+	}
+
+	current := toNetWorthPointResponse(&netWorth.Current)
+	return &NetWorthResponse{
+		UserID:      netWorth.UserID,
+		Currency:    netWorth.Currency,
+		AsOf:        current.Date,
+		Assets:      current.Assets,
+		Liabilities: current.Liabilities,
+		NetWorth:    current.NetWorth,
+		Accounts:    accounts,
+		History:     history,
+	}
+}
+
+func toNetWorthPointResponse(point *entity.NetWorthPoint) *NetWorthPointResponse {
+	return &NetWorthPointResponse{
+		Date:        point.Date.Format(time.DateOnly),
+		Assets:      point.Assets.String(),
+		Liabilities: point.Liabilities.String(),
+		NetWorth:    point.NetWorth.String(),
+	}
+}
+
+// lastDay formats the day before an exclusive end date.
+func lastDay(end time.Time) string {
+	return end.AddDate(0, 0, -1).Format(time.DateOnly)
+}
+
+// granularityNames returns the accepted values of the granularity parameter.
+func granularityNames() []string {
+	names := make([]string, 0, len(constant.Granularities))
+	for _, granularity := range constant.Granularities {
+		names = append(names, string(granularity))
+	}
+	return names
+}
+
+func extractID(path, prefix string) string {
+	path = strings.TrimPrefix(path, prefix)
+	parts := strings.Split(path, "/")
+	if len(parts) > 0 {
+		return parts[0]
+	}
+	return ""
+}
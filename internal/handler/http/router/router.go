@@ -5,7 +5,18 @@ import (
 	"strings"
 
 	"accounting/internal/handler/http/account"
+	"accounting/internal/handler/http/balance"
+	"accounting/internal/handler/http/category"
+	"accounting/internal/handler/http/categoryrule"
+	"accounting/internal/handler/http/currency"
+	"accounting/internal/handler/http/exchangerate"
+	"accounting/internal/handler/http/journal"
+	"accounting/internal/handler/http/payee"
+	"accounting/internal/handler/http/report"
+	"accounting/internal/handler/http/statement"
+	"accounting/internal/handler/http/statementimport"
 	"accounting/internal/handler/http/transaction"
+	"accounting/internal/handler/http/transfer"
 	"accounting/internal/handler/http/user"
 	"accounting/internal/service"
 )
@@ -20,6 +31,16 @@ func NewRouter(
 	userService *service.UserService,
 	accountService *service.AccountService,
 	transactionService *service.TransactionService,
+	transferService *service.TransferService,
+	journalService *service.JournalService,
+	exchangeRateService *service.ExchangeRateService,
+	reportService *service.ReportService,
+	balanceService *service.BalanceService,
+	statementService *service.StatementService,
+	importService *service.ImportService,
+	categoryRuleService *service.CategoryRuleService,
+	categoryService *service.CategoryService,
+	payeeService *service.PayeeService,
 ) *Router {
 	mux := http.NewServeMux()
 
@@ -36,6 +57,11 @@ func NewRouter(
 	deleteAccountHandler := account.NewDeleteAccountHandler(accountService)
 	getAccountHandler := account.NewGetAccountHandler(accountService)
 	listUserAccountsHandler := account.NewListUserAccountsHandler(accountService)
+	getAccountTreeHandler := account.NewGetAccountTreeHandler(accountService)
+
+	// Balance handlers
+	getBalanceHandler := balance.NewGetBalanceHandler(balanceService)
+	getDailyBalancesHandler := balance.NewGetDailyBalancesHandler(balanceService)
 
 	// Transaction handlers
 	createTransactionHandler := transaction.NewCreateTransactionHandler(transactionService)
@@ -44,6 +70,65 @@ func NewRouter(
 	getTransactionHandler := transaction.NewGetTransactionHandler(transactionService)
 	listAccountTransactionsHandler := transaction.NewListAccountTransactionsHandler(transactionService)
 
+	// Transfer handlers
+	createTransferHandler := transfer.NewCreateTransferHandler(transferService)
+	getTransferHandler := transfer.NewGetTransferHandler(transferService)
+
+	// Journal handlers
+	createJournalEntryHandler := journal.NewCreateJournalEntryHandler(journalService)
+	getJournalEntryHandler := journal.NewGetJournalEntryHandler(journalService)
+	deleteJournalEntryHandler := journal.NewDeleteJournalEntryHandler(journalService)
+	listUserJournalEntriesHandler := journal.NewListUserJournalEntriesHandler(journalService)
+	previewTransactionJournalEntryHandler := journal.NewPreviewTransactionJournalEntryHandler(journalService)
+
+	// Exchange rate handlers
+	setExchangeRatesHandler := exchangerate.NewSetExchangeRatesHandler(exchangeRateService)
+	getExchangeRateHandler := exchangerate.NewGetExchangeRateHandler(exchangeRateService)
+
+	// Report handlers
+	getSummaryHandler := report.NewGetSummaryHandler(reportService)
+	getTagReportHandler := report.NewGetTagReportHandler(reportService)
+	getPayeeReportHandler := report.NewGetPayeeReportHandler(reportService)
+	getNetWorthHandler := report.NewGetNetWorthHandler(reportService)
+
+	// Statement handlers
+	getTrialBalanceHandler := statement.NewGetTrialBalanceHandler(statementService)
+	getBalanceSheetHandler := statement.NewGetBalanceSheetHandler(statementService)
+	getIncomeStatementHandler := statement.NewGetIncomeStatementHandler(statementService)
+
+	// Import handlers
+	createImportProfileHandler := statementimport.NewCreateImportProfileHandler(importService)
+	updateImportProfileHandler := statementimport.NewUpdateImportProfileHandler(importService)
+	deleteImportProfileHandler := statementimport.NewDeleteImportProfileHandler(importService)
+	getImportProfileHandler := statementimport.NewGetImportProfileHandler(importService)
+	listUserImportProfilesHandler := statementimport.NewListUserImportProfilesHandler(importService)
+	importStatementHandler := statementimport.NewImportStatementHandler(importService)
+
+	// Category handlers
+	createCategoryHandler := category.NewCreateCategoryHandler(categoryService)
+	updateCategoryHandler := category.NewUpdateCategoryHandler(categoryService)
+	deleteCategoryHandler := category.NewDeleteCategoryHandler(categoryService)
+	getCategoryHandler := category.NewGetCategoryHandler(categoryService)
+	listUserCategoriesHandler := category.NewListUserCategoriesHandler(categoryService)
+
+	// Payee handlers
+	createPayeeHandler := payee.NewCreatePayeeHandler(payeeService)
+	updatePayeeHandler := payee.NewUpdatePayeeHandler(payeeService)
+	deletePayeeHandler := payee.NewDeletePayeeHandler(payeeService)
+	getPayeeHandler := payee.NewGetPayeeHandler(payeeService)
+	listUserPayeesHandler := payee.NewListUserPayeesHandler(payeeService)
+
+	// Category rule handlers
+	createCategoryRuleHandler := categoryrule.NewCreateCategoryRuleHandler(categoryRuleService)
+	updateCategoryRuleHandler := categoryrule.NewUpdateCategoryRuleHandler(categoryRuleService)
+	deleteCategoryRuleHandler := categoryrule.NewDeleteCategoryRuleHandler(categoryRuleService)
+	getCategoryRuleHandler := categoryrule.NewGetCategoryRuleHandler(categoryRuleService)
+	listUserCategoryRulesHandler := categoryrule.NewListUserCategoryRulesHandler(categoryRuleService)
+	applyCategoryRulesHandler := categoryrule.NewApplyCategoryRulesHandler(categoryRuleService)
+
+	// Currency handlers
+	listCurrenciesHandler := currency.NewListCurrenciesHandler()
+
 	// User routes
 	mux.HandleFunc("/api/v1/users", func(w http.ResponseWriter, r *http.Request) {
 		if r.Method == http.MethodPost {
@@ -60,12 +145,96 @@ func NewRouter(
 		}
 	})
 	mux.HandleFunc("/api/v1/users/", func(w http.ResponseWriter, r *http.Request) {
+		// Handle /api/v1/users/{userId}/accounts/tree
+		if strings.HasSuffix(r.URL.Path, "/accounts/tree") && r.Method == http.MethodGet {
+			getAccountTreeHandler.Handle(w, r)
+			return
+		}
+
 		// Handle /api/v1/users/{userId}/accounts
 		if strings.HasSuffix(r.URL.Path, "/accounts") && r.Method == http.MethodGet {
 			listUserAccountsHandler.Handle(w, r)
 			return
 		}
 
+		// Handle /api/v1/users/{userId}/reports/summary
+		if strings.HasSuffix(r.URL.Path, "/reports/summary") && r.Method == http.MethodGet {
+			getSummaryHandler.Handle(w, r)
+			return
+		}
+
+		// Handle /api/v1/users/{userId}/reports/tags
+		if strings.HasSuffix(r.URL.Path, "/reports/tags") && r.Method == http.MethodGet {
+			getTagReportHandler.Handle(w, r)
+			return
+		}
+
+		// Handle /api/v1/users/{userId}/reports/payees
+		if strings.HasSuffix(r.URL.Path, "/reports/payees") && r.Method == http.MethodGet {
+			getPayeeReportHandler.Handle(w, r)
+			return
+		}
+
+		// Handle /api/v1/users/{userId}/net-worth
+		if strings.HasSuffix(r.URL.Path, "/net-worth") && r.Method == http.MethodGet {
+			getNetWorthHandler.Handle(w, r)
+			return
+		}
+
+		// Handle /api/v1/users/{userId}/statements/trial-balance
+		if strings.HasSuffix(r.URL.Path, "/statements/trial-balance") && r.Method == http.MethodGet {
+			getTrialBalanceHandler.Handle(w, r)
+			return
+		}
+
+		// Handle /api/v1/users/{userId}/statements/balance-sheet
+		if strings.HasSuffix(r.URL.Path, "/statements/balance-sheet") && r.Method == http.MethodGet {
+			getBalanceSheetHandler.Handle(w, r)
+			return
+		}
+
+		// Handle /api/v1/users/{userId}/statements/income-statement
+		if strings.HasSuffix(r.URL.Path, "/statements/income-statement") && r.Method == http.MethodGet {
+			getIncomeStatementHandler.Handle(w, r)
+			return
+		}
+
+		// Handle /api/v1/users/{userId}/import-profiles
+		if strings.HasSuffix(r.URL.Path, "/import-profiles") && r.Method == http.MethodGet {
+			listUserImportProfilesHandler.Handle(w, r)
+			return
+		}
+
+		// Handle /api/v1/users/{userId}/categories
+		if strings.HasSuffix(r.URL.Path, "/categories") && r.Method == http.MethodGet {
+			listUserCategoriesHandler.Handle(w, r)
+			return
+		}
+
+		// Handle /api/v1/users/{userId}/payees
+		if strings.HasSuffix(r.URL.Path, "/payees") && r.Method == http.MethodGet {
+			listUserPayeesHandler.Handle(w, r)
+			return
+		}
+
+		// Handle /api/v1/users/{userId}/category-rules/apply
+		if strings.HasSuffix(r.URL.Path, "/category-rules/apply") && r.Method == http.MethodPost {
+			applyCategoryRulesHandler.Handle(w, r)
+			return
+		}
+
+		// Handle /api/v1/users/{userId}/category-rules
+		if strings.HasSuffix(r.URL.Path, "/category-rules") && r.Method == http.MethodGet {
+			listUserCategoryRulesHandler.Handle(w, r)
+			return
+		}
+
+		// Handle /api/v1/users/{userId}/journal-entries
+		if strings.HasSuffix(r.URL.Path, "/journal-entries") && r.Method == http.MethodGet {
+			listUserJournalEntriesHandler.Handle(w, r)
+			return
+		}
+
 		// Handle /api/v1/users/{id}
 		switch r.Method {
 		case http.MethodGet:
@@ -94,6 +263,24 @@ func NewRouter(
 			return
 		}
 
+		// Handle /api/v1/accounts/{accountId}/imports
+		if strings.HasSuffix(r.URL.Path, "/imports") && r.Method == http.MethodPost {
+			importStatementHandler.Handle(w, r)
+			return
+		}
+
+		// Handle /api/v1/accounts/{accountId}/balance/daily
+		if strings.HasSuffix(r.URL.Path, "/balance/daily") && r.Method == http.MethodGet {
+			getDailyBalancesHandler.Handle(w, r)
+			return
+		}
+
+		// Handle /api/v1/accounts/{accountId}/balance
+		if strings.HasSuffix(r.URL.Path, "/balance") && r.Method == http.MethodGet {
+			getBalanceHandler.Handle(w, r)
+			return
+		}
+
 		// Handle /api/v1/accounts/{id}
 		switch r.Method {
 		case http.MethodGet:
@@ -116,6 +303,12 @@ func NewRouter(
 		}
 	})
 	mux.HandleFunc("/api/v1/transactions/", func(w http.ResponseWriter, r *http.Request) {
+		// Handle /api/v1/transactions/{transactionId}/journal-entry
+		if strings.HasSuffix(r.URL.Path, "/journal-entry") && r.Method == http.MethodGet {
+			previewTransactionJournalEntryHandler.Handle(w, r)
+			return
+		}
+
 		switch r.Method {
 		case http.MethodGet:
 			getTransactionHandler.Handle(w, r)
@@ -128,6 +321,146 @@ func NewRouter(
 		}
 	})
 
+	// Transfer routes
+	mux.HandleFunc("/api/v1/transfers", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			createTransferHandler.Handle(w, r)
+		} else {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+	mux.HandleFunc("/api/v1/transfers/", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			getTransferHandler.Handle(w, r)
+		} else {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+
+	// Journal routes
+	mux.HandleFunc("/api/v1/journal-entries", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			createJournalEntryHandler.Handle(w, r)
+		} else {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+	mux.HandleFunc("/api/v1/journal-entries/", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			getJournalEntryHandler.Handle(w, r)
+		case http.MethodDelete:
+			deleteJournalEntryHandler.Handle(w, r)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+
+	// Import profile routes
+	mux.HandleFunc("/api/v1/import-profiles", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			createImportProfileHandler.Handle(w, r)
+		} else {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+	mux.HandleFunc("/api/v1/import-profiles/", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			getImportProfileHandler.Handle(w, r)
+		case http.MethodPut:
+			updateImportProfileHandler.Handle(w, r)
+		case http.MethodDelete:
+			deleteImportProfileHandler.Handle(w, r)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+
+	// Category routes
+	mux.HandleFunc("/api/v1/categories", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			createCategoryHandler.Handle(w, r)
+		} else {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+	mux.HandleFunc("/api/v1/categories/", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			getCategoryHandler.Handle(w, r)
+		case http.MethodPut:
+			updateCategoryHandler.Handle(w, r)
+		case http.MethodDelete:
+			deleteCategoryHandler.Handle(w, r)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+
+	// Payee routes
+	mux.HandleFunc("/api/v1/payees", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			createPayeeHandler.Handle(w, r)
+		} else {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+	mux.HandleFunc("/api/v1/payees/", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			getPayeeHandler.Handle(w, r)
+		case http.MethodPut:
+			updatePayeeHandler.Handle(w, r)
+		case http.MethodDelete:
+			deletePayeeHandler.Handle(w, r)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+
+	// Category rule routes
+	mux.HandleFunc("/api/v1/category-rules", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			createCategoryRuleHandler.Handle(w, r)
+		} else {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+	mux.HandleFunc("/api/v1/category-rules/", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			getCategoryRuleHandler.Handle(w, r)
+		case http.MethodPut:
+			updateCategoryRuleHandler.Handle(w, r)
+		case http.MethodDelete:
+			deleteCategoryRuleHandler.Handle(w, r)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+
+	// Currency routes
+	mux.HandleFunc("/api/v1/currencies", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			listCurrenciesHandler.Handle(w, r)
+		} else {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+
+	// Exchange rate routes
+	mux.HandleFunc("/api/v1/exchange-rates", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			setExchangeRatesHandler.Handle(w, r)
+		case http.MethodGet:
+			getExchangeRateHandler.Handle(w, r)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+
 	return &Router{mux: mux}
 }
 
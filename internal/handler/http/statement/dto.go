@@ -0,0 +1,73 @@
+package statement
+
+import "accounting/internal/domain/constant"
+
+type TrialBalanceLineResponse struct {
+	// AccountID and Code are omitted for lines that stand for transaction categories
+	AccountID string               `json:"account_id,omitempty"`
+	Code      string               `json:"code,omitempty"`
+	Name      string               `json:"name"`
+	Type      constant.AccountType `json:"type"`
+	Debit     string               `json:"debit"`
+	Credit    string               `json:"credit"`
+}
+
+type TrialBalanceSectionResponse struct {
+	Currency    string                      `json:"currency"`
+	Lines       []*TrialBalanceLineResponse `json:"lines"`
+	TotalDebit  string                      `json:"total_debit"`
+	TotalCredit string                      `json:"total_credit"`
+}
+
+type TrialBalanceResponse struct {
+	UserID string `json:"user_id"`
+	// AsOf is the day (YYYY-MM-DD) the balances were taken at the end of
+	AsOf     string                         `json:"as_of"`
+	Sections []*TrialBalanceSectionResponse `json:"sections"`
+}
+
+type StatementLineResponse struct {
+	// AccountID and Code are omitted for categories and computed lines such as retained earnings
+	AccountID string `json:"account_id,omitempty"`
+	Code      string `json:"code,omitempty"`
+	Name      string `json:"name"`
+	Amount    string `json:"amount"`
+}
+
+type StatementGroupResponse struct {
+	Type  constant.AccountType     `json:"type"`
+	Lines []*StatementLineResponse `json:"lines"`
+	Total string                   `json:"total"`
+}
+
+type BalanceSheetSectionResponse struct {
+	Currency         string                    `json:"currency"`
+	Assets           []*StatementGroupResponse `json:"assets"`
+	Liabilities      []*StatementGroupResponse `json:"liabilities"`
+	Equity           *StatementGroupResponse   `json:"equity"`
+	TotalAssets      string                    `json:"total_assets"`
+	TotalLiabilities string                    `json:"total_liabilities"`
+	TotalEquity      string                    `json:"total_equity"`
+}
+
+type BalanceSheetResponse struct {
+	UserID string `json:"user_id"`
+	// AsOf is the day (YYYY-MM-DD) the balance sheet was drawn up at the end of
+	AsOf     string                         `json:"as_of"`
+	Sections []*BalanceSheetSectionResponse `json:"sections"`
+}
+
+type IncomeStatementSectionResponse struct {
+	Currency  string                  `json:"currency"`
+	Income    *StatementGroupResponse `json:"income"`
+	Expenses  *StatementGroupResponse `json:"expenses"`
+	NetIncome string                  `json:"net_income"`
+}
+
+type IncomeStatementResponse struct {
+	UserID string `json:"user_id"`
+	// From and To are the requested dates (YYYY-MM-DD), both inclusive
+	From     string                            `json:"from"`
+	To       string                            `json:"to"`
+	Sections []*IncomeStatementSectionResponse `json:"sections"`
+}
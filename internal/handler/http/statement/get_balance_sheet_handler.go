@@ -0,0 +1,48 @@
+package statement
+
+import (
+	"net/http"
+
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type GetBalanceSheetHandler struct {
+	service interfaces.StatementService
+}
+
+func NewGetBalanceSheetHandler(service interfaces.StatementService) *GetBalanceSheetHandler {
+	return &GetBalanceSheetHandler{service: service}
+}
+
+// @Summary Get balance sheet
+// @Description Draw up a user's assets, liabilities and equity at the end of a day (UTC), grouped by account type. Liabilities are shown as the amount owed. Equity holds the equity accounts, the retained earnings from all income and expenses to date and any imbalance left by transfers between currencies. Each currency forms its own section, where assets equal liabilities plus equity.
+// @Tags statements
+// @Accept json
+// @Produce json
+// @Param user_id path string true "User ID (UUID)"
+// @Param as_of query string false "Day to draw up the balance sheet at the end of (YYYY-MM-DD), default today"
+// @Success 200 {object} BalanceSheetResponse
+// @Failure 400 {object} common.ValidationProblem "Validation error"
+// @Failure 404 {object} common.ProblemDetail "User not found"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/users/{user_id}/statements/balance-sheet [get]
+func (h *GetBalanceSheetHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		common.WriteProblem(w, common.NewMethodNotAllowedProblem(r.RequestURI))
+		return
+	}
+
+	userID, asOf, ok := parseAsOf(w, r)
+	if !ok {
+		return
+	}
+
+	balanceSheet, err := h.service.GetBalanceSheet(r.Context(), userID, asOf)
+	if err != nil {
+		writeStatementError(w, r, err)
+		return
+	}
+
+	common.WriteJSON(w, http.StatusOK, toBalanceSheetResponse(balanceSheet))
+}
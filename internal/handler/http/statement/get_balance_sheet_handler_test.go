@@ -0,0 +1,162 @@
+package statement
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/money"
+	httptesting "accounting/internal/handler/http"
+)
+
+const balanceSheetPath = "/api/v1/users/123e4567-e89b-12d3-a456-426614174000/statements/balance-sheet"
+
+func TestGetBalanceSheetHandlerSuccess(t *testing.T) {
+	asOf := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+	mockService := &httptesting.MockStatementService{
+		BalanceSheetToReturn: &entity.BalanceSheet{
+			UserID: "123e4567-e89b-12d3-a456-426614174000",
+			AsOf:   asOf,
+			Sections: []*entity.BalanceSheetSection{
+				{
+					Currency: "USD",
+					Assets: []*entity.StatementGroup{
+						{
+							Type: constant.AccountTypeChecking,
+							Lines: []*entity.StatementLine{
+								{AccountID: "checking", Code: "1000", Name: "Checking", Amount: money.MustParse("1000", "USD")},
+							},
+							Total: money.MustParse("1000", "USD"),
+						},
+					},
+					Liabilities: []*entity.StatementGroup{
+						{
+							Type: constant.AccountTypeCreditCard,
+							Lines: []*entity.StatementLine{
+								{AccountID: "card", Code: "2000", Name: "Card", Amount: money.MustParse("200", "USD")},
+							},
+							Total: money.MustParse("200", "USD"),
+						},
+					},
+					Equity: &entity.StatementGroup{
+						Type: constant.AccountTypeEquity,
+						Lines: []*entity.StatementLine{
+							{Name: "Retained earnings", Amount: money.MustParse("800", "USD")},
+						},
+						Total: money.MustParse("800", "USD"),
+					},
+					TotalAssets:      money.MustParse("1000", "USD"),
+					TotalLiabilities: money.MustParse("200", "USD"),
+					TotalEquity:      money.MustParse("800", "USD"),
+				},
+			},
+		},
+	}
+	handler := NewGetBalanceSheetHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, balanceSheetPath+"?as_of=2024-03-31", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	if !mockService.LastAsOf.Equal(asOf) {
+		t.Errorf("expected as_of %s, got %s", asOf, mockService.LastAsOf)
+	}
+
+	var response BalanceSheetResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if response.AsOf != "2024-03-31" || len(response.Sections) != 1 {
+		t.Fatalf("expected one section on 2024-03-31, got %+v", response)
+	}
+	section := response.Sections[0]
+	if section.TotalAssets != "1000.00" || section.TotalLiabilities != "200.00" || section.TotalEquity != "800.00" {
+		t.Errorf("expected 1000.00 = 200.00 + 800.00, got %+v", section)
+	}
+	if len(section.Assets) != 1 || section.Assets[0].Type != constant.AccountTypeChecking || section.Assets[0].Lines[0].Amount != "1000.00" {
+		t.Errorf("expected one checking group, got %+v", section.Assets)
+	}
+	if section.Equity == nil || len(section.Equity.Lines) != 1 || section.Equity.Lines[0].Name != "Retained earnings" {
+		t.Errorf("expected retained earnings in equity, got %+v", section.Equity)
+	}
+}
+
+func TestGetBalanceSheetHandlerValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+	}{
+		{"invalid user ID", "/api/v1/users/not-a-uuid/statements/balance-sheet"},
+		{"bad as_of", balanceSheetPath + "?as_of=03/31/2024"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &httptesting.MockStatementService{}
+			handler := NewGetBalanceSheetHandler(mockService)
+
+			req, _ := http.NewRequest(http.MethodGet, tt.path, nil)
+			w := httptest.NewRecorder()
+
+			handler.Handle(w, req)
+
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+			}
+			if mockService.GetBalanceSheetCalls != 0 {
+				t.Errorf("expected no service call, got %d", mockService.GetBalanceSheetCalls)
+			}
+		})
+	}
+}
+
+func TestGetBalanceSheetHandlerErrors(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		expectedStatus int
+	}{
+		{"user not found", domainerrors.NewErrNotFound("user", "123e4567-e89b-12d3-a456-426614174000"), http.StatusNotFound},
+		{"internal error", errors.New("database down"), http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &httptesting.MockStatementService{LastGetBalanceSheetErr: tt.err}
+			handler := NewGetBalanceSheetHandler(mockService)
+
+			req, _ := http.NewRequest(http.MethodGet, balanceSheetPath, nil)
+			w := httptest.NewRecorder()
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d", tt.expectedStatus, w.Code)
+			}
+		})
+	}
+}
+
+func TestGetBalanceSheetHandlerInvalidMethod(t *testing.T) {
+	mockService := &httptesting.MockStatementService{}
+	handler := NewGetBalanceSheetHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodDelete, balanceSheetPath, nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected status %d, got %d", http.StatusMethodNotAllowed, w.Code)
+	}
+}
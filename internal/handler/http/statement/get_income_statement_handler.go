@@ -0,0 +1,63 @@
+package statement
+
+import (
+	"net/http"
+	"time"
+
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type GetIncomeStatementHandler struct {
+	service interfaces.StatementService
+}
+
+func NewGetIncomeStatementHandler(service interfaces.StatementService) *GetIncomeStatementHandler {
+	return &GetIncomeStatementHandler{service: service}
+}
+
+// @Summary Get income statement
+// @Description Total a user's income and expenses for a period, listing the income and expense accounts followed by the transaction categories, with the net income. Transfers are left out. Each currency forms its own section.
+// @Tags statements
+// @Accept json
+// @Produce json
+// @Param user_id path string true "User ID (UUID)"
+// @Param from query string true "First day of the period (YYYY-MM-DD)"
+// @Param to query string true "Last day of the period (YYYY-MM-DD), inclusive"
+// @Success 200 {object} IncomeStatementResponse
+// @Failure 400 {object} common.ValidationProblem "Validation error"
+// @Failure 404 {object} common.ProblemDetail "User not found"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/users/{user_id}/statements/income-statement [get]
+func (h *GetIncomeStatementHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		common.WriteProblem(w, common.NewMethodNotAllowedProblem(r.RequestURI))
+		return
+	}
+
+	userID := extractID(r.URL.Path, "/api/v1/users/")
+	query := r.URL.Query()
+	fromParam, toParam := query.Get("from"), query.Get("to")
+
+	validationErrors := common.CollectErrors(
+		common.ValidateUUID(userID, "user_id"),
+		common.ValidateDate(fromParam, "from"),
+		common.ValidateDate(toParam, "to"),
+	)
+	if len(validationErrors) > 0 {
+		common.WriteProblem(w, common.NewValidationProblemWithErrors(r.RequestURI, validationErrors))
+		return
+	}
+
+	from, _ := time.Parse(time.DateOnly, fromParam)
+	to, _ := time.Parse(time.DateOnly, toParam)
+
+	// The service takes an exclusive end, so include the whole of the last day
+	statement, err := h.service.GetIncomeStatement(r.Context(), userID, from, to.AddDate(0, 0, 1))
+	if err != nil {
+		writeStatementError(w, r, err)
+		return
+	}
+
+	common.WriteJSON(w, http.StatusOK, toIncomeStatementResponse(statement))
+}
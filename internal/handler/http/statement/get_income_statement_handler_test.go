@@ -0,0 +1,130 @@
+package statement
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/money"
+	httptesting "accounting/internal/handler/http"
+)
+
+const incomeStatementPath = "/api/v1/users/123e4567-e89b-12d3-a456-426614174000/statements/income-statement"
+
+func TestGetIncomeStatementHandlerSuccess(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	mockService := &httptesting.MockStatementService{
+		IncomeStatementToReturn: &entity.IncomeStatement{
+			UserID: "123e4567-e89b-12d3-a456-426614174000",
+			From:   from,
+			To:     to,
+			Sections: []*entity.IncomeStatementSection{
+				{
+					Currency: "USD",
+					Income: &entity.StatementGroup{
+						Type:  constant.AccountTypeIncome,
+						Lines: []*entity.StatementLine{{Name: "Salary", Amount: money.MustParse("3000", "USD")}},
+						Total: money.MustParse("3000", "USD"),
+					},
+					Expenses: &entity.StatementGroup{
+						Type:  constant.AccountTypeExpense,
+						Lines: []*entity.StatementLine{{Name: "Rent", Amount: money.MustParse("1200", "USD")}},
+						Total: money.MustParse("1200", "USD"),
+					},
+					NetIncome: money.MustParse("1800", "USD"),
+				},
+			},
+		},
+	}
+	handler := NewGetIncomeStatementHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, incomeStatementPath+"?from=2024-01-01&to=2024-03-31", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	if !mockService.LastFrom.Equal(from) || !mockService.LastTo.Equal(to) {
+		t.Errorf("expected the service to get [2024-01-01, 2024-04-01), got [%s, %s)", mockService.LastFrom, mockService.LastTo)
+	}
+
+	var response IncomeStatementResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if response.From != "2024-01-01" || response.To != "2024-03-31" {
+		t.Errorf("expected the inclusive range 2024-01-01..2024-03-31, got %s..%s", response.From, response.To)
+	}
+	if len(response.Sections) != 1 || response.Sections[0].NetIncome != "1800.00" || response.Sections[0].Income.Total != "3000.00" {
+		t.Errorf("expected net income of 1800.00, got %+v", response.Sections)
+	}
+}
+
+func TestGetIncomeStatementHandlerValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+	}{
+		{"invalid user ID", "/api/v1/users/not-a-uuid/statements/income-statement?from=2024-01-01&to=2024-03-31"},
+		{"missing from", incomeStatementPath + "?to=2024-03-31"},
+		{"missing to", incomeStatementPath + "?from=2024-01-01"},
+		{"bad to", incomeStatementPath + "?from=2024-01-01&to=2024-3-31"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &httptesting.MockStatementService{}
+			handler := NewGetIncomeStatementHandler(mockService)
+
+			req, _ := http.NewRequest(http.MethodGet, tt.path, nil)
+			w := httptest.NewRecorder()
+
+			handler.Handle(w, req)
+
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+			}
+			if mockService.GetIncomeStatementCalls != 0 {
+				t.Errorf("expected no service call, got %d", mockService.GetIncomeStatementCalls)
+			}
+		})
+	}
+}
+
+func TestGetIncomeStatementHandlerInvalidRange(t *testing.T) {
+	mockService := &httptesting.MockStatementService{
+		LastGetIncomeStatementErr: domainerrors.NewErrInvalidInput("to", "to must be after from"),
+	}
+	handler := NewGetIncomeStatementHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, incomeStatementPath+"?from=2024-03-31&to=2024-01-01", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestGetIncomeStatementHandlerInvalidMethod(t *testing.T) {
+	mockService := &httptesting.MockStatementService{}
+	handler := NewGetIncomeStatementHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodPut, incomeStatementPath, nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected status %d, got %d", http.StatusMethodNotAllowed, w.Code)
+	}
+}
@@ -0,0 +1,48 @@
+package statement
+
+import (
+	"net/http"
+
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type GetTrialBalanceHandler struct {
+	service interfaces.StatementService
+}
+
+func NewGetTrialBalanceHandler(service interfaces.StatementService) *GetTrialBalanceHandler {
+	return &GetTrialBalanceHandler{service: service}
+}
+
+// @Summary Get trial balance
+// @Description List the debit or credit balance of every account of a user at the end of a day (UTC), ordered by account type. Transaction categories appear as income and expense lines, standing in for the counterpart accounts that single-entry transactions leave implicit. Each currency forms its own section, whose debits and credits are equal.
+// @Tags statements
+// @Accept json
+// @Produce json
+// @Param user_id path string true "User ID (UUID)"
+// @Param as_of query string false "Day to draw up the trial balance at the end of (YYYY-MM-DD), default today"
+// @Success 200 {object} TrialBalanceResponse
+// @Failure 400 {object} common.ValidationProblem "Validation error"
+// @Failure 404 {object} common.ProblemDetail "User not found"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/users/{user_id}/statements/trial-balance [get]
+func (h *GetTrialBalanceHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		common.WriteProblem(w, common.NewMethodNotAllowedProblem(r.RequestURI))
+		return
+	}
+
+	userID, asOf, ok := parseAsOf(w, r)
+	if !ok {
+		return
+	}
+
+	trialBalance, err := h.service.GetTrialBalance(r.Context(), userID, asOf)
+	if err != nil {
+		writeStatementError(w, r, err)
+		return
+	}
+
+	common.WriteJSON(w, http.StatusOK, toTrialBalanceResponse(trialBalance))
+}
@@ -0,0 +1,154 @@
+package statement
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/money"
+	httptesting "accounting/internal/handler/http"
+)
+
+const trialBalancePath = "/api/v1/users/123e4567-e89b-12d3-a456-426614174000/statements/trial-balance"
+
+func TestGetTrialBalanceHandlerSuccess(t *testing.T) {
+	asOf := time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)
+	mockService := &httptesting.MockStatementService{
+		TrialBalanceToReturn: &entity.TrialBalance{
+			UserID: "123e4567-e89b-12d3-a456-426614174000",
+			AsOf:   asOf,
+			Sections: []*entity.TrialBalanceSection{
+				{
+					Currency: "USD",
+					Lines: []*entity.TrialBalanceLine{
+						{
+							AccountID: "checking",
+							Code:      "1000",
+							Name:      "Checking",
+							Type:      constant.AccountTypeChecking,
+							Debit:     money.MustParse("1000", "USD"),
+							Credit:    money.Zero("USD"),
+						},
+						{
+							Name:   "Salary",
+							Type:   constant.AccountTypeIncome,
+							Debit:  money.Zero("USD"),
+							Credit: money.MustParse("1000", "USD"),
+						},
+					},
+					TotalDebit:  money.MustParse("1000", "USD"),
+					TotalCredit: money.MustParse("1000", "USD"),
+				},
+			},
+		},
+	}
+	handler := NewGetTrialBalanceHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, trialBalancePath+"?as_of=2024-03-31", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	if !mockService.LastAsOf.Equal(asOf) {
+		t.Errorf("expected as_of %s, got %s", asOf, mockService.LastAsOf)
+	}
+
+	var response TrialBalanceResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if response.AsOf != "2024-03-31" || len(response.Sections) != 1 {
+		t.Fatalf("expected one section on 2024-03-31, got %+v", response)
+	}
+	section := response.Sections[0]
+	if section.TotalDebit != "1000.00" || section.TotalCredit != "1000.00" {
+		t.Errorf("expected totals of 1000.00, got %s and %s", section.TotalDebit, section.TotalCredit)
+	}
+	if len(section.Lines) != 2 || section.Lines[0].Code != "1000" || section.Lines[1].AccountID != "" || section.Lines[1].Credit != "1000.00" {
+		t.Errorf("expected the checking account and the salary category, got %+v", section.Lines)
+	}
+}
+
+func TestGetTrialBalanceHandlerDefaultsToToday(t *testing.T) {
+	mockService := &httptesting.MockStatementService{}
+	handler := NewGetTrialBalanceHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, trialBalancePath, nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	if time.Since(mockService.LastAsOf) > time.Minute {
+		t.Errorf("expected as_of to default to now, got %s", mockService.LastAsOf)
+	}
+}
+
+func TestGetTrialBalanceHandlerValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+	}{
+		{"invalid user ID", "/api/v1/users/not-a-uuid/statements/trial-balance"},
+		{"bad as_of", trialBalancePath + "?as_of=2024-02-30"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &httptesting.MockStatementService{}
+			handler := NewGetTrialBalanceHandler(mockService)
+
+			req, _ := http.NewRequest(http.MethodGet, tt.path, nil)
+			w := httptest.NewRecorder()
+
+			handler.Handle(w, req)
+
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+			}
+			if mockService.GetTrialBalanceCalls != 0 {
+				t.Errorf("expected no service call, got %d", mockService.GetTrialBalanceCalls)
+			}
+		})
+	}
+}
+
+func TestGetTrialBalanceHandlerUserNotFound(t *testing.T) {
+	mockService := &httptesting.MockStatementService{
+		LastGetTrialBalanceErr: domainerrors.NewErrNotFound("user", "123e4567-e89b-12d3-a456-426614174000"),
+	}
+	handler := NewGetTrialBalanceHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, trialBalancePath, nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestGetTrialBalanceHandlerInvalidMethod(t *testing.T) {
+	mockService := &httptesting.MockStatementService{}
+	handler := NewGetTrialBalanceHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodPost, trialBalancePath, nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected status %d, got %d", http.StatusMethodNotAllowed, w.Code)
+	}
+}
@@ -0,0 +1,156 @@
+package statement
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/handler/http/common"
+)
+
+func toTrialBalanceResponse(trialBalance *entity.TrialBalance) *TrialBalanceResponse {
+	sections := make([]*TrialBalanceSectionResponse, 0, len(trialBalance.Sections))
+	for _, section := range trialBalance.Sections {
+		lines := make([]*TrialBalanceLineResponse, 0, len(section.Lines))
+		for _, line := range section.Lines {
+			lines = append(lines, &TrialBalanceLineResponse{
+				AccountID: line.AccountID,
+				Code:      line.Code,
+				Name:      line.Name,
+				Type:      line.Type,
+				Debit:     line.Debit.String(),
+				Credit:    line.Credit.String(),
+			})
+		}
+		sections = append(sections, &TrialBalanceSectionResponse{
+			Currency:    section.Currency,
+			Lines:       lines,
+			TotalDebit:  section.TotalDebit.String(),
+			TotalCredit: section.TotalCredit.String(),
+		})
+	}
+
+	return &TrialBalanceResponse{
+		UserID:   trialBalance.UserID,
+		AsOf:     trialBalance.AsOf.Format(time.DateOnly),
+		Sections: sections,
+	}
+}
+
+func toBalanceSheetResponse(balanceSheet *entity.BalanceSheet) *BalanceSheetResponse {
+	sections := make([]*BalanceSheetSectionResponse, 0, len(balanceSheet.Sections))
+	for _, section := range balanceSheet.Sections {
+		sections = append(sections, &BalanceSheetSectionResponse{
+			Currency:         section.Currency,
+			Assets:           toStatementGroupResponses(section.Assets),
+			Liabilities:      toStatementGroupResponses(section.Liabilities),
+			Equity:           toStatementGroupResponse(section.Equity),
+			TotalAssets:      section.TotalAssets.String(),
+			TotalLiabilities: section.TotalLiabilities.String(),
+			TotalEquity:      section.TotalEquity.String(),
+		})
+	}
+
+	return &BalanceSheetResponse{
+		UserID:   balanceSheet.UserID,
+		AsOf:     balanceSheet.AsOf.Format(time.DateOnly),
+		Sections: sections,
+	}
+}
+
+func toIncomeStatementResponse(statement *entity.IncomeStatement) *IncomeStatementResponse {
+	sections := make([]*IncomeStatementSectionResponse, 0, len(statement.Sections))
+	for _, section := range statement.Sections {
+		sections = append(sections, &IncomeStatementSectionResponse{
+			Currency:  section.Currency,
+			Income:    toStatementGroupResponse(section.Income),
+			Expenses:  toStatementGroupResponse(section.Expenses),
+			NetIncome: section.NetIncome.String(),
+		})
+	}
+
+	return &IncomeStatementResponse{
+		UserID:   statement.UserID,
+		From:     statement.From.Format(time.DateOnly),
+		To:       statement.To.AddDate(0, 0, -1).Format(time.DateOnly),
+		Sections: sections,
+	}
+}
+
+func toStatementGroupResponses(groups []*entity.StatementGroup) []*StatementGroupResponse {
+	responses := make([]*StatementGroupResponse, 0, len(groups))
+	for _, group := range groups {
+		responses = append(responses, toStatementGroupResponse(group))
+	}
+	return responses
+}
+
+func toStatementGroupResponse(group *entity.StatementGroup) *StatementGroupResponse {
+	lines := make([]*StatementLineResponse, 0, len(group.Lines))
+	for _, line := range group.Lines {
+		lines = append(lines, &StatementLineResponse{
+			AccountID: line.AccountID,
+			Code:      line.Code,
+			Name:      line.Name,
+			Amount:    line.Amount.String(),
+		})
+	}
+	return &StatementGroupResponse{
+		Type:  group.Type,
+		Lines: lines,
+		Total: group.Total.String(),
+	}
+}
+
+// parseAsOf validates the user ID and the optional as_of parameter, which
+// defaults to today. It writes a problem response and returns false when
+// either is invalid.
+func parseAsOf(w http.ResponseWriter, r *http.Request) (string, time.Time, bool) {
+	userID := extractID(r.URL.Path, "/api/v1/users/")
+	asOfParam := r.URL.Query().Get("as_of")
+
+	checks := []*common.ValidationError{
+		common.ValidateUUID(userID, "user_id"),
+	}
+	if asOfParam != "" {
+		checks = append(checks, common.ValidateDate(asOfParam, "as_of"))
+	}
+	validationErrors := common.CollectErrors(checks...)
+	if len(validationErrors) > 0 {
+		common.WriteProblem(w, common.NewValidationProblemWithErrors(r.RequestURI, validationErrors))
+		return "", time.Time{}, false
+	}
+
+	asOf := time.Now()
+	if asOfParam != "" {
+		asOf, _ = time.Parse(time.DateOnly, asOfParam)
+	}
+	return userID, asOf, true
+}
+
+// writeStatementError maps statement service errors to problem responses.
+func writeStatementError(w http.ResponseWriter, r *http.Request, err error) {
+	var notFoundErr *domainerrors.ErrNotFound
+	if errors.As(err, &notFoundErr) {
+		common.WriteProblem(w, common.NewNotFoundProblem(err.Error(), r.RequestURI))
+		return
+	}
+	var invalidErr *domainerrors.ErrInvalidInput
+	if errors.As(err, &invalidErr) {
+		common.WriteProblem(w, common.NewValidationProblem(err.Error(), r.RequestURI))
+		return
+	}
+	common.WriteProblem(w, common.NewInternalErrorProblem(r.RequestURI))
+}
+
+func extractID(path, prefix string) string {
+	path = strings.TrimPrefix(path, prefix)
+	parts := strings.Split(path, "/")
+	if len(parts) > 0 {
+		return parts[0]
+	}
+	return ""
+}
@@ -0,0 +1,65 @@
+package statementimport
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type CreateImportProfileHandler struct {
+	service interfaces.ImportService
+}
+
+func NewCreateImportProfileHandler(service interfaces.ImportService) *CreateImportProfileHandler {
+	return &CreateImportProfileHandler{service: service}
+}
+
+// CreateImportProfile godoc
+// @Summary Create an import profile
+// @Description Save a CSV column mapping for a user under a name unique to the user, typically one per bank, to import statements with later
+// @Tags imports
+// @Accept json
+// @Produce json
+// @Param request body CreateImportProfileRequest true "Import profile creation request"
+// @Success 201 {object} ImportProfileResponse
+// @Failure 400 {object} common.ValidationProblem "Validation error or duplicate name"
+// @Failure 404 {object} common.ProblemDetail "User not found"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/import-profiles [post]
+func (h *CreateImportProfileHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		common.WriteProblem(w, common.NewMethodNotAllowedProblem(r.RequestURI))
+		return
+	}
+
+	var req CreateImportProfileRequest
+	if r.Body == nil {
+		common.WriteProblem(w, common.NewBadRequestProblem("invalid request body", r.RequestURI))
+		return
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		common.WriteProblem(w, common.NewBadRequestProblem("invalid request body", r.RequestURI))
+		return
+	}
+
+	validationErrors := common.CollectErrors(
+		common.ValidateUUID(req.UserID, "user_id"),
+		common.ValidateRequired(req.Name, "name"),
+		common.ValidateStringLength(req.Name, "name", 1, 100),
+	)
+	validationErrors = append(validationErrors, validateCSVMapping(req.Mapping)...)
+	if len(validationErrors) > 0 {
+		common.WriteProblem(w, common.NewValidationProblemWithErrors(r.RequestURI, validationErrors))
+		return
+	}
+
+	profile, err := h.service.CreateProfile(r.Context(), req.UserID, req.Name, toDomainCSVMapping(req.Mapping))
+	if err != nil {
+		writeImportError(w, r, err)
+		return
+	}
+
+	common.WriteJSON(w, http.StatusCreated, toImportProfileResponse(profile))
+}
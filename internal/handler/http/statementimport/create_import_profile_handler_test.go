@@ -0,0 +1,134 @@
+package statementimport
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/errors"
+	httptesting "accounting/internal/handler/http"
+)
+
+const testUserID = "123e4567-e89b-12d3-a456-426614174000"
+
+func testMapping() CSVMapping {
+	return CSVMapping{
+		Delimiter:         ";",
+		DateColumn:        "Booking date",
+		DateFormat:        "DD.MM.YYYY",
+		AmountSign:        constant.AmountSignSigned,
+		AmountColumn:      "Amount",
+		DescriptionColumn: "Text",
+		DecimalSeparator:  ",",
+	}
+}
+
+func TestCreateImportProfileHandlerSuccess(t *testing.T) {
+	mockService := &httptesting.MockImportService{}
+	handler := NewCreateImportProfileHandler(mockService)
+
+	req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/import-profiles", CreateImportProfileRequest{
+		UserID:  testUserID,
+		Name:    "Main bank",
+		Mapping: testMapping(),
+	})
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+
+	var response ImportProfileResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if response.Name != "Main bank" || response.UserID != testUserID {
+		t.Errorf("unexpected profile %+v", response)
+	}
+	if response.Mapping != testMapping() {
+		t.Errorf("expected mapping %+v, got %+v", testMapping(), response.Mapping)
+	}
+	if mockService.CreateProfileCalls != 1 {
+		t.Errorf("expected 1 createProfile call, got %d", mockService.CreateProfileCalls)
+	}
+}
+
+func TestCreateImportProfileHandlerValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		request CreateImportProfileRequest
+	}{
+		{"invalid user ID", CreateImportProfileRequest{UserID: "not-a-uuid", Name: "Bank", Mapping: testMapping()}},
+		{"missing name", CreateImportProfileRequest{UserID: testUserID, Mapping: testMapping()}},
+		{"missing date column", CreateImportProfileRequest{UserID: testUserID, Name: "Bank", Mapping: CSVMapping{DateFormat: "YYYY-MM-DD", AmountSign: constant.AmountSignSigned}}},
+		{"unknown amount sign", CreateImportProfileRequest{UserID: testUserID, Name: "Bank", Mapping: CSVMapping{DateColumn: "Date", DateFormat: "YYYY-MM-DD", AmountSign: "BACKWARDS"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &httptesting.MockImportService{}
+			handler := NewCreateImportProfileHandler(mockService)
+
+			req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/import-profiles", tt.request)
+			w := httptest.NewRecorder()
+
+			handler.Handle(w, req)
+
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+			}
+			if mockService.CreateProfileCalls != 0 {
+				t.Errorf("expected no createProfile call, got %d", mockService.CreateProfileCalls)
+			}
+		})
+	}
+}
+
+func TestCreateImportProfileHandlerServiceErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{"duplicate name", errors.NewErrDuplicateImportProfile(testUserID, "Bank"), http.StatusBadRequest},
+		{"invalid mapping", errors.NewErrInvalidInput("date_format", "bad format"), http.StatusBadRequest},
+		{"user not found", errors.NewErrNotFound("user", testUserID), http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &httptesting.MockImportService{LastCreateProfileErr: tt.err}
+			handler := NewCreateImportProfileHandler(mockService)
+
+			req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/import-profiles", CreateImportProfileRequest{
+				UserID:  testUserID,
+				Name:    "Bank",
+				Mapping: testMapping(),
+			})
+			w := httptest.NewRecorder()
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+		})
+	}
+}
+
+func TestCreateImportProfileHandlerMethodNotAllowed(t *testing.T) {
+	handler := NewCreateImportProfileHandler(&httptesting.MockImportService{})
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/import-profiles", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected status %d, got %d", http.StatusMethodNotAllowed, w.Code)
+	}
+}
@@ -0,0 +1,47 @@
+package statementimport
+
+import (
+	"net/http"
+
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type DeleteImportProfileHandler struct {
+	service interfaces.ImportService
+}
+
+func NewDeleteImportProfileHandler(service interfaces.ImportService) *DeleteImportProfileHandler {
+	return &DeleteImportProfileHandler{service: service}
+}
+
+// DeleteImportProfile godoc
+// @Summary Delete an import profile
+// @Description Delete a saved CSV column mapping. Transactions imported with it are kept.
+// @Tags imports
+// @Accept json
+// @Produce json
+// @Param profile_id path string true "Import profile ID (UUID)"
+// @Success 204 "Import profile deleted successfully"
+// @Failure 400 {object} common.ValidationProblem "Validation error"
+// @Failure 404 {object} common.ProblemDetail "Import profile not found"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/import-profiles/{profile_id} [delete]
+func (h *DeleteImportProfileHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodDelete {
+		common.WriteProblem(w, common.NewMethodNotAllowedProblem(r.RequestURI))
+		return
+	}
+
+	id, ok := extractProfileID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteProfile(r.Context(), id); err != nil {
+		writeImportError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,42 @@
+package statementimport
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"accounting/internal/domain/errors"
+	httptesting "accounting/internal/handler/http"
+)
+
+func TestDeleteImportProfileHandlerSuccess(t *testing.T) {
+	mockService := &httptesting.MockImportService{}
+	handler := NewDeleteImportProfileHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodDelete, "/api/v1/import-profiles/"+testProfileID, nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusNoContent {
+		t.Errorf("expected status %d, got %d", http.StatusNoContent, w.Code)
+	}
+	if mockService.DeleteProfileCalls != 1 {
+		t.Errorf("expected 1 deleteProfile call, got %d", mockService.DeleteProfileCalls)
+	}
+}
+
+func TestDeleteImportProfileHandlerNotFound(t *testing.T) {
+	handler := NewDeleteImportProfileHandler(&httptesting.MockImportService{
+		LastDeleteProfileErr: errors.NewErrNotFound("import profile", testProfileID),
+	})
+
+	req, _ := http.NewRequest(http.MethodDelete, "/api/v1/import-profiles/"+testProfileID, nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
@@ -0,0 +1,71 @@
+package statementimport
+
+import (
+	"time"
+
+	"accounting/internal/domain/constant"
+)
+
+// CSVMapping describes how the columns of a CSV statement map onto
+// transactions. Columns are referred to by their header, ignoring case.
+type CSVMapping struct {
+	// Delimiter separates fields, "," when omitted
+	Delimiter string `json:"delimiter,omitempty"`
+	// SkipRows is the number of lines before the header row
+	SkipRows   int    `json:"skip_rows,omitempty"`
+	DateColumn string `json:"date_column"`
+	// DateFormat spells the date with YYYY or YY, MM and DD, e.g. DD.MM.YYYY
+	DateFormat string              `json:"date_format"`
+	AmountSign constant.AmountSign `json:"amount_sign"`
+	// AmountColumn is required with the SIGNED and INVERTED amount signs
+	AmountColumn string `json:"amount_column,omitempty"`
+	// DebitColumn and CreditColumn are required with the DEBIT_CREDIT amount sign
+	DebitColumn       string `json:"debit_column,omitempty"`
+	CreditColumn      string `json:"credit_column,omitempty"`
+	DescriptionColumn string `json:"description_column,omitempty"`
+	// CategoryColumn names the category of each row, ignoring case. A name
+	// several of the user's categories share fails the row.
+	CategoryColumn string `json:"category_column,omitempty"`
+	// DecimalSeparator is "." (the default) or ","
+	DecimalSeparator string `json:"decimal_separator,omitempty"`
+}
+
+type CreateImportProfileRequest struct {
+	UserID  string     `json:"user_id"`
+	Name    string     `json:"name"`
+	Mapping CSVMapping `json:"mapping"`
+}
+
+type UpdateImportProfileRequest struct {
+	Name string `json:"name,omitempty"`
+	// Mapping replaces the whole stored mapping when given
+	Mapping *CSVMapping `json:"mapping,omitempty"`
+}
+
+type ImportProfileResponse struct {
+	ID        string     `json:"id"`
+	UserID    string     `json:"user_id"`
+	Name      string     `json:"name"`
+	Mapping   CSVMapping `json:"mapping"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+type ImportRowResponse struct {
+	// Line is the 1-based line of the row in the uploaded file
+	Line          int                      `json:"line"`
+	Status        constant.ImportRowStatus `json:"status"`
+	TransactionID string                   `json:"transaction_id,omitempty"`
+	Error         string                   `json:"error,omitempty"`
+}
+
+type ImportResultResponse struct {
+	AccountID string `json:"account_id"`
+	Imported  int    `json:"imported"`
+	Failed    int    `json:"failed"`
+	Skipped   int    `json:"skipped"`
+	// Duplicates counts the rows not imported because they look like a
+	// transaction already recorded on the account
+	Duplicates int                  `json:"duplicates"`
+	Rows       []*ImportRowResponse `json:"rows"`
+}
@@ -0,0 +1,52 @@
+package statementimport
+
+import (
+	"net/http"
+
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type GetImportProfileHandler struct {
+	service interfaces.ImportService
+}
+
+func NewGetImportProfileHandler(service interfaces.ImportService) *GetImportProfileHandler {
+	return &GetImportProfileHandler{service: service}
+}
+
+// GetImportProfile godoc
+// @Summary Get an import profile by ID
+// @Description Retrieve a saved CSV column mapping
+// @Tags imports
+// @Accept json
+// @Produce json
+// @Param profile_id path string true "Import profile ID (UUID)"
+// @Success 200 {object} ImportProfileResponse
+// @Failure 400 {object} common.ValidationProblem "Validation error"
+// @Failure 404 {object} common.ProblemDetail "Import profile not found"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/import-profiles/{profile_id} [get]
+func (h *GetImportProfileHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		common.WriteProblem(w, common.NewMethodNotAllowedProblem(r.RequestURI))
+		return
+	}
+
+	id, ok := extractProfileID(w, r)
+	if !ok {
+		return
+	}
+
+	profile, err := h.service.GetProfile(r.Context(), id)
+	if err != nil {
+		writeImportError(w, r, err)
+		return
+	}
+	if profile == nil {
+		common.WriteProblem(w, common.NewNotFoundProblem("import profile not found", r.RequestURI))
+		return
+	}
+
+	common.WriteJSON(w, http.StatusOK, toImportProfileResponse(profile))
+}
@@ -0,0 +1,72 @@
+package statementimport
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"accounting/internal/domain/entity"
+	httptesting "accounting/internal/handler/http"
+)
+
+const testProfileID = "223e4567-e89b-12d3-a456-426614174000"
+
+func TestGetImportProfileHandlerSuccess(t *testing.T) {
+	mockService := &httptesting.MockImportService{
+		ProfileToReturn: &entity.ImportProfile{
+			ID:      testProfileID,
+			UserID:  testUserID,
+			Name:    "Main bank",
+			Mapping: toDomainCSVMapping(testMapping()),
+		},
+	}
+	handler := NewGetImportProfileHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/import-profiles/"+testProfileID, nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var response ImportProfileResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if response.ID != testProfileID || response.Mapping.DateFormat != "DD.MM.YYYY" {
+		t.Errorf("unexpected profile %+v", response)
+	}
+}
+
+func TestGetImportProfileHandlerNotFound(t *testing.T) {
+	handler := NewGetImportProfileHandler(&httptesting.MockImportService{})
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/import-profiles/"+testProfileID, nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestGetImportProfileHandlerInvalidID(t *testing.T) {
+	mockService := &httptesting.MockImportService{}
+	handler := NewGetImportProfileHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/import-profiles/not-a-uuid", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+	if mockService.GetProfileCalls != 0 {
+		t.Errorf("expected no getProfile call, got %d", mockService.GetProfileCalls)
+	}
+}
@@ -0,0 +1,172 @@
+package statementimport
+
+import (
+	"encoding/json"
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/handler/http/common"
+)
+
+func toImportProfileResponse(profile *entity.ImportProfile) *ImportProfileResponse {
+	return &ImportProfileResponse{
+		ID:        profile.ID,
+		UserID:    profile.UserID,
+		Name:      profile.Name,
+		Mapping:   toCSVMappingResponse(profile.Mapping),
+		CreatedAt: profile.CreatedAt,
+		UpdatedAt: profile.UpdatedAt,
+	}
+}
+
+func toCSVMappingResponse(mapping entity.CSVMapping) CSVMapping {
+	return CSVMapping{
+		Delimiter:         mapping.Delimiter,
+		SkipRows:          mapping.SkipRows,
+		DateColumn:        mapping.DateColumn,
+		DateFormat:        mapping.DateFormat,
+		AmountSign:        mapping.AmountSign,
+		AmountColumn:      mapping.AmountColumn,
+		DebitColumn:       mapping.DebitColumn,
+		CreditColumn:      mapping.CreditColumn,
+		DescriptionColumn: mapping.DescriptionColumn,
+		CategoryColumn:    mapping.CategoryColumn,
+		DecimalSeparator:  mapping.DecimalSeparator,
+	}
+}
+
+func toDomainCSVMapping(mapping CSVMapping) entity.CSVMapping {
+	return entity.CSVMapping{
+		Delimiter:         mapping.Delimiter,
+		SkipRows:          mapping.SkipRows,
+		DateColumn:        mapping.DateColumn,
+		DateFormat:        mapping.DateFormat,
+		AmountSign:        mapping.AmountSign,
+		AmountColumn:      mapping.AmountColumn,
+		DebitColumn:       mapping.DebitColumn,
+		CreditColumn:      mapping.CreditColumn,
+		DescriptionColumn: mapping.DescriptionColumn,
+		CategoryColumn:    mapping.CategoryColumn,
+		DecimalSeparator:  mapping.DecimalSeparator,
+	}
+}
+
+func toImportResultResponse(result *entity.ImportResult) *ImportResultResponse {
+	response := &ImportResultResponse{
+		AccountID:  result.AccountID,
+		Imported:   result.Imported,
+		Failed:     result.Failed,
+		Skipped:    result.Skipped,
+		Duplicates: result.Duplicates,
+		Rows:       make([]*ImportRowResponse, 0, len(result.Rows)),
+	}
+	for _, row := range result.Rows {
+		response.Rows = append(response.Rows, &ImportRowResponse{
+			Line:          row.Line,
+			Status:        row.Status,
+			TransactionID: row.TransactionID,
+			Error:         row.Error,
+		})
+	}
+	return response
+}
+
+// validateCSVMapping checks the fields of a mapping every amount sign needs;
+// the service checks the rest.
+func validateCSVMapping(mapping CSVMapping) []common.ValidationError {
+	return common.CollectErrors(
+		common.ValidateRequired(mapping.DateColumn, "mapping.date_column"),
+		common.ValidateRequired(mapping.DateFormat, "mapping.date_format"),
+		common.ValidateEnum(string(mapping.AmountSign), amountSignNames(), "mapping.amount_sign"),
+	)
+}
+
+// amountSignNames returns the accepted values of the amount_sign field.
+func amountSignNames() []string {
+	names := make([]string, 0, len(constant.AmountSigns))
+	for _, sign := range constant.AmountSigns {
+		names = append(names, string(sign))
+	}
+	return names
+}
+
+// parseCSVMappingFields reads the form fields choosing how a CSV statement is
+// read: the ID of a saved profile or a mapping given as JSON, but not both.
+func parseCSVMappingFields(profileID, mappingValue string) (*entity.CSVMapping, []common.ValidationError) {
+	switch {
+	case profileID != "" && mappingValue != "":
+		return nil, []common.ValidationError{{Field: "mapping", Message: "give either profile_id or mapping, not both"}}
+	case profileID != "":
+		return nil, common.CollectErrors(common.ValidateUUID(profileID, "profile_id"))
+	case mappingValue != "":
+		var req CSVMapping
+		if err := json.Unmarshal([]byte(mappingValue), &req); err != nil {
+			return nil, []common.ValidationError{{Field: "mapping", Message: "mapping must be a JSON object"}}
+		}
+		mapping := toDomainCSVMapping(req)
+		return &mapping, validateCSVMapping(req)
+	default:
+		return nil, []common.ValidationError{{Field: "profile_id", Message: "profile_id or mapping is required"}}
+	}
+}
+
+// detectStatementFormat guesses the format of an uploaded statement from its file name.
+func detectStatementFormat(file *multipart.FileHeader) string {
+	if file != nil {
+		switch strings.ToLower(filepath.Ext(file.Filename)) {
+		case ".ofx", ".qfx":
+			return statementFormatOFX
+		case ".xml":
+			return statementFormatCamt053
+		case ".sta", ".mt940", ".940":
+			return statementFormatMT940
+		}
+	}
+	return statementFormatCSV
+}
+
+// writeImportError maps import service errors to problem responses.
+func writeImportError(w http.ResponseWriter, r *http.Request, err error) {
+	var dupErr *domainerrors.ErrDuplicateImportProfile
+	if errors.As(err, &dupErr) {
+		common.WriteProblem(w, common.NewValidationProblem(err.Error(), r.RequestURI))
+		return
+	}
+	var notFoundErr *domainerrors.ErrNotFound
+	if errors.As(err, &notFoundErr) {
+		common.WriteProblem(w, common.NewNotFoundProblem(err.Error(), r.RequestURI))
+		return
+	}
+	var invalidErr *domainerrors.ErrInvalidInput
+	if errors.As(err, &invalidErr) {
+		common.WriteProblem(w, common.NewValidationProblem(err.Error(), r.RequestURI))
+		return
+	}
+	common.WriteProblem(w, common.NewInternalErrorProblem(r.RequestURI))
+}
+
+// extractProfileID reads and validates the profile ID of an
+// /api/v1/import-profiles/{id} request, writing a problem if it is invalid.
+func extractProfileID(w http.ResponseWriter, r *http.Request) (string, bool) {
+	id := extractID(r.URL.Path, "/api/v1/import-profiles/")
+	if err := common.ValidateUUID(id, "profile_id"); err != nil {
+		common.WriteProblem(w, common.NewValidationProblemWithErrors(r.RequestURI, common.CollectErrors(err)))
+		return "", false
+	}
+	return id, true
+}
+
+func extractID(path, prefix string) string {
+	path = strings.TrimPrefix(path, prefix)
+	parts := strings.Split(path, "/")
+	if len(parts) > 0 {
+		return parts[0]
+	}
+	return ""
+}
@@ -0,0 +1,130 @@
+package statementimport
+
+import (
+	"errors"
+	"net/http"
+
+	"accounting/internal/domain/entity"
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+const (
+	// maxStatementSize caps the size of an uploaded statement, form fields included
+	maxStatementSize = 10 << 20
+	// maxStatementMemory is the part of an upload kept in memory rather than a temporary file
+	maxStatementMemory = 1 << 20
+)
+
+// Statement formats the import endpoint reads
+const (
+	statementFormatCSV     = "csv"
+	statementFormatOFX     = "ofx"
+	statementFormatCamt053 = "camt053"
+	statementFormatMT940   = "mt940"
+)
+
+var statementFormats = []string{statementFormatCSV, statementFormatOFX, statementFormatCamt053, statementFormatMT940}
+
+type ImportStatementHandler struct {
+	service interfaces.ImportService
+}
+
+func NewImportStatementHandler(service interfaces.ImportService) *ImportStatementHandler {
+	return &ImportStatementHandler{service: service}
+}
+
+// ImportStatement godoc
+// @Summary Import a bank statement
+// @Description Upload a bank statement and create a transaction in the account for each of its rows. Rows that cannot be imported are reported without stopping the others; the response gives the outcome of every row.
+// @Description CSV statements are read with a saved import profile or with a mapping given inline.
+// @Description OFX and QFX statements need no mapping. Transactions whose FITID was imported into the account before are skipped, so a statement can be imported again without duplicates.
+// @Description ISO 20022 camt.053 and SWIFT MT940 statements need no mapping either. Transactions are dated on their booking date, or on their value date when the bank gives no booking date; pending camt.053 entries are not imported. Amounts in another currency than the account's are converted, and transactions whose bank reference was imported before are skipped.
+// @Tags imports
+// @Accept multipart/form-data
+// @Produce json
+// @Param account_id path string true "Account ID (UUID)"
+// @Param file formData file true "Statement, at most 10 MiB"
+// @Param format formData string false "Statement format; by default ofx for .ofx and .qfx files, camt053 for .xml files, mt940 for .sta, .mt940 and .940 files and csv otherwise" Enums(csv, ofx, camt053, mt940)
+// @Param profile_id formData string false "CSV only: ID of the saved import profile to read the file with"
+// @Param mapping formData string false "CSV only: CSVMapping as JSON, when no profile_id is given"
+// @Success 200 {object} ImportResultResponse
+// @Failure 400 {object} common.ValidationProblem "Validation error or unreadable file"
+// @Failure 404 {object} common.ProblemDetail "Account or import profile not found"
+// @Failure 413 {object} common.ProblemDetail "Statement too large"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/accounts/{account_id}/imports [post]
+func (h *ImportStatementHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		common.WriteProblem(w, common.NewMethodNotAllowedProblem(r.RequestURI))
+		return
+	}
+
+	accountID := extractID(r.URL.Path, "/api/v1/accounts/")
+	if err := common.ValidateUUID(accountID, "account_id"); err != nil {
+		common.WriteProblem(w, common.NewValidationProblemWithErrors(r.RequestURI, common.CollectErrors(err)))
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxStatementSize)
+	if err := r.ParseMultipartForm(maxStatementMemory); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			common.WriteProblem(w, common.NewTooLargeProblem("the statement must not exceed 10 MiB", r.RequestURI))
+			return
+		}
+		common.WriteProblem(w, common.NewBadRequestProblem("the request must be a multipart/form-data upload", r.RequestURI))
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	var validationErrors []common.ValidationError
+	file, fileHeader, err := r.FormFile("file")
+	if err != nil {
+		validationErrors = append(validationErrors, common.ValidationError{Field: "file", Message: "file is required"})
+	} else {
+		defer file.Close()
+	}
+
+	format := r.FormValue("format")
+	if format == "" {
+		format = detectStatementFormat(fileHeader)
+	}
+	profileID := r.FormValue("profile_id")
+	mappingValue := r.FormValue("mapping")
+	var mapping *entity.CSVMapping
+	switch format {
+	case statementFormatCSV:
+		var mappingErrors []common.ValidationError
+		mapping, mappingErrors = parseCSVMappingFields(profileID, mappingValue)
+		validationErrors = append(validationErrors, mappingErrors...)
+	case statementFormatOFX, statementFormatCamt053, statementFormatMT940:
+		if profileID != "" || mappingValue != "" {
+			validationErrors = append(validationErrors, common.ValidationError{Field: "format", Message: "profile_id and mapping only apply to CSV statements"})
+		}
+	default:
+		validationErrors = append(validationErrors, common.CollectErrors(common.ValidateEnum(format, statementFormats, "format"))...)
+	}
+	if len(validationErrors) > 0 {
+		common.WriteProblem(w, common.NewValidationProblemWithErrors(r.RequestURI, validationErrors))
+		return
+	}
+
+	var result *entity.ImportResult
+	switch format {
+	case statementFormatOFX:
+		result, err = h.service.ImportOFX(r.Context(), accountID, file)
+	case statementFormatCamt053:
+		result, err = h.service.ImportCamt053(r.Context(), accountID, file)
+	case statementFormatMT940:
+		result, err = h.service.ImportMT940(r.Context(), accountID, file)
+	default:
+		result, err = h.service.ImportCSV(r.Context(), accountID, profileID, mapping, file)
+	}
+	if err != nil {
+		writeImportError(w, r, err)
+		return
+	}
+
+	common.WriteJSON(w, http.StatusOK, toImportResultResponse(result))
+}
@@ -0,0 +1,258 @@
+package statementimport
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	"accounting/internal/domain/errors"
+	httptesting "accounting/internal/handler/http"
+)
+
+const (
+	testAccountID = "323e4567-e89b-12d3-a456-426614174000"
+	testImportURL = "/api/v1/accounts/" + testAccountID + "/imports"
+	testStatement = "Booking date;Amount;Text\n01.03.2024;-12,50;Coffee\n"
+)
+
+func TestImportStatementHandlerWithProfile(t *testing.T) {
+	mockService := &httptesting.MockImportService{
+		ImportResultToReturn: &entity.ImportResult{
+			AccountID: testAccountID,
+			Imported:  1,
+			Failed:    1,
+			Rows: []*entity.ImportRow{
+				{Line: 2, Status: constant.ImportRowStatusImported, TransactionID: "transaction-1"},
+				{Line: 3, Status: constant.ImportRowStatusFailed, Error: "the amount is zero"},
+			},
+		},
+	}
+	handler := NewImportStatementHandler(mockService)
+
+	req := httptesting.NewMultipartTestRequest(http.MethodPost, testImportURL, map[string]string{"profile_id": testProfileID}, "statement.csv", testStatement)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+
+	var response ImportResultResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if response.Imported != 1 || response.Failed != 1 || len(response.Rows) != 2 {
+		t.Fatalf("unexpected result %+v", response)
+	}
+	if response.Rows[0].TransactionID != "transaction-1" || response.Rows[1].Error != "the amount is zero" {
+		t.Errorf("unexpected rows %+v, %+v", response.Rows[0], response.Rows[1])
+	}
+
+	if mockService.LastProfileID != testProfileID || mockService.LastMapping != nil {
+		t.Errorf("expected profile %s without a mapping, got %q and %+v", testProfileID, mockService.LastProfileID, mockService.LastMapping)
+	}
+	if mockService.LastData != testStatement {
+		t.Errorf("expected the uploaded file to be passed, got %q", mockService.LastData)
+	}
+}
+
+func TestImportStatementHandlerWithMapping(t *testing.T) {
+	mockService := &httptesting.MockImportService{}
+	handler := NewImportStatementHandler(mockService)
+
+	mapping, _ := json.Marshal(testMapping())
+	req := httptesting.NewMultipartTestRequest(http.MethodPost, testImportURL, map[string]string{"mapping": string(mapping)}, "statement.csv", testStatement)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	if mockService.LastMapping == nil || *mockService.LastMapping != toDomainCSVMapping(testMapping()) {
+		t.Errorf("expected mapping %+v, got %+v", testMapping(), mockService.LastMapping)
+	}
+}
+
+func TestImportStatementHandlerOFX(t *testing.T) {
+	tests := []struct {
+		name     string
+		fields   map[string]string
+		filename string
+	}{
+		{"detected from .ofx", nil, "statement.ofx"},
+		{"detected from .QFX", nil, "STATEMENT.QFX"},
+		{"explicit format", map[string]string{"format": "ofx"}, "download.txt"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &httptesting.MockImportService{
+				ImportResultToReturn: &entity.ImportResult{
+					AccountID: testAccountID,
+					Skipped:   1,
+					Rows:      []*entity.ImportRow{{Line: 12, Status: constant.ImportRowStatusSkipped, TransactionID: "transaction-1"}},
+				},
+			}
+			handler := NewImportStatementHandler(mockService)
+
+			req := httptesting.NewMultipartTestRequest(http.MethodPost, testImportURL, tt.fields, tt.filename, "<OFX></OFX>")
+			w := httptest.NewRecorder()
+
+			handler.Handle(w, req)
+
+			if w.Code != http.StatusOK {
+				t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+			}
+			if mockService.ImportOFXCalls != 1 || mockService.ImportCSVCalls != 0 {
+				t.Errorf("expected 1 importOFX call, got %d importOFX and %d importCSV calls", mockService.ImportOFXCalls, mockService.ImportCSVCalls)
+			}
+
+			var response ImportResultResponse
+			if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if response.Skipped != 1 || response.Rows[0].Status != constant.ImportRowStatusSkipped {
+				t.Errorf("unexpected result %+v", response)
+			}
+		})
+	}
+}
+
+func TestImportStatementHandlerBankFormats(t *testing.T) {
+	tests := []struct {
+		name        string
+		fields      map[string]string
+		filename    string
+		wantCamt053 int
+		wantMT940   int
+	}{
+		{"camt.053 detected from .xml", nil, "camt053.xml", 1, 0},
+		{"camt.053 explicit format", map[string]string{"format": "camt053"}, "statement.dat", 1, 0},
+		{"MT940 detected from .sta", nil, "statement.sta", 0, 1},
+		{"MT940 detected from .940", nil, "STATEMENT.940", 0, 1},
+		{"MT940 explicit format", map[string]string{"format": "mt940"}, "statement.txt", 0, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &httptesting.MockImportService{}
+			handler := NewImportStatementHandler(mockService)
+
+			req := httptesting.NewMultipartTestRequest(http.MethodPost, testImportURL, tt.fields, tt.filename, "statement")
+			w := httptest.NewRecorder()
+
+			handler.Handle(w, req)
+
+			if w.Code != http.StatusOK {
+				t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+			}
+			if mockService.ImportCamt053Calls != tt.wantCamt053 || mockService.ImportMT940Calls != tt.wantMT940 || mockService.ImportCSVCalls != 0 {
+				t.Errorf("expected %d importCamt053 and %d importMT940 calls, got %d importCamt053, %d importMT940 and %d importCSV calls",
+					tt.wantCamt053, tt.wantMT940, mockService.ImportCamt053Calls, mockService.ImportMT940Calls, mockService.ImportCSVCalls)
+			}
+			if mockService.LastData != "statement" {
+				t.Errorf("expected the uploaded statement, got %q", mockService.LastData)
+			}
+		})
+	}
+}
+
+func TestImportStatementHandlerValidation(t *testing.T) {
+	mapping, _ := json.Marshal(testMapping())
+	tests := []struct {
+		name     string
+		url      string
+		fields   map[string]string
+		filename string
+	}{
+		{"invalid account ID", "/api/v1/accounts/not-a-uuid/imports", map[string]string{"profile_id": testProfileID}, "statement.csv"},
+		{"missing file", testImportURL, map[string]string{"profile_id": testProfileID}, ""},
+		{"no profile or mapping", testImportURL, nil, "statement.csv"},
+		{"both profile and mapping", testImportURL, map[string]string{"profile_id": testProfileID, "mapping": string(mapping)}, "statement.csv"},
+		{"invalid profile ID", testImportURL, map[string]string{"profile_id": "not-a-uuid"}, "statement.csv"},
+		{"malformed mapping", testImportURL, map[string]string{"mapping": "{"}, "statement.csv"},
+		{"incomplete mapping", testImportURL, map[string]string{"mapping": `{"date_column":"Date"}`}, "statement.csv"},
+		{"unknown format", testImportURL, map[string]string{"format": "pdf"}, "statement.pdf"},
+		{"profile with OFX", testImportURL, map[string]string{"profile_id": testProfileID}, "statement.ofx"},
+		{"mapping with MT940", testImportURL, map[string]string{"mapping": string(mapping)}, "statement.sta"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &httptesting.MockImportService{}
+			handler := NewImportStatementHandler(mockService)
+
+			req := httptesting.NewMultipartTestRequest(http.MethodPost, tt.url, tt.fields, tt.filename, testStatement)
+			w := httptest.NewRecorder()
+
+			handler.Handle(w, req)
+
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+			}
+			if calls := mockService.ImportCSVCalls + mockService.ImportOFXCalls + mockService.ImportCamt053Calls + mockService.ImportMT940Calls; calls != 0 {
+				t.Errorf("expected no import call, got %d", calls)
+			}
+		})
+	}
+}
+
+func TestImportStatementHandlerNotMultipart(t *testing.T) {
+	handler := NewImportStatementHandler(&httptesting.MockImportService{})
+
+	req := httptesting.NewTestRequest(http.MethodPost, testImportURL, map[string]string{"profile_id": testProfileID})
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestImportStatementHandlerTooLarge(t *testing.T) {
+	handler := NewImportStatementHandler(&httptesting.MockImportService{})
+
+	content := strings.Repeat("01.03.2024;-12,50;Coffee\n", maxStatementSize/20)
+	req := httptesting.NewMultipartTestRequest(http.MethodPost, testImportURL, map[string]string{"profile_id": testProfileID}, "statement.csv", content)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("expected status %d, got %d", http.StatusRequestEntityTooLarge, w.Code)
+	}
+}
+
+func TestImportStatementHandlerServiceErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+	}{
+		{"account not found", errors.NewErrNotFound("account", testAccountID), http.StatusNotFound},
+		{"profile not found", errors.NewErrNotFound("import profile", testProfileID), http.StatusNotFound},
+		{"missing column", errors.NewErrInvalidInput("file", `the header row has no column "Amount"`), http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := NewImportStatementHandler(&httptesting.MockImportService{LastImportCSVErr: tt.err})
+
+			req := httptesting.NewMultipartTestRequest(http.MethodPost, testImportURL, map[string]string{"profile_id": testProfileID}, "statement.csv", testStatement)
+			w := httptest.NewRecorder()
+
+			handler.Handle(w, req)
+
+			if w.Code != tt.wantStatus {
+				t.Errorf("expected status %d, got %d", tt.wantStatus, w.Code)
+			}
+		})
+	}
+}
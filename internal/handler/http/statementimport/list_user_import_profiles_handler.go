@@ -0,0 +1,54 @@
+package statementimport
+
+import (
+	"net/http"
+
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type ListUserImportProfilesHandler struct {
+	service interfaces.ImportService
+}
+
+func NewListUserImportProfilesHandler(service interfaces.ImportService) *ListUserImportProfilesHandler {
+	return &ListUserImportProfilesHandler{service: service}
+}
+
+// ListUserImportProfiles godoc
+// @Summary List a user's import profiles
+// @Description Retrieve every CSV column mapping a user has saved, ordered by name
+// @Tags imports
+// @Accept json
+// @Produce json
+// @Param user_id path string true "User ID (UUID)"
+// @Success 200 {array} ImportProfileResponse
+// @Failure 400 {object} common.ValidationProblem "Validation error"
+// @Failure 404 {object} common.ProblemDetail "User not found"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/users/{user_id}/import-profiles [get]
+func (h *ListUserImportProfilesHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		common.WriteProblem(w, common.NewMethodNotAllowedProblem(r.RequestURI))
+		return
+	}
+
+	userID := extractID(r.URL.Path, "/api/v1/users/")
+	if err := common.ValidateUUID(userID, "user_id"); err != nil {
+		common.WriteProblem(w, common.NewValidationProblemWithErrors(r.RequestURI, common.CollectErrors(err)))
+		return
+	}
+
+	profiles, err := h.service.ListUserProfiles(r.Context(), userID)
+	if err != nil {
+		writeImportError(w, r, err)
+		return
+	}
+
+	response := make([]*ImportProfileResponse, 0, len(profiles))
+	for _, profile := range profiles {
+		response = append(response, toImportProfileResponse(profile))
+	}
+
+	common.WriteJSON(w, http.StatusOK, response)
+}
@@ -0,0 +1,70 @@
+package statementimport
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"accounting/internal/domain/entity"
+	"accounting/internal/domain/errors"
+	httptesting "accounting/internal/handler/http"
+)
+
+func TestListUserImportProfilesHandlerSuccess(t *testing.T) {
+	mockService := &httptesting.MockImportService{
+		ProfilesToReturn: []*entity.ImportProfile{
+			{ID: "profile-1", UserID: testUserID, Name: "Card"},
+			{ID: "profile-2", UserID: testUserID, Name: "Main bank"},
+		},
+	}
+	handler := NewListUserImportProfilesHandler(mockService)
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/users/"+testUserID+"/import-profiles", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var response []*ImportProfileResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(response) != 2 || response[1].Name != "Main bank" {
+		t.Errorf("unexpected profiles %+v", response)
+	}
+}
+
+func TestListUserImportProfilesHandlerEmpty(t *testing.T) {
+	handler := NewListUserImportProfilesHandler(&httptesting.MockImportService{})
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/users/"+testUserID+"/import-profiles", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if body := w.Body.String(); body != "[]\n" {
+		t.Errorf("expected an empty array, got %q", body)
+	}
+}
+
+func TestListUserImportProfilesHandlerUserNotFound(t *testing.T) {
+	handler := NewListUserImportProfilesHandler(&httptesting.MockImportService{
+		LastListUserProfilesErr: errors.NewErrNotFound("user", testUserID),
+	})
+
+	req, _ := http.NewRequest(http.MethodGet, "/api/v1/users/"+testUserID+"/import-profiles", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
@@ -0,0 +1,78 @@
+package statementimport
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"accounting/internal/domain/entity"
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type UpdateImportProfileHandler struct {
+	service interfaces.ImportService
+}
+
+func NewUpdateImportProfileHandler(service interfaces.ImportService) *UpdateImportProfileHandler {
+	return &UpdateImportProfileHandler{service: service}
+}
+
+// UpdateImportProfile godoc
+// @Summary Update an import profile
+// @Description Rename an import profile or replace its mapping. Omitted fields keep their stored value; a given mapping replaces the stored one as a whole.
+// @Tags imports
+// @Accept json
+// @Produce json
+// @Param profile_id path string true "Import profile ID (UUID)"
+// @Param request body UpdateImportProfileRequest true "Import profile update request"
+// @Success 200 {object} ImportProfileResponse
+// @Failure 400 {object} common.ValidationProblem "Validation error or duplicate name"
+// @Failure 404 {object} common.ProblemDetail "Import profile not found"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/import-profiles/{profile_id} [put]
+func (h *UpdateImportProfileHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPut {
+		common.WriteProblem(w, common.NewMethodNotAllowedProblem(r.RequestURI))
+		return
+	}
+
+	id, ok := extractProfileID(w, r)
+	if !ok {
+		return
+	}
+
+	var req UpdateImportProfileRequest
+	if r.Body == nil {
+		common.WriteProblem(w, common.NewBadRequestProblem("invalid request body", r.RequestURI))
+		return
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		common.WriteProblem(w, common.NewBadRequestProblem("invalid request body", r.RequestURI))
+		return
+	}
+
+	var validationErrors []common.ValidationError
+	if req.Name != "" {
+		validationErrors = append(validationErrors, common.CollectErrors(
+			common.ValidateStringLength(req.Name, "name", 1, 100),
+		)...)
+	}
+	var mapping *entity.CSVMapping
+	if req.Mapping != nil {
+		validationErrors = append(validationErrors, validateCSVMapping(*req.Mapping)...)
+		domainMapping := toDomainCSVMapping(*req.Mapping)
+		mapping = &domainMapping
+	}
+	if len(validationErrors) > 0 {
+		common.WriteProblem(w, common.NewValidationProblemWithErrors(r.RequestURI, validationErrors))
+		return
+	}
+
+	profile, err := h.service.UpdateProfile(r.Context(), id, req.Name, mapping)
+	if err != nil {
+		writeImportError(w, r, err)
+		return
+	}
+
+	common.WriteJSON(w, http.StatusOK, toImportProfileResponse(profile))
+}
@@ -0,0 +1,77 @@
+package statementimport
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"accounting/internal/domain/errors"
+	httptesting "accounting/internal/handler/http"
+)
+
+func TestUpdateImportProfileHandlerRename(t *testing.T) {
+	mockService := &httptesting.MockImportService{}
+	handler := NewUpdateImportProfileHandler(mockService)
+
+	req := httptesting.NewTestRequest(http.MethodPut, "/api/v1/import-profiles/"+testProfileID, UpdateImportProfileRequest{Name: "Savings"})
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if mockService.LastMapping != nil {
+		t.Errorf("expected the mapping to be left unchanged, got %+v", mockService.LastMapping)
+	}
+}
+
+func TestUpdateImportProfileHandlerMapping(t *testing.T) {
+	mockService := &httptesting.MockImportService{}
+	handler := NewUpdateImportProfileHandler(mockService)
+
+	mapping := testMapping()
+	req := httptesting.NewTestRequest(http.MethodPut, "/api/v1/import-profiles/"+testProfileID, UpdateImportProfileRequest{Mapping: &mapping})
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if mockService.LastMapping == nil || mockService.LastMapping.DateColumn != "Booking date" {
+		t.Errorf("expected the new mapping to be passed, got %+v", mockService.LastMapping)
+	}
+}
+
+func TestUpdateImportProfileHandlerInvalidMapping(t *testing.T) {
+	mockService := &httptesting.MockImportService{}
+	handler := NewUpdateImportProfileHandler(mockService)
+
+	req := httptesting.NewTestRequest(http.MethodPut, "/api/v1/import-profiles/"+testProfileID, UpdateImportProfileRequest{Mapping: &CSVMapping{DateColumn: "Date"}})
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+	if mockService.UpdateProfileCalls != 0 {
+		t.Errorf("expected no updateProfile call, got %d", mockService.UpdateProfileCalls)
+	}
+}
+
+func TestUpdateImportProfileHandlerNotFound(t *testing.T) {
+	handler := NewUpdateImportProfileHandler(&httptesting.MockImportService{
+		LastUpdateProfileErr: errors.NewErrNotFound("import profile", testProfileID),
+	})
+
+	req := httptesting.NewTestRequest(http.MethodPut, "/api/v1/import-profiles/"+testProfileID, UpdateImportProfileRequest{Name: "Savings"})
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
@@ -4,41 +4,131 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"time"
 
 	"accounting/internal/domain/constant"
 	"accounting/internal/domain/entity"
+	"accounting/internal/domain/money"
 )
 
 // UserServicer defines the interface for user service operations
 type UserServicer interface {
-	CreateUser(ctx context.Context, name, email string) (*entity.User, error)
+	CreateUser(ctx context.Context, name, email, baseCurrency string) (*entity.User, error)
 	GetUser(ctx context.Context, id string) (*entity.User, error)
 	GetUserByEmail(ctx context.Context, email string) (*entity.User, error)
-	UpdateUser(ctx context.Context, id, name, email string) (*entity.User, error)
+	UpdateUser(ctx context.Context, id, name, email, baseCurrency string) (*entity.User, error)
 	DeleteUser(ctx context.Context, id string) error
 }
 
 // AccountServicer defines the interface for account service operations
 type AccountServicer interface {
-	CreateAccount(ctx context.Context, userID, name string, accountType constant.AccountType, currency string) (*entity.Account, error)
+	CreateAccount(ctx context.Context, userID, name string, accountType constant.AccountType, currency, parentID, code string) (*entity.Account, error)
 	GetAccount(ctx context.Context, id string) (*entity.Account, error)
-	ListUserAccounts(ctx context.Context, userID string) ([]*entity.Account, error)
-	UpdateAccount(ctx context.Context, id, name string, accountType constant.AccountType, currency string) (*entity.Account, error)
+	ListUserAccounts(ctx context.Context, userID string, page entity.PageRequest) ([]*entity.Account, string, error)
+	GetAccountTree(ctx context.Context, userID, baseCurrency string) ([]*entity.AccountNode, error)
+	UpdateAccount(ctx context.Context, id, name string, accountType constant.AccountType, currency, parentID, code string) (*entity.Account, error)
 	DeleteAccount(ctx context.Context, id string) error
 }
 
 // TransactionServicer defines the interface for transaction service operations
 type TransactionServicer interface {
-	CreateTransaction(ctx context.Context, accountID string, amount float64, currency, description, category string, transactionType constant.TransactionType, date time.Time) (*entity.Transaction, error)
+	CreateTransaction(ctx context.Context, accountID string, amount money.Money, description, payeeID, categoryID string, tags []string, splits []*entity.Split, transactionType constant.TransactionType, date time.Time, allowDuplicate bool) (*entity.Transaction, error)
+	CreateImportedTransaction(ctx context.Context, accountID, externalID string, amount money.Money, description, payeeID, categoryID string, tags []string, splits []*entity.Split, transactionType constant.TransactionType, date time.Time, allowDuplicate bool) (*entity.Transaction, error)
+	FindDuplicates(ctx context.Context, accountID, externalID string, amount money.Money, description string, transactionType constant.TransactionType, date time.Time) ([]*entity.Transaction, error)
 	GetTransaction(ctx context.Context, id string) (*entity.Transaction, error)
-	ListAccountTransactions(ctx context.Context, accountID string) ([]*entity.Transaction, error)
-	UpdateTransaction(ctx context.Context, id string, amount float64, currency, description, category string, transactionType constant.TransactionType, date time.Time) (*entity.Transaction, error)
+	ListAccountTransactions(ctx context.Context, accountID string, filter entity.TransactionFilter, page entity.PageRequest) ([]*entity.Transaction, string, error)
+	UpdateTransaction(ctx context.Context, id, accountID string, amount money.Money, description, payeeID, categoryID string, tags []string, splits []*entity.Split, transactionType constant.TransactionType, date time.Time) (*entity.Transaction, error)
 	DeleteTransaction(ctx context.Context, id string) error
 }
 
+// TransferServicer defines the interface for transfer service operations
+type TransferServicer interface {
+	CreateTransfer(ctx context.Context, fromAccountID, toAccountID string, amount money.Money, description string, date time.Time) (*entity.Transfer, error)
+	GetTransfer(ctx context.Context, id string) (*entity.Transfer, error)
+}
+
+// JournalServicer defines the interface for journal service operations
+type JournalServicer interface {
+	CreateEntry(ctx context.Context, userID string, date time.Time, description string, postings []*entity.Posting) (*entity.JournalEntry, error)
+	GetEntry(ctx context.Context, id string) (*entity.JournalEntry, error)
+	ListUserEntries(ctx context.Context, userID string) ([]*entity.JournalEntry, error)
+	DeleteEntry(ctx context.Context, id string) error
+	PreviewTransactionEntry(ctx context.Context, transactionID, categoryAccountID string) (*entity.JournalEntry, error)
+}
+
+// ExchangeRateServicer defines the interface for exchange rate service operations
+type ExchangeRateServicer interface {
+	SetRates(ctx context.Context, rates []*entity.ExchangeRate) error
+	GetRate(ctx context.Context, baseCurrency, quoteCurrency string, date time.Time) (*entity.ExchangeRate, error)
+	Convert(ctx context.Context, amount money.Money, currency string, date time.Time) (money.Money, money.Rate, error)
+}
+
+// BalanceServicer defines the interface for balance service operations
+type BalanceServicer interface {
+	GetBalanceAsOf(ctx context.Context, accountID string, day time.Time) (*entity.DailyBalance, error)
+	GetDailyBalances(ctx context.Context, accountID string, from, to time.Time) ([]*entity.DailyBalance, error)
+}
+
+// ReportServicer defines the interface for report service operations
+type ReportServicer interface {
+	GetSummary(ctx context.Context, userID string, from, to time.Time, granularity constant.Granularity, baseCurrency string) (*entity.Summary, error)
+	GetTagReport(ctx context.Context, userID string, from, to time.Time) (*entity.TagReport, error)
+	GetPayeeReport(ctx context.Context, userID string, from, to time.Time) (*entity.PayeeReport, error)
+	GetNetWorth(ctx context.Context, userID string, from, to time.Time) (*entity.NetWorth, error)
+}
+
+// StatementServicer defines the interface for financial statement operations
+type StatementServicer interface {
+	GetTrialBalance(ctx context.Context, userID string, asOf time.Time) (*entity.TrialBalance, error)
+	GetBalanceSheet(ctx context.Context, userID string, asOf time.Time) (*entity.BalanceSheet, error)
+	GetIncomeStatement(ctx context.Context, userID string, from, to time.Time) (*entity.IncomeStatement, error)
+}
+
+// ImportServicer defines the interface for statement import operations
+type ImportServicer interface {
+	CreateProfile(ctx context.Context, userID, name string, mapping entity.CSVMapping) (*entity.ImportProfile, error)
+	GetProfile(ctx context.Context, id string) (*entity.ImportProfile, error)
+	ListUserProfiles(ctx context.Context, userID string) ([]*entity.ImportProfile, error)
+	UpdateProfile(ctx context.Context, id, name string, mapping *entity.CSVMapping) (*entity.ImportProfile, error)
+	DeleteProfile(ctx context.Context, id string) error
+	ImportCSV(ctx context.Context, accountID, profileID string, mapping *entity.CSVMapping, data io.Reader) (*entity.ImportResult, error)
+	ImportOFX(ctx context.Context, accountID string, data io.Reader) (*entity.ImportResult, error)
+	ImportCamt053(ctx context.Context, accountID string, data io.Reader) (*entity.ImportResult, error)
+	ImportMT940(ctx context.Context, accountID string, data io.Reader) (*entity.ImportResult, error)
+}
+
+// CategoryRuleServicer defines the interface for categorization rule operations
+type CategoryRuleServicer interface {
+	CreateRule(ctx context.Context, userID, name string, priority int, conditions entity.RuleConditions, actions entity.RuleActions) (*entity.CategoryRule, error)
+	GetRule(ctx context.Context, id string) (*entity.CategoryRule, error)
+	ListUserRules(ctx context.Context, userID string) ([]*entity.CategoryRule, error)
+	UpdateRule(ctx context.Context, id, name string, priority *int, conditions *entity.RuleConditions, actions *entity.RuleActions) (*entity.CategoryRule, error)
+	DeleteRule(ctx context.Context, id string) error
+	ApplyRules(ctx context.Context, userID string, uncategorizedOnly, dryRun bool) (*entity.RuleApplication, error)
+}
+
+// CategoryServicer defines the interface for category operations
+type CategoryServicer interface {
+	CreateCategory(ctx context.Context, userID, name, parentID string, categoryType constant.TransactionType) (*entity.Category, error)
+	GetCategory(ctx context.Context, id string) (*entity.Category, error)
+	ListUserCategories(ctx context.Context, userID string, includeArchived bool) ([]*entity.Category, error)
+	UpdateCategory(ctx context.Context, id, name string, parentID *string, categoryType *constant.TransactionType, archived *bool) (*entity.Category, error)
+	DeleteCategory(ctx context.Context, id string) error
+}
+
+// PayeeServicer defines the interface for payee operations
+type PayeeServicer interface {
+	CreatePayee(ctx context.Context, userID, name string, aliases []string, defaultCategoryID string) (*entity.Payee, error)
+	GetPayee(ctx context.Context, id string) (*entity.Payee, error)
+	ListUserPayees(ctx context.Context, userID string) ([]*entity.Payee, error)
+	UpdatePayee(ctx context.Context, id, name string, aliases []string, defaultCategoryID *string) (*entity.Payee, error)
+	DeletePayee(ctx context.Context, id string) error
+}
+
 // MockUserService is a mock implementation of UserServicer for testing
 type MockUserService struct {
 	CreateUserCalls     int
@@ -56,7 +146,7 @@ type MockUserService struct {
 	UserToReturn *entity.User
 }
 
-func (m *MockUserService) CreateUser(ctx context.Context, name, email string) (*entity.User, error) {
+func (m *MockUserService) CreateUser(ctx context.Context, name, email, baseCurrency string) (*entity.User, error) {
 	m.CreateUserCalls++
 	if m.LastCreateUserErr != nil {
 		return nil, m.LastCreateUserErr
@@ -81,7 +171,7 @@ func (m *MockUserService) GetUserByEmail(ctx context.Context, email string) (*en
 	return m.UserToReturn, m.LastGetUserByEmailErr
 }
 
-func (m *MockUserService) UpdateUser(ctx context.Context, id, name, email string) (*entity.User, error) {
+func (m *MockUserService) UpdateUser(ctx context.Context, id, name, email, baseCurrency string) (*entity.User, error) {
 	m.UpdateUserCalls++
 	return m.UserToReturn, m.LastUpdateUserErr
 }
@@ -96,20 +186,31 @@ type MockAccountService struct {
 	CreateAccountCalls    int
 	GetAccountCalls       int
 	ListUserAccountsCalls int
+	GetAccountTreeCalls   int
 	UpdateAccountCalls    int
 	DeleteAccountCalls    int
 
 	LastCreateAccountErr    error
 	LastGetAccountErr       error
 	LastListUserAccountsErr error
+	LastGetAccountTreeErr   error
 	LastUpdateAccountErr    error
 	LastDeleteAccountErr    error
 
-	AccountToReturn  *entity.Account
-	AccountsToReturn []*entity.Account
+	AccountToReturn     *entity.Account
+	AccountsToReturn    []*entity.Account
+	AccountTreeToReturn []*entity.AccountNode
+	NextCursorToReturn  string
+
+	// AccountPagesToReturn, when set, are returned by successive
+	// ListUserAccounts calls, each but the last with a next cursor
+	AccountPagesToReturn [][]*entity.Account
+
+	// LastPage is the page requested by the latest ListUserAccounts call
+	LastPage entity.PageRequest
 }
 
-func (m *MockAccountService) CreateAccount(ctx context.Context, userID, name string, accountType constant.AccountType, currency string) (*entity.Account, error) {
+func (m *MockAccountService) CreateAccount(ctx context.Context, userID, name string, accountType constant.AccountType, currency, parentID, code string) (*entity.Account, error) {
 	m.CreateAccountCalls++
 	if m.LastCreateAccountErr != nil {
 		return nil, m.LastCreateAccountErr
@@ -120,9 +221,11 @@ func (m *MockAccountService) CreateAccount(ctx context.Context, userID, name str
 	return &entity.Account{
 		ID:       "account-123",
 		UserID:   userID,
+		ParentID: parentID,
+		Code:     code,
 		Name:     name,
 		Type:     accountType,
-		Balance:  0.0,
+		Balance:  money.Zero(currency),
 		Currency: currency,
 	}, nil
 }
@@ -132,12 +235,22 @@ func (m *MockAccountService) GetAccount(ctx context.Context, id string) (*entity
 	return m.AccountToReturn, m.LastGetAccountErr
 }
 
-func (m *MockAccountService) ListUserAccounts(ctx context.Context, userID string) ([]*entity.Account, error) {
+func (m *MockAccountService) ListUserAccounts(ctx context.Context, userID string, page entity.PageRequest) ([]*entity.Account, string, error) {
 	m.ListUserAccountsCalls++
-	return m.AccountsToReturn, m.LastListUserAccountsErr
+	m.LastPage = page
+	if m.AccountPagesToReturn != nil {
+		accounts, nextCursor := nextMockPage(m.AccountPagesToReturn, m.ListUserAccountsCalls)
+		return accounts, nextCursor, m.LastListUserAccountsErr
+	}
+	return m.AccountsToReturn, m.NextCursorToReturn, m.LastListUserAccountsErr
+}
+
+func (m *MockAccountService) GetAccountTree(ctx context.Context, userID, baseCurrency string) ([]*entity.AccountNode, error) {
+	m.GetAccountTreeCalls++
+	return m.AccountTreeToReturn, m.LastGetAccountTreeErr
 }
 
-func (m *MockAccountService) UpdateAccount(ctx context.Context, id, name string, accountType constant.AccountType, currency string) (*entity.Account, error) {
+func (m *MockAccountService) UpdateAccount(ctx context.Context, id, name string, accountType constant.AccountType, currency, parentID, code string) (*entity.Account, error) {
 	m.UpdateAccountCalls++
 	return m.AccountToReturn, m.LastUpdateAccountErr
 }
@@ -147,6 +260,18 @@ func (m *MockAccountService) DeleteAccount(ctx context.Context, id string) error
 	return m.LastDeleteAccountErr
 }
 
+// nextMockPage returns the page fetched by the given call of a paginated
+// mock, and the cursor of the following page, or "" on the last page.
+func nextMockPage[T any](pages [][]T, call int) ([]T, string) {
+	if call > len(pages) {
+		return nil, ""
+	}
+	if call == len(pages) {
+		return pages[call-1], ""
+	}
+	return pages[call-1], fmt.Sprintf("page-%d", call+1)
+}
+
 // MockTransactionService is a mock implementation of TransactionServicer for testing
 type MockTransactionService struct {
 	CreateTransactionCalls       int
@@ -163,10 +288,30 @@ type MockTransactionService struct {
 
 	TransactionToReturn  *entity.Transaction
 	TransactionsToReturn []*entity.Transaction
+	NextCursorToReturn   string
+
+	// TransactionPagesToReturn, when set, are returned by successive
+	// ListAccountTransactions calls, each but the last with a next cursor
+	TransactionPagesToReturn [][]*entity.Transaction
+
+	// LastFilter and LastPage are the arguments of the latest ListAccountTransactions call
+	LastFilter entity.TransactionFilter
+	LastPage   entity.PageRequest
+	// LastAllowDuplicate is the allowDuplicate argument of the latest CreateTransaction call
+	LastAllowDuplicate bool
+	// LastPayeeID, LastTags and LastSplits are the arguments of the latest
+	// CreateTransaction or UpdateTransaction call
+	LastPayeeID string
+	LastTags    []string
+	LastSplits  []*entity.Split
 }
 
-func (m *MockTransactionService) CreateTransaction(ctx context.Context, accountID string, amount float64, currency, description, category string, transactionType constant.TransactionType, date time.Time) (*entity.Transaction, error) {
+func (m *MockTransactionService) CreateTransaction(ctx context.Context, accountID string, amount money.Money, description, payeeID, categoryID string, tags []string, splits []*entity.Split, transactionType constant.TransactionType, date time.Time, allowDuplicate bool) (*entity.Transaction, error) {
 	m.CreateTransactionCalls++
+	m.LastAllowDuplicate = allowDuplicate
+	m.LastPayeeID = payeeID
+	m.LastTags = tags
+	m.LastSplits = splits
 	if m.LastCreateTransactionErr != nil {
 		return nil, m.LastCreateTransactionErr
 	}
@@ -177,26 +322,51 @@ func (m *MockTransactionService) CreateTransaction(ctx context.Context, accountI
 		ID:          "transaction-123",
 		AccountID:   accountID,
 		Amount:      amount,
-		Currency:    currency,
 		Description: description,
+		PayeeID:     payeeID,
 		Date:        date,
 		Type:        transactionType,
-		Category:    category,
+		CategoryID:  categoryID,
+		Splits:      splits,
+		Tags:        tags,
 	}, nil
 }
 
+func (m *MockTransactionService) CreateImportedTransaction(ctx context.Context, accountID, externalID string, amount money.Money, description, payeeID, categoryID string, tags []string, splits []*entity.Split, transactionType constant.TransactionType, date time.Time, allowDuplicate bool) (*entity.Transaction, error) {
+	transaction, err := m.CreateTransaction(ctx, accountID, amount, description, payeeID, categoryID, tags, splits, transactionType, date, allowDuplicate)
+	if err != nil {
+		return nil, err
+	}
+	imported := *transaction
+	imported.ExternalID = externalID
+	return &imported, nil
+}
+
+func (m *MockTransactionService) FindDuplicates(ctx context.Context, accountID, externalID string, amount money.Money, description string, transactionType constant.TransactionType, date time.Time) ([]*entity.Transaction, error) {
+	return nil, nil
+}
+
 func (m *MockTransactionService) GetTransaction(ctx context.Context, id string) (*entity.Transaction, error) {
 	m.GetTransactionCalls++
 	return m.TransactionToReturn, m.LastGetTransactionErr
 }
 
-func (m *MockTransactionService) ListAccountTransactions(ctx context.Context, accountID string) ([]*entity.Transaction, error) {
+func (m *MockTransactionService) ListAccountTransactions(ctx context.Context, accountID string, filter entity.TransactionFilter, page entity.PageRequest) ([]*entity.Transaction, string, error) {
 	m.ListAccountTransactionsCalls++
-	return m.TransactionsToReturn, m.LastListAccountTransactionsErr
+	m.LastFilter = filter
+	m.LastPage = page
+	if m.TransactionPagesToReturn != nil {
+		pages, nextCursor := nextMockPage(m.TransactionPagesToReturn, m.ListAccountTransactionsCalls)
+		return pages, nextCursor, m.LastListAccountTransactionsErr
+	}
+	return m.TransactionsToReturn, m.NextCursorToReturn, m.LastListAccountTransactionsErr
 }
 
-func (m *MockTransactionService) UpdateTransaction(ctx context.Context, id string, amount float64, currency, description, category string, transactionType constant.TransactionType, date time.Time) (*entity.Transaction, error) {
+func (m *MockTransactionService) UpdateTransaction(ctx context.Context, id, accountID string, amount money.Money, description, payeeID, categoryID string, tags []string, splits []*entity.Split, transactionType constant.TransactionType, date time.Time) (*entity.Transaction, error) {
 	m.UpdateTransactionCalls++
+	m.LastPayeeID = payeeID
+	m.LastTags = tags
+	m.LastSplits = splits
 	return m.TransactionToReturn, m.LastUpdateTransactionErr
 }
 
@@ -205,6 +375,662 @@ func (m *MockTransactionService) DeleteTransaction(ctx context.Context, id strin
 	return m.LastDeleteTransactionErr
 }
 
+// MockTransferService is a mock implementation of TransferServicer for testing
+type MockTransferService struct {
+	CreateTransferCalls int
+	GetTransferCalls    int
+
+	LastCreateTransferErr error
+	LastGetTransferErr    error
+
+	TransferToReturn *entity.Transfer
+}
+
+func (m *MockTransferService) CreateTransfer(ctx context.Context, fromAccountID, toAccountID string, amount money.Money, description string, date time.Time) (*entity.Transfer, error) {
+	m.CreateTransferCalls++
+	if m.LastCreateTransferErr != nil {
+		return nil, m.LastCreateTransferErr
+	}
+	if m.TransferToReturn != nil {
+		return m.TransferToReturn, nil
+	}
+	return &entity.Transfer{
+		ID: "transfer-123",
+		Debit: &entity.Transaction{
+			ID:                "transaction-out",
+			AccountID:         fromAccountID,
+			Amount:            amount,
+			Description:       description,
+			Date:              date,
+			Type:              constant.TransactionTypeTransfer,
+			TransferID:        "transfer-123",
+			TransferDirection: constant.TransferDirectionOut,
+		},
+		Credit: &entity.Transaction{
+			ID:                "transaction-in",
+			AccountID:         toAccountID,
+			Amount:            amount,
+			Description:       description,
+			Date:              date,
+			Type:              constant.TransactionTypeTransfer,
+			TransferID:        "transfer-123",
+			TransferDirection: constant.TransferDirectionIn,
+		},
+	}, nil
+}
+
+func (m *MockTransferService) GetTransfer(ctx context.Context, id string) (*entity.Transfer, error) {
+	m.GetTransferCalls++
+	return m.TransferToReturn, m.LastGetTransferErr
+}
+
+// MockJournalService is a mock implementation of JournalServicer for testing
+type MockJournalService struct {
+	CreateEntryCalls     int
+	GetEntryCalls        int
+	ListUserEntriesCalls int
+	DeleteEntryCalls     int
+	PreviewEntryCalls    int
+
+	LastCreateEntryErr     error
+	LastGetEntryErr        error
+	LastListUserEntriesErr error
+	LastDeleteEntryErr     error
+	LastPreviewEntryErr    error
+
+	EntryToReturn   *entity.JournalEntry
+	EntriesToReturn []*entity.JournalEntry
+
+	// LastCategoryAccountID is the category account of the latest preview
+	LastCategoryAccountID string
+}
+
+func (m *MockJournalService) CreateEntry(ctx context.Context, userID string, date time.Time, description string, postings []*entity.Posting) (*entity.JournalEntry, error) {
+	m.CreateEntryCalls++
+	if m.LastCreateEntryErr != nil {
+		return nil, m.LastCreateEntryErr
+	}
+	if m.EntryToReturn != nil {
+		return m.EntryToReturn, nil
+	}
+	return &entity.JournalEntry{
+		ID:          "entry-123",
+		UserID:      userID,
+		Date:        date,
+		Description: description,
+		Postings:    postings,
+	}, nil
+}
+
+func (m *MockJournalService) GetEntry(ctx context.Context, id string) (*entity.JournalEntry, error) {
+	m.GetEntryCalls++
+	return m.EntryToReturn, m.LastGetEntryErr
+}
+
+func (m *MockJournalService) ListUserEntries(ctx context.Context, userID string) ([]*entity.JournalEntry, error) {
+	m.ListUserEntriesCalls++
+	return m.EntriesToReturn, m.LastListUserEntriesErr
+}
+
+func (m *MockJournalService) DeleteEntry(ctx context.Context, id string) error {
+	m.DeleteEntryCalls++
+	return m.LastDeleteEntryErr
+}
+
+func (m *MockJournalService) PreviewTransactionEntry(ctx context.Context, transactionID, categoryAccountID string) (*entity.JournalEntry, error) {
+	m.PreviewEntryCalls++
+	m.LastCategoryAccountID = categoryAccountID
+	return m.EntryToReturn, m.LastPreviewEntryErr
+}
+
+// MockExchangeRateService is a mock implementation of ExchangeRateServicer for testing
+type MockExchangeRateService struct {
+	SetRatesCalls int
+	GetRateCalls  int
+	ConvertCalls  int
+
+	LastSetRatesErr error
+	LastGetRateErr  error
+	LastConvertErr  error
+
+	// LastRates holds the rates passed to the latest SetRates call
+	LastRates    []*entity.ExchangeRate
+	RateToReturn *entity.ExchangeRate
+}
+
+func (m *MockExchangeRateService) SetRates(ctx context.Context, rates []*entity.ExchangeRate) error {
+	m.SetRatesCalls++
+	m.LastRates = rates
+	return m.LastSetRatesErr
+}
+
+func (m *MockExchangeRateService) GetRate(ctx context.Context, baseCurrency, quoteCurrency string, date time.Time) (*entity.ExchangeRate, error) {
+	m.GetRateCalls++
+	return m.RateToReturn, m.LastGetRateErr
+}
+
+func (m *MockExchangeRateService) Convert(ctx context.Context, amount money.Money, currency string, date time.Time) (money.Money, money.Rate, error) {
+	m.ConvertCalls++
+	if m.LastConvertErr != nil {
+		return money.Money{}, money.Rate{}, m.LastConvertErr
+	}
+	return amount, money.OneRate(), nil
+}
+
+// MockReportService is a mock implementation of ReportServicer for testing
+type MockReportService struct {
+	GetSummaryCalls     int
+	GetTagReportCalls   int
+	GetPayeeReportCalls int
+	GetNetWorthCalls    int
+
+	LastGetSummaryErr     error
+	LastGetTagReportErr   error
+	LastGetPayeeReportErr error
+	LastGetNetWorthErr    error
+
+	SummaryToReturn     *entity.Summary
+	TagReportToReturn   *entity.TagReport
+	PayeeReportToReturn *entity.PayeeReport
+	NetWorthToReturn    *entity.NetWorth
+
+	// LastFrom and LastTo are the range of the latest call, LastGranularity and
+	// LastBaseCurrency the granularity and base currency of the latest
+	// GetSummary call
+	LastFrom         time.Time
+	LastTo           time.Time
+	LastGranularity  constant.Granularity
+	LastBaseCurrency string
+}
+
+func (m *MockReportService) GetSummary(ctx context.Context, userID string, from, to time.Time, granularity constant.Granularity, baseCurrency string) (*entity.Summary, error) {
+	m.GetSummaryCalls++
+	m.LastFrom, m.LastTo, m.LastGranularity, m.LastBaseCurrency = from, to, granularity, baseCurrency
+	if m.LastGetSummaryErr != nil {
+		return nil, m.LastGetSummaryErr
+	}
+	if m.SummaryToReturn != nil {
+		return m.SummaryToReturn, nil
+	}
+	return &entity.Summary{UserID: userID, From: from, To: to, Granularity: granularity, BaseCurrency: baseCurrency}, nil
+}
+
+func (m *MockReportService) GetTagReport(ctx context.Context, userID string, from, to time.Time) (*entity.TagReport, error) {
+	m.GetTagReportCalls++
+	m.LastFrom, m.LastTo = from, to
+	if m.LastGetTagReportErr != nil {
+		return nil, m.LastGetTagReportErr
+	}
+	if m.TagReportToReturn != nil {
+		return m.TagReportToReturn, nil
+	}
+	return &entity.TagReport{UserID: userID, From: from, To: to}, nil
+}
+
+func (m *MockReportService) GetPayeeReport(ctx context.Context, userID string, from, to time.Time) (*entity.PayeeReport, error) {
+	m.GetPayeeReportCalls++
+	m.LastFrom, m.LastTo = from, to
+	if m.LastGetPayeeReportErr != nil {
+		return nil, m.LastGetPayeeReportErr
+	}
+	if m.PayeeReportToReturn != nil {
+		return m.PayeeReportToReturn, nil
+	}
+	return &entity.PayeeReport{UserID: userID, From: from, To: to}, nil
+}
+
+func (m *MockReportService) GetNetWorth(ctx context.Context, userID string, from, to time.Time) (*entity.NetWorth, error) {
+	m.GetNetWorthCalls++
+	m.LastFrom, m.LastTo = from, to
+	if m.LastGetNetWorthErr != nil {
+		return nil, m.LastGetNetWorthErr
+	}
+	if m.NetWorthToReturn != nil {
+		return m.NetWorthToReturn, nil
+	}
+	return &entity.NetWorth{UserID: userID, Currency: "USD"}, nil
+}
+
+// MockStatementService is a mock implementation of StatementServicer for testing
+type MockStatementService struct {
+	GetTrialBalanceCalls    int
+	GetBalanceSheetCalls    int
+	GetIncomeStatementCalls int
+
+	LastGetTrialBalanceErr    error
+	LastGetBalanceSheetErr    error
+	LastGetIncomeStatementErr error
+
+	TrialBalanceToReturn    *entity.TrialBalance
+	BalanceSheetToReturn    *entity.BalanceSheet
+	IncomeStatementToReturn *entity.IncomeStatement
+
+	// LastAsOf is the day passed to the latest trial balance or balance sheet
+	// call, and LastFrom and LastTo the range of the latest income statement call
+	LastAsOf time.Time
+	LastFrom time.Time
+	LastTo   time.Time
+}
+
+func (m *MockStatementService) GetTrialBalance(ctx context.Context, userID string, asOf time.Time) (*entity.TrialBalance, error) {
+	m.GetTrialBalanceCalls++
+	m.LastAsOf = asOf
+	if m.LastGetTrialBalanceErr != nil {
+		return nil, m.LastGetTrialBalanceErr
+	}
+	if m.TrialBalanceToReturn != nil {
+		return m.TrialBalanceToReturn, nil
+	}
+	return &entity.TrialBalance{UserID: userID, AsOf: asOf}, nil
+}
+
+func (m *MockStatementService) GetBalanceSheet(ctx context.Context, userID string, asOf time.Time) (*entity.BalanceSheet, error) {
+	m.GetBalanceSheetCalls++
+	m.LastAsOf = asOf
+	if m.LastGetBalanceSheetErr != nil {
+		return nil, m.LastGetBalanceSheetErr
+	}
+	if m.BalanceSheetToReturn != nil {
+		return m.BalanceSheetToReturn, nil
+	}
+	return &entity.BalanceSheet{UserID: userID, AsOf: asOf}, nil
+}
+
+func (m *MockStatementService) GetIncomeStatement(ctx context.Context, userID string, from, to time.Time) (*entity.IncomeStatement, error) {
+	m.GetIncomeStatementCalls++
+	m.LastFrom, m.LastTo = from, to
+	if m.LastGetIncomeStatementErr != nil {
+		return nil, m.LastGetIncomeStatementErr
+	}
+	if m.IncomeStatementToReturn != nil {
+		return m.IncomeStatementToReturn, nil
+	}
+	return &entity.IncomeStatement{UserID: userID, From: from, To: to}, nil
+}
+
+// MockBalanceService is a mock implementation of BalanceServicer for testing
+type MockBalanceService struct {
+	GetBalanceAsOfCalls   int
+	GetDailyBalancesCalls int
+
+	LastGetBalanceAsOfErr   error
+	LastGetDailyBalancesErr error
+
+	BalanceToReturn  *entity.DailyBalance
+	BalancesToReturn []*entity.DailyBalance
+
+	// LastDay is the day passed to the latest GetBalanceAsOf call
+	LastDay time.Time
+}
+
+func (m *MockBalanceService) GetBalanceAsOf(ctx context.Context, accountID string, day time.Time) (*entity.DailyBalance, error) {
+	m.GetBalanceAsOfCalls++
+	m.LastDay = day
+	if m.LastGetBalanceAsOfErr != nil {
+		return nil, m.LastGetBalanceAsOfErr
+	}
+	if m.BalanceToReturn != nil {
+		return m.BalanceToReturn, nil
+	}
+	return &entity.DailyBalance{Date: day, Balance: money.Zero("USD")}, nil
+}
+
+func (m *MockBalanceService) GetDailyBalances(ctx context.Context, accountID string, from, to time.Time) ([]*entity.DailyBalance, error) {
+	m.GetDailyBalancesCalls++
+	return m.BalancesToReturn, m.LastGetDailyBalancesErr
+}
+
+// MockImportService is a mock implementation of ImportServicer for testing
+type MockImportService struct {
+	CreateProfileCalls    int
+	GetProfileCalls       int
+	ListUserProfilesCalls int
+	UpdateProfileCalls    int
+	DeleteProfileCalls    int
+	ImportCSVCalls        int
+	ImportOFXCalls        int
+	ImportCamt053Calls    int
+	ImportMT940Calls      int
+
+	LastCreateProfileErr    error
+	LastGetProfileErr       error
+	LastListUserProfilesErr error
+	LastUpdateProfileErr    error
+	LastDeleteProfileErr    error
+	LastImportCSVErr        error
+	LastImportOFXErr        error
+	LastImportCamt053Err    error
+	LastImportMT940Err      error
+
+	ProfileToReturn      *entity.ImportProfile
+	ProfilesToReturn     []*entity.ImportProfile
+	ImportResultToReturn *entity.ImportResult
+
+	// LastProfileID and LastMapping are the arguments of the latest ImportCSV
+	// call, LastMapping also of the latest profile create or update, and
+	// LastData is the statement of the latest import
+	LastProfileID string
+	LastMapping   *entity.CSVMapping
+	LastData      string
+}
+
+func (m *MockImportService) CreateProfile(ctx context.Context, userID, name string, mapping entity.CSVMapping) (*entity.ImportProfile, error) {
+	m.CreateProfileCalls++
+	m.LastMapping = &mapping
+	if m.LastCreateProfileErr != nil {
+		return nil, m.LastCreateProfileErr
+	}
+	return &entity.ImportProfile{
+		ID:        "profile-123",
+		UserID:    userID,
+		Name:      name,
+		Mapping:   mapping,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}, nil
+}
+
+func (m *MockImportService) GetProfile(ctx context.Context, id string) (*entity.ImportProfile, error) {
+	m.GetProfileCalls++
+	return m.ProfileToReturn, m.LastGetProfileErr
+}
+
+func (m *MockImportService) ListUserProfiles(ctx context.Context, userID string) ([]*entity.ImportProfile, error) {
+	m.ListUserProfilesCalls++
+	return m.ProfilesToReturn, m.LastListUserProfilesErr
+}
+
+func (m *MockImportService) UpdateProfile(ctx context.Context, id, name string, mapping *entity.CSVMapping) (*entity.ImportProfile, error) {
+	m.UpdateProfileCalls++
+	m.LastMapping = mapping
+	if m.LastUpdateProfileErr != nil {
+		return nil, m.LastUpdateProfileErr
+	}
+	profile := &entity.ImportProfile{ID: id, Name: name, UpdatedAt: time.Now()}
+	if mapping != nil {
+		profile.Mapping = *mapping
+	}
+	return profile, nil
+}
+
+func (m *MockImportService) DeleteProfile(ctx context.Context, id string) error {
+	m.DeleteProfileCalls++
+	return m.LastDeleteProfileErr
+}
+
+func (m *MockImportService) ImportCSV(ctx context.Context, accountID, profileID string, mapping *entity.CSVMapping, data io.Reader) (*entity.ImportResult, error) {
+	m.ImportCSVCalls++
+	m.LastProfileID, m.LastMapping = profileID, mapping
+	return m.importResult(accountID, data, m.LastImportCSVErr)
+}
+
+func (m *MockImportService) ImportOFX(ctx context.Context, accountID string, data io.Reader) (*entity.ImportResult, error) {
+	m.ImportOFXCalls++
+	return m.importResult(accountID, data, m.LastImportOFXErr)
+}
+
+func (m *MockImportService) ImportCamt053(ctx context.Context, accountID string, data io.Reader) (*entity.ImportResult, error) {
+	m.ImportCamt053Calls++
+	return m.importResult(accountID, data, m.LastImportCamt053Err)
+}
+
+func (m *MockImportService) ImportMT940(ctx context.Context, accountID string, data io.Reader) (*entity.ImportResult, error) {
+	m.ImportMT940Calls++
+	return m.importResult(accountID, data, m.LastImportMT940Err)
+}
+
+func (m *MockImportService) importResult(accountID string, data io.Reader, importErr error) (*entity.ImportResult, error) {
+	content, err := io.ReadAll(data)
+	if err != nil {
+		return nil, err
+	}
+	m.LastData = string(content)
+	if importErr != nil {
+		return nil, importErr
+	}
+	if m.ImportResultToReturn != nil {
+		return m.ImportResultToReturn, nil
+	}
+	return &entity.ImportResult{AccountID: accountID}, nil
+}
+
+// MockCategoryRuleService is a mock implementation of CategoryRuleServicer for testing
+type MockCategoryRuleService struct {
+	CreateRuleCalls    int
+	GetRuleCalls       int
+	ListUserRulesCalls int
+	UpdateRuleCalls    int
+	DeleteRuleCalls    int
+	ApplyRulesCalls    int
+
+	LastCreateRuleErr    error
+	LastGetRuleErr       error
+	LastListUserRulesErr error
+	LastUpdateRuleErr    error
+	LastDeleteRuleErr    error
+	LastApplyRulesErr    error
+
+	RuleToReturn            *entity.CategoryRule
+	RulesToReturn           []*entity.CategoryRule
+	RuleApplicationToReturn *entity.RuleApplication
+
+	// LastPriority, LastConditions and LastActions are the arguments of the
+	// latest rule create or update; LastUncategorizedOnly and LastDryRun
+	// those of the latest ApplyRules call
+	LastPriority          *int
+	LastConditions        *entity.RuleConditions
+	LastActions           *entity.RuleActions
+	LastUncategorizedOnly bool
+	LastDryRun            bool
+}
+
+func (m *MockCategoryRuleService) CreateRule(ctx context.Context, userID, name string, priority int, conditions entity.RuleConditions, actions entity.RuleActions) (*entity.CategoryRule, error) {
+	m.CreateRuleCalls++
+	m.LastPriority, m.LastConditions, m.LastActions = &priority, &conditions, &actions
+	if m.LastCreateRuleErr != nil {
+		return nil, m.LastCreateRuleErr
+	}
+	return &entity.CategoryRule{
+		ID:         "rule-123",
+		UserID:     userID,
+		Name:       name,
+		Priority:   priority,
+		Conditions: conditions,
+		Actions:    actions,
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	}, nil
+}
+
+func (m *MockCategoryRuleService) GetRule(ctx context.Context, id string) (*entity.CategoryRule, error) {
+	m.GetRuleCalls++
+	return m.RuleToReturn, m.LastGetRuleErr
+}
+
+func (m *MockCategoryRuleService) ListUserRules(ctx context.Context, userID string) ([]*entity.CategoryRule, error) {
+	m.ListUserRulesCalls++
+	return m.RulesToReturn, m.LastListUserRulesErr
+}
+
+func (m *MockCategoryRuleService) UpdateRule(ctx context.Context, id, name string, priority *int, conditions *entity.RuleConditions, actions *entity.RuleActions) (*entity.CategoryRule, error) {
+	m.UpdateRuleCalls++
+	m.LastPriority, m.LastConditions, m.LastActions = priority, conditions, actions
+	if m.LastUpdateRuleErr != nil {
+		return nil, m.LastUpdateRuleErr
+	}
+	rule := &entity.CategoryRule{ID: id, Name: name, UpdatedAt: time.Now()}
+	if priority != nil {
+		rule.Priority = *priority
+	}
+	if conditions != nil {
+		rule.Conditions = *conditions
+	}
+	if actions != nil {
+		rule.Actions = *actions
+	}
+	return rule, nil
+}
+
+func (m *MockCategoryRuleService) DeleteRule(ctx context.Context, id string) error {
+	m.DeleteRuleCalls++
+	return m.LastDeleteRuleErr
+}
+
+func (m *MockCategoryRuleService) ApplyRules(ctx context.Context, userID string, uncategorizedOnly, dryRun bool) (*entity.RuleApplication, error) {
+	m.ApplyRulesCalls++
+	m.LastUncategorizedOnly, m.LastDryRun = uncategorizedOnly, dryRun
+	if m.LastApplyRulesErr != nil {
+		return nil, m.LastApplyRulesErr
+	}
+	if m.RuleApplicationToReturn != nil {
+		return m.RuleApplicationToReturn, nil
+	}
+	return &entity.RuleApplication{UserID: userID, DryRun: dryRun}, nil
+}
+
+// MockCategoryService is a mock implementation of CategoryServicer for testing
+type MockCategoryService struct {
+	CreateCategoryCalls     int
+	GetCategoryCalls        int
+	ListUserCategoriesCalls int
+	UpdateCategoryCalls     int
+	DeleteCategoryCalls     int
+
+	LastCreateCategoryErr     error
+	LastGetCategoryErr        error
+	LastListUserCategoriesErr error
+	LastUpdateCategoryErr     error
+	LastDeleteCategoryErr     error
+
+	CategoryToReturn   *entity.Category
+	CategoriesToReturn []*entity.Category
+
+	// LastParentID, LastType and LastArchived are the arguments of the latest
+	// category update; LastIncludeArchived that of the latest list
+	LastParentID        *string
+	LastType            *constant.TransactionType
+	LastArchived        *bool
+	LastIncludeArchived bool
+}
+
+func (m *MockCategoryService) CreateCategory(ctx context.Context, userID, name, parentID string, categoryType constant.TransactionType) (*entity.Category, error) {
+	m.CreateCategoryCalls++
+	if m.LastCreateCategoryErr != nil {
+		return nil, m.LastCreateCategoryErr
+	}
+	return &entity.Category{
+		ID:        "category-123",
+		UserID:    userID,
+		ParentID:  parentID,
+		Name:      name,
+		Type:      categoryType,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}, nil
+}
+
+func (m *MockCategoryService) GetCategory(ctx context.Context, id string) (*entity.Category, error) {
+	m.GetCategoryCalls++
+	return m.CategoryToReturn, m.LastGetCategoryErr
+}
+
+func (m *MockCategoryService) ListUserCategories(ctx context.Context, userID string, includeArchived bool) ([]*entity.Category, error) {
+	m.ListUserCategoriesCalls++
+	m.LastIncludeArchived = includeArchived
+	return m.CategoriesToReturn, m.LastListUserCategoriesErr
+}
+
+func (m *MockCategoryService) UpdateCategory(ctx context.Context, id, name string, parentID *string, categoryType *constant.TransactionType, archived *bool) (*entity.Category, error) {
+	m.UpdateCategoryCalls++
+	m.LastParentID, m.LastType, m.LastArchived = parentID, categoryType, archived
+	if m.LastUpdateCategoryErr != nil {
+		return nil, m.LastUpdateCategoryErr
+	}
+	category := &entity.Category{ID: id, Name: name, UpdatedAt: time.Now()}
+	if parentID != nil {
+		category.ParentID = *parentID
+	}
+	if categoryType != nil {
+		category.Type = *categoryType
+	}
+	if archived != nil {
+		category.Archived = *archived
+	}
+	return category, nil
+}
+
+func (m *MockCategoryService) DeleteCategory(ctx context.Context, id string) error {
+	m.DeleteCategoryCalls++
+	return m.LastDeleteCategoryErr
+}
+
+// MockPayeeService is a mock implementation of PayeeServicer for testing
+type MockPayeeService struct {
+	CreatePayeeCalls    int
+	GetPayeeCalls       int
+	ListUserPayeesCalls int
+	UpdatePayeeCalls    int
+	DeletePayeeCalls    int
+
+	LastCreatePayeeErr    error
+	LastGetPayeeErr       error
+	LastListUserPayeesErr error
+	LastUpdatePayeeErr    error
+	LastDeletePayeeErr    error
+
+	PayeeToReturn  *entity.Payee
+	PayeesToReturn []*entity.Payee
+
+	// LastAliases and LastDefaultCategoryID are the arguments of the latest
+	// payee update
+	LastAliases           []string
+	LastDefaultCategoryID *string
+}
+
+func (m *MockPayeeService) CreatePayee(ctx context.Context, userID, name string, aliases []string, defaultCategoryID string) (*entity.Payee, error) {
+	m.CreatePayeeCalls++
+	if m.LastCreatePayeeErr != nil {
+		return nil, m.LastCreatePayeeErr
+	}
+	return &entity.Payee{
+		ID:                "payee-123",
+		UserID:            userID,
+		Name:              name,
+		Aliases:           aliases,
+		DefaultCategoryID: defaultCategoryID,
+		CreatedAt:         time.Now(),
+		UpdatedAt:         time.Now(),
+	}, nil
+}
+
+func (m *MockPayeeService) GetPayee(ctx context.Context, id string) (*entity.Payee, error) {
+	m.GetPayeeCalls++
+	return m.PayeeToReturn, m.LastGetPayeeErr
+}
+
+func (m *MockPayeeService) ListUserPayees(ctx context.Context, userID string) ([]*entity.Payee, error) {
+	m.ListUserPayeesCalls++
+	return m.PayeesToReturn, m.LastListUserPayeesErr
+}
+
+func (m *MockPayeeService) UpdatePayee(ctx context.Context, id, name string, aliases []string, defaultCategoryID *string) (*entity.Payee, error) {
+	m.UpdatePayeeCalls++
+	m.LastAliases, m.LastDefaultCategoryID = aliases, defaultCategoryID
+	if m.LastUpdatePayeeErr != nil {
+		return nil, m.LastUpdatePayeeErr
+	}
+	payee := &entity.Payee{ID: id, Name: name, Aliases: aliases, UpdatedAt: time.Now()}
+	if defaultCategoryID != nil {
+		payee.DefaultCategoryID = *defaultCategoryID
+	}
+	return payee, nil
+}
+
+func (m *MockPayeeService) DeletePayee(ctx context.Context, id string) error {
+	m.DeletePayeeCalls++
+	return m.LastDeletePayeeErr
+}
+
 // Helper functions
 
 // NewTestRequest creates an HTTP request for testing
@@ -220,6 +1046,25 @@ func NewTestRequest(method, path string, body interface{}) *http.Request {
 	return req
 }
 
+// NewMultipartTestRequest creates a multipart/form-data HTTP request for
+// testing, with the given form fields and, unless filename is empty, a file part
+func NewMultipartTestRequest(method, path string, fields map[string]string, filename, content string) *http.Request {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	for name, value := range fields {
+		_ = writer.WriteField(name, value)
+	}
+	if filename != "" {
+		part, _ := writer.CreateFormFile("file", filename)
+		_, _ = io.WriteString(part, content)
+	}
+	_ = writer.Close()
+
+	req, _ := http.NewRequest(method, path, &body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
+}
+
 // ReadResponseBody reads and returns the response body as a string
 func ReadResponseBody(w *http.Response) string {
 	bodyBytes, _ := io.ReadAll(w.Body)
@@ -8,6 +8,7 @@ import (
 
 	domainerrors "accounting/internal/domain/errors"
 	"accounting/internal/domain/interfaces"
+	"accounting/internal/domain/money"
 	"accounting/internal/handler/http/common"
 )
 
@@ -20,13 +21,18 @@ func NewCreateTransactionHandler(service interfaces.TransactionService) *CreateT
 }
 
 // @Summary Create a new transaction
-// @Description Create a new transaction for an account
+// @Description Create a new transaction for an account. An amount in another currency is converted into the account currency at the rate effective on the transaction date, keeping the original amount and rate.
+// @Description Splits divide the transaction between categories in place of category_id: at least two lines in the currency of the amount, adding up to it. They are converted along with the amount.
+// @Description Without payee_id, the user's payee whose name or alias the description contains, ignoring case, becomes the payee; the longest name or alias wins. A transaction without category_id or splits that no categorization rule categorizes gets its payee's default category.
+// @Description Tags are stored in lower case; tags the user does not have yet are created.
+// @Description A transaction of the same type and amount with the same description, dated within three days on the same account, is taken for a duplicate: the request fails with 409 and the ID of the existing transaction in existing_id, unless allow_duplicate is set.
 // @Tags transactions
 // @Accept json
 // @Produce json
 // @Param body body CreateTransactionRequest true "Transaction request"
 // @Success 201 {object} TransactionResponse
 // @Failure 400 {object} common.ValidationProblem "Validation error"
+// @Failure 409 {object} common.ConflictProblem "Likely duplicate of an existing transaction"
 // @Failure 500 {object} common.ProblemDetail "Internal server error"
 // @Router /api/v1/transactions [post]
 func (h *CreateTransactionHandler) Handle(w http.ResponseWriter, r *http.Request) {
@@ -51,16 +57,41 @@ func (h *CreateTransactionHandler) Handle(w http.ResponseWriter, r *http.Request
 	// Validate input
 	validationErrors := common.CollectErrors(
 		common.ValidateUUID(req.AccountID, "account_id"),
-		common.ValidatePositive(req.Amount, "amount"),
 		common.ValidateCurrency(req.Currency, "currency"),
-		common.ValidateEnum(string(req.Type), []string{"INCOME", "EXPENSE", "TRANSFER"}, "type"),
+		common.ValidateAmount(req.Amount, req.Currency, "amount"),
+		common.ValidateEnum(string(req.Type), []string{"INCOME", "EXPENSE"}, "type"),
 	)
+	if req.PayeeID != "" {
+		validationErrors = append(validationErrors, common.CollectErrors(
+			common.ValidateUUID(req.PayeeID, "payee_id"),
+		)...)
+	}
+	if req.CategoryID != "" {
+		validationErrors = append(validationErrors, common.CollectErrors(
+			common.ValidateUUID(req.CategoryID, "category_id"),
+		)...)
+	}
+	validationErrors = append(validationErrors, common.CollectErrors(validateSplits(req.Splits)...)...)
 	if len(validationErrors) > 0 {
 		problem := common.NewValidationProblemWithErrors(r.RequestURI, validationErrors)
 		common.WriteProblem(w, problem)
 		return
 	}
 
+	amount, err := money.Parse(req.Amount, req.Currency)
+	if err != nil {
+		problem := common.NewValidationProblem(err.Error(), r.RequestURI)
+		common.WriteProblem(w, problem)
+		return
+	}
+
+	splits, err := toSplits(req.Splits)
+	if err != nil {
+		problem := common.NewValidationProblem(err.Error(), r.RequestURI)
+		common.WriteProblem(w, problem)
+		return
+	}
+
 	var date time.Time
 	if req.Date != nil {
 		date = *req.Date
@@ -69,14 +100,23 @@ func (h *CreateTransactionHandler) Handle(w http.ResponseWriter, r *http.Request
 	transaction, err := h.service.CreateTransaction(
 		r.Context(),
 		req.AccountID,
-		req.Amount,
-		req.Currency,
+		amount,
 		req.Description,
-		req.Category,
+		req.PayeeID,
+		req.CategoryID,
+		req.Tags,
+		splits,
 		req.Type,
 		date,
+		req.AllowDuplicate,
 	)
 	if err != nil {
+		var duplicateErr *domainerrors.ErrDuplicateTransaction
+		if errors.As(err, &duplicateErr) {
+			problem := common.NewConflictProblem("a transaction with the same amount and description was recorded on the account within a few days; set allow_duplicate to record it anyway", duplicateErr.ExistingID, r.RequestURI)
+			common.WriteProblem(w, problem)
+			return
+		}
 		var notFoundErr *domainerrors.ErrNotFound
 		if errors.As(err, &notFoundErr) {
 			problem := common.NewNotFoundProblem(err.Error(), r.RequestURI)
@@ -94,5 +134,5 @@ func (h *CreateTransactionHandler) Handle(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	common.WriteJSON(w, http.StatusCreated, toTransactionResponse(transaction))
+	common.WriteJSON(w, http.StatusCreated, ToTransactionResponse(transaction))
 }
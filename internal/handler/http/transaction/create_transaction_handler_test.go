@@ -4,12 +4,14 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"accounting/internal/domain/constant"
 	"accounting/internal/domain/errors"
 	httptesting "accounting/internal/handler/http"
+	"accounting/internal/handler/http/common"
 )
 
 func TestCreateTransactionHandlerSuccess(t *testing.T) {
@@ -18,9 +20,10 @@ func TestCreateTransactionHandlerSuccess(t *testing.T) {
 
 	reqBody := CreateTransactionRequest{
 		AccountID:   "123e4567-e89b-12d3-a456-426614174000",
-		Amount:      100.00,
+		Amount:      "100.00",
 		Currency:    "USD",
 		Description: "Test transaction",
+		Tags:        []string{"reimbursable", "vacation-2026"},
 		Type:        constant.TransactionTypeExpense,
 	}
 
@@ -38,8 +41,12 @@ func TestCreateTransactionHandlerSuccess(t *testing.T) {
 		t.Fatalf("failed to decode response: %v", err)
 	}
 
-	if response.Amount != 100.00 {
-		t.Errorf("expected amount 100.00, got %f", response.Amount)
+	if response.Amount != "100.00" {
+		t.Errorf("expected amount %q, got %q", "100.00", response.Amount)
+	}
+
+	if strings.Join(response.Tags, ",") != "reimbursable,vacation-2026" {
+		t.Errorf("expected tags reimbursable and vacation-2026, got %v", response.Tags)
 	}
 
 	if mockService.CreateTransactionCalls != 1 {
@@ -53,7 +60,7 @@ func TestCreateTransactionHandlerInvalidAmount(t *testing.T) {
 
 	reqBody := CreateTransactionRequest{
 		AccountID: "123e4567-e89b-12d3-a456-426614174000",
-		Amount:    -50.00,
+		Amount:    "-50.00",
 		Currency:  "USD",
 		Type:      constant.TransactionTypeExpense,
 	}
@@ -74,7 +81,7 @@ func TestCreateTransactionHandlerInvalidCurrency(t *testing.T) {
 
 	reqBody := CreateTransactionRequest{
 		AccountID: "123e4567-e89b-12d3-a456-426614174000",
-		Amount:    100.00,
+		Amount:    "100.00",
 		Currency:  "INVALID",
 		Type:      constant.TransactionTypeExpense,
 	}
@@ -98,7 +105,7 @@ func TestCreateTransactionHandlerAccountNotFound(t *testing.T) {
 
 	reqBody := CreateTransactionRequest{
 		AccountID: "123e4567-e89b-12d3-a456-426614174001",
-		Amount:    100.00,
+		Amount:    "100.00",
 		Currency:  "USD",
 		Type:      constant.TransactionTypeExpense,
 	}
@@ -134,7 +141,7 @@ func TestCreateTransactionHandlerWithDate(t *testing.T) {
 	date := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
 	reqBody := CreateTransactionRequest{
 		AccountID:   "123e4567-e89b-12d3-a456-426614174000",
-		Amount:      100.00,
+		Amount:      "100.00",
 		Currency:    "USD",
 		Description: "Test transaction",
 		Type:        constant.TransactionTypeExpense,
@@ -150,3 +157,294 @@ func TestCreateTransactionHandlerWithDate(t *testing.T) {
 		t.Errorf("expected status %d, got %d", http.StatusCreated, w.Code)
 	}
 }
+
+func TestCreateTransactionHandlerTooManyDecimals(t *testing.T) {
+	mockService := &httptesting.MockTransactionService{}
+	handler := NewCreateTransactionHandler(mockService)
+
+	reqBody := CreateTransactionRequest{
+		AccountID: "123e4567-e89b-12d3-a456-426614174000",
+		Amount:    "10.005",
+		Currency:  "USD",
+		Type:      constant.TransactionTypeExpense,
+	}
+
+	req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/transactions", reqBody)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+
+	if mockService.CreateTransactionCalls != 0 {
+		t.Errorf("expected no createTransaction call, got %d", mockService.CreateTransactionCalls)
+	}
+}
+
+func TestCreateTransactionHandlerUnknownCurrency(t *testing.T) {
+	mockService := &httptesting.MockTransactionService{}
+	handler := NewCreateTransactionHandler(mockService)
+
+	reqBody := CreateTransactionRequest{
+		AccountID: "123e4567-e89b-12d3-a456-426614174000",
+		Amount:    "100.00",
+		Currency:  "ABC",
+		Type:      constant.TransactionTypeExpense,
+	}
+
+	req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/transactions", reqBody)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+
+	if mockService.CreateTransactionCalls != 0 {
+		t.Errorf("expected 0 createTransaction calls, got %d", mockService.CreateTransactionCalls)
+	}
+}
+
+func TestCreateTransactionHandlerDecimalsForZeroDecimalCurrency(t *testing.T) {
+	mockService := &httptesting.MockTransactionService{}
+	handler := NewCreateTransactionHandler(mockService)
+
+	reqBody := CreateTransactionRequest{
+		AccountID: "123e4567-e89b-12d3-a456-426614174000",
+		Amount:    "1500.50",
+		Currency:  "JPY",
+		Type:      constant.TransactionTypeExpense,
+	}
+
+	req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/transactions", reqBody)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+
+	if mockService.CreateTransactionCalls != 0 {
+		t.Errorf("expected 0 createTransaction calls, got %d", mockService.CreateTransactionCalls)
+	}
+}
+
+func TestCreateTransactionHandlerRejectsTransferType(t *testing.T) {
+	mockService := &httptesting.MockTransactionService{}
+	handler := NewCreateTransactionHandler(mockService)
+
+	reqBody := CreateTransactionRequest{
+		AccountID: "123e4567-e89b-12d3-a456-426614174000",
+		Amount:    "10.00",
+		Currency:  "USD",
+		Type:      constant.TransactionTypeTransfer,
+	}
+
+	req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/transactions", reqBody)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+
+	if mockService.CreateTransactionCalls != 0 {
+		t.Errorf("expected no createTransaction call, got %d", mockService.CreateTransactionCalls)
+	}
+}
+
+func TestCreateTransactionHandlerDuplicate(t *testing.T) {
+	mockService := &httptesting.MockTransactionService{
+		LastCreateTransactionErr: errors.NewErrDuplicateTransaction("123e4567-e89b-12d3-a456-426614174000", "", "existing-transaction-1"),
+	}
+	handler := NewCreateTransactionHandler(mockService)
+
+	reqBody := CreateTransactionRequest{
+		AccountID:   "123e4567-e89b-12d3-a456-426614174000",
+		Amount:      "100.00",
+		Currency:    "USD",
+		Description: "Test transaction",
+		Type:        constant.TransactionTypeExpense,
+	}
+
+	req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/transactions", reqBody)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusConflict {
+		t.Fatalf("expected status %d, got %d", http.StatusConflict, w.Code)
+	}
+
+	var problem common.ConflictProblem
+	if err := json.NewDecoder(w.Body).Decode(&problem); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if problem.Type != common.TypeConflict || problem.ExistingID != "existing-transaction-1" {
+		t.Errorf("unexpected problem %+v", problem)
+	}
+	if mockService.LastAllowDuplicate {
+		t.Error("expected duplicates to be refused by default")
+	}
+}
+
+func TestCreateTransactionHandlerAllowDuplicate(t *testing.T) {
+	mockService := &httptesting.MockTransactionService{}
+	handler := NewCreateTransactionHandler(mockService)
+
+	reqBody := CreateTransactionRequest{
+		AccountID:      "123e4567-e89b-12d3-a456-426614174000",
+		Amount:         "100.00",
+		Currency:       "USD",
+		Type:           constant.TransactionTypeExpense,
+		AllowDuplicate: true,
+	}
+
+	req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/transactions", reqBody)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Errorf("expected status %d, got %d", http.StatusCreated, w.Code)
+	}
+	if !mockService.LastAllowDuplicate {
+		t.Error("expected allow_duplicate to be passed to the service")
+	}
+}
+
+func TestCreateTransactionHandlerWithSplits(t *testing.T) {
+	mockService := &httptesting.MockTransactionService{}
+	handler := NewCreateTransactionHandler(mockService)
+
+	reqBody := CreateTransactionRequest{
+		AccountID:   "123e4567-e89b-12d3-a456-426614174000",
+		Amount:      "100.00",
+		Currency:    "USD",
+		Description: "Supermarket",
+		Splits: []SplitRequest{
+			{Amount: "70.00", Currency: "USD", CategoryID: "123e4567-e89b-12d3-a456-426614174001"},
+			{Amount: "30.00", Currency: "USD", Memo: "Detergent"},
+		},
+		Type: constant.TransactionTypeExpense,
+	}
+
+	req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/transactions", reqBody)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+	if len(mockService.LastSplits) != 2 || mockService.LastSplits[0].CategoryID != "123e4567-e89b-12d3-a456-426614174001" || mockService.LastSplits[1].Memo != "Detergent" {
+		t.Errorf("expected the splits to be passed on, got %+v", mockService.LastSplits)
+	}
+
+	var response TransactionResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(response.Splits) != 2 || response.Splits[0].Amount != "70.00" || response.Splits[1].Currency != "USD" {
+		t.Errorf("expected the splits in the response, got %+v", response.Splits)
+	}
+}
+
+func TestCreateTransactionHandlerInvalidSplits(t *testing.T) {
+	mockService := &httptesting.MockTransactionService{}
+	handler := NewCreateTransactionHandler(mockService)
+
+	reqBody := CreateTransactionRequest{
+		AccountID: "123e4567-e89b-12d3-a456-426614174000",
+		Amount:    "100.00",
+		Currency:  "USD",
+		Splits: []SplitRequest{
+			{Amount: "70.00", Currency: "USD", CategoryID: "food"},
+			{Amount: "-30.00", Currency: "USD"},
+		},
+		Type: constant.TransactionTypeExpense,
+	}
+
+	req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/transactions", reqBody)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+	for _, field := range []string{"splits[0].category_id", "splits[1].amount"} {
+		if !strings.Contains(w.Body.String(), field) {
+			t.Errorf("expected an error on %s, got %s", field, w.Body.String())
+		}
+	}
+	if mockService.CreateTransactionCalls != 0 {
+		t.Errorf("expected no createTransaction call, got %d", mockService.CreateTransactionCalls)
+	}
+}
+
+func TestCreateTransactionHandlerWithPayee(t *testing.T) {
+	mockService := &httptesting.MockTransactionService{}
+	handler := NewCreateTransactionHandler(mockService)
+
+	reqBody := CreateTransactionRequest{
+		AccountID: "123e4567-e89b-12d3-a456-426614174000",
+		Amount:    "42.10",
+		Currency:  "USD",
+		PayeeID:   "423e4567-e89b-12d3-a456-426614174000",
+		Type:      constant.TransactionTypeExpense,
+	}
+
+	req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/transactions", reqBody)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+	if mockService.LastPayeeID != "423e4567-e89b-12d3-a456-426614174000" {
+		t.Errorf("expected the payee to be passed on, got %q", mockService.LastPayeeID)
+	}
+
+	var response TransactionResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if response.PayeeID != "423e4567-e89b-12d3-a456-426614174000" {
+		t.Errorf("expected the payee in the response, got %q", response.PayeeID)
+	}
+}
+
+func TestCreateTransactionHandlerInvalidPayeeID(t *testing.T) {
+	mockService := &httptesting.MockTransactionService{}
+	handler := NewCreateTransactionHandler(mockService)
+
+	reqBody := CreateTransactionRequest{
+		AccountID: "123e4567-e89b-12d3-a456-426614174000",
+		Amount:    "42.10",
+		Currency:  "USD",
+		PayeeID:   "tesco",
+		Type:      constant.TransactionTypeExpense,
+	}
+
+	req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/transactions", reqBody)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+	if !strings.Contains(w.Body.String(), "payee_id") {
+		t.Errorf("expected an error on payee_id, got %s", w.Body.String())
+	}
+	if mockService.CreateTransactionCalls != 0 {
+		t.Errorf("expected no createTransaction call, got %d", mockService.CreateTransactionCalls)
+	}
+}
@@ -1,8 +1,10 @@
 package transaction
 
 import (
+	"errors"
 	"net/http"
 
+	domainerrors "accounting/internal/domain/errors"
 	"accounting/internal/domain/interfaces"
 	"accounting/internal/handler/http/common"
 )
@@ -16,7 +18,7 @@ func NewDeleteTransactionHandler(service interfaces.TransactionService) *DeleteT
 }
 
 // @Summary Delete a transaction
-// @Description Delete an existing transaction
+// @Description Delete an existing transaction and reverse its effect on the account balance
 // @Tags transactions
 // @Accept json
 // @Produce json
@@ -51,6 +53,12 @@ func (h *DeleteTransactionHandler) Handle(w http.ResponseWriter, r *http.Request
 	}
 
 	if err := h.service.DeleteTransaction(r.Context(), id); err != nil {
+		var notFoundErr *domainerrors.ErrNotFound
+		if errors.As(err, &notFoundErr) {
+			problem := common.NewNotFoundProblem(err.Error(), r.RequestURI)
+			common.WriteProblem(w, problem)
+			return
+		}
 		problem := common.NewInternalErrorProblem(r.RequestURI)
 		common.WriteProblem(w, problem)
 		return
@@ -5,6 +5,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"accounting/internal/domain/errors"
 	httptesting "accounting/internal/handler/http"
 )
 
@@ -65,3 +66,23 @@ func TestDeleteTransactionHandlerInvalidMethod(t *testing.T) {
 		t.Errorf("expected status %d, got %d", http.StatusMethodNotAllowed, w.Code)
 	}
 }
+
+func TestDeleteTransactionHandlerNotFound(t *testing.T) {
+	mockService := &httptesting.MockTransactionService{
+		LastDeleteTransactionErr: errors.NewErrNotFound("transaction", "123e4567-e89b-12d3-a456-426614174000"),
+	}
+	handler := NewDeleteTransactionHandler(mockService)
+
+	req, _ := http.NewRequest(
+		http.MethodDelete,
+		"/api/v1/transactions/123e4567-e89b-12d3-a456-426614174000",
+		nil,
+	)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
@@ -5,32 +5,134 @@ import (
 	"time"
 )
 
+// SplitRequest is one line of a transaction divided between categories. Its
+// currency is that of the transaction's amount as entered.
+type SplitRequest struct {
+	Amount     string `json:"amount"`
+	Currency   string `json:"currency"`
+	CategoryID string `json:"category_id,omitempty"`
+	Memo       string `json:"memo,omitempty"`
+}
+
 type CreateTransactionRequest struct {
-	AccountID   string                   `json:"account_id"`
-	Amount      float64                  `json:"amount"`
-	Currency    string                   `json:"currency"`
-	Description string                   `json:"description,omitempty"`
-	Category    string                   `json:"category,omitempty"`
-	Type        constant.TransactionType `json:"type"`
-	Date        *time.Time               `json:"date,omitempty"`
+	AccountID   string `json:"account_id"`
+	Amount      string `json:"amount"`
+	Currency    string `json:"currency"`
+	Description string `json:"description,omitempty"`
+	// PayeeID is one of the user's payees; without it the payee whose name or
+	// alias the description contains is set
+	PayeeID string `json:"payee_id,omitempty"`
+	// CategoryID is one of the user's categories; without it the user's
+	// categorization rules, then the payee's default category, may set one
+	CategoryID string `json:"category_id,omitempty"`
+	// Splits divide the transaction between categories instead of
+	// category_id; there must be at least two, adding up to the amount
+	Splits []SplitRequest `json:"splits,omitempty"`
+	// Tags label the transaction, e.g. "reimbursable"; tags the user does not
+	// have yet are created
+	Tags []string                 `json:"tags,omitempty"`
+	Type constant.TransactionType `json:"type"`
+	Date *time.Time               `json:"date,omitempty"`
+	// AllowDuplicate records the transaction even when it looks like one
+	// already on the account
+	AllowDuplicate bool `json:"allow_duplicate,omitempty"`
 }
 
 type UpdateTransactionRequest struct {
-	Amount      float64                  `json:"amount,omitempty"`
+	AccountID   string                   `json:"account_id,omitempty"`
+	Amount      string                   `json:"amount,omitempty"`
 	Currency    string                   `json:"currency,omitempty"`
 	Description string                   `json:"description,omitempty"`
-	Category    string                   `json:"category,omitempty"`
+	PayeeID     string                   `json:"payee_id,omitempty"`
+	CategoryID  string                   `json:"category_id,omitempty"`
 	Type        constant.TransactionType `json:"type,omitempty"`
 	Date        *time.Time               `json:"date,omitempty"`
+	// Tags replace the transaction's tags unless null or absent; an empty list
+	// removes them all
+	Tags []string `json:"tags"`
+	// Splits replace the transaction's splits and category unless null or
+	// absent; an empty list removes them all
+	Splits []SplitRequest `json:"splits"`
+}
+
+type SplitResponse struct {
+	ID string `json:"id"`
+	// Amount is in the account's currency
+	Amount   string `json:"amount"`
+	Currency string `json:"currency"`
+	// CategoryID and Category, the category's name, are empty for an
+	// uncategorized split
+	CategoryID string `json:"category_id"`
+	Category   string `json:"category"`
+	Memo       string `json:"memo,omitempty"`
 }
 
 type TransactionResponse struct {
 	ID          string                   `json:"id"`
 	AccountID   string                   `json:"account_id"`
-	Amount      float64                  `json:"amount"`
+	Amount      string                   `json:"amount"`
 	Currency    string                   `json:"currency"`
 	Description string                   `json:"description"`
-	Category    string                   `json:"category"`
 	Type        constant.TransactionType `json:"type"`
 	Date        time.Time                `json:"date"`
+
+	// PayeeID and Payee, the payee's name, are empty when the payee is unknown
+	PayeeID string `json:"payee_id"`
+	Payee   string `json:"payee"`
+
+	// CategoryID and Category, the category's name, are empty for
+	// uncategorized transactions, split transactions and transfer legs
+	CategoryID string `json:"category_id"`
+	Category   string `json:"category"`
+
+	// Splits divide a split transaction between categories
+	Splits []*SplitResponse `json:"splits,omitempty"`
+
+	// Tags are in lower case and sorted
+	Tags []string `json:"tags"`
+
+	TransferID        string                     `json:"transfer_id,omitempty"`
+	TransferDirection constant.TransferDirection `json:"transfer_direction,omitempty"`
+
+	// ExternalID is the bank's identifier of a transaction imported from a statement
+	ExternalID string `json:"external_id,omitempty"`
+
+	// OriginalAmount, OriginalCurrency and ExchangeRate are set when the amount
+	// was entered in another currency and converted into the account's currency
+	OriginalAmount   string `json:"original_amount,omitempty"`
+	OriginalCurrency string `json:"original_currency,omitempty"`
+	ExchangeRate     string `json:"exchange_rate,omitempty"`
+
+	// RunningBalance is the account balance just after the transaction, set
+	// when the listing is requested with running_balance=true
+	RunningBalance string `json:"running_balance,omitempty"`
+}
+
+type TransactionListResponse struct {
+	Items []*TransactionResponse `json:"items"`
+	Limit int                    `json:"limit"`
+	// NextCursor fetches the following page when passed as cursor; omitted on the last page
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// transactionCSVHeader is the column layout of the CSV export. Columns are only
+// ever appended so that spreadsheets built on the export keep working.
+var transactionCSVHeader = []string{
+	"id",
+	"account_id",
+	"date",
+	"type",
+	"amount",
+	"currency",
+	"category",
+	"description",
+	"transfer_id",
+	"transfer_direction",
+	"original_amount",
+	"original_currency",
+	"exchange_rate",
+	"category_id",
+	"tags",
+	"payee",
+	"payee_id",
 }
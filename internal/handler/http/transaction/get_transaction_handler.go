@@ -70,5 +70,5 @@ func (h *GetTransactionHandler) Handle(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	common.WriteJSON(w, http.StatusOK, toTransactionResponse(transaction))
+	common.WriteJSON(w, http.StatusOK, ToTransactionResponse(transaction))
 }
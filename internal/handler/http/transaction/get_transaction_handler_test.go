@@ -10,6 +10,7 @@ import (
 	"accounting/internal/domain/constant"
 	"accounting/internal/domain/entity"
 	"accounting/internal/domain/errors"
+	"accounting/internal/domain/money"
 	httptesting "accounting/internal/handler/http"
 )
 
@@ -17,8 +18,7 @@ func TestGetTransactionHandlerSuccess(t *testing.T) {
 	testTransaction := &entity.Transaction{
 		ID:          "123e4567-e89b-12d3-a456-426614174000",
 		AccountID:   "account-123",
-		Amount:      100.00,
-		Currency:    "USD",
+		Amount:      money.MustParse("100.00", "USD"),
 		Description: "Test transaction",
 		Date:        time.Now(),
 		Type:        constant.TransactionTypeExpense,
@@ -51,8 +51,8 @@ func TestGetTransactionHandlerSuccess(t *testing.T) {
 		t.Errorf("expected ID %q, got %q", testTransaction.ID, response.ID)
 	}
 
-	if response.Amount != testTransaction.Amount {
-		t.Errorf("expected amount %f, got %f", testTransaction.Amount, response.Amount)
+	if response.Amount != testTransaction.Amount.String() {
+		t.Errorf("expected amount %q, got %q", testTransaction.Amount.String(), response.Amount)
 	}
 
 	if mockService.GetTransactionCalls != 1 {
@@ -134,3 +134,38 @@ func TestGetTransactionHandlerServiceError(t *testing.T) {
 		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
 	}
 }
+
+func TestGetTransactionHandlerConvertedAmount(t *testing.T) {
+	testTransaction := &entity.Transaction{
+		ID:             "123e4567-e89b-12d3-a456-426614174000",
+		AccountID:      "account-123",
+		Amount:         money.MustParse("54.23", "USD"),
+		OriginalAmount: money.MustParse("50.00", "EUR"),
+		ExchangeRate:   money.MustParseRate("1.0845"),
+		Date:           time.Now(),
+		Type:           constant.TransactionTypeExpense,
+		Category:       "Food",
+	}
+	mockService := &httptesting.MockTransactionService{
+		TransactionToReturn: testTransaction,
+	}
+	handler := NewGetTransactionHandler(mockService)
+
+	req, _ := http.NewRequest(
+		http.MethodGet,
+		"/api/v1/transactions/123e4567-e89b-12d3-a456-426614174000",
+		nil,
+	)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	var response TransactionResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if response.OriginalAmount != "50.00" || response.OriginalCurrency != "EUR" || response.ExchangeRate != "1.0845" {
+		t.Errorf("unexpected conversion fields: %q %q %q", response.OriginalAmount, response.OriginalCurrency, response.ExchangeRate)
+	}
+}
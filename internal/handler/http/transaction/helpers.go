@@ -1,22 +1,214 @@
 package transaction
 
 import (
+	"fmt"
+	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
+	"accounting/internal/domain/constant"
 	"accounting/internal/domain/entity"
+	"accounting/internal/domain/money"
+	"accounting/internal/handler/http/common"
 )
 
-func toTransactionResponse(transaction *entity.Transaction) *TransactionResponse {
-	return &TransactionResponse{
+// ToTransactionResponse maps a domain transaction to its API representation
+func ToTransactionResponse(transaction *entity.Transaction) *TransactionResponse {
+	response := &TransactionResponse{
 		ID:          transaction.ID,
 		AccountID:   transaction.AccountID,
-		Amount:      transaction.Amount,
-		Currency:    transaction.Currency,
+		Amount:      transaction.Amount.String(),
+		Currency:    transaction.Amount.Currency(),
 		Description: transaction.Description,
-		Category:    transaction.Category,
 		Type:        transaction.Type,
 		Date:        transaction.Date,
+
+		PayeeID: transaction.PayeeID,
+		Payee:   transaction.Payee,
+
+		CategoryID: transaction.CategoryID,
+		Category:   transaction.Category,
+		Tags:       transaction.Tags,
+
+		TransferID:        transaction.TransferID,
+		TransferDirection: transaction.TransferDirection,
+
+		ExternalID: transaction.ExternalID,
+	}
+	for _, split := range transaction.Splits {
+		response.Splits = append(response.Splits, &SplitResponse{
+			ID:         split.ID,
+			Amount:     split.Amount.String(),
+			Currency:   split.Amount.Currency(),
+			CategoryID: split.CategoryID,
+			Category:   split.Category,
+			Memo:       split.Memo,
+		})
+	}
+	if response.Tags == nil {
+		response.Tags = []string{}
+	}
+	if transaction.OriginalAmount.Currency() != "" {
+		response.OriginalAmount = transaction.OriginalAmount.String()
+		response.OriginalCurrency = transaction.OriginalAmount.Currency()
+		response.ExchangeRate = transaction.ExchangeRate.String()
+	}
+	if transaction.RunningBalance.Currency() != "" {
+		response.RunningBalance = transaction.RunningBalance.String()
+	}
+	return response
+}
+
+// validateSplits checks the fields of each split of a request.
+func validateSplits(splits []SplitRequest) []*common.ValidationError {
+	var checks []*common.ValidationError
+	for i, split := range splits {
+		field := fmt.Sprintf("splits[%d].", i)
+		checks = append(checks,
+			common.ValidateCurrency(split.Currency, field+"currency"),
+			common.ValidateAmount(split.Amount, split.Currency, field+"amount"),
+		)
+		if split.CategoryID != "" {
+			checks = append(checks, common.ValidateUUID(split.CategoryID, field+"category_id"))
+		}
+	}
+	return checks
+}
+
+// toSplits maps the splits of a request, validated by validateSplits, to
+// domain splits. Nil stays nil, so that updates can tell it apart from
+// removing the splits.
+func toSplits(splits []SplitRequest) ([]*entity.Split, error) {
+	if splits == nil {
+		return nil, nil
+	}
+	result := make([]*entity.Split, 0, len(splits))
+	for _, split := range splits {
+		amount, err := money.Parse(split.Amount, split.Currency)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, &entity.Split{
+			Amount:     amount,
+			CategoryID: split.CategoryID,
+			Memo:       split.Memo,
+		})
+	}
+	return result, nil
+}
+
+// toTransactionCSVRecord maps a domain transaction to a row of the CSV export,
+// in the order of transactionCSVHeader
+func toTransactionCSVRecord(transaction *entity.Transaction) []string {
+	response := ToTransactionResponse(transaction)
+	return []string{
+		response.ID,
+		response.AccountID,
+		response.Date.UTC().Format(time.DateOnly),
+		string(response.Type),
+		response.Amount,
+		response.Currency,
+		common.CSVText(response.Category),
+		common.CSVText(response.Description),
+		response.TransferID,
+		string(response.TransferDirection),
+		response.OriginalAmount,
+		response.OriginalCurrency,
+		response.ExchangeRate,
+		response.CategoryID,
+		common.CSVText(strings.Join(response.Tags, ",")),
+		common.CSVText(response.Payee),
+		response.PayeeID,
+	}
+}
+
+// parseTransactionFilter reads the filter, sort and running_balance query
+// parameters of the transaction listing. Dates are whole days, so to includes the whole day.
+func parseTransactionFilter(query url.Values) (entity.TransactionFilter, []common.ValidationError) {
+	filter := entity.TransactionFilter{
+		Type:        constant.TransactionType(query.Get("type")),
+		MinAmount:   query.Get("min_amount"),
+		MaxAmount:   query.Get("max_amount"),
+		Description: strings.TrimSpace(query.Get("description")),
+		Sort:        constant.TransactionSort(query.Get("sort")),
+		TagMatch:    constant.TagMatch(query.Get("tag_match")),
+	}
+
+	var checks []*common.ValidationError
+	if from := query.Get("from"); from != "" {
+		checks = append(checks, common.ValidateDate(from, "from"))
+		filter.From, _ = time.Parse(time.DateOnly, from)
+	}
+	if to := query.Get("to"); to != "" {
+		checks = append(checks, common.ValidateDate(to, "to"))
+		if day, err := time.Parse(time.DateOnly, to); err == nil {
+			filter.To = day.AddDate(0, 0, 1)
+		}
+	}
+	if filter.Type != "" {
+		checks = append(checks, common.ValidateEnum(string(filter.Type), transactionTypeNames(), "type"))
+	}
+	if filter.Sort != "" {
+		checks = append(checks, common.ValidateEnum(string(filter.Sort), transactionSortNames(), "sort"))
+	}
+	if runningBalance := query.Get("running_balance"); runningBalance != "" {
+		checks = append(checks, common.ValidateBool(runningBalance, "running_balance"))
+		filter.RunningBalance, _ = strconv.ParseBool(runningBalance)
+	}
+	// Categories may be repeated, comma-separated or both
+	for _, value := range query["category_id"] {
+		for _, categoryID := range strings.Split(value, ",") {
+			if categoryID = strings.TrimSpace(categoryID); categoryID != "" {
+				checks = append(checks, common.ValidateUUID(categoryID, "category_id"))
+				filter.CategoryIDs = append(filter.CategoryIDs, categoryID)
+			}
+		}
+	}
+	// Tags too; tag names never contain commas
+	for _, value := range query["tag"] {
+		for _, tag := range strings.Split(value, ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				filter.Tags = append(filter.Tags, tag)
+			}
+		}
+	}
+	if filter.TagMatch != "" {
+		checks = append(checks, common.ValidateEnum(string(filter.TagMatch), tagMatchNames(), "tag_match"))
+	}
+	validationErrors := common.CollectErrors(checks...)
+	if len(validationErrors) == 0 && !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		validationErrors = append(validationErrors, common.ValidationError{Field: "to", Message: "to must not be before from"})
+	}
+
+	return filter, validationErrors
+}
+
+// transactionTypeNames returns the accepted values of the type filter.
+func transactionTypeNames() []string {
+	names := make([]string, 0, len(constant.TransactionTypes))
+	for _, transactionType := range constant.TransactionTypes {
+		names = append(names, string(transactionType))
+	}
+	return names
+}
+
+// transactionSortNames returns the accepted values of the sort parameter.
+func transactionSortNames() []string {
+	names := make([]string, 0, len(constant.TransactionSorts))
+	for _, sort := range constant.TransactionSorts {
+		names = append(names, string(sort))
+	}
+	return names
+}
+
+// tagMatchNames returns the accepted values of the tag_match parameter.
+func tagMatchNames() []string {
+	names := make([]string, 0, len(constant.TagMatches))
+	for _, match := range constant.TagMatches {
+		names = append(names, string(match))
 	}
+	return names
 }
 
 func extractID(path, prefix string) string {
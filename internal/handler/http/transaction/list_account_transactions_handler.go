@@ -4,6 +4,7 @@ import (
 	"errors"
 	"net/http"
 
+	"accounting/internal/domain/entity"
 	domainerrors "accounting/internal/domain/errors"
 	"accounting/internal/domain/interfaces"
 	"accounting/internal/handler/http/common"
@@ -18,12 +19,28 @@ func NewListAccountTransactionsHandler(service interfaces.TransactionService) *L
 }
 
 // @Summary List account transactions
-// @Description Retrieve all transactions for a specific account
+// @Description Retrieve a page of an account's transactions, newest first unless sort says otherwise. Filters combine with AND. Pass next_cursor back as cursor, with the same filters, to fetch the following page.
+// @Description With format=csv or an Accept header of text/csv, every matching transaction is streamed as CSV with a fixed column layout instead, and limit and cursor are ignored. CSV exports do not support running_balance.
 // @Tags transactions
 // @Accept json
 // @Produce json
+// @Produce text/csv
 // @Param accountID path string true "Account ID"
-// @Success 200 {array} TransactionResponse
+// @Param from query string false "Earliest transaction date (YYYY-MM-DD), inclusive"
+// @Param to query string false "Latest transaction date (YYYY-MM-DD), inclusive"
+// @Param type query string false "Transaction type" Enums(INCOME, EXPENSE, TRANSFER)
+// @Param category_id query []string false "IDs of the categories to include, with their subcategories; repeat the parameter or separate with commas" collectionFormat(multi)
+// @Param tag query []string false "Tags to filter by, ignoring case; repeat the parameter or separate with commas" collectionFormat(multi)
+// @Param tag_match query string false "Whether transactions need any or all of the tags (default any)" Enums(any, all)
+// @Param min_amount query string false "Smallest amount, inclusive, in the account currency"
+// @Param max_amount query string false "Largest amount, inclusive, in the account currency"
+// @Param description query string false "Case-insensitive substring of the description"
+// @Param sort query string false "Order of the listing (default date_desc)" Enums(date_desc, date_asc, amount_desc, amount_asc)
+// @Param running_balance query bool false "Include each transaction's running_balance, computed over the account's whole history regardless of filters and pagination; not available with CSV"
+// @Param format query string false "Response format, overriding the Accept header (default json)" Enums(json, csv)
+// @Param limit query int false "Page size (1-200, default 50)"
+// @Param cursor query string false "Cursor returned as next_cursor by the previous page"
+// @Success 200 {object} TransactionListResponse
 // @Failure 400 {object} common.ValidationProblem "Validation error"
 // @Failure 404 {object} common.ProblemDetail "Account not found"
 // @Failure 500 {object} common.ProblemDetail "Internal server error"
@@ -52,23 +69,85 @@ func (h *ListAccountTransactionsHandler) Handle(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	transactions, err := h.service.ListAccountTransactions(r.Context(), accountID)
-	if err != nil {
-		var notFoundErr *domainerrors.ErrNotFound
-		if errors.As(err, &notFoundErr) {
-			problem := common.NewNotFoundProblem(err.Error(), r.RequestURI)
-			common.WriteProblem(w, problem)
-			return
-		}
-		problem := common.NewInternalErrorProblem(r.RequestURI)
+	csvFormat, formatErr := common.WantsCSV(r)
+	page, validationErrors := common.ParsePageRequest(r.URL.Query())
+	filter, filterErrors := parseTransactionFilter(r.URL.Query())
+	validationErrors = append(validationErrors, filterErrors...)
+	validationErrors = append(validationErrors, common.CollectErrors(formatErr)...)
+	// Every page of an export would rerun the running balance window over the
+	// whole history, so exports leave it out
+	if csvFormat && filter.RunningBalance {
+		validationErrors = append(validationErrors, common.ValidationError{Field: "running_balance", Message: "running_balance is not available in CSV exports"})
+	}
+	if len(validationErrors) > 0 {
+		problem := common.NewValidationProblemWithErrors(r.RequestURI, validationErrors)
 		common.WriteProblem(w, problem)
 		return
 	}
 
-	response := make([]*TransactionResponse, 0, len(transactions))
+	if csvFormat {
+		h.writeCSV(w, r, accountID, filter)
+		return
+	}
+
+	transactions, nextCursor, err := h.service.ListAccountTransactions(r.Context(), accountID, filter, page)
+	if err != nil {
+		writeListError(w, r, err)
+		return
+	}
+
+	response := &TransactionListResponse{
+		Items:      make([]*TransactionResponse, 0, len(transactions)),
+		Limit:      common.PageLimit(page),
+		NextCursor: nextCursor,
+	}
 	for _, txn := range transactions {
-		response = append(response, toTransactionResponse(txn))
+		response.Items = append(response.Items, ToTransactionResponse(txn))
 	}
 
 	common.WriteJSON(w, http.StatusOK, response)
 }
+
+// writeCSV streams every transaction matching the filter as CSV, fetching the
+// largest page the service allows at a time.
+func (h *ListAccountTransactionsHandler) writeCSV(w http.ResponseWriter, r *http.Request, accountID string, filter entity.TransactionFilter) {
+	stream := common.NewCSVStream(w, "transactions-"+accountID+".csv", transactionCSVHeader)
+	page := entity.PageRequest{Limit: entity.MaxPageLimit}
+	for {
+		transactions, nextCursor, err := h.service.ListAccountTransactions(r.Context(), accountID, filter, page)
+		if err != nil {
+			// Once rows are sent the status can no longer change, so the export is cut short
+			if page.Cursor == "" {
+				writeListError(w, r, err)
+			}
+			return
+		}
+		for _, txn := range transactions {
+			if err := stream.Write(toTransactionCSVRecord(txn)); err != nil {
+				return
+			}
+		}
+		if err := stream.Flush(); err != nil || nextCursor == "" {
+			return
+		}
+		page.Cursor = nextCursor
+	}
+}
+
+// writeListError maps transaction listing errors to problem responses.
+func writeListError(w http.ResponseWriter, r *http.Request, err error) {
+	var notFoundErr *domainerrors.ErrNotFound
+	if errors.As(err, &notFoundErr) {
+		problem := common.NewNotFoundProblem(err.Error(), r.RequestURI)
+		common.WriteProblem(w, problem)
+		return
+	}
+	var invalidErr *domainerrors.ErrInvalidInput
+	if errors.As(err, &invalidErr) {
+		problem := common.NewValidationProblem(err.Error(), r.RequestURI)
+		common.WriteProblem(w, problem)
+		return
+	}
+	problem := common.NewInternalErrorProblem(r.RequestURI)
+	common.WriteProblem(w, problem)
+}
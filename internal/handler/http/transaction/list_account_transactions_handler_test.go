@@ -1,14 +1,19 @@
 package transaction
 
 import (
+	"encoding/csv"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
 	"accounting/internal/domain/constant"
 	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/money"
 	httptesting "accounting/internal/handler/http"
 )
 
@@ -17,8 +22,7 @@ func TestListAccountTransactionsHandlerSuccess(t *testing.T) {
 		{
 			ID:          "transaction-1",
 			AccountID:   "123e4567-e89b-12d3-a456-426614174000",
-			Amount:      100.00,
-			Currency:    "USD",
+			Amount:      money.MustParse("100.00", "USD"),
 			Description: "First transaction",
 			Date:        time.Now(),
 			Type:        constant.TransactionTypeExpense,
@@ -27,8 +31,7 @@ func TestListAccountTransactionsHandlerSuccess(t *testing.T) {
 		{
 			ID:          "transaction-2",
 			AccountID:   "123e4567-e89b-12d3-a456-426614174000",
-			Amount:      50.00,
-			Currency:    "USD",
+			Amount:      money.MustParse("50.00", "USD"),
 			Description: "Second transaction",
 			Date:        time.Now(),
 			Type:        constant.TransactionTypeIncome,
@@ -37,6 +40,7 @@ func TestListAccountTransactionsHandlerSuccess(t *testing.T) {
 	}
 	mockService := &httptesting.MockTransactionService{
 		TransactionsToReturn: transactions,
+		NextCursorToReturn:   "next-page",
 	}
 	handler := NewListAccountTransactionsHandler(mockService)
 
@@ -53,17 +57,25 @@ func TestListAccountTransactionsHandlerSuccess(t *testing.T) {
 		t.Errorf("expected status %d, got %d", http.StatusOK, w.Code)
 	}
 
-	var response []TransactionResponse
+	var response TransactionListResponse
 	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
 		t.Fatalf("failed to decode response: %v", err)
 	}
 
-	if len(response) != 2 {
-		t.Errorf("expected 2 transactions, got %d", len(response))
+	if len(response.Items) != 2 {
+		t.Fatalf("expected 2 transactions, got %d", len(response.Items))
 	}
 
-	if response[0].Description != "First transaction" {
-		t.Errorf("expected first transaction description %q, got %q", "First transaction", response[0].Description)
+	if response.Items[0].Description != "First transaction" {
+		t.Errorf("expected first transaction description %q, got %q", "First transaction", response.Items[0].Description)
+	}
+
+	if response.Limit != entity.DefaultPageLimit {
+		t.Errorf("expected limit %d, got %d", entity.DefaultPageLimit, response.Limit)
+	}
+
+	if response.NextCursor != "next-page" {
+		t.Errorf("expected next cursor %q, got %q", "next-page", response.NextCursor)
 	}
 
 	if mockService.ListAccountTransactionsCalls != 1 {
@@ -90,13 +102,277 @@ func TestListAccountTransactionsHandlerEmpty(t *testing.T) {
 		t.Errorf("expected status %d, got %d", http.StatusOK, w.Code)
 	}
 
-	var response []TransactionResponse
+	var response TransactionListResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if len(response.Items) != 0 {
+		t.Errorf("expected 0 transactions, got %d", len(response.Items))
+	}
+
+	if response.NextCursor != "" {
+		t.Errorf("expected no next cursor, got %q", response.NextCursor)
+	}
+}
+
+func TestListAccountTransactionsHandlerPageParams(t *testing.T) {
+	mockService := &httptesting.MockTransactionService{}
+	handler := NewListAccountTransactionsHandler(mockService)
+
+	req, _ := http.NewRequest(
+		http.MethodGet,
+		"/api/v1/accounts/123e4567-e89b-12d3-a456-426614174000/transactions?limit=10&cursor=abc",
+		nil,
+	)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	if mockService.LastPage.Limit != 10 {
+		t.Errorf("expected limit 10, got %d", mockService.LastPage.Limit)
+	}
+
+	if mockService.LastPage.Cursor != "abc" {
+		t.Errorf("expected cursor %q, got %q", "abc", mockService.LastPage.Cursor)
+	}
+}
+
+func TestListAccountTransactionsHandlerInvalidLimit(t *testing.T) {
+	for _, limit := range []string{"0", "201", "ten"} {
+		mockService := &httptesting.MockTransactionService{}
+		handler := NewListAccountTransactionsHandler(mockService)
+
+		req, _ := http.NewRequest(
+			http.MethodGet,
+			"/api/v1/accounts/123e4567-e89b-12d3-a456-426614174000/transactions?limit="+limit,
+			nil,
+		)
+		w := httptest.NewRecorder()
+
+		handler.Handle(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("limit %s: expected status %d, got %d", limit, http.StatusBadRequest, w.Code)
+		}
+
+		if mockService.ListAccountTransactionsCalls != 0 {
+			t.Errorf("limit %s: expected no service call, got %d", limit, mockService.ListAccountTransactionsCalls)
+		}
+	}
+}
+
+func TestListAccountTransactionsHandlerInvalidCursor(t *testing.T) {
+	mockService := &httptesting.MockTransactionService{
+		LastListAccountTransactionsErr: domainerrors.NewErrInvalidInput("cursor", "invalid cursor"),
+	}
+	handler := NewListAccountTransactionsHandler(mockService)
+
+	req, _ := http.NewRequest(
+		http.MethodGet,
+		"/api/v1/accounts/123e4567-e89b-12d3-a456-426614174000/transactions?cursor=garbage",
+		nil,
+	)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestListAccountTransactionsHandlerFilters(t *testing.T) {
+	mockService := &httptesting.MockTransactionService{}
+	handler := NewListAccountTransactionsHandler(mockService)
+
+	req, _ := http.NewRequest(
+		http.MethodGet,
+		"/api/v1/accounts/123e4567-e89b-12d3-a456-426614174000/transactions"+
+			"?from=2024-01-01&to=2024-01-31&type=EXPENSE&category_id=323e4567-e89b-12d3-a456-426614174001,323e4567-e89b-12d3-a456-426614174002&category_id=323e4567-e89b-12d3-a456-426614174003"+
+			"&min_amount=100&max_amount=500.50&description=%20market%20&sort=amount_desc&tag=reimbursable,%20vacation-2026&tag=tax-deductible&tag_match=all",
+		nil,
+	)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+
+	filter := mockService.LastFilter
+	if !filter.From.Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected from 2024-01-01, got %s", filter.From)
+	}
+	if !filter.To.Equal(time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected to to include the whole of 2024-01-31, got %s", filter.To)
+	}
+	if filter.Type != constant.TransactionTypeExpense {
+		t.Errorf("expected type %q, got %q", constant.TransactionTypeExpense, filter.Type)
+	}
+	if len(filter.CategoryIDs) != 3 || filter.CategoryIDs[0] != "323e4567-e89b-12d3-a456-426614174001" || filter.CategoryIDs[2] != "323e4567-e89b-12d3-a456-426614174003" {
+		t.Errorf("expected three category IDs in query order, got %v", filter.CategoryIDs)
+	}
+	if filter.MinAmount != "100" || filter.MaxAmount != "500.50" {
+		t.Errorf("expected amounts 100..500.50, got %s..%s", filter.MinAmount, filter.MaxAmount)
+	}
+	if filter.Description != "market" {
+		t.Errorf("expected description %q, got %q", "market", filter.Description)
+	}
+	if filter.Sort != constant.TransactionSortAmountDesc {
+		t.Errorf("expected sort %q, got %q", constant.TransactionSortAmountDesc, filter.Sort)
+	}
+	if strings.Join(filter.Tags, ",") != "reimbursable,vacation-2026,tax-deductible" {
+		t.Errorf("expected three tags in query order, got %v", filter.Tags)
+	}
+	if filter.TagMatch != constant.TagMatchAll {
+		t.Errorf("expected tag match %q, got %q", constant.TagMatchAll, filter.TagMatch)
+	}
+}
+
+func TestListAccountTransactionsHandlerRunningBalance(t *testing.T) {
+	mockService := &httptesting.MockTransactionService{
+		TransactionsToReturn: []*entity.Transaction{
+			{
+				ID:             "transaction-1",
+				AccountID:      "123e4567-e89b-12d3-a456-426614174000",
+				Amount:         money.MustParse("100.00", "USD"),
+				Type:           constant.TransactionTypeIncome,
+				RunningBalance: money.MustParse("250.00", "USD"),
+			},
+		},
+	}
+	handler := NewListAccountTransactionsHandler(mockService)
+
+	req, _ := http.NewRequest(
+		http.MethodGet,
+		"/api/v1/accounts/123e4567-e89b-12d3-a456-426614174000/transactions?running_balance=true&from=2024-01-01",
+		nil,
+	)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	if !mockService.LastFilter.RunningBalance {
+		t.Error("expected the filter to ask for running balances")
+	}
+
+	var response TransactionListResponse
 	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
 		t.Fatalf("failed to decode response: %v", err)
 	}
+	if len(response.Items) != 1 {
+		t.Fatalf("expected 1 transaction, got %d", len(response.Items))
+	}
+	if response.Items[0].RunningBalance != "250.00" {
+		t.Errorf("expected running balance 250.00, got %q", response.Items[0].RunningBalance)
+	}
+}
 
-	if len(response) != 0 {
-		t.Errorf("expected 0 transactions, got %d", len(response))
+func TestListAccountTransactionsHandlerOmitsRunningBalance(t *testing.T) {
+	mockService := &httptesting.MockTransactionService{
+		TransactionsToReturn: []*entity.Transaction{
+			{
+				ID:        "transaction-1",
+				AccountID: "123e4567-e89b-12d3-a456-426614174000",
+				Amount:    money.MustParse("100.00", "USD"),
+				Type:      constant.TransactionTypeIncome,
+			},
+		},
+	}
+	handler := NewListAccountTransactionsHandler(mockService)
+
+	req, _ := http.NewRequest(
+		http.MethodGet,
+		"/api/v1/accounts/123e4567-e89b-12d3-a456-426614174000/transactions",
+		nil,
+	)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+	if mockService.LastFilter.RunningBalance {
+		t.Error("expected running balances not to be requested by default")
+	}
+
+	var body struct {
+		Items []map[string]any `json:"items"`
+	}
+	if err := json.NewDecoder(w.Body).Decode(&body); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if _, ok := body.Items[0]["running_balance"]; ok {
+		t.Error("expected running_balance to be omitted")
+	}
+}
+
+func TestListAccountTransactionsHandlerInvalidFilters(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{"bad from", "from=01/02/2024"},
+		{"bad to", "to=tomorrow"},
+		{"to before from", "from=2024-02-01&to=2024-01-01"},
+		{"unknown type", "type=REFUND"},
+		{"unknown sort", "sort=name"},
+		{"bad running_balance", "running_balance=maybe"},
+		{"bad category_id", "category_id=groceries"},
+		{"unknown tag_match", "tag=reimbursable&tag_match=some"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &httptesting.MockTransactionService{}
+			handler := NewListAccountTransactionsHandler(mockService)
+
+			req, _ := http.NewRequest(
+				http.MethodGet,
+				"/api/v1/accounts/123e4567-e89b-12d3-a456-426614174000/transactions?"+tt.query,
+				nil,
+			)
+			w := httptest.NewRecorder()
+
+			handler.Handle(w, req)
+
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+			}
+			if mockService.ListAccountTransactionsCalls != 0 {
+				t.Errorf("expected no service call, got %d", mockService.ListAccountTransactionsCalls)
+			}
+		})
+	}
+}
+
+func TestListAccountTransactionsHandlerAccountNotFound(t *testing.T) {
+	mockService := &httptesting.MockTransactionService{
+		LastListAccountTransactionsErr: domainerrors.NewErrNotFound("account", "123e4567-e89b-12d3-a456-426614174000"),
+	}
+	handler := NewListAccountTransactionsHandler(mockService)
+
+	req, _ := http.NewRequest(
+		http.MethodGet,
+		"/api/v1/accounts/123e4567-e89b-12d3-a456-426614174000/transactions",
+		nil,
+	)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
 	}
 }
 
@@ -135,3 +411,202 @@ func TestListAccountTransactionsHandlerInvalidMethod(t *testing.T) {
 		t.Errorf("expected status %d, got %d", http.StatusMethodNotAllowed, w.Code)
 	}
 }
+
+func TestListAccountTransactionsHandlerCSV(t *testing.T) {
+	date := time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)
+	mockService := &httptesting.MockTransactionService{
+		TransactionPagesToReturn: [][]*entity.Transaction{
+			{
+				{
+					ID:          "transaction-1",
+					AccountID:   "123e4567-e89b-12d3-a456-426614174000",
+					Amount:      money.MustParse("100.00", "USD"),
+					Description: "Groceries, weekly",
+					PayeeID:     "423e4567-e89b-12d3-a456-426614174001",
+					Payee:       "Corner Shop",
+					Date:        date,
+					Type:        constant.TransactionTypeExpense,
+					CategoryID:  "323e4567-e89b-12d3-a456-426614174001",
+					Category:    "Food",
+					Tags:        []string{"reimbursable", "vacation-2026"},
+				},
+			},
+			{
+				{
+					ID:          "transaction-2",
+					AccountID:   "123e4567-e89b-12d3-a456-426614174000",
+					Amount:      money.MustParse("50.00", "USD"),
+					Description: "=HYPERLINK(\"http://example.com\")",
+					Date:        date,
+					Type:        constant.TransactionTypeIncome,
+					CategoryID:  "323e4567-e89b-12d3-a456-426614174002",
+					Category:    "Salary",
+				},
+			},
+		},
+	}
+	handler := NewListAccountTransactionsHandler(mockService)
+
+	req, _ := http.NewRequest(
+		http.MethodGet,
+		"/api/v1/accounts/123e4567-e89b-12d3-a456-426614174000/transactions?category_id=323e4567-e89b-12d3-a456-426614174001,323e4567-e89b-12d3-a456-426614174002&limit=1",
+		nil,
+	)
+	req.Header.Set("Accept", "text/csv")
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	if contentType := w.Header().Get("Content-Type"); !strings.HasPrefix(contentType, "text/csv") {
+		t.Errorf("expected a text/csv response, got %q", contentType)
+	}
+	if mockService.ListAccountTransactionsCalls != 2 {
+		t.Errorf("expected both pages to be fetched, got %d calls", mockService.ListAccountTransactionsCalls)
+	}
+	if mockService.LastPage.Limit != entity.MaxPageLimit || mockService.LastPage.Cursor != "page-2" {
+		t.Errorf("expected the second page to be fetched at the maximum limit, got %+v", mockService.LastPage)
+	}
+	if len(mockService.LastFilter.CategoryIDs) != 2 {
+		t.Errorf("expected the category filter to be applied, got %+v", mockService.LastFilter)
+	}
+
+	records, err := csv.NewReader(w.Body).ReadAll()
+	if err != nil {
+		t.Fatalf("failed to parse CSV: %v", err)
+	}
+	if len(records) != 3 {
+		t.Fatalf("expected a header and 2 rows, got %d records", len(records))
+	}
+	if strings.Join(records[0], ",") != strings.Join(transactionCSVHeader, ",") {
+		t.Errorf("expected header %v, got %v", transactionCSVHeader, records[0])
+	}
+	if records[1][0] != "transaction-1" || records[1][2] != "2024-03-05" || records[1][4] != "100.00" || records[1][7] != "Groceries, weekly" {
+		t.Errorf("unexpected first row %v", records[1])
+	}
+	if tags := records[1][slices.Index(transactionCSVHeader, "tags")]; tags != "reimbursable,vacation-2026" {
+		t.Errorf("expected the tags in the tags column, got %q", tags)
+	}
+	if payee := records[1][slices.Index(transactionCSVHeader, "payee")]; payee != "Corner Shop" {
+		t.Errorf("expected the payee in the payee column, got %q", payee)
+	}
+	if records[2][7] != "'=HYPERLINK(\"http://example.com\")" {
+		t.Errorf("expected the formula to be neutralised, got %q", records[2][7])
+	}
+}
+
+func TestListAccountTransactionsHandlerCSVFormatParam(t *testing.T) {
+	tests := []struct {
+		name        string
+		query       string
+		accept      string
+		expectedCSV bool
+	}{
+		{"format csv", "?format=csv", "", true},
+		{"accept with parameters", "", "application/json;q=0.5, text/csv;q=0.9", true},
+		{"format json overrides accept", "?format=json", "text/csv", false},
+		{"default json", "", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &httptesting.MockTransactionService{}
+			handler := NewListAccountTransactionsHandler(mockService)
+
+			req, _ := http.NewRequest(
+				http.MethodGet,
+				"/api/v1/accounts/123e4567-e89b-12d3-a456-426614174000/transactions"+tt.query,
+				nil,
+			)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			w := httptest.NewRecorder()
+
+			handler.Handle(w, req)
+
+			if w.Code != http.StatusOK {
+				t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+			}
+			isCSV := strings.HasPrefix(w.Header().Get("Content-Type"), "text/csv")
+			if isCSV != tt.expectedCSV {
+				t.Errorf("expected CSV %v, got Content-Type %q", tt.expectedCSV, w.Header().Get("Content-Type"))
+			}
+			if isCSV && strings.TrimSpace(w.Body.String()) != strings.Join(transactionCSVHeader, ",") {
+				t.Errorf("expected only the header row, got %q", w.Body.String())
+			}
+		})
+	}
+}
+
+func TestListAccountTransactionsHandlerCSVErrors(t *testing.T) {
+	t.Run("unknown format", func(t *testing.T) {
+		mockService := &httptesting.MockTransactionService{}
+		handler := NewListAccountTransactionsHandler(mockService)
+
+		req, _ := http.NewRequest(
+			http.MethodGet,
+			"/api/v1/accounts/123e4567-e89b-12d3-a456-426614174000/transactions?format=xml",
+			nil,
+		)
+		w := httptest.NewRecorder()
+
+		handler.Handle(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+		}
+		if mockService.ListAccountTransactionsCalls != 0 {
+			t.Errorf("expected no service call, got %d", mockService.ListAccountTransactionsCalls)
+		}
+	})
+
+	t.Run("running balance", func(t *testing.T) {
+		mockService := &httptesting.MockTransactionService{}
+		handler := NewListAccountTransactionsHandler(mockService)
+
+		req, _ := http.NewRequest(
+			http.MethodGet,
+			"/api/v1/accounts/123e4567-e89b-12d3-a456-426614174000/transactions?format=csv&running_balance=true",
+			nil,
+		)
+		w := httptest.NewRecorder()
+
+		handler.Handle(w, req)
+
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+		}
+		if !strings.Contains(w.Body.String(), "running_balance") {
+			t.Errorf("expected running_balance to be reported, got %s", w.Body.String())
+		}
+		if mockService.ListAccountTransactionsCalls != 0 {
+			t.Errorf("expected no service call, got %d", mockService.ListAccountTransactionsCalls)
+		}
+	})
+
+	t.Run("account not found", func(t *testing.T) {
+		mockService := &httptesting.MockTransactionService{
+			LastListAccountTransactionsErr: domainerrors.NewErrNotFound("account", "123e4567-e89b-12d3-a456-426614174000"),
+		}
+		handler := NewListAccountTransactionsHandler(mockService)
+
+		req, _ := http.NewRequest(
+			http.MethodGet,
+			"/api/v1/accounts/123e4567-e89b-12d3-a456-426614174000/transactions?format=csv",
+			nil,
+		)
+		w := httptest.NewRecorder()
+
+		handler.Handle(w, req)
+
+		if w.Code != http.StatusNotFound {
+			t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+		}
+		if contentType := w.Header().Get("Content-Type"); contentType != "application/problem+json" {
+			t.Errorf("expected a problem response, got %q", contentType)
+		}
+	})
+}
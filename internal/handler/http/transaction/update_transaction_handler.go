@@ -8,6 +8,7 @@ import (
 
 	domainerrors "accounting/internal/domain/errors"
 	"accounting/internal/domain/interfaces"
+	"accounting/internal/domain/money"
 	"accounting/internal/handler/http/common"
 )
 
@@ -20,7 +21,7 @@ func NewUpdateTransactionHandler(service interfaces.TransactionService) *UpdateT
 }
 
 // @Summary Update a transaction
-// @Description Update an existing transaction
+// @Description Update an existing transaction and recalculate the affected account balances. Setting account_id moves the transaction to another account. Setting payee_id gives a transaction without a category or splits the payee's default category. Tags, when given, replace the transaction's tags; an empty list removes them. Splits, when given, replace the transaction's splits and category, in the currency of the amount as entered; an empty list removes them. Splits must be given again when a new amount, date or account no longer converts to their total.
 // @Tags transactions
 // @Accept json
 // @Produce json
@@ -68,17 +69,31 @@ func (h *UpdateTransactionHandler) Handle(w http.ResponseWriter, r *http.Request
 	}
 
 	// Validate optional input fields - only validate fields that are provided
+	// Amount and currency describe a single money value, so they are updated together
 	var validationErrs []*common.ValidationError
-	if req.Amount != 0 {
-		if err := common.ValidatePositive(req.Amount, "amount"); err != nil {
+	if req.AccountID != "" {
+		if err := common.ValidateUUID(req.AccountID, "account_id"); err != nil {
 			validationErrs = append(validationErrs, err)
 		}
 	}
-	if req.Currency != "" {
+	if req.Amount != "" || req.Currency != "" {
 		if err := common.ValidateCurrency(req.Currency, "currency"); err != nil {
 			validationErrs = append(validationErrs, err)
+		} else if err := common.ValidateAmount(req.Amount, req.Currency, "amount"); err != nil {
+			validationErrs = append(validationErrs, err)
 		}
 	}
+	if req.PayeeID != "" {
+		if err := common.ValidateUUID(req.PayeeID, "payee_id"); err != nil {
+			validationErrs = append(validationErrs, err)
+		}
+	}
+	if req.CategoryID != "" {
+		if err := common.ValidateUUID(req.CategoryID, "category_id"); err != nil {
+			validationErrs = append(validationErrs, err)
+		}
+	}
+	validationErrs = append(validationErrs, validateSplits(req.Splits)...)
 	if req.Type != "" {
 		if err := common.ValidateEnum(string(req.Type), []string{"INCOME", "EXPENSE", "TRANSFER"}, "type"); err != nil {
 			validationErrs = append(validationErrs, err)
@@ -92,6 +107,24 @@ func (h *UpdateTransactionHandler) Handle(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	var amount money.Money
+	if req.Amount != "" {
+		parsed, err := money.Parse(req.Amount, req.Currency)
+		if err != nil {
+			problem := common.NewValidationProblem(err.Error(), r.RequestURI)
+			common.WriteProblem(w, problem)
+			return
+		}
+		amount = parsed
+	}
+
+	splits, err := toSplits(req.Splits)
+	if err != nil {
+		problem := common.NewValidationProblem(err.Error(), r.RequestURI)
+		common.WriteProblem(w, problem)
+		return
+	}
+
 	var date time.Time
 	if req.Date != nil {
 		date = *req.Date
@@ -100,10 +133,13 @@ func (h *UpdateTransactionHandler) Handle(w http.ResponseWriter, r *http.Request
 	transaction, err := h.service.UpdateTransaction(
 		r.Context(),
 		id,
-		req.Amount,
-		req.Currency,
+		req.AccountID,
+		amount,
 		req.Description,
-		req.Category,
+		req.PayeeID,
+		req.CategoryID,
+		req.Tags,
+		splits,
 		req.Type,
 		date,
 	)
@@ -125,5 +161,5 @@ func (h *UpdateTransactionHandler) Handle(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	common.WriteJSON(w, http.StatusOK, toTransactionResponse(transaction))
+	common.WriteJSON(w, http.StatusOK, ToTransactionResponse(transaction))
 }
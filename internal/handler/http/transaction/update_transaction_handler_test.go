@@ -4,12 +4,14 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"slices"
 	"testing"
 	"time"
 
 	"accounting/internal/domain/constant"
 	"accounting/internal/domain/entity"
 	"accounting/internal/domain/errors"
+	"accounting/internal/domain/money"
 	httptesting "accounting/internal/handler/http"
 )
 
@@ -17,8 +19,7 @@ func TestUpdateTransactionHandlerSuccess(t *testing.T) {
 	testTransaction := &entity.Transaction{
 		ID:          "123e4567-e89b-12d3-a456-426614174000",
 		AccountID:   "account-123",
-		Amount:      200.00,
-		Currency:    "EUR",
+		Amount:      money.MustParse("200.00", "EUR"),
 		Description: "Updated transaction",
 		Date:        time.Now(),
 		Type:        constant.TransactionTypeIncome,
@@ -30,7 +31,7 @@ func TestUpdateTransactionHandlerSuccess(t *testing.T) {
 	handler := NewUpdateTransactionHandler(mockService)
 
 	reqBody := UpdateTransactionRequest{
-		Amount:      200.00,
+		Amount:      "200.00",
 		Currency:    "EUR",
 		Description: "Updated transaction",
 		Type:        constant.TransactionTypeIncome,
@@ -50,8 +51,8 @@ func TestUpdateTransactionHandlerSuccess(t *testing.T) {
 		t.Fatalf("failed to decode response: %v", err)
 	}
 
-	if response.Amount != 200.00 {
-		t.Errorf("expected amount 200.00, got %f", response.Amount)
+	if response.Amount != "200.00" {
+		t.Errorf("expected amount %q, got %q", "200.00", response.Amount)
 	}
 
 	if mockService.UpdateTransactionCalls != 1 {
@@ -63,8 +64,7 @@ func TestUpdateTransactionHandlerPartialUpdate(t *testing.T) {
 	testTransaction := &entity.Transaction{
 		ID:          "123e4567-e89b-12d3-a456-426614174000",
 		AccountID:   "account-123",
-		Amount:      100.00,
-		Currency:    "USD",
+		Amount:      money.MustParse("100.00", "USD"),
 		Description: "Updated description",
 		Date:        time.Now(),
 		Type:        constant.TransactionTypeExpense,
@@ -89,6 +89,84 @@ func TestUpdateTransactionHandlerPartialUpdate(t *testing.T) {
 	}
 }
 
+func TestUpdateTransactionHandlerTags(t *testing.T) {
+	tests := []struct {
+		name string
+		tags []string
+		want []string
+	}{
+		{"absent keeps the tags", nil, nil},
+		{"empty removes the tags", []string{}, []string{}},
+		{"replaces the tags", []string{"reimbursable"}, []string{"reimbursable"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &httptesting.MockTransactionService{
+				TransactionToReturn: &entity.Transaction{
+					ID:     "123e4567-e89b-12d3-a456-426614174000",
+					Amount: money.MustParse("100.00", "USD"),
+					Type:   constant.TransactionTypeExpense,
+				},
+			}
+			handler := NewUpdateTransactionHandler(mockService)
+
+			req := httptesting.NewTestRequest(http.MethodPut, "/api/v1/transactions/123e4567-e89b-12d3-a456-426614174000", UpdateTransactionRequest{Tags: tt.tags})
+			w := httptest.NewRecorder()
+
+			handler.Handle(w, req)
+
+			if w.Code != http.StatusOK {
+				t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+			}
+			if (mockService.LastTags == nil) != (tt.want == nil) || !slices.Equal(mockService.LastTags, tt.want) {
+				t.Errorf("expected tags %#v, got %#v", tt.want, mockService.LastTags)
+			}
+		})
+	}
+}
+
+func TestUpdateTransactionHandlerSplits(t *testing.T) {
+	tests := []struct {
+		name   string
+		splits []SplitRequest
+		want   int
+	}{
+		{"absent keeps the splits", nil, -1},
+		{"empty removes the splits", []SplitRequest{}, 0},
+		{"replaces the splits", []SplitRequest{{Amount: "60.00", Currency: "USD"}, {Amount: "40.00", Currency: "USD"}}, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &httptesting.MockTransactionService{
+				TransactionToReturn: &entity.Transaction{
+					ID:     "123e4567-e89b-12d3-a456-426614174000",
+					Amount: money.MustParse("100.00", "USD"),
+					Type:   constant.TransactionTypeExpense,
+				},
+			}
+			handler := NewUpdateTransactionHandler(mockService)
+
+			req := httptesting.NewTestRequest(http.MethodPut, "/api/v1/transactions/123e4567-e89b-12d3-a456-426614174000", UpdateTransactionRequest{Splits: tt.splits})
+			w := httptest.NewRecorder()
+
+			handler.Handle(w, req)
+
+			if w.Code != http.StatusOK {
+				t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+			}
+			if tt.want < 0 {
+				if mockService.LastSplits != nil {
+					t.Errorf("expected no splits, got %+v", mockService.LastSplits)
+				}
+			} else if mockService.LastSplits == nil || len(mockService.LastSplits) != tt.want {
+				t.Errorf("expected %d splits, got %#v", tt.want, mockService.LastSplits)
+			}
+		})
+	}
+}
+
 func TestUpdateTransactionHandlerInvalidCurrency(t *testing.T) {
 	mockService := &httptesting.MockTransactionService{}
 	handler := NewUpdateTransactionHandler(mockService)
@@ -0,0 +1,105 @@
+package transfer
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/domain/money"
+	"accounting/internal/handler/http/common"
+)
+
+type CreateTransferHandler struct {
+	service interfaces.TransferService
+}
+
+func NewCreateTransferHandler(service interfaces.TransferService) *CreateTransferHandler {
+	return &CreateTransferHandler{service: service}
+}
+
+// @Summary Create a transfer
+// @Description Move money between two accounts of the same user as a linked pair of transactions. Each leg is converted into its account currency when needed.
+// @Tags transfers
+// @Accept json
+// @Produce json
+// @Param body body CreateTransferRequest true "Transfer request"
+// @Success 201 {object} TransferResponse
+// @Failure 400 {object} common.ValidationProblem "Validation error"
+// @Failure 404 {object} common.ProblemDetail "Account not found"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/transfers [post]
+func (h *CreateTransferHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		problem := common.NewMethodNotAllowedProblem(r.RequestURI)
+		common.WriteProblem(w, problem)
+		return
+	}
+
+	var req CreateTransferRequest
+	if r.Body == nil {
+		problem := common.NewBadRequestProblem("invalid request body", r.RequestURI)
+		common.WriteProblem(w, problem)
+		return
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		problem := common.NewBadRequestProblem("invalid request body", r.RequestURI)
+		common.WriteProblem(w, problem)
+		return
+	}
+
+	// Validate input
+	validationErrors := common.CollectErrors(
+		common.ValidateUUID(req.FromAccountID, "from_account_id"),
+		common.ValidateUUID(req.ToAccountID, "to_account_id"),
+		common.ValidateCurrency(req.Currency, "currency"),
+		common.ValidateAmount(req.Amount, req.Currency, "amount"),
+	)
+	if len(validationErrors) > 0 {
+		problem := common.NewValidationProblemWithErrors(r.RequestURI, validationErrors)
+		common.WriteProblem(w, problem)
+		return
+	}
+
+	amount, err := money.Parse(req.Amount, req.Currency)
+	if err != nil {
+		problem := common.NewValidationProblem(err.Error(), r.RequestURI)
+		common.WriteProblem(w, problem)
+		return
+	}
+
+	var date time.Time
+	if req.Date != nil {
+		date = *req.Date
+	}
+
+	transfer, err := h.service.CreateTransfer(
+		r.Context(),
+		req.FromAccountID,
+		req.ToAccountID,
+		amount,
+		req.Description,
+		date,
+	)
+	if err != nil {
+		var notFoundErr *domainerrors.ErrNotFound
+		if errors.As(err, &notFoundErr) {
+			problem := common.NewNotFoundProblem(err.Error(), r.RequestURI)
+			common.WriteProblem(w, problem)
+			return
+		}
+		var invalidErr *domainerrors.ErrInvalidInput
+		if errors.As(err, &invalidErr) {
+			problem := common.NewValidationProblem(err.Error(), r.RequestURI)
+			common.WriteProblem(w, problem)
+			return
+		}
+		problem := common.NewInternalErrorProblem(r.RequestURI)
+		common.WriteProblem(w, problem)
+		return
+	}
+
+	common.WriteJSON(w, http.StatusCreated, toTransferResponse(transfer))
+}
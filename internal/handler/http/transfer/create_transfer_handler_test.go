@@ -0,0 +1,165 @@
+package transfer
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/errors"
+	httptesting "accounting/internal/handler/http"
+)
+
+func TestCreateTransferHandlerSuccess(t *testing.T) {
+	mockService := &httptesting.MockTransferService{}
+	handler := NewCreateTransferHandler(mockService)
+
+	reqBody := CreateTransferRequest{
+		FromAccountID: "123e4567-e89b-12d3-a456-426614174000",
+		ToAccountID:   "123e4567-e89b-12d3-a456-426614174001",
+		Amount:        "250.00",
+		Currency:      "USD",
+		Description:   "Monthly savings",
+	}
+
+	req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/transfers", reqBody)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusCreated {
+		t.Errorf("expected status %d, got %d", http.StatusCreated, w.Code)
+	}
+
+	var response TransferResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if response.Debit == nil || response.Credit == nil {
+		t.Fatal("expected both legs in response")
+	}
+
+	if response.Debit.AccountID != reqBody.FromAccountID || response.Debit.TransferDirection != constant.TransferDirectionOut {
+		t.Errorf("unexpected debit leg: %+v", response.Debit)
+	}
+
+	if response.Credit.AccountID != reqBody.ToAccountID || response.Credit.TransferDirection != constant.TransferDirectionIn {
+		t.Errorf("unexpected credit leg: %+v", response.Credit)
+	}
+
+	if response.Credit.Amount != "250.00" {
+		t.Errorf("expected amount %q, got %q", "250.00", response.Credit.Amount)
+	}
+
+	if mockService.CreateTransferCalls != 1 {
+		t.Errorf("expected 1 createTransfer call, got %d", mockService.CreateTransferCalls)
+	}
+}
+
+func TestCreateTransferHandlerInvalidAccountID(t *testing.T) {
+	mockService := &httptesting.MockTransferService{}
+	handler := NewCreateTransferHandler(mockService)
+
+	reqBody := CreateTransferRequest{
+		FromAccountID: "not-a-uuid",
+		ToAccountID:   "123e4567-e89b-12d3-a456-426614174001",
+		Amount:        "250.00",
+		Currency:      "USD",
+	}
+
+	req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/transfers", reqBody)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+
+	if mockService.CreateTransferCalls != 0 {
+		t.Errorf("expected 0 createTransfer calls, got %d", mockService.CreateTransferCalls)
+	}
+}
+
+func TestCreateTransferHandlerInvalidAmount(t *testing.T) {
+	mockService := &httptesting.MockTransferService{}
+	handler := NewCreateTransferHandler(mockService)
+
+	reqBody := CreateTransferRequest{
+		FromAccountID: "123e4567-e89b-12d3-a456-426614174000",
+		ToAccountID:   "123e4567-e89b-12d3-a456-426614174001",
+		Amount:        "0",
+		Currency:      "USD",
+	}
+
+	req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/transfers", reqBody)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestCreateTransferHandlerAccountNotFound(t *testing.T) {
+	mockService := &httptesting.MockTransferService{
+		LastCreateTransferErr: errors.NewErrNotFound("account", "123e4567-e89b-12d3-a456-426614174001"),
+	}
+	handler := NewCreateTransferHandler(mockService)
+
+	reqBody := CreateTransferRequest{
+		FromAccountID: "123e4567-e89b-12d3-a456-426614174000",
+		ToAccountID:   "123e4567-e89b-12d3-a456-426614174001",
+		Amount:        "250.00",
+		Currency:      "USD",
+	}
+
+	req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/transfers", reqBody)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestCreateTransferHandlerServiceValidationError(t *testing.T) {
+	mockService := &httptesting.MockTransferService{
+		LastCreateTransferErr: errors.NewErrInvalidInput("currency", "currency must match account currency EUR"),
+	}
+	handler := NewCreateTransferHandler(mockService)
+
+	reqBody := CreateTransferRequest{
+		FromAccountID: "123e4567-e89b-12d3-a456-426614174000",
+		ToAccountID:   "123e4567-e89b-12d3-a456-426614174001",
+		Amount:        "250.00",
+		Currency:      "USD",
+	}
+
+	req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/transfers", reqBody)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestCreateTransferHandlerInvalidMethod(t *testing.T) {
+	mockService := &httptesting.MockTransferService{}
+	handler := NewCreateTransferHandler(mockService)
+
+	req := httptesting.NewTestRequest(http.MethodGet, "/api/v1/transfers", nil)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected status %d, got %d", http.StatusMethodNotAllowed, w.Code)
+	}
+}
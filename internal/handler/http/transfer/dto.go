@@ -0,0 +1,22 @@
+package transfer
+
+import (
+	"time"
+
+	"accounting/internal/handler/http/transaction"
+)
+
+type CreateTransferRequest struct {
+	FromAccountID string     `json:"from_account_id"`
+	ToAccountID   string     `json:"to_account_id"`
+	Amount        string     `json:"amount"`
+	Currency      string     `json:"currency"`
+	Description   string     `json:"description,omitempty"`
+	Date          *time.Time `json:"date,omitempty"`
+}
+
+type TransferResponse struct {
+	ID     string                           `json:"id"`
+	Debit  *transaction.TransactionResponse `json:"debit"`
+	Credit *transaction.TransactionResponse `json:"credit"`
+}
@@ -0,0 +1,66 @@
+package transfer
+
+import (
+	"net/http"
+
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/handler/http/common"
+)
+
+type GetTransferHandler struct {
+	service interfaces.TransferService
+}
+
+func NewGetTransferHandler(service interfaces.TransferService) *GetTransferHandler {
+	return &GetTransferHandler{service: service}
+}
+
+// @Summary Get a transfer
+// @Description Retrieve both legs of a transfer by transfer ID
+// @Tags transfers
+// @Accept json
+// @Produce json
+// @Param id path string true "Transfer ID"
+// @Success 200 {object} TransferResponse
+// @Failure 400 {object} common.ValidationProblem "Validation error"
+// @Failure 404 {object} common.ProblemDetail "Transfer not found"
+// @Failure 500 {object} common.ProblemDetail "Internal server error"
+// @Router /api/v1/transfers/{id} [get]
+func (h *GetTransferHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		problem := common.NewMethodNotAllowedProblem(r.RequestURI)
+		common.WriteProblem(w, problem)
+		return
+	}
+
+	id := extractID(r.URL.Path, "/api/v1/transfers/")
+	if id == "" {
+		validationErrors := []common.ValidationError{
+			{Field: "id", Message: "transfer ID is required"},
+		}
+		problem := common.NewValidationProblemWithErrors(r.RequestURI, validationErrors)
+		common.WriteProblem(w, problem)
+		return
+	}
+
+	// Validate ID is a valid UUID
+	if validationErr := common.ValidateUUID(id, "id"); validationErr != nil {
+		problem := common.NewValidationProblemWithErrors(r.RequestURI, []common.ValidationError{*validationErr})
+		common.WriteProblem(w, problem)
+		return
+	}
+
+	transfer, err := h.service.GetTransfer(r.Context(), id)
+	if err != nil {
+		problem := common.NewInternalErrorProblem(r.RequestURI)
+		common.WriteProblem(w, problem)
+		return
+	}
+	if transfer == nil {
+		problem := common.NewNotFoundProblem("transfer not found", r.RequestURI)
+		common.WriteProblem(w, problem)
+		return
+	}
+
+	common.WriteJSON(w, http.StatusOK, toTransferResponse(transfer))
+}
@@ -0,0 +1,90 @@
+package transfer
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"accounting/internal/domain/entity"
+	"accounting/internal/domain/money"
+	httptesting "accounting/internal/handler/http"
+)
+
+func TestGetTransferHandlerSuccess(t *testing.T) {
+	testTransfer := &entity.Transfer{
+		ID:     "123e4567-e89b-12d3-a456-426614174000",
+		Debit:  &entity.Transaction{ID: "leg-out", Amount: money.MustParse("75.00", "USD")},
+		Credit: &entity.Transaction{ID: "leg-in", Amount: money.MustParse("75.00", "USD")},
+	}
+	mockService := &httptesting.MockTransferService{
+		TransferToReturn: testTransfer,
+	}
+	handler := NewGetTransferHandler(mockService)
+
+	req, _ := http.NewRequest(
+		http.MethodGet,
+		"/api/v1/transfers/123e4567-e89b-12d3-a456-426614174000",
+		nil,
+	)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Errorf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+
+	var response TransferResponse
+	if err := json.NewDecoder(w.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if response.ID != testTransfer.ID {
+		t.Errorf("expected ID %q, got %q", testTransfer.ID, response.ID)
+	}
+
+	if response.Debit == nil || response.Debit.ID != "leg-out" {
+		t.Errorf("unexpected debit leg: %+v", response.Debit)
+	}
+
+	if mockService.GetTransferCalls != 1 {
+		t.Errorf("expected 1 getTransfer call, got %d", mockService.GetTransferCalls)
+	}
+}
+
+func TestGetTransferHandlerNotFound(t *testing.T) {
+	mockService := &httptesting.MockTransferService{}
+	handler := NewGetTransferHandler(mockService)
+
+	req, _ := http.NewRequest(
+		http.MethodGet,
+		"/api/v1/transfers/123e4567-e89b-12d3-a456-426614174000",
+		nil,
+	)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestGetTransferHandlerInvalidID(t *testing.T) {
+	mockService := &httptesting.MockTransferService{}
+	handler := NewGetTransferHandler(mockService)
+
+	req, _ := http.NewRequest(
+		http.MethodGet,
+		"/api/v1/transfers/not-a-uuid",
+		nil,
+	)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
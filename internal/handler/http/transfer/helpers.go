@@ -0,0 +1,28 @@
+package transfer
+
+import (
+	"strings"
+
+	"accounting/internal/domain/entity"
+	"accounting/internal/handler/http/transaction"
+)
+
+func toTransferResponse(transfer *entity.Transfer) *TransferResponse {
+	response := &TransferResponse{ID: transfer.ID}
+	if transfer.Debit != nil {
+		response.Debit = transaction.ToTransactionResponse(transfer.Debit)
+	}
+	if transfer.Credit != nil {
+		response.Credit = transaction.ToTransactionResponse(transfer.Credit)
+	}
+	return response
+}
+
+func extractID(path, prefix string) string {
+	path = strings.TrimPrefix(path, prefix)
+	parts := strings.Split(path, "/")
+	if len(parts) > 0 {
+		return parts[0]
+	}
+	return ""
+}
@@ -20,7 +20,7 @@ func NewCreateUserHandler(service interfaces.UserService) *CreateUserHandler {
 
 // Handle creates a new user
 // @Summary Create a new user
-// @Description Create a new user with name and email
+// @Description Create a new user with name, email and an optional base currency (default USD)
 // @Tags users
 // @Accept json
 // @Produce json
@@ -46,18 +46,22 @@ func (h *CreateUserHandler) Handle(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Validate input
-	validationErrors := common.CollectErrors(
+	checks := []*common.ValidationError{
 		common.ValidateRequired(req.Name, "name"),
 		common.ValidateStringLength(req.Name, "name", 1, 100),
 		common.ValidateEmail(req.Email, "email"),
-	)
+	}
+	if req.BaseCurrency != "" {
+		checks = append(checks, common.ValidateCurrency(req.BaseCurrency, "base_currency"))
+	}
+	validationErrors := common.CollectErrors(checks...)
 
 	if len(validationErrors) > 0 {
 		common.WriteProblem(w, common.NewValidationProblemWithErrors(r.URL.Path, validationErrors))
 		return
 	}
 
-	user, err := h.service.CreateUser(r.Context(), req.Name, req.Email)
+	user, err := h.service.CreateUser(r.Context(), req.Name, req.Email, req.BaseCurrency)
 	if err != nil {
 		var dupErr *domainerrors.ErrDuplicateEmail
 		if errors.As(err, &dupErr) {
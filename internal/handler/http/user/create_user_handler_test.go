@@ -87,6 +87,29 @@ func TestCreateUserHandlerInvalidEmail(t *testing.T) {
 	}
 }
 
+func TestCreateUserHandlerInvalidBaseCurrency(t *testing.T) {
+	mockService := &httptesting.MockUserService{}
+	handler := NewCreateUserHandler(mockService)
+
+	reqBody := CreateUserRequest{
+		Name:         "John Doe",
+		Email:        "john@example.com",
+		BaseCurrency: "XYZ",
+	}
+
+	req := httptesting.NewTestRequest(http.MethodPost, "/api/v1/users", reqBody)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+	if mockService.CreateUserCalls != 0 {
+		t.Errorf("expected no createUser call, got %d", mockService.CreateUserCalls)
+	}
+}
+
 func TestCreateUserHandlerDuplicateEmail(t *testing.T) {
 	dupErr := errors.NewErrDuplicateEmail("john@example.com")
 	mockService := &httptesting.MockUserService{
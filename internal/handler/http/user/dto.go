@@ -3,15 +3,19 @@ package user
 type CreateUserRequest struct {
 	Name  string `json:"name"`
 	Email string `json:"email"`
+	// BaseCurrency is the ISO 4217 code reports convert totals into; defaults to USD
+	BaseCurrency string `json:"base_currency,omitempty"`
 }
 
 type UpdateUserRequest struct {
-	Name  string `json:"name,omitempty"`
-	Email string `json:"email,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Email        string `json:"email,omitempty"`
+	BaseCurrency string `json:"base_currency,omitempty"`
 }
 
 type UserResponse struct {
-	ID    string `json:"id"`
-	Name  string `json:"name"`
-	Email string `json:"email"`
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Email        string `json:"email"`
+	BaseCurrency string `json:"base_currency"`
 }
@@ -8,9 +8,10 @@ import (
 
 func toUserResponse(user *entity.User) *UserResponse {
 	return &UserResponse{
-		ID:    user.ID,
-		Name:  user.Name,
-		Email: user.Email,
+		ID:           user.ID,
+		Name:         user.Name,
+		Email:        user.Email,
+		BaseCurrency: user.BaseCurrency,
 	}
 }
 
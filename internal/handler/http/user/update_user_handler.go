@@ -66,10 +66,16 @@ func (h *UpdateUserHandler) Handle(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	if req.Name == "" && req.Email == "" {
+	if req.BaseCurrency != "" {
+		if err := common.ValidateCurrency(req.BaseCurrency, "base_currency"); err != nil {
+			validationErrors = append(validationErrors, *err)
+		}
+	}
+
+	if req.Name == "" && req.Email == "" && req.BaseCurrency == "" {
 		validationErrors = append(validationErrors, common.ValidationError{
 			Field:   "request",
-			Message: "At least one field (name or email) must be provided",
+			Message: "At least one field (name, email or base_currency) must be provided",
 		})
 	}
 
@@ -78,13 +84,18 @@ func (h *UpdateUserHandler) Handle(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	user, err := h.service.UpdateUser(r.Context(), id, req.Name, req.Email)
+	user, err := h.service.UpdateUser(r.Context(), id, req.Name, req.Email, req.BaseCurrency)
 	if err != nil {
 		var notFoundErr *domainerrors.ErrNotFound
 		if errors.As(err, &notFoundErr) {
 			common.WriteProblem(w, common.NewNotFoundProblem(err.Error(), r.URL.Path))
 			return
 		}
+		var invalidErr *domainerrors.ErrInvalidInput
+		if errors.As(err, &invalidErr) {
+			common.WriteProblem(w, common.NewValidationProblem(err.Error(), r.URL.Path))
+			return
+		}
 		common.WriteProblem(w, common.NewInternalErrorProblem(r.URL.Path))
 		return
 	}
@@ -109,6 +109,27 @@ func TestUpdateUserHandlerInvalidEmail(t *testing.T) {
 	}
 }
 
+func TestUpdateUserHandlerInvalidBaseCurrency(t *testing.T) {
+	mockService := &httptesting.MockUserService{}
+	handler := NewUpdateUserHandler(mockService)
+
+	reqBody := UpdateUserRequest{
+		BaseCurrency: "usd",
+	}
+
+	req := httptesting.NewTestRequest(http.MethodPut, "/api/v1/users/123e4567-e89b-12d3-a456-426614174000", reqBody)
+	w := httptest.NewRecorder()
+
+	handler.Handle(w, req)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+	if mockService.UpdateUserCalls != 0 {
+		t.Errorf("expected no updateUser call, got %d", mockService.UpdateUserCalls)
+	}
+}
+
 func TestUpdateUserHandlerNotFound(t *testing.T) {
 	notFoundErr := errors.NewErrNotFound("user", "123e4567-e89b-12d3-a456-426614174000")
 	mockService := &httptesting.MockUserService{
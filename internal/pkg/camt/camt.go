@@ -0,0 +1,313 @@
+// Package camt reads ISO 20022 camt.053 bank-to-customer statements, the XML
+// account statements European banks publish. The element names are the same
+// across the message versions in use, from camt.053.001.02 onwards, so the
+// version is not checked.
+package camt
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Entry statuses
+const (
+	StatusBooked  = "BOOK"
+	StatusPending = "PDNG"
+)
+
+// Statement is an account statement (Stmt) of a camt.053 message.
+type Statement struct {
+	// ID identifies the statement at the bank (Id).
+	ID string
+	// Account is the IBAN of the account, or the bank's other identification of it.
+	Account string
+	// Currency is the currency of the account, if the statement gives it.
+	Currency string
+	// Entries lists the statement entries (Ntry) in file order.
+	Entries []*Entry
+}
+
+// Entry is a statement entry: an amount booked to the account, which may
+// bundle several transactions such as the payments of a batch.
+type Entry struct {
+	// Line is the 1-based line of the entry's opening tag.
+	Line int
+	// Amount is the positive amount of the entry, with a point as decimal separator.
+	Amount string
+	// Currency is the currency of the amount.
+	Currency string
+	// Credit tells whether the entry credits the account (CRDT) or debits it
+	// (DBIT). The indicator gives the direction of the entry itself, also for
+	// the reversal of an earlier entry.
+	Credit bool
+	// Status is the status of the entry, e.g. StatusBooked.
+	Status string
+	// BookingDate is the date the entry was booked; ValueDate the date the
+	// amount starts or stops earning interest. Either may be the zero time.
+	BookingDate time.Time
+	ValueDate   time.Time
+	// Reference is the bank's unique reference of the entry (AcctSvcrRef).
+	Reference string
+	// Counterparty is the name of the payer of a credit or the payee of a debit.
+	Counterparty string
+	// RemittanceInfo is the unstructured remittance information of the payment.
+	RemittanceInfo string
+	// AdditionalInfo is the bank's free text description of the entry.
+	AdditionalInfo string
+}
+
+// SyntaxError reports a file that is not a well-formed camt.053 document.
+type SyntaxError struct {
+	// Line is the 1-based line the problem was found on.
+	Line int
+	Msg  string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Msg)
+}
+
+// document mirrors the parts of a camt.053 message that are read.
+type document struct {
+	XMLName    xml.Name       `xml:"Document"`
+	Statements []statementXML `xml:"BkToCstmrStmt>Stmt"`
+}
+
+type statementXML struct {
+	ID      string `xml:"Id"`
+	Account struct {
+		IBAN     string `xml:"Id>IBAN"`
+		Other    string `xml:"Id>Othr>Id"`
+		Currency string `xml:"Ccy"`
+	} `xml:"Acct"`
+	Entries []entryXML `xml:"Ntry"`
+}
+
+type entryXML struct {
+	line           int
+	Amount         amountXML    `xml:"Amt"`
+	CreditDebit    string       `xml:"CdtDbtInd"`
+	Status         statusXML    `xml:"Sts"`
+	BookingDate    dateXML      `xml:"BookgDt"`
+	ValueDate      dateXML      `xml:"ValDt"`
+	Reference      string       `xml:"AcctSvcrRef"`
+	AdditionalInfo string       `xml:"AddtlNtryInf"`
+	Transactions   []detailsXML `xml:"NtryDtls>TxDtls"`
+}
+
+// UnmarshalXML records the line of the entry before decoding it.
+func (e *entryXML) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain entryXML
+	e.line, _ = d.InputPos()
+	return d.DecodeElement((*plain)(e), &start)
+}
+
+type amountXML struct {
+	Value    string `xml:",chardata"`
+	Currency string `xml:"Ccy,attr"`
+}
+
+// statusXML holds the status as text in camt.053.001.02 to .07 and as a code
+// element from camt.053.001.08.
+type statusXML struct {
+	Text string `xml:",chardata"`
+	Code string `xml:"Cd"`
+}
+
+type dateXML struct {
+	Date     string `xml:"Dt"`
+	DateTime string `xml:"DtTm"`
+}
+
+type detailsXML struct {
+	Reference  string   `xml:"Refs>AcctSvcrRef"`
+	Debtor     partyXML `xml:"RltdPties>Dbtr"`
+	Creditor   partyXML `xml:"RltdPties>Cdtr"`
+	Remittance []string `xml:"RmtInf>Ustrd"`
+}
+
+// partyXML holds the name directly in camt.053.001.02 to .07 and in a Pty
+// element from camt.053.001.08.
+type partyXML struct {
+	Name      string `xml:"Nm"`
+	PartyName string `xml:"Pty>Nm"`
+}
+
+func (p partyXML) name() string {
+	if p.Name != "" {
+		return strings.TrimSpace(p.Name)
+	}
+	return strings.TrimSpace(p.PartyName)
+}
+
+// Parse reads the statements of a camt.053 message, in file order.
+func Parse(r io.Reader) ([]*Statement, error) {
+	decoder := xml.NewDecoder(r)
+	decoder.CharsetReader = charsetReader
+
+	var doc document
+	if err := decoder.Decode(&doc); err != nil {
+		line, _ := decoder.InputPos()
+		var syntaxErr *xml.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			return nil, &SyntaxError{Line: syntaxErr.Line, Msg: syntaxErr.Msg}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil, &SyntaxError{Line: 1, Msg: "the file is not an XML document"}
+		}
+		return nil, &SyntaxError{Line: line, Msg: err.Error()}
+	}
+	if len(doc.Statements) == 0 {
+		return nil, &SyntaxError{Line: 1, Msg: "the file is not a camt.053 statement"}
+	}
+
+	statements := make([]*Statement, 0, len(doc.Statements))
+	for _, stmt := range doc.Statements {
+		statement := &Statement{
+			ID:       strings.TrimSpace(stmt.ID),
+			Account:  strings.TrimSpace(stmt.Account.IBAN),
+			Currency: strings.TrimSpace(stmt.Account.Currency),
+		}
+		if statement.Account == "" {
+			statement.Account = strings.TrimSpace(stmt.Account.Other)
+		}
+		for i := range stmt.Entries {
+			entry, err := readEntry(&stmt.Entries[i], statement.Currency)
+			if err != nil {
+				return nil, err
+			}
+			statement.Entries = append(statement.Entries, entry)
+		}
+		statements = append(statements, statement)
+	}
+	return statements, nil
+}
+
+// readEntry checks an entry and converts it. The currency of the amount
+// defaults to the currency of the account.
+func readEntry(ntry *entryXML, currency string) (*Entry, error) {
+	invalid := func(format string, args ...any) error {
+		return &SyntaxError{Line: ntry.line, Msg: fmt.Sprintf(format, args...)}
+	}
+
+	entry := &Entry{
+		Line:           ntry.line,
+		Amount:         strings.TrimSpace(ntry.Amount.Value),
+		Currency:       strings.TrimSpace(ntry.Amount.Currency),
+		Status:         strings.TrimSpace(ntry.Status.Code),
+		Reference:      strings.TrimSpace(ntry.Reference),
+		AdditionalInfo: strings.TrimSpace(ntry.AdditionalInfo),
+	}
+	if entry.Currency == "" {
+		entry.Currency = currency
+	}
+	if entry.Status == "" {
+		entry.Status = strings.TrimSpace(ntry.Status.Text)
+	}
+	if !isDecimal(entry.Amount) {
+		return nil, invalid("entry amount %q is not a decimal amount", entry.Amount)
+	}
+
+	switch strings.TrimSpace(ntry.CreditDebit) {
+	case "CRDT":
+		entry.Credit = true
+	case "DBIT":
+	default:
+		return nil, invalid("entry credit/debit indicator %q is not CRDT or DBIT", ntry.CreditDebit)
+	}
+
+	var err error
+	if entry.BookingDate, err = ntry.BookingDate.parse(); err != nil {
+		return nil, invalid("entry booking date: %v", err)
+	}
+	if entry.ValueDate, err = ntry.ValueDate.parse(); err != nil {
+		return nil, invalid("entry value date: %v", err)
+	}
+
+	// The details of a single transaction describe the entry as a whole; a
+	// batch is described by the entry's additional information only
+	if len(ntry.Transactions) == 1 {
+		details := ntry.Transactions[0]
+		if entry.Reference == "" {
+			entry.Reference = strings.TrimSpace(details.Reference)
+		}
+		if entry.Credit {
+			entry.Counterparty = details.Debtor.name()
+		} else {
+			entry.Counterparty = details.Creditor.name()
+		}
+		var remittance []string
+		for _, line := range details.Remittance {
+			if line = strings.TrimSpace(line); line != "" {
+				remittance = append(remittance, line)
+			}
+		}
+		entry.RemittanceInfo = strings.Join(remittance, " ")
+	}
+	return entry, nil
+}
+
+// parse reads an ISO date, or the date part of an ISO date and time. It
+// returns the zero time when neither is given.
+func (d dateXML) parse() (time.Time, error) {
+	value := strings.TrimSpace(d.Date)
+	if value == "" {
+		value = strings.TrimSpace(d.DateTime)
+		if len(value) > len(time.DateOnly) && value[len(time.DateOnly)] == 'T' {
+			// The date the bank gives, without converting the time zone
+			value = value[:len(time.DateOnly)]
+		}
+	}
+	if value == "" {
+		return time.Time{}, nil
+	}
+	date, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is not an ISO date", value)
+	}
+	return date, nil
+}
+
+// isDecimal reports whether s is a non-negative decimal number with a point
+// as decimal separator.
+func isDecimal(s string) bool {
+	digits, fraction, hasPoint := strings.Cut(s, ".")
+	if digits == "" || hasPoint && fraction == "" {
+		return false
+	}
+	for _, c := range digits + fraction {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// charsetReader decodes the single-byte character sets some banks declare
+// instead of UTF-8.
+func charsetReader(charset string, input io.Reader) (io.Reader, error) {
+	switch strings.ToLower(charset) {
+	case "iso-8859-1", "iso8859-1", "latin1", "latin-1", "iso-8859-15", "windows-1252", "cp1252":
+	default:
+		return nil, fmt.Errorf("unsupported character set %s", charset)
+	}
+	data, err := io.ReadAll(input)
+	if err != nil {
+		return nil, err
+	}
+	if utf8.Valid(data) {
+		return bytes.NewReader(data), nil
+	}
+	var b bytes.Buffer
+	b.Grow(len(data))
+	for _, c := range data {
+		b.WriteRune(rune(c))
+	}
+	return &b, nil
+}
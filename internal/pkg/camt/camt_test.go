@@ -0,0 +1,150 @@
+package camt
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func parseFile(t *testing.T, name string) []*Statement {
+	t.Helper()
+	file, err := os.Open("testdata/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	statements, err := Parse(file)
+	if err != nil {
+		t.Fatalf("Parse(%s) error = %v", name, err)
+	}
+	return statements
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func checkEntries(t *testing.T, got []*Entry, want []Entry) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("expected %d entries, got %d", len(want), len(got))
+	}
+	for i, entry := range got {
+		if *entry != want[i] {
+			t.Errorf("entry %d = %+v, want %+v", i, *entry, want[i])
+		}
+	}
+}
+
+func TestParseVersion2(t *testing.T) {
+	statements := parseFile(t, "camt053_v02.xml")
+	if len(statements) != 1 {
+		t.Fatalf("expected 1 statement, got %d", len(statements))
+	}
+	statement := statements[0]
+	if statement.ID != "2024-03-DE89370400440532013000" || statement.Account != "DE89370400440532013000" || statement.Currency != "EUR" {
+		t.Errorf("unexpected statement %+v", statement)
+	}
+
+	checkEntries(t, statement.Entries, []Entry{
+		{
+			Line: 24, Amount: "2500.00", Currency: "EUR", Credit: true, Status: StatusBooked,
+			BookingDate: date(2024, 3, 1), ValueDate: date(2024, 3, 1), Reference: "2024030100001",
+			Counterparty: "ACME GmbH", RemittanceInfo: "Gehalt Maerz 2024",
+		},
+		{
+			Line: 46, Amount: "89.90", Currency: "EUR", Status: StatusBooked,
+			BookingDate: date(2024, 3, 4), ValueDate: date(2024, 3, 5), Reference: "2024030400002",
+			Counterparty: "Stadtwerke Musterstadt", RemittanceInfo: "Abschlag Strom Vertrag 123456",
+		},
+		{
+			Line: 67, Amount: "120.00", Currency: "USD", Status: StatusBooked,
+			BookingDate: date(2024, 3, 12), ValueDate: date(2024, 3, 13), Reference: "2024031200003",
+			AdditionalInfo: "Card payment HOTEL NEW YORK",
+		},
+		{
+			Line: 76, Amount: "310.00", Currency: "EUR", Status: StatusBooked,
+			BookingDate: date(2024, 3, 15), ValueDate: date(2024, 3, 15), Reference: "2024031500004",
+			AdditionalInfo: "Batch transfer 2 payments",
+		},
+		{
+			Line: 96, Amount: "15.00", Currency: "EUR", Status: StatusPending,
+			ValueDate: date(2024, 4, 2), AdditionalInfo: "Card payment BAKERY",
+		},
+	})
+}
+
+func TestParseVersion8(t *testing.T) {
+	statements := parseFile(t, "camt053_v08.xml")
+	if len(statements) != 1 {
+		t.Fatalf("expected 1 statement, got %d", len(statements))
+	}
+	statement := statements[0]
+	if statement.ID != "CH-2024-03-USD" || statement.Account != "0042-123456-01" || statement.Currency != "" {
+		t.Errorf("unexpected statement %+v", statement)
+	}
+
+	checkEntries(t, statement.Entries, []Entry{
+		{
+			Line: 13, Amount: "1000.00", Currency: "USD", Credit: true, Status: StatusBooked,
+			BookingDate: date(2024, 3, 20), ValueDate: date(2024, 3, 21), Reference: "CH20240320-7",
+			Counterparty: "Globex Corp", RemittanceInfo: "Invoice 2024-17",
+		},
+		{
+			Line: 29, Amount: "25.00", Currency: "USD", Credit: true, Status: StatusBooked,
+			ValueDate: date(2024, 3, 22), Reference: "CH20240322-2", AdditionalInfo: "Reversal of account fee",
+		},
+	})
+}
+
+func TestParseLatin1(t *testing.T) {
+	data := "<?xml version=\"1.0\" encoding=\"ISO-8859-1\"?>\n<Document><BkToCstmrStmt><Stmt><Ntry>" +
+		"<Amt Ccy=\"EUR\">3.00</Amt><CdtDbtInd>DBIT</CdtDbtInd><AddtlNtryInf>Caf\xe9</AddtlNtryInf>" +
+		"</Ntry></Stmt></BkToCstmrStmt></Document>"
+
+	statements, err := Parse(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if got := statements[0].Entries[0].AdditionalInfo; got != "Café" {
+		t.Errorf("expected the text decoded from Latin-1, got %q", got)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	entry := func(content string) string {
+		return "<Document>\n<BkToCstmrStmt>\n<Stmt>\n<Ntry>" + content + "</Ntry>\n</Stmt>\n</BkToCstmrStmt>\n</Document>\n"
+	}
+	tests := []struct {
+		name     string
+		data     string
+		wantLine int
+	}{
+		{"empty", "", 1},
+		{"not XML", "Date,Amount\n2024-03-01,10.00\n", 1},
+		{"not a statement", "<Document>\n<CstmrCdtTrfInitn/>\n</Document>\n", 1},
+		{"not well-formed", "<Document>\n<BkToCstmrStmt>\n<Stmt>\n</BkToCstmrStmt>\n</Document>\n", 4},
+		{"negative amount", entry(`<Amt Ccy="EUR">-1.00</Amt><CdtDbtInd>DBIT</CdtDbtInd>`), 4},
+		{"invalid amount", entry(`<Amt Ccy="EUR">1.</Amt><CdtDbtInd>DBIT</CdtDbtInd>`), 4},
+		{"missing amount", entry(`<CdtDbtInd>DBIT</CdtDbtInd>`), 4},
+		{"invalid indicator", entry(`<Amt Ccy="EUR">1.00</Amt><CdtDbtInd>D</CdtDbtInd>`), 4},
+		{"invalid booking date", entry(`<Amt Ccy="EUR">1.00</Amt><CdtDbtInd>DBIT</CdtDbtInd><BookgDt><Dt>01.03.2024</Dt></BookgDt>`), 4},
+		{"invalid value date", entry(`<Amt Ccy="EUR">1.00</Amt><CdtDbtInd>DBIT</CdtDbtInd><ValDt><DtTm>2024-02-30T10:00:00</DtTm></ValDt>`), 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.data))
+			var syntaxErr *SyntaxError
+			if !errors.As(err, &syntaxErr) {
+				t.Fatalf("expected a SyntaxError, got %v", err)
+			}
+			if syntaxErr.Line != tt.wantLine {
+				t.Errorf("expected line %d, got %d (%v)", tt.wantLine, syntaxErr.Line, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package iso4217
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		code       string
+		wantOK     bool
+		minorUnits int
+	}{
+		{"USD", true, 2},
+		{"EUR", true, 2},
+		{"JPY", true, 0},
+		{"KWD", true, 3},
+		{"ABC", false, 0},
+		{"usd", false, 0},
+		{"", false, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			c, ok := Lookup(tt.code)
+			if ok != tt.wantOK {
+				t.Fatalf("Lookup(%q) ok = %v, want %v", tt.code, ok, tt.wantOK)
+			}
+			if ok && c.MinorUnits != tt.minorUnits {
+				t.Errorf("Lookup(%q) minor units = %d, want %d", tt.code, c.MinorUnits, tt.minorUnits)
+			}
+			if IsValid(tt.code) != tt.wantOK {
+				t.Errorf("IsValid(%q) = %v, want %v", tt.code, !tt.wantOK, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestAllIsSortedAndUnique(t *testing.T) {
+	all := All()
+	if len(all) == 0 {
+		t.Fatal("expected a non-empty registry")
+	}
+	for i := 1; i < len(all); i++ {
+		if all[i-1].Code >= all[i].Code {
+			t.Fatalf("codes out of order or duplicated: %s, %s", all[i-1].Code, all[i].Code)
+		}
+	}
+
+	// Callers must not be able to modify the registry
+	all[0].MinorUnits = 9
+	if slices.Equal(All(), all) {
+		t.Error("expected All to return a copy")
+	}
+}
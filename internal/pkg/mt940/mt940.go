@@ -0,0 +1,299 @@
+// Package mt940 reads SWIFT MT940 customer statement messages. Files may hold
+// several messages, with or without the SWIFT envelope of {1:...}{4: blocks.
+package mt940
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Statement is one MT940 message: the statement of an account in one currency.
+type Statement struct {
+	// Reference is the transaction reference number of the message (:20:).
+	Reference string
+	// Account is the account identification (:25:).
+	Account string
+	// Number is the statement and sequence number (:28C:).
+	Number string
+	// Currency is the currency of the opening balance (:60F: or :60M:) and of
+	// every amount of the statement.
+	Currency string
+	// Transactions lists the statement lines (:61:) in file order.
+	Transactions []*Transaction
+}
+
+// Transaction is a statement line (:61:) with its information to account
+// owner (:86:).
+type Transaction struct {
+	// Line is the 1-based line of the :61: field.
+	Line int
+	// ValueDate is the date the amount starts or stops earning interest.
+	ValueDate time.Time
+	// EntryDate is the date the transaction was booked, or the zero time when
+	// the statement line leaves it out.
+	EntryDate time.Time
+	// Credit tells whether the transaction credits the account. The mark RC,
+	// the reversal of a credit, debits it; RD, the reversal of a debit, credits it.
+	Credit   bool
+	Reversal bool
+	// Amount is the positive amount, with a point as decimal separator.
+	Amount string
+	// TypeCode is the transaction type identification code, e.g. NTRF.
+	TypeCode string
+	// CustomerReference is the account owner's reference; NONREF when there is none.
+	CustomerReference string
+	// BankReference is the reference the bank gives the transaction, if any.
+	BankReference string
+	// Details is the information to account owner, with the lines joined.
+	Details string
+	// Name and Purpose are the counterparty and the remittance information of
+	// Details, when it is structured in ?NN subfields as German banks do.
+	Name    string
+	Purpose string
+}
+
+// SyntaxError reports a file that is not a well-formed MT940 statement.
+type SyntaxError struct {
+	// Line is the 1-based line the problem was found on.
+	Line int
+	Msg  string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Msg)
+}
+
+// field is a tagged field of a message, such as :61:, with its continuation lines.
+type field struct {
+	tag   string
+	line  int
+	lines []string
+}
+
+var (
+	fieldPattern = regexp.MustCompile(`^:([0-9]{2}[A-Z]?):(.*)$`)
+	// balancePattern matches a balance: mark, date, currency and amount.
+	balancePattern = regexp.MustCompile(`^[CD][0-9]{6}([A-Z]{3})[0-9]+,[0-9]*$`)
+	// linePattern matches the first line of a statement line: value date,
+	// optional entry date, mark, optional funds code, amount, transaction
+	// type, customer reference and optional bank reference.
+	linePattern = regexp.MustCompile(`^([0-9]{6})([0-9]{4})?(C|D|RC|RD)([A-Z])?([0-9]+,[0-9]*)([NSF][A-Z0-9]{3})(.*?)(?://(.*))?$`)
+)
+
+// Parse reads the statements of an MT940 file, in file order.
+func Parse(r io.Reader) ([]*Statement, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	text := string(data)
+	if !utf8.Valid(data) {
+		// Banks outside SWIFT's character set write Latin-1
+		var b strings.Builder
+		for _, c := range data {
+			b.WriteRune(rune(c))
+		}
+		text = b.String()
+	}
+
+	fields, err := splitFields(text)
+	if err != nil {
+		return nil, err
+	}
+
+	var statements []*Statement
+	var statement *Statement
+	var last *Transaction
+	for _, f := range fields {
+		if f.tag != "20" && statement == nil {
+			return nil, &SyntaxError{Line: f.line, Msg: fmt.Sprintf(":%s: before the :20: field of a statement", f.tag)}
+		}
+		value := strings.TrimSpace(f.lines[0])
+
+		switch f.tag {
+		case "20":
+			statement = &Statement{Reference: value}
+			statements = append(statements, statement)
+		case "25":
+			statement.Account = value
+		case "28C":
+			statement.Number = value
+		case "60F", "60M":
+			match := balancePattern.FindStringSubmatch(value)
+			if match == nil {
+				return nil, &SyntaxError{Line: f.line, Msg: fmt.Sprintf("invalid opening balance %q", value)}
+			}
+			statement.Currency = match[1]
+		case "61":
+			if statement.Currency == "" {
+				return nil, &SyntaxError{Line: f.line, Msg: "statement line before the opening balance"}
+			}
+			trn, err := readTransaction(f)
+			if err != nil {
+				return nil, err
+			}
+			statement.Transactions = append(statement.Transactions, trn)
+			last = trn
+			continue
+		case "86":
+			// Information to account owner follows the statement line it
+			// describes; after the closing balance it describes the statement
+			if last != nil {
+				last.readDetails(f.lines)
+			}
+		}
+		last = nil
+	}
+
+	if len(statements) == 0 {
+		return nil, &SyntaxError{Line: 1, Msg: "the file is not an MT940 statement"}
+	}
+	return statements, nil
+}
+
+// splitFields splits a file into the fields of its messages. Lines that do
+// not start a field continue the field before them, except for the SWIFT
+// envelope: header blocks and the "-" or "-}" that ends a message.
+func splitFields(text string) ([]*field, error) {
+	var fields []*field
+	var current *field
+	for i, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		number := i + 1
+		if match := fieldPattern.FindStringSubmatch(line); match != nil {
+			current = &field{tag: match[1], line: number, lines: []string{match[2]}}
+			fields = append(fields, current)
+			continue
+		}
+
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == "" && current == nil:
+		case strings.HasPrefix(trimmed, "{"):
+			// A header block, or a block 4 opening: {1:...}{2:...}{4:
+			current = nil
+		case trimmed == "-" || strings.HasPrefix(trimmed, "-}"):
+			current = nil
+		case current == nil:
+			return nil, &SyntaxError{Line: number, Msg: "text outside of a field"}
+		default:
+			current.lines = append(current.lines, line)
+		}
+	}
+	return fields, nil
+}
+
+// readTransaction reads a :61: field.
+func readTransaction(f *field) (*Transaction, error) {
+	value := strings.TrimSpace(f.lines[0])
+	match := linePattern.FindStringSubmatch(value)
+	if match == nil {
+		return nil, &SyntaxError{Line: f.line, Msg: fmt.Sprintf("invalid statement line %q", value)}
+	}
+
+	trn := &Transaction{
+		Line:              f.line,
+		Reversal:          strings.HasPrefix(match[3], "R"),
+		Amount:            strings.TrimSuffix(strings.Replace(match[5], ",", ".", 1), "."),
+		TypeCode:          match[6],
+		CustomerReference: strings.TrimSpace(match[7]),
+		BankReference:     strings.TrimSpace(match[8]),
+	}
+	trn.Credit = strings.HasSuffix(match[3], "C") != trn.Reversal
+
+	var err error
+	if trn.ValueDate, err = time.Parse("060102", match[1]); err != nil {
+		return nil, &SyntaxError{Line: f.line, Msg: fmt.Sprintf("invalid value date %q", match[1])}
+	}
+	if match[2] != "" {
+		if trn.EntryDate, err = entryDate(trn.ValueDate, match[2]); err != nil {
+			return nil, &SyntaxError{Line: f.line, Msg: fmt.Sprintf("invalid entry date %q", match[2])}
+		}
+	}
+	return trn, nil
+}
+
+// entryDate reads an entry date given as MMDD. Its year is the one that puts
+// it closest to the value date, so that a December value date may be booked
+// in January and the other way around.
+func entryDate(valueDate time.Time, monthDay string) (time.Time, error) {
+	var closest time.Time
+	for year := valueDate.Year() - 1; year <= valueDate.Year()+1; year++ {
+		date, err := time.Parse("20060102", fmt.Sprintf("%04d%s", year, monthDay))
+		if err != nil {
+			continue
+		}
+		if closest.IsZero() || date.Sub(valueDate).Abs() < closest.Sub(valueDate).Abs() {
+			closest = date
+		}
+	}
+	if closest.IsZero() {
+		return time.Time{}, fmt.Errorf("%q is not a month and day", monthDay)
+	}
+	return closest, nil
+}
+
+// readDetails reads the :86: field of a transaction. Structured information
+// starts with a three-digit business transaction code followed by subfields
+// ?00 posting text, ?20 to ?29 and ?60 to ?63 purpose, and ?32 and ?33 name.
+// Its lines split subfields at arbitrary points and are joined as is; the
+// lines of free text are joined with spaces.
+func (trn *Transaction) readDetails(lines []string) {
+	joined := strings.Join(lines, "")
+	if len(joined) < 4 || joined[3] != '?' || !isDigits(joined[:3]) {
+		for i, line := range lines {
+			lines[i] = strings.TrimSpace(line)
+		}
+		trn.Details = strings.TrimSpace(strings.Join(lines, " "))
+		trn.Purpose = trn.Details
+		return
+	}
+
+	trn.Details = joined
+	var name, purpose []string
+	for _, sub := range strings.Split(joined[4:], "?") {
+		if len(sub) < 2 || !isDigits(sub[:2]) {
+			continue
+		}
+		content := sub[2:]
+		switch code := sub[:2]; {
+		case code >= "20" && code <= "29", code >= "60" && code <= "63":
+			purpose = append(purpose, content)
+		case code == "32", code == "33":
+			name = append(name, content)
+		}
+	}
+	trn.Name = strings.TrimSpace(strings.Join(name, ""))
+	trn.Purpose = sepaPurpose(strings.TrimSpace(strings.Join(purpose, "")))
+}
+
+// sepaKeywords mark the parts of the purpose of a SEPA payment, such as the
+// end-to-end reference EREF+ and the remittance information SVWZ+.
+var sepaKeywords = []string{"EREF+", "KREF+", "MREF+", "CRED+", "DEBT+", "COAM+", "OAMT+", "SVWZ+", "ABWA+", "ABWE+", "IBAN+", "BIC+"}
+
+// sepaPurpose returns the remittance information of a purpose made of SEPA
+// keywords, or the purpose as is when it has none.
+func sepaPurpose(purpose string) string {
+	_, remittance, ok := strings.Cut(purpose, "SVWZ+")
+	if !ok {
+		return purpose
+	}
+	for _, keyword := range sepaKeywords {
+		if end := strings.Index(remittance, keyword); end >= 0 {
+			remittance = remittance[:end]
+		}
+	}
+	return strings.TrimSpace(remittance)
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
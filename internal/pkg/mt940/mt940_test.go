@@ -0,0 +1,162 @@
+package mt940
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestParse(t *testing.T) {
+	file, err := os.Open("testdata/statement.sta")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	statements, err := Parse(file)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(statements) != 2 {
+		t.Fatalf("expected 2 statements, got %d", len(statements))
+	}
+
+	tests := []struct {
+		reference string
+		currency  string
+		want      []Transaction
+	}{
+		{
+			reference: "STMT240101",
+			currency:  "EUR",
+			want: []Transaction{
+				{
+					Line: 6, ValueDate: date(2023, 12, 29), EntryDate: date(2024, 1, 2), Credit: true, Amount: "2500.00",
+					TypeCode: "NTRF", CustomerReference: "NONREF", BankReference: "B4A01234",
+					Details: "166?00GUTSCHRIFT?109251?20EREF+PAYROLL-2023-12?21SVWZ+Gehalt Dezember 2023?30DEUTDEFF?31DE02120300000000202051?32ACME GmbH",
+					Name:    "ACME GmbH", Purpose: "Gehalt Dezember 2023",
+				},
+				{
+					Line: 9, ValueDate: date(2024, 1, 2), Amount: "89.90",
+					TypeCode: "NDDT", CustomerReference: "INV-4711", BankReference: "B4A01235",
+					Details: "105?00LASTSCHRIFT?20Abschlag Strom Vertrag 1?2123456?32Stadtwerke Muster?33stadt",
+					Name:    "Stadtwerke Musterstadt", Purpose: "Abschlag Strom Vertrag 123456",
+				},
+				{
+					Line: 13, ValueDate: date(2024, 1, 3), EntryDate: date(2024, 1, 3), Credit: true, Reversal: true, Amount: "12.00",
+					TypeCode: "NCHG", CustomerReference: "NONREF", BankReference: "B4A01236",
+					Details: "Ruecklastschrift Gebuehr erstattet", Purpose: "Ruecklastschrift Gebuehr erstattet",
+				},
+			},
+		},
+		{
+			reference: "STMT240101USD",
+			currency:  "USD",
+			want: []Transaction{
+				{
+					Line: 24, ValueDate: date(2024, 1, 2), Credit: true, Amount: "1000",
+					TypeCode: "NTRF", CustomerReference: "INV-2024-17",
+					Details: "Globex Corp invoice 2024-17", Purpose: "Globex Corp invoice 2024-17",
+				},
+				{
+					Line: 26, ValueDate: date(2024, 1, 5), Reversal: true, Amount: "25.00",
+					TypeCode: "NMSC", CustomerReference: "NONREF", BankReference: "B4A01237",
+				},
+			},
+		},
+	}
+
+	for i, tt := range tests {
+		statement := statements[i]
+		if statement.Reference != tt.reference || statement.Account != "DEUTDEFF/0532013000" || statement.Number != "1/1" || statement.Currency != tt.currency {
+			t.Errorf("unexpected statement %+v", statement)
+		}
+		if len(statement.Transactions) != len(tt.want) {
+			t.Fatalf("statement %s: expected %d transactions, got %d", tt.reference, len(tt.want), len(statement.Transactions))
+		}
+		for j, trn := range statement.Transactions {
+			if *trn != tt.want[j] {
+				t.Errorf("statement %s transaction %d = %+v, want %+v", tt.reference, j, *trn, tt.want[j])
+			}
+		}
+	}
+}
+
+func TestParseWithoutEnvelope(t *testing.T) {
+	data := ":20:1\n:25:NL91ABNA0417164300\n:60F:C240228EUR0,00\n:61:2402280301D5,NMSCNONREF\n:86:Caf\xe9\n:62F:D240301EUR5,00\n"
+
+	statements, err := Parse(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	trn := statements[0].Transactions[0]
+	if trn.Amount != "5" || trn.Credit || !trn.EntryDate.Equal(date(2024, 3, 1)) {
+		t.Errorf("unexpected transaction %+v", trn)
+	}
+	if trn.Details != "Café" {
+		t.Errorf("expected the details decoded from Latin-1, got %q", trn.Details)
+	}
+}
+
+func TestEntryDate(t *testing.T) {
+	tests := []struct {
+		valueDate time.Time
+		monthDay  string
+		want      time.Time
+	}{
+		{date(2024, 3, 1), "0301", date(2024, 3, 1)},
+		{date(2023, 12, 29), "0102", date(2024, 1, 2)},
+		{date(2024, 1, 2), "1229", date(2023, 12, 29)},
+		{date(2023, 3, 1), "0229", date(2024, 2, 29)},
+	}
+
+	for _, tt := range tests {
+		got, err := entryDate(tt.valueDate, tt.monthDay)
+		if err != nil {
+			t.Fatalf("entryDate(%v, %q) error = %v", tt.valueDate, tt.monthDay, err)
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("entryDate(%v, %q) = %v, want %v", tt.valueDate, tt.monthDay, got, tt.want)
+		}
+	}
+
+	if _, err := entryDate(date(2024, 3, 1), "1332"); err == nil {
+		t.Error("expected an error for an invalid month and day")
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		wantLine int
+	}{
+		{"empty", "", 1},
+		{"not MT940", "Date,Amount\n2024-03-01,10.00\n", 1},
+		{"field before :20:", ":25:NL91ABNA0417164300\n:20:1\n", 1},
+		{"invalid opening balance", ":20:1\n:60F:C2402EUR0,00\n", 2},
+		{"line before opening balance", ":20:1\n:25:NL91ABNA0417164300\n:61:240301D5,00NMSCNONREF\n", 3},
+		{"invalid statement line", ":20:1\n:60F:C240228EUR0,00\n:61:240301X5,00NMSCNONREF\n", 3},
+		{"invalid value date", ":20:1\n:60F:C240228EUR0,00\n:61:241301D5,00NMSCNONREF\n", 3},
+		{"invalid entry date", ":20:1\n:60F:C240228EUR0,00\n:61:2403011301D5,00NMSCNONREF\n", 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.data))
+			var syntaxErr *SyntaxError
+			if !errors.As(err, &syntaxErr) {
+				t.Fatalf("expected a SyntaxError, got %v", err)
+			}
+			if syntaxErr.Line != tt.wantLine {
+				t.Errorf("expected line %d, got %d (%v)", tt.wantLine, syntaxErr.Line, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package ofx
+
+import (
+	"bytes"
+	"html"
+	"strings"
+	"unicode/utf8"
+)
+
+// element is an OFX element: an aggregate with children, or a leaf with a value.
+type element struct {
+	name     string
+	text     string
+	line     int
+	children []*element
+}
+
+// child returns the first child element with the given name. It returns an
+// empty element when there is none, so that lookups can be chained.
+func (e *element) child(name string) *element {
+	for _, c := range e.children {
+		if c.name == name {
+			return c
+		}
+	}
+	return &element{}
+}
+
+// value returns the value of the first child element with the given name.
+func (e *element) value(name string) string {
+	return e.child(name).text
+}
+
+// walk calls fn for the element and its descendants in document order,
+// skipping the descendants of an element for which fn returns false.
+func (e *element) walk(fn func(*element) bool) {
+	if !fn(e) {
+		return
+	}
+	for _, c := range e.children {
+		c.walk(fn)
+	}
+}
+
+// token is a tag or the text between two tags.
+type token struct {
+	line int
+	// name is the tag name, empty for text.
+	name    string
+	closing bool
+	text    string
+}
+
+// parseDocument reads the element tree of an OFX file, skipping the header.
+//
+// In the SGML syntax of OFX 1.x leaf elements have no end tag, while in the
+// XML syntax every element is closed. Both are read by treating a start tag
+// followed by text as a leaf, whose end tag is optional, and any other start
+// tag as an aggregate, which must be closed.
+func parseDocument(data []byte) (*element, error) {
+	// OFX 1.x files are often written in Windows-1252 rather than UTF-8
+	text := string(data)
+	if !utf8.Valid(data) {
+		text = decodeWindows1252(data)
+	}
+
+	start := strings.Index(text, "<OFX>")
+	if start < 0 {
+		return nil, &SyntaxError{Line: 1, Msg: "the file is not an OFX document"}
+	}
+	tokens, err := tokenize(text[start:], 1+strings.Count(text[:start], "\n"))
+	if err != nil {
+		return nil, err
+	}
+
+	root := &element{}
+	stack := []*element{root}
+	for i := 0; i < len(tokens); i++ {
+		tok := tokens[i]
+		parent := stack[len(stack)-1]
+
+		if tok.name == "" {
+			return nil, &SyntaxError{Line: tok.line, Msg: "text outside of an element"}
+		}
+
+		if tok.closing {
+			index := len(stack) - 1
+			for index > 0 && stack[index].name != tok.name {
+				index--
+			}
+			if index == 0 {
+				return nil, &SyntaxError{Line: tok.line, Msg: "unexpected </" + tok.name + ">"}
+			}
+			if index != len(stack)-1 {
+				return nil, &SyntaxError{Line: tok.line, Msg: "<" + stack[len(stack)-1].name + "> is not closed"}
+			}
+			stack = stack[:index]
+			continue
+		}
+
+		e := &element{name: tok.name, line: tok.line}
+		parent.children = append(parent.children, e)
+
+		next := func(offset int) *token {
+			if i+offset < len(tokens) {
+				return &tokens[i+offset]
+			}
+			return nil
+		}
+		if text := next(1); text != nil && text.name == "" {
+			// A leaf, with an optional end tag
+			e.text = text.text
+			i++
+			if end := next(1); end != nil && end.closing && end.name == e.name {
+				i++
+			}
+			continue
+		}
+		if end := next(1); end != nil && end.closing && end.name == e.name {
+			// A leaf without a value
+			i++
+			continue
+		}
+		stack = append(stack, e)
+	}
+
+	if len(stack) > 1 {
+		open := stack[len(stack)-1]
+		return nil, &SyntaxError{Line: open.line, Msg: "<" + open.name + "> is not closed"}
+	}
+	return root, nil
+}
+
+// tokenize splits an OFX body into tags and the non-blank text between them,
+// dropping comments and processing instructions. firstLine is the line the
+// body starts on.
+func tokenize(body string, firstLine int) ([]token, error) {
+	var tokens []token
+	line := firstLine
+	for len(body) > 0 {
+		open := strings.IndexByte(body, '<')
+		if open < 0 {
+			open = len(body)
+		}
+		raw := body[:open]
+		if text := strings.TrimSpace(raw); text != "" {
+			leading := len(raw) - len(strings.TrimLeft(raw, " \t\r\n"))
+			tokens = append(tokens, token{line: line + strings.Count(raw[:leading], "\n"), text: html.UnescapeString(text)})
+		}
+		line += strings.Count(raw, "\n")
+		body = body[open:]
+		if body == "" {
+			break
+		}
+
+		var end string
+		switch {
+		case strings.HasPrefix(body, "<!--"):
+			end = "-->"
+		case strings.HasPrefix(body, "<?"):
+			end = "?>"
+		default:
+			end = ">"
+		}
+		closeAt := strings.Index(body, end)
+		if closeAt < 0 {
+			return nil, &SyntaxError{Line: line, Msg: "unterminated tag"}
+		}
+		tag := body[:closeAt+len(end)]
+		body = body[len(tag):]
+
+		if end == ">" {
+			tok := token{line: line}
+			name := strings.TrimSuffix(tag[1:len(tag)-1], "/")
+			if tok.closing = strings.HasPrefix(name, "/"); tok.closing {
+				name = name[1:]
+			}
+			if tok.name = strings.TrimSpace(name); !isName(tok.name) {
+				return nil, &SyntaxError{Line: line, Msg: "invalid tag " + tag}
+			}
+			tokens = append(tokens, tok)
+			if strings.HasSuffix(tag, "/>") {
+				// An empty XML element closes itself
+				tokens = append(tokens, token{line: line, name: tok.name, closing: true})
+			}
+		}
+		line += strings.Count(tag, "\n")
+	}
+	return tokens, nil
+}
+
+// isName reports whether s is an OFX element name. Names are upper case and
+// may hold digits, dots and underscores, as in Intuit's INTU.BID.
+func isName(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if !(c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '.' || c == '_') {
+			return false
+		}
+	}
+	return true
+}
+
+// windows1252 maps the bytes 0x80 to 0x9F of Windows-1252; the other bytes
+// are the Latin-1 code points of the same value.
+var windows1252 = [32]rune{
+	'\u20ac', '\ufffd', '\u201a', '\u0192', '\u201e', '\u2026', '\u2020', '\u2021',
+	'\u02c6', '\u2030', '\u0160', '\u2039', '\u0152', '\ufffd', '\u017d', '\ufffd',
+	'\ufffd', '\u2018', '\u2019', '\u201c', '\u201d', '\u2022', '\u2013', '\u2014',
+	'\u02dc', '\u2122', '\u0161', '\u203a', '\u0153', '\ufffd', '\u017e', '\u0178',
+}
+
+func decodeWindows1252(data []byte) string {
+	var b bytes.Buffer
+	b.Grow(len(data))
+	for _, c := range data {
+		if c >= 0x80 && c < 0xa0 {
+			b.WriteRune(windows1252[c-0x80])
+		} else {
+			b.WriteRune(rune(c))
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,163 @@
+// Package ofx reads bank and credit card statements from Open Financial
+// Exchange files, in both the SGML syntax of OFX 1.x and the XML syntax of
+// OFX 2.x. Quicken's QFX files are OFX files and are read the same way.
+package ofx
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Statement is the statement of one account in an OFX file.
+type Statement struct {
+	// Currency is the default currency of the statement (CURDEF).
+	Currency string
+	// AccountID is the account number the bank gives the account (ACCTID).
+	AccountID string
+	// Transactions lists the statement transactions (STMTTRN) in file order.
+	Transactions []*Transaction
+}
+
+// Transaction is a statement transaction. Its values are kept as written in
+// the file; ParseDateTime and ParseAmount convert the date and the amount.
+type Transaction struct {
+	// Line is the 1-based line of the transaction's opening tag.
+	Line int
+	// Type is the transaction type (TRNTYPE), e.g. DEBIT or CREDIT.
+	Type string
+	// DatePosted is the date the transaction was posted (DTPOSTED).
+	DatePosted string
+	// Amount is the signed amount (TRNAMT); negative amounts leave the account.
+	Amount string
+	// FITID is the identifier the bank gives the transaction, unique within the account.
+	FITID string
+	// Name is the payee (NAME, or the NAME of the PAYEE aggregate).
+	Name string
+	// Memo is extra information about the transaction (MEMO).
+	Memo string
+}
+
+// SyntaxError reports a file that is not a well-formed OFX document.
+type SyntaxError struct {
+	// Line is the 1-based line the problem was found on.
+	Line int
+	Msg  string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Msg)
+}
+
+// Parse reads the bank and credit card statements of an OFX file, in file order.
+func Parse(r io.Reader) ([]*Statement, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := parseDocument(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var statements []*Statement
+	root.walk(func(e *element) bool {
+		var accountFrom string
+		switch e.name {
+		case "STMTRS":
+			accountFrom = "BANKACCTFROM"
+		case "CCSTMTRS":
+			accountFrom = "CCACCTFROM"
+		default:
+			return true
+		}
+
+		statement := &Statement{
+			Currency:  e.value("CURDEF"),
+			AccountID: e.child(accountFrom).value("ACCTID"),
+		}
+		for _, trn := range e.child("BANKTRANLIST").children {
+			if trn.name != "STMTTRN" {
+				continue
+			}
+			name := trn.value("NAME")
+			if name == "" {
+				name = trn.child("PAYEE").value("NAME")
+			}
+			statement.Transactions = append(statement.Transactions, &Transaction{
+				Line:       trn.line,
+				Type:       trn.value("TRNTYPE"),
+				DatePosted: trn.value("DTPOSTED"),
+				Amount:     trn.value("TRNAMT"),
+				FITID:      trn.value("FITID"),
+				Name:       name,
+				Memo:       trn.value("MEMO"),
+			})
+		}
+		statements = append(statements, statement)
+		return false
+	})
+
+	return statements, nil
+}
+
+// ParseDateTime reads an OFX date and time, written
+// YYYYMMDD[HHMMSS[.XXX]][[offset[:zone]]] with the offset in hours from UTC.
+// The time is in UTC when no offset is given.
+func ParseDateTime(value string) (time.Time, error) {
+	invalid := fmt.Errorf("%q is not an OFX date", value)
+
+	location := time.UTC
+	if open := strings.IndexByte(value, '['); open >= 0 {
+		zone, ok := strings.CutSuffix(value[open+1:], "]")
+		if !ok {
+			return time.Time{}, invalid
+		}
+		offset, name, _ := strings.Cut(zone, ":")
+		hours, err := strconv.ParseFloat(offset, 64)
+		if err != nil || hours < -12 || hours > 14 {
+			return time.Time{}, invalid
+		}
+		location = time.FixedZone(name, int(hours*3600))
+		value = value[:open]
+	}
+	value, _, _ = strings.Cut(value, ".")
+
+	layouts := map[int]string{8: "20060102", 12: "200601021504", 14: "20060102150405"}
+	layout, ok := layouts[len(value)]
+	if !ok {
+		return time.Time{}, invalid
+	}
+	t, err := time.ParseInLocation(layout, value, location)
+	if err != nil {
+		return time.Time{}, invalid
+	}
+	return t, nil
+}
+
+// ParseAmount normalizes an OFX amount to a plain decimal with a point as
+// decimal separator. Some banks write amounts with a decimal comma.
+func ParseAmount(value string) (string, error) {
+	amount := strings.TrimPrefix(strings.TrimSpace(value), "+")
+	if !strings.Contains(amount, ".") {
+		amount = strings.Replace(amount, ",", ".", 1)
+	}
+
+	digits, fraction, _ := strings.Cut(strings.TrimPrefix(amount, "-"), ".")
+	if digits == "" && fraction == "" || !isDigits(digits) || !isDigits(fraction) {
+		return "", fmt.Errorf("%q is not an OFX amount", value)
+	}
+	return amount, nil
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
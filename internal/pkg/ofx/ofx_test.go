@@ -0,0 +1,191 @@
+package ofx
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func parseFile(t *testing.T, name string) []*Statement {
+	t.Helper()
+	file, err := os.Open("testdata/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	statements, err := Parse(file)
+	if err != nil {
+		t.Fatalf("Parse(%s) error = %v", name, err)
+	}
+	return statements
+}
+
+func TestParseSGML(t *testing.T) {
+	statements := parseFile(t, "checking.ofx")
+	if len(statements) != 1 {
+		t.Fatalf("expected 1 statement, got %d", len(statements))
+	}
+	statement := statements[0]
+	if statement.Currency != "USD" || statement.AccountID != "1234567890" {
+		t.Errorf("unexpected statement %+v", statement)
+	}
+
+	want := []Transaction{
+		{Line: 40, Type: "DEBIT", DatePosted: "20240304120000.000[-5:EST]", Amount: "-42.17", FITID: "2024030401", Name: "CORNER GROCERY", Memo: "POS PURCHASE"},
+		{Line: 48, Type: "CREDIT", DatePosted: "20240315", Amount: "2500.00", FITID: "2024031502", Name: "ACME PAYROLL"},
+		{Line: 55, Type: "CHECK", DatePosted: "20240320", Amount: "-120.00", FITID: "2024032003", Name: "AT&T", Memo: "Phone bill"},
+	}
+	if len(statement.Transactions) != len(want) {
+		t.Fatalf("expected %d transactions, got %d", len(want), len(statement.Transactions))
+	}
+	for i, trn := range statement.Transactions {
+		if *trn != want[i] {
+			t.Errorf("transaction %d = %+v, want %+v", i, *trn, want[i])
+		}
+	}
+}
+
+func TestParseXML(t *testing.T) {
+	statements := parseFile(t, "creditcard.qfx")
+	if len(statements) != 1 {
+		t.Fatalf("expected 1 statement, got %d", len(statements))
+	}
+	statement := statements[0]
+	if statement.Currency != "EUR" || statement.AccountID != "4111111111111111" {
+		t.Errorf("unexpected statement %+v", statement)
+	}
+
+	want := []Transaction{
+		{Line: 23, Type: "DEBIT", DatePosted: "20240302000000[+1:CET]", Amount: "-18,90", FITID: "CC-0001", Name: "Café & Bar"},
+		{Line: 31, Type: "CREDIT", DatePosted: "20240310", Amount: "+5,00", FITID: "CC-0002", Name: "Refund"},
+	}
+	if len(statement.Transactions) != len(want) {
+		t.Fatalf("expected %d transactions, got %d", len(want), len(statement.Transactions))
+	}
+	for i, trn := range statement.Transactions {
+		if *trn != want[i] {
+			t.Errorf("transaction %d = %+v, want %+v", i, *trn, want[i])
+		}
+	}
+}
+
+func TestParseWindows1252(t *testing.T) {
+	data := "OFXHEADER:100\nCHARSET:1252\n\n<OFX><BANKMSGSRSV1><STMTTRNRS><STMTRS><CURDEF>EUR<BANKTRANLIST>" +
+		"<STMTTRN><TRNAMT>-3.00<NAME>Caf\xe9 \x80 3</STMTTRN></BANKTRANLIST></STMTRS></STMTTRNRS></BANKMSGSRSV1></OFX>"
+
+	statements, err := Parse(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if got := statements[0].Transactions[0].Name; got != "Café € 3" {
+		t.Errorf("expected the name decoded from Windows-1252, got %q", got)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		wantLine int
+	}{
+		{"not OFX", "Date,Amount\n2024-03-01,10.00\n", 1},
+		{"unclosed aggregate", "OFXHEADER:100\n\n<OFX>\n<BANKMSGSRSV1>\n<STMTTRNRS>\n</BANKMSGSRSV1>\n</OFX>\n", 6},
+		{"unexpected end tag", "<OFX>\n<STMTRS>\n</STMTRS>\n</BANKTRANLIST>\n</OFX>\n", 4},
+		{"missing end of document", "<OFX>\n<STMTRS>\n<CURDEF>USD\n</STMTRS>\n", 1},
+		{"unterminated tag", "<OFX>\n<STMTRS\n", 2},
+		{"invalid tag", "<OFX>\n<stmtrs>\n</OFX>\n", 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.data))
+			var syntaxErr *SyntaxError
+			if !errors.As(err, &syntaxErr) {
+				t.Fatalf("expected a SyntaxError, got %v", err)
+			}
+			if syntaxErr.Line != tt.wantLine {
+				t.Errorf("expected line %d, got %d (%v)", tt.wantLine, syntaxErr.Line, err)
+			}
+		})
+	}
+}
+
+func TestParseDateTime(t *testing.T) {
+	est := time.FixedZone("EST", -5*3600)
+	ist := time.FixedZone("IST", 5*3600+1800)
+	tests := []struct {
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{value: "20240304", want: time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)},
+		{value: "202403041230", want: time.Date(2024, 3, 4, 12, 30, 0, 0, time.UTC)},
+		{value: "20240304123015.123", want: time.Date(2024, 3, 4, 12, 30, 15, 0, time.UTC)},
+		{value: "20240304120000.000[-5:EST]", want: time.Date(2024, 3, 4, 12, 0, 0, 0, est)},
+		{value: "20240304[+5.5:IST]", want: time.Date(2024, 3, 4, 0, 0, 0, 0, ist)},
+		{value: "20240304[0]", want: time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)},
+		{value: "", wantErr: true},
+		{value: "2024-03-04", wantErr: true},
+		{value: "20241304", wantErr: true},
+		{value: "20240304[-5:EST", wantErr: true},
+		{value: "20240304[abc]", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseDateTime(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseDateTime(%q) = %v, want an error", tt.value, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDateTime(%q) error = %v", tt.value, err)
+			}
+			if !got.Equal(tt.want) || got.Day() != tt.want.Day() {
+				t.Errorf("ParseDateTime(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{value: "-42.17", want: "-42.17"},
+		{value: "2500", want: "2500"},
+		{value: "+5.00", want: "5.00"},
+		{value: "-18,90", want: "-18.90"},
+		{value: " 7.5 ", want: "7.5"},
+		{value: "", wantErr: true},
+		{value: "-", wantErr: true},
+		{value: "1,234.56", wantErr: true},
+		{value: "1e3", wantErr: true},
+		{value: "NaN", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseAmount(tt.value)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseAmount(%q) = %q, want an error", tt.value, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseAmount(%q) error = %v", tt.value, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseAmount(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
@@ -1,15 +1,18 @@
 package entity
 
 import (
+	"database/sql"
 	"time"
 )
 
 type Account struct {
 	ID        string
 	UserID    string
+	ParentID  sql.NullString
+	Code      sql.NullString
 	Name      string
 	Type      string
-	Balance   float64
+	Balance   string
 	Currency  string
 	CreatedAt time.Time
 	UpdatedAt time.Time
@@ -0,0 +1,17 @@
+package entity
+
+import "time"
+
+type BalanceSnapshot struct {
+	AccountID string
+	Date      time.Time
+	Balance   string
+	CreatedAt time.Time
+}
+
+type DailyMovement struct {
+	Date    time.Time
+	Net     string
+	Debits  string
+	Credits string
+}
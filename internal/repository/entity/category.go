@@ -0,0 +1,17 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+)
+
+type Category struct {
+	ID        string
+	UserID    string
+	ParentID  sql.NullString
+	Name      string
+	Type      string
+	Archived  bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
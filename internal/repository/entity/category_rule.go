@@ -0,0 +1,27 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type CategoryRule struct {
+	ID                  string
+	UserID              string
+	Name                string
+	Priority            int
+	DescriptionContains string
+	DescriptionPattern  string
+	MinAmount           sql.NullString
+	MaxAmount           sql.NullString
+	AmountCurrency      sql.NullString
+	AccountID           sql.NullString
+	Type                string
+	SetCategoryID       sql.NullString
+	SetDescription      string
+	SetTags             pq.StringArray
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
@@ -0,0 +1,12 @@
+package entity
+
+import "time"
+
+type ExchangeRate struct {
+	BaseCurrency  string
+	QuoteCurrency string
+	Date          time.Time
+	Rate          string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
@@ -0,0 +1,22 @@
+package entity
+
+import "time"
+
+type ImportProfile struct {
+	ID                string
+	UserID            string
+	Name              string
+	Delimiter         string
+	SkipRows          int
+	DateColumn        string
+	DateFormat        string
+	AmountSign        string
+	AmountColumn      string
+	DebitColumn       string
+	CreditColumn      string
+	DescriptionColumn string
+	CategoryColumn    string
+	DecimalSeparator  string
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
@@ -0,0 +1,25 @@
+package entity
+
+import (
+	"time"
+)
+
+type JournalEntry struct {
+	ID          string
+	UserID      string
+	Date        time.Time
+	Description string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type Posting struct {
+	ID        string
+	EntryID   string
+	LineNo    int
+	AccountID string
+	Direction string
+	Amount    string
+	Currency  string
+	Memo      string
+}
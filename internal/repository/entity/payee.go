@@ -0,0 +1,18 @@
+package entity
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+type Payee struct {
+	ID                string
+	UserID            string
+	Name              string
+	Aliases           pq.StringArray
+	DefaultCategoryID sql.NullString
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
@@ -0,0 +1,26 @@
+package entity
+
+import "time"
+
+type CategoryTotal struct {
+	PeriodStart time.Time
+	Currency    string
+	CategoryID  string
+	Income      string
+	Expense     string
+}
+
+type TagTotal struct {
+	Tag      string
+	Currency string
+	Income   string
+	Expense  string
+}
+
+type PayeeTotal struct {
+	PayeeID  string
+	Payee    string
+	Currency string
+	Income   string
+	Expense  string
+}
@@ -0,0 +1,14 @@
+package entity
+
+type AccountMovement struct {
+	AccountID string
+	Currency  string
+	NetDebit  string
+}
+
+type CategoryMovement struct {
+	Type     string
+	Category string
+	Currency string
+	Amount   string
+}
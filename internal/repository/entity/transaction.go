@@ -1,18 +1,44 @@
 package entity
 
 import (
+	"database/sql"
 	"time"
+
+	"github.com/lib/pq"
 )
 
 type Transaction struct {
-	ID          string
-	AccountID   string
-	Amount      float64
-	Currency    string
-	Description string
-	Date        time.Time
-	Type        string
-	Category    string
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	ID                string
+	AccountID         string
+	Amount            string
+	Currency          string
+	Description       string
+	PayeeID           sql.NullString
+	Payee             sql.NullString
+	Date              time.Time
+	Type              string
+	CategoryID        sql.NullString
+	Category          sql.NullString
+	Tags              pq.StringArray
+	TransferID        sql.NullString
+	TransferDirection sql.NullString
+	OriginalAmount    sql.NullString
+	OriginalCurrency  sql.NullString
+	ExchangeRate      sql.NullString
+	ExternalID        sql.NullString
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	// RunningBalance is only selected by listings that ask for it
+	RunningBalance sql.NullString
+}
+
+type TransactionSplit struct {
+	ID            string
+	TransactionID string
+	LineNo        int
+	Amount        string
+	Currency      string
+	CategoryID    sql.NullString
+	Category      sql.NullString
+	Memo          string
 }
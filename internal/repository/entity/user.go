@@ -5,9 +5,10 @@ import (
 )
 
 type User struct {
-	ID        string
-	Name      string
-	Email     string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID           string
+	Name         string
+	Email        string
+	BaseCurrency string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
 }
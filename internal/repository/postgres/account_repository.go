@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"time"
 
 	"accounting/internal/domain/constant"
@@ -12,6 +13,9 @@ import (
 	repoEntity "accounting/internal/repository/entity"
 )
 
+// accountColumns lists the columns read by scanAccount, in order.
+const accountColumns = `id, user_id, parent_id, code, name, type, balance, currency, created_at`
+
 type AccountRepository struct {
 	db *sql.DB
 }
@@ -25,23 +29,52 @@ func toRepoAccount(account *entity.Account) *repoEntity.Account {
 	return &repoEntity.Account{
 		ID:       account.ID,
 		UserID:   account.UserID,
+		ParentID: toNullString(account.ParentID),
+		Code:     toNullString(account.Code),
 		Name:     account.Name,
 		Type:     string(account.Type),
-		Balance:  account.Balance,
+		Balance:  account.Balance.String(),
 		Currency: account.Currency,
 	}
 }
 
 // Mapper: Repository Entity -> Domain Entity
-func toDomainAccount(dbAccount *repoEntity.Account) *entity.Account {
+func toDomainAccount(dbAccount *repoEntity.Account) (*entity.Account, error) {
+	balance, err := parseAmount(dbAccount.Balance, dbAccount.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("parsing balance of account %s: %w", dbAccount.ID, err)
+	}
+
 	return &entity.Account{
 		ID:       dbAccount.ID,
 		UserID:   dbAccount.UserID,
+		ParentID: dbAccount.ParentID.String,
+		Code:     dbAccount.Code.String,
 		Name:     dbAccount.Name,
 		Type:     constant.AccountType(dbAccount.Type),
-		Balance:  dbAccount.Balance,
+		Balance:  balance,
 		Currency: dbAccount.Currency,
+	}, nil
+}
+
+// scanAccount reads a row selected with accountColumns.
+func scanAccount(row scanner) (*repoEntity.Account, error) {
+	var dbAccount repoEntity.Account
+	err := row.Scan(
+		&dbAccount.ID,
+		&dbAccount.UserID,
+		&dbAccount.ParentID,
+		&dbAccount.Code,
+		&dbAccount.Name,
+		&dbAccount.Type,
+		&dbAccount.Balance,
+		&dbAccount.Currency,
+		&dbAccount.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
 	}
+	return &dbAccount, nil
 }
 
 func (r *AccountRepository) Create(ctx context.Context, account *entity.Account) error {
@@ -53,13 +86,15 @@ func (r *AccountRepository) Create(ctx context.Context, account *entity.Account)
 	dbAccount.UpdatedAt = now
 
 	query := `
-INSERT INTO accounts (id, user_id, name, type, balance, currency, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+INSERT INTO accounts (id, user_id, parent_id, code, name, type, balance, currency, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 `
 
-	_, err := r.db.ExecContext(ctx, query,
+	_, err := GetExecutor(ctx, r.db).ExecContext(ctx, query,
 		dbAccount.ID,
 		dbAccount.UserID,
+		dbAccount.ParentID,
+		dbAccount.Code,
 		dbAccount.Name,
 		dbAccount.Type,
 		dbAccount.Balance,
@@ -73,21 +108,39 @@ VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 
 func (r *AccountRepository) GetByID(ctx context.Context, id string) (*entity.Account, error) {
 	query := `
-SELECT id, user_id, name, type, balance, currency
+SELECT ` + accountColumns + `
 FROM accounts
 WHERE id = $1
 `
 
-	var dbAccount repoEntity.Account
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&dbAccount.ID,
-		&dbAccount.UserID,
-		&dbAccount.Name,
-		&dbAccount.Type,
-		&dbAccount.Balance,
-		&dbAccount.Currency,
-	)
+	return r.getOne(ctx, query, id)
+}
+
+// GetByIDForUpdate reads the account and locks its row until the surrounding
+// database transaction ends, so concurrent balance updates are serialized.
+func (r *AccountRepository) GetByIDForUpdate(ctx context.Context, id string) (*entity.Account, error) {
+	query := `
+SELECT ` + accountColumns + `
+FROM accounts
+WHERE id = $1
+FOR UPDATE
+`
+
+	return r.getOne(ctx, query, id)
+}
+
+func (r *AccountRepository) GetByCode(ctx context.Context, userID, code string) (*entity.Account, error) {
+	query := `
+SELECT ` + accountColumns + `
+FROM accounts
+WHERE user_id = $1 AND code = $2
+`
+
+	return r.getOne(ctx, query, userID, code)
+}
 
+func (r *AccountRepository) getOne(ctx context.Context, query string, args ...any) (*entity.Account, error) {
+	dbAccount, err := scanAccount(GetExecutor(ctx, r.db).QueryRowContext(ctx, query, args...))
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -95,41 +148,116 @@ WHERE id = $1
 		return nil, err
 	}
 
-	return toDomainAccount(&dbAccount), nil
+	return toDomainAccount(dbAccount)
 }
 
-func (r *AccountRepository) ListByUserID(ctx context.Context, userID string) ([]*entity.Account, error) {
+func (r *AccountRepository) ListByUserID(ctx context.Context, userID string, page entity.PageRequest) ([]*entity.Account, string, error) {
+	cursor, err := decodeCursor(page.Cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	if cursor != nil && cursor.Date != nil {
+		return nil, "", errInvalidCursor
+	}
+
 	query := `
-SELECT id, user_id, name, type, balance, currency
+SELECT ` + accountColumns + `
 FROM accounts
-WHERE user_id = $1
-ORDER BY created_at DESC
+WHERE user_id = $1`
+	args := []any{userID}
+	if cursor != nil {
+		query += `
+  AND (created_at, id) < ($2, $3)`
+		args = append(args, cursor.CreatedAt, cursor.ID)
+	}
+	query += `
+ORDER BY created_at DESC, id DESC`
+	limit, args := limitClause(page.Limit, args)
+
+	dbAccounts, err := r.query(ctx, query+limit, args...)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var nextCursor string
+	if page.Limit > 0 && len(dbAccounts) > page.Limit {
+		dbAccounts = dbAccounts[:page.Limit]
+		last := dbAccounts[page.Limit-1]
+		nextCursor = encodeCursor(pageCursor{CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+
+	accounts, err := toDomainAccounts(dbAccounts)
+	if err != nil {
+		return nil, "", err
+	}
+	return accounts, nextCursor, nil
+}
+
+func (r *AccountRepository) ListByParentID(ctx context.Context, parentID string) ([]*entity.Account, error) {
+	query := `
+SELECT ` + accountColumns + `
+FROM accounts
+WHERE parent_id = $1
+ORDER BY code, name
+`
+
+	dbAccounts, err := r.query(ctx, query, parentID)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainAccounts(dbAccounts)
+}
+
+func (r *AccountRepository) GetActivity(ctx context.Context, id string) (*entity.AccountActivity, error) {
+	query := `
+SELECT (SELECT COUNT(*) FROM transactions WHERE account_id = $1),
+       (SELECT COUNT(*) FROM transactions WHERE account_id = $1 AND transfer_id IS NOT NULL),
+       (SELECT COUNT(*) FROM journal_postings WHERE account_id = $1)
 `
 
-	rows, err := r.db.QueryContext(ctx, query, userID)
+	var activity entity.AccountActivity
+	err := GetExecutor(ctx, r.db).QueryRowContext(ctx, query, id).Scan(
+		&activity.Transactions,
+		&activity.TransferLegs,
+		&activity.Postings,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &activity, nil
+}
+
+// query runs a query selecting accountColumns and scans every row.
+func (r *AccountRepository) query(ctx context.Context, query string, args ...any) ([]*repoEntity.Account, error) {
+	rows, err := GetExecutor(ctx, r.db).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var accounts []*entity.Account
+	var dbAccounts []*repoEntity.Account
 	for rows.Next() {
-		var dbAccount repoEntity.Account
-		err := rows.Scan(
-			&dbAccount.ID,
-			&dbAccount.UserID,
-			&dbAccount.Name,
-			&dbAccount.Type,
-			&dbAccount.Balance,
-			&dbAccount.Currency,
-		)
+		dbAccount, err := scanAccount(rows)
 		if err != nil {
 			return nil, err
 		}
-		accounts = append(accounts, toDomainAccount(&dbAccount))
+		dbAccounts = append(dbAccounts, dbAccount)
 	}
 
-	return accounts, rows.Err()
+	return dbAccounts, rows.Err()
+}
+
+func toDomainAccounts(dbAccounts []*repoEntity.Account) ([]*entity.Account, error) {
+	accounts := make([]*entity.Account, 0, len(dbAccounts))
+	for _, dbAccount := range dbAccounts {
+		account, err := toDomainAccount(dbAccount)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, account)
+	}
+	return accounts, nil
 }
 
 func (r *AccountRepository) Update(ctx context.Context, account *entity.Account) error {
@@ -140,13 +268,15 @@ func (r *AccountRepository) Update(ctx context.Context, account *entity.Account)
 
 	query := `
 UPDATE accounts
-SET user_id = $2, name = $3, type = $4, balance = $5, currency = $6, updated_at = $7
+SET user_id = $2, parent_id = $3, code = $4, name = $5, type = $6, balance = $7, currency = $8, updated_at = $9
 WHERE id = $1
 `
 
-	result, err := r.db.ExecContext(ctx, query,
+	result, err := GetExecutor(ctx, r.db).ExecContext(ctx, query,
 		dbAccount.ID,
 		dbAccount.UserID,
+		dbAccount.ParentID,
+		dbAccount.Code,
 		dbAccount.Name,
 		dbAccount.Type,
 		dbAccount.Balance,
@@ -171,7 +301,7 @@ WHERE id = $1
 func (r *AccountRepository) Delete(ctx context.Context, id string) error {
 	query := `DELETE FROM accounts WHERE id = $1`
 
-	result, err := r.db.ExecContext(ctx, query, id)
+	result, err := GetExecutor(ctx, r.db).ExecContext(ctx, query, id)
 	if err != nil {
 		return err
 	}
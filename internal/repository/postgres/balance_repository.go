@@ -0,0 +1,149 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"accounting/internal/domain/entity"
+	"accounting/internal/domain/interfaces"
+	repoEntity "accounting/internal/repository/entity"
+)
+
+type BalanceRepository struct {
+	db *sql.DB
+}
+
+func NewBalanceRepository(db *sql.DB) interfaces.BalanceRepository {
+	return &BalanceRepository{db: db}
+}
+
+// Mapper: Repository Entity -> Domain Entity
+func toDomainDailyMovement(dbMovement *repoEntity.DailyMovement, currency string) (*entity.DailyMovement, error) {
+	net, err := parseAmount(dbMovement.Net, currency)
+	if err != nil {
+		return nil, fmt.Errorf("parsing movement on %s: %w", dbMovement.Date.Format(time.DateOnly), err)
+	}
+	debits, err := parseAmount(dbMovement.Debits, currency)
+	if err != nil {
+		return nil, fmt.Errorf("parsing debits on %s: %w", dbMovement.Date.Format(time.DateOnly), err)
+	}
+	credits, err := parseAmount(dbMovement.Credits, currency)
+	if err != nil {
+		return nil, fmt.Errorf("parsing credits on %s: %w", dbMovement.Date.Format(time.DateOnly), err)
+	}
+
+	return &entity.DailyMovement{
+		Date:    dbMovement.Date,
+		Net:     net,
+		Debits:  debits,
+		Credits: credits,
+	}, nil
+}
+
+func (r *BalanceRepository) ListDailyMovements(ctx context.Context, accountID, currency string, from, to time.Time) ([]*entity.DailyMovement, error) {
+	query := `
+WITH movements AS (
+    SELECT date_trunc('day', date) AS day,
+           CASE WHEN type = 'EXPENSE' OR transfer_direction = 'OUT' THEN -amount ELSE amount END AS net,
+           0 AS debit,
+           0 AS credit
+    FROM transactions
+    WHERE account_id = $1 AND date >= $2 AND date < $3
+    UNION ALL
+    SELECT date_trunc('day', e.date),
+           0,
+           CASE WHEN p.direction = 'DEBIT' THEN p.amount ELSE 0 END,
+           CASE WHEN p.direction = 'CREDIT' THEN p.amount ELSE 0 END
+    FROM journal_postings p
+    JOIN journal_entries e ON e.id = p.entry_id
+    WHERE p.account_id = $1 AND e.date >= $2 AND e.date < $3
+)
+SELECT day, SUM(net), SUM(debit), SUM(credit)
+FROM movements
+GROUP BY day
+ORDER BY day
+`
+
+	rows, err := GetExecutor(ctx, r.db).QueryContext(ctx, query, accountID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var movements []*entity.DailyMovement
+	for rows.Next() {
+		var dbMovement repoEntity.DailyMovement
+		if err := rows.Scan(
+			&dbMovement.Date,
+			&dbMovement.Net,
+			&dbMovement.Debits,
+			&dbMovement.Credits,
+		); err != nil {
+			return nil, err
+		}
+		movement, err := toDomainDailyMovement(&dbMovement, currency)
+		if err != nil {
+			return nil, err
+		}
+		movements = append(movements, movement)
+	}
+
+	return movements, rows.Err()
+}
+
+func (r *BalanceRepository) GetLatestSnapshot(ctx context.Context, accountID, currency string, date time.Time) (*entity.BalanceSnapshot, error) {
+	query := `
+SELECT account_id, date, balance
+FROM balance_snapshots
+WHERE account_id = $1 AND date <= $2
+ORDER BY date DESC
+LIMIT 1
+`
+
+	var dbSnapshot repoEntity.BalanceSnapshot
+	err := GetExecutor(ctx, r.db).QueryRowContext(ctx, query, accountID, date).Scan(
+		&dbSnapshot.AccountID,
+		&dbSnapshot.Date,
+		&dbSnapshot.Balance,
+	)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := parseAmount(dbSnapshot.Balance, currency)
+	if err != nil {
+		return nil, fmt.Errorf("parsing snapshot balance: %w", err)
+	}
+
+	return &entity.BalanceSnapshot{
+		AccountID: dbSnapshot.AccountID,
+		Date:      dbSnapshot.Date,
+		Balance:   balance,
+	}, nil
+}
+
+func (r *BalanceRepository) UpsertSnapshot(ctx context.Context, snapshot *entity.BalanceSnapshot) error {
+	query := `
+INSERT INTO balance_snapshots (account_id, date, balance, created_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (account_id, date)
+DO UPDATE SET balance = EXCLUDED.balance, created_at = EXCLUDED.created_at
+`
+
+	_, err := GetExecutor(ctx, r.db).ExecContext(ctx, query,
+		snapshot.AccountID,
+		snapshot.Date,
+		snapshot.Balance.String(),
+		time.Now(),
+	)
+
+	return err
+}
+
+// Compile-time interface check
+var _ interfaces.BalanceRepository = (*BalanceRepository)(nil)
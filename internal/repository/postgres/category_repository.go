@@ -0,0 +1,251 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/interfaces"
+	repoEntity "accounting/internal/repository/entity"
+)
+
+// categoryColumns lists the columns read by scanCategory, in order.
+const categoryColumns = `id, user_id, parent_id, name, type, archived, created_at, updated_at`
+
+type CategoryRepository struct {
+	db *sql.DB
+}
+
+func NewCategoryRepository(db *sql.DB) interfaces.CategoryRepository {
+	return &CategoryRepository{db: db}
+}
+
+// Mapper: Domain Entity -> Repository Entity
+func toRepoCategory(category *entity.Category) *repoEntity.Category {
+	return &repoEntity.Category{
+		ID:        category.ID,
+		UserID:    category.UserID,
+		ParentID:  toNullString(category.ParentID),
+		Name:      category.Name,
+		Type:      string(category.Type),
+		Archived:  category.Archived,
+		CreatedAt: category.CreatedAt,
+		UpdatedAt: category.UpdatedAt,
+	}
+}
+
+// Mapper: Repository Entity -> Domain Entity
+func toDomainCategory(dbCategory *repoEntity.Category) *entity.Category {
+	return &entity.Category{
+		ID:        dbCategory.ID,
+		UserID:    dbCategory.UserID,
+		ParentID:  dbCategory.ParentID.String,
+		Name:      dbCategory.Name,
+		Type:      constant.TransactionType(dbCategory.Type),
+		Archived:  dbCategory.Archived,
+		CreatedAt: dbCategory.CreatedAt,
+		UpdatedAt: dbCategory.UpdatedAt,
+	}
+}
+
+// scanCategory reads a row selected with categoryColumns.
+func scanCategory(row scanner) (*repoEntity.Category, error) {
+	var dbCategory repoEntity.Category
+	err := row.Scan(
+		&dbCategory.ID,
+		&dbCategory.UserID,
+		&dbCategory.ParentID,
+		&dbCategory.Name,
+		&dbCategory.Type,
+		&dbCategory.Archived,
+		&dbCategory.CreatedAt,
+		&dbCategory.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &dbCategory, nil
+}
+
+func (r *CategoryRepository) Create(ctx context.Context, category *entity.Category) error {
+	dbCategory := toRepoCategory(category)
+
+	// Set timestamps at repository layer
+	now := time.Now()
+	dbCategory.CreatedAt = now
+	dbCategory.UpdatedAt = now
+
+	query := `
+INSERT INTO categories (` + categoryColumns + `)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+`
+
+	_, err := GetExecutor(ctx, r.db).ExecContext(ctx, query,
+		dbCategory.ID,
+		dbCategory.UserID,
+		dbCategory.ParentID,
+		dbCategory.Name,
+		dbCategory.Type,
+		dbCategory.Archived,
+		dbCategory.CreatedAt,
+		dbCategory.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Update domain entity with timestamps
+	category.CreatedAt = dbCategory.CreatedAt
+	category.UpdatedAt = dbCategory.UpdatedAt
+
+	return nil
+}
+
+func (r *CategoryRepository) GetByID(ctx context.Context, id string) (*entity.Category, error) {
+	query := `
+SELECT ` + categoryColumns + `
+FROM categories
+WHERE id = $1
+`
+
+	return r.get(ctx, query, id)
+}
+
+func (r *CategoryRepository) GetByName(ctx context.Context, userID, parentID, name string) (*entity.Category, error) {
+	query := `
+SELECT ` + categoryColumns + `
+FROM categories
+WHERE user_id = $1 AND COALESCE(parent_id::text, '') = $2 AND LOWER(name) = LOWER($3)
+`
+
+	return r.get(ctx, query, userID, parentID, name)
+}
+
+func (r *CategoryRepository) ListByName(ctx context.Context, userID, name string) ([]*entity.Category, error) {
+	query := `
+SELECT ` + categoryColumns + `
+FROM categories
+WHERE user_id = $1 AND LOWER(name) = LOWER($2)
+ORDER BY name
+`
+
+	return r.list(ctx, query, userID, name)
+}
+
+// get runs a query selecting categoryColumns of at most one row.
+func (r *CategoryRepository) get(ctx context.Context, query string, args ...any) (*entity.Category, error) {
+	dbCategory, err := scanCategory(GetExecutor(ctx, r.db).QueryRowContext(ctx, query, args...))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainCategory(dbCategory), nil
+}
+
+func (r *CategoryRepository) ListByUserID(ctx context.Context, userID string, includeArchived bool) ([]*entity.Category, error) {
+	query := `
+SELECT ` + categoryColumns + `
+FROM categories
+WHERE user_id = $1 AND ($2 OR NOT archived)
+ORDER BY name
+`
+
+	return r.list(ctx, query, userID, includeArchived)
+}
+
+func (r *CategoryRepository) ListByParentID(ctx context.Context, parentID string) ([]*entity.Category, error) {
+	query := `
+SELECT ` + categoryColumns + `
+FROM categories
+WHERE parent_id = $1
+ORDER BY name
+`
+
+	return r.list(ctx, query, parentID)
+}
+
+// list runs a query selecting categoryColumns and maps every row.
+func (r *CategoryRepository) list(ctx context.Context, query string, args ...any) ([]*entity.Category, error) {
+	rows, err := GetExecutor(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var categories []*entity.Category
+	for rows.Next() {
+		dbCategory, err := scanCategory(rows)
+		if err != nil {
+			return nil, err
+		}
+		categories = append(categories, toDomainCategory(dbCategory))
+	}
+
+	return categories, rows.Err()
+}
+
+func (r *CategoryRepository) Update(ctx context.Context, category *entity.Category) error {
+	dbCategory := toRepoCategory(category)
+
+	// Set updated timestamp at repository layer
+	dbCategory.UpdatedAt = time.Now()
+
+	query := `
+UPDATE categories
+SET parent_id = $2, name = $3, type = $4, archived = $5, updated_at = $6
+WHERE id = $1
+`
+
+	result, err := GetExecutor(ctx, r.db).ExecContext(ctx, query,
+		dbCategory.ID,
+		dbCategory.ParentID,
+		dbCategory.Name,
+		dbCategory.Type,
+		dbCategory.Archived,
+		dbCategory.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domainerrors.NewErrNotFound("category", category.ID)
+	}
+
+	// Update domain entity with new timestamp
+	category.UpdatedAt = dbCategory.UpdatedAt
+
+	return nil
+}
+
+func (r *CategoryRepository) Delete(ctx context.Context, id string) error {
+	query := `DELETE FROM categories WHERE id = $1`
+
+	result, err := GetExecutor(ctx, r.db).ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domainerrors.NewErrNotFound("category", id)
+	}
+
+	return nil
+}
+
+// Compile-time interface check
+var _ interfaces.CategoryRepository = (*CategoryRepository)(nil)
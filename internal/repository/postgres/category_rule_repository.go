@@ -0,0 +1,286 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/domain/money"
+	repoEntity "accounting/internal/repository/entity"
+)
+
+// categoryRuleColumns lists the columns read by scanCategoryRule, in order.
+const categoryRuleColumns = `id, user_id, name, priority, description_contains, description_pattern,
+	min_amount, max_amount, amount_currency, account_id, type, set_category_id, set_description,
+	set_tags, created_at, updated_at`
+
+type CategoryRuleRepository struct {
+	db *sql.DB
+}
+
+func NewCategoryRuleRepository(db *sql.DB) interfaces.CategoryRuleRepository {
+	return &CategoryRuleRepository{db: db}
+}
+
+// Mapper: Domain Entity -> Repository Entity
+func toRepoCategoryRule(rule *entity.CategoryRule) *repoEntity.CategoryRule {
+	conditions := rule.Conditions
+	currency := conditions.MinAmount.Currency()
+	if currency == "" {
+		currency = conditions.MaxAmount.Currency()
+	}
+	tags := rule.Actions.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	return &repoEntity.CategoryRule{
+		ID:                  rule.ID,
+		UserID:              rule.UserID,
+		Name:                rule.Name,
+		Priority:            rule.Priority,
+		DescriptionContains: conditions.DescriptionContains,
+		DescriptionPattern:  conditions.DescriptionPattern,
+		MinAmount:           sql.NullString{String: conditions.MinAmount.String(), Valid: conditions.MinAmount.Currency() != ""},
+		MaxAmount:           sql.NullString{String: conditions.MaxAmount.String(), Valid: conditions.MaxAmount.Currency() != ""},
+		AmountCurrency:      toNullString(currency),
+		AccountID:           toNullString(conditions.AccountID),
+		Type:                string(conditions.Type),
+		SetCategoryID:       toNullString(rule.Actions.CategoryID),
+		SetDescription:      rule.Actions.Description,
+		SetTags:             tags,
+		CreatedAt:           rule.CreatedAt,
+		UpdatedAt:           rule.UpdatedAt,
+	}
+}
+
+// Mapper: Repository Entity -> Domain Entity
+func toDomainCategoryRule(dbRule *repoEntity.CategoryRule) (*entity.CategoryRule, error) {
+	var minAmount, maxAmount money.Money
+	var err error
+	if dbRule.MinAmount.Valid {
+		minAmount, err = parseAmount(dbRule.MinAmount.String, dbRule.AmountCurrency.String)
+		if err != nil {
+			return nil, fmt.Errorf("parsing minimum amount of category rule %s: %w", dbRule.ID, err)
+		}
+	}
+	if dbRule.MaxAmount.Valid {
+		maxAmount, err = parseAmount(dbRule.MaxAmount.String, dbRule.AmountCurrency.String)
+		if err != nil {
+			return nil, fmt.Errorf("parsing maximum amount of category rule %s: %w", dbRule.ID, err)
+		}
+	}
+
+	return &entity.CategoryRule{
+		ID:       dbRule.ID,
+		UserID:   dbRule.UserID,
+		Name:     dbRule.Name,
+		Priority: dbRule.Priority,
+		Conditions: entity.RuleConditions{
+			DescriptionContains: dbRule.DescriptionContains,
+			DescriptionPattern:  dbRule.DescriptionPattern,
+			MinAmount:           minAmount,
+			MaxAmount:           maxAmount,
+			AccountID:           dbRule.AccountID.String,
+			Type:                constant.TransactionType(dbRule.Type),
+		},
+		Actions: entity.RuleActions{
+			CategoryID:  dbRule.SetCategoryID.String,
+			Description: dbRule.SetDescription,
+			Tags:        dbRule.SetTags,
+		},
+		CreatedAt: dbRule.CreatedAt,
+		UpdatedAt: dbRule.UpdatedAt,
+	}, nil
+}
+
+// scanCategoryRule reads a row selected with categoryRuleColumns.
+func scanCategoryRule(row scanner) (*repoEntity.CategoryRule, error) {
+	var dbRule repoEntity.CategoryRule
+	err := row.Scan(
+		&dbRule.ID,
+		&dbRule.UserID,
+		&dbRule.Name,
+		&dbRule.Priority,
+		&dbRule.DescriptionContains,
+		&dbRule.DescriptionPattern,
+		&dbRule.MinAmount,
+		&dbRule.MaxAmount,
+		&dbRule.AmountCurrency,
+		&dbRule.AccountID,
+		&dbRule.Type,
+		&dbRule.SetCategoryID,
+		&dbRule.SetDescription,
+		&dbRule.SetTags,
+		&dbRule.CreatedAt,
+		&dbRule.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &dbRule, nil
+}
+
+func (r *CategoryRuleRepository) Create(ctx context.Context, rule *entity.CategoryRule) error {
+	dbRule := toRepoCategoryRule(rule)
+
+	// Set timestamps at repository layer
+	now := time.Now()
+	dbRule.CreatedAt = now
+	dbRule.UpdatedAt = now
+
+	query := `
+INSERT INTO category_rules (` + categoryRuleColumns + `)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+`
+
+	_, err := GetExecutor(ctx, r.db).ExecContext(ctx, query,
+		dbRule.ID,
+		dbRule.UserID,
+		dbRule.Name,
+		dbRule.Priority,
+		dbRule.DescriptionContains,
+		dbRule.DescriptionPattern,
+		dbRule.MinAmount,
+		dbRule.MaxAmount,
+		dbRule.AmountCurrency,
+		dbRule.AccountID,
+		dbRule.Type,
+		dbRule.SetCategoryID,
+		dbRule.SetDescription,
+		dbRule.SetTags,
+		dbRule.CreatedAt,
+		dbRule.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Update domain entity with timestamps
+	rule.CreatedAt = dbRule.CreatedAt
+	rule.UpdatedAt = dbRule.UpdatedAt
+
+	return nil
+}
+
+func (r *CategoryRuleRepository) GetByID(ctx context.Context, id string) (*entity.CategoryRule, error) {
+	query := `
+SELECT ` + categoryRuleColumns + `
+FROM category_rules
+WHERE id = $1
+`
+
+	dbRule, err := scanCategoryRule(GetExecutor(ctx, r.db).QueryRowContext(ctx, query, id))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainCategoryRule(dbRule)
+}
+
+func (r *CategoryRuleRepository) ListByUserID(ctx context.Context, userID string) ([]*entity.CategoryRule, error) {
+	query := `
+SELECT ` + categoryRuleColumns + `
+FROM category_rules
+WHERE user_id = $1
+ORDER BY priority, name, created_at
+`
+
+	rows, err := GetExecutor(ctx, r.db).QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rules []*entity.CategoryRule
+	for rows.Next() {
+		dbRule, err := scanCategoryRule(rows)
+		if err != nil {
+			return nil, err
+		}
+		rule, err := toDomainCategoryRule(dbRule)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, rows.Err()
+}
+
+func (r *CategoryRuleRepository) Update(ctx context.Context, rule *entity.CategoryRule) error {
+	dbRule := toRepoCategoryRule(rule)
+
+	// Set updated timestamp at repository layer
+	dbRule.UpdatedAt = time.Now()
+
+	query := `
+UPDATE category_rules
+SET name = $2, priority = $3, description_contains = $4, description_pattern = $5, min_amount = $6,
+	max_amount = $7, amount_currency = $8, account_id = $9, type = $10, set_category_id = $11,
+	set_description = $12, set_tags = $13, updated_at = $14
+WHERE id = $1
+`
+
+	result, err := GetExecutor(ctx, r.db).ExecContext(ctx, query,
+		dbRule.ID,
+		dbRule.Name,
+		dbRule.Priority,
+		dbRule.DescriptionContains,
+		dbRule.DescriptionPattern,
+		dbRule.MinAmount,
+		dbRule.MaxAmount,
+		dbRule.AmountCurrency,
+		dbRule.AccountID,
+		dbRule.Type,
+		dbRule.SetCategoryID,
+		dbRule.SetDescription,
+		dbRule.SetTags,
+		dbRule.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domainerrors.NewErrNotFound("category rule", rule.ID)
+	}
+
+	// Update domain entity with new timestamp
+	rule.UpdatedAt = dbRule.UpdatedAt
+
+	return nil
+}
+
+func (r *CategoryRuleRepository) Delete(ctx context.Context, id string) error {
+	query := `DELETE FROM category_rules WHERE id = $1`
+
+	result, err := GetExecutor(ctx, r.db).ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domainerrors.NewErrNotFound("category rule", id)
+	}
+
+	return nil
+}
+
+// Compile-time interface check
+var _ interfaces.CategoryRuleRepository = (*CategoryRuleRepository)(nil)
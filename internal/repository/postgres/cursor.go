@@ -0,0 +1,58 @@
+package postgres
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	domainerrors "accounting/internal/domain/errors"
+)
+
+// pageCursor is the sort key of the last row of a page. Listings continue
+// strictly after it, so rows inserted between requests never shift a page.
+// It is handed to clients as opaque base64-encoded JSON.
+type pageCursor struct {
+	// Sort is the order the cursor was issued for; a cursor only continues
+	// the listing in that order.
+	Sort      string     `json:"s,omitempty"`
+	Amount    string     `json:"a,omitempty"`
+	Date      *time.Time `json:"d,omitempty"`
+	CreatedAt time.Time  `json:"c"`
+	ID        string     `json:"i"`
+}
+
+func encodeCursor(c pageCursor) string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// decodeCursor parses a cursor produced by encodeCursor. It returns nil for an
+// empty cursor, meaning the first page.
+func decodeCursor(s string) (*pageCursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errInvalidCursor
+	}
+	var c pageCursor
+	if err := json.Unmarshal(b, &c); err != nil || c.ID == "" {
+		return nil, errInvalidCursor
+	}
+	return &c, nil
+}
+
+// limitClause appends a LIMIT that fetches one row more than the page size,
+// so the caller can tell whether another page follows.
+func limitClause(limit int, args []any) (string, []any) {
+	if limit <= 0 {
+		return "", args
+	}
+	args = append(args, limit+1)
+	return fmt.Sprintf("\nLIMIT $%d", len(args)), args
+}
+
+// errInvalidCursor rejects cursors that were not produced by this repository.
+var errInvalidCursor = domainerrors.NewErrInvalidInput("cursor", "cursor is malformed or expired")
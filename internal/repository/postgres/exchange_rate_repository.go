@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"accounting/internal/domain/entity"
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/domain/money"
+	repoEntity "accounting/internal/repository/entity"
+)
+
+// exchangeRateColumns lists the columns read by scanExchangeRate, in order.
+const exchangeRateColumns = `base_currency, quote_currency, date, rate`
+
+type ExchangeRateRepository struct {
+	db *sql.DB
+}
+
+func NewExchangeRateRepository(db *sql.DB) interfaces.ExchangeRateRepository {
+	return &ExchangeRateRepository{db: db}
+}
+
+// Mapper: Domain Entity -> Repository Entity
+func toRepoExchangeRate(rate *entity.ExchangeRate) *repoEntity.ExchangeRate {
+	return &repoEntity.ExchangeRate{
+		BaseCurrency:  rate.BaseCurrency,
+		QuoteCurrency: rate.QuoteCurrency,
+		Date:          rate.Date,
+		Rate:          rate.Rate.String(),
+	}
+}
+
+// Mapper: Repository Entity -> Domain Entity
+func toDomainExchangeRate(dbRate *repoEntity.ExchangeRate) (*entity.ExchangeRate, error) {
+	rate, err := money.ParseRate(dbRate.Rate)
+	if err != nil {
+		return nil, fmt.Errorf("parsing rate %s/%s on %s: %w", dbRate.BaseCurrency, dbRate.QuoteCurrency, dbRate.Date.Format(time.DateOnly), err)
+	}
+
+	return &entity.ExchangeRate{
+		BaseCurrency:  dbRate.BaseCurrency,
+		QuoteCurrency: dbRate.QuoteCurrency,
+		Date:          dbRate.Date,
+		Rate:          rate,
+	}, nil
+}
+
+// scanExchangeRate reads a row selected with exchangeRateColumns.
+func scanExchangeRate(row scanner) (*repoEntity.ExchangeRate, error) {
+	var dbRate repoEntity.ExchangeRate
+	err := row.Scan(
+		&dbRate.BaseCurrency,
+		&dbRate.QuoteCurrency,
+		&dbRate.Date,
+		&dbRate.Rate,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &dbRate, nil
+}
+
+func (r *ExchangeRateRepository) Upsert(ctx context.Context, rate *entity.ExchangeRate) error {
+	dbRate := toRepoExchangeRate(rate)
+
+	// Set timestamps at repository layer
+	now := time.Now()
+	dbRate.CreatedAt = now
+	dbRate.UpdatedAt = now
+
+	query := `
+INSERT INTO exchange_rates (base_currency, quote_currency, date, rate, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (base_currency, quote_currency, date)
+DO UPDATE SET rate = EXCLUDED.rate, updated_at = EXCLUDED.updated_at
+`
+
+	_, err := GetExecutor(ctx, r.db).ExecContext(ctx, query,
+		dbRate.BaseCurrency,
+		dbRate.QuoteCurrency,
+		dbRate.Date,
+		dbRate.Rate,
+		dbRate.CreatedAt,
+		dbRate.UpdatedAt,
+	)
+
+	return err
+}
+
+func (r *ExchangeRateRepository) GetEffective(ctx context.Context, baseCurrency, quoteCurrency string, date time.Time) (*entity.ExchangeRate, error) {
+	query := `
+SELECT ` + exchangeRateColumns + `
+FROM exchange_rates
+WHERE base_currency = $1 AND quote_currency = $2 AND date <= $3
+ORDER BY date DESC
+LIMIT 1
+`
+
+	dbRate, err := scanExchangeRate(GetExecutor(ctx, r.db).QueryRowContext(ctx, query, baseCurrency, quoteCurrency, date))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainExchangeRate(dbRate)
+}
+
+// Compile-time interface check
+var _ interfaces.ExchangeRateRepository = (*ExchangeRateRepository)(nil)
@@ -0,0 +1,270 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/interfaces"
+	repoEntity "accounting/internal/repository/entity"
+)
+
+// importProfileColumns lists the columns read by scanImportProfile, in order.
+const importProfileColumns = `id, user_id, name, delimiter, skip_rows, date_column, date_format, amount_sign,
+	amount_column, debit_column, credit_column, description_column, category_column, decimal_separator,
+	created_at, updated_at`
+
+type ImportProfileRepository struct {
+	db *sql.DB
+}
+
+func NewImportProfileRepository(db *sql.DB) interfaces.ImportProfileRepository {
+	return &ImportProfileRepository{db: db}
+}
+
+// Mapper: Domain Entity -> Repository Entity
+func toRepoImportProfile(profile *entity.ImportProfile) *repoEntity.ImportProfile {
+	return &repoEntity.ImportProfile{
+		ID:                profile.ID,
+		UserID:            profile.UserID,
+		Name:              profile.Name,
+		Delimiter:         profile.Mapping.Delimiter,
+		SkipRows:          profile.Mapping.SkipRows,
+		DateColumn:        profile.Mapping.DateColumn,
+		DateFormat:        profile.Mapping.DateFormat,
+		AmountSign:        string(profile.Mapping.AmountSign),
+		AmountColumn:      profile.Mapping.AmountColumn,
+		DebitColumn:       profile.Mapping.DebitColumn,
+		CreditColumn:      profile.Mapping.CreditColumn,
+		DescriptionColumn: profile.Mapping.DescriptionColumn,
+		CategoryColumn:    profile.Mapping.CategoryColumn,
+		DecimalSeparator:  profile.Mapping.DecimalSeparator,
+		CreatedAt:         profile.CreatedAt,
+		UpdatedAt:         profile.UpdatedAt,
+	}
+}
+
+// Mapper: Repository Entity -> Domain Entity
+func toDomainImportProfile(dbProfile *repoEntity.ImportProfile) *entity.ImportProfile {
+	return &entity.ImportProfile{
+		ID:     dbProfile.ID,
+		UserID: dbProfile.UserID,
+		Name:   dbProfile.Name,
+		Mapping: entity.CSVMapping{
+			Delimiter:         dbProfile.Delimiter,
+			SkipRows:          dbProfile.SkipRows,
+			DateColumn:        dbProfile.DateColumn,
+			DateFormat:        dbProfile.DateFormat,
+			AmountSign:        constant.AmountSign(dbProfile.AmountSign),
+			AmountColumn:      dbProfile.AmountColumn,
+			DebitColumn:       dbProfile.DebitColumn,
+			CreditColumn:      dbProfile.CreditColumn,
+			DescriptionColumn: dbProfile.DescriptionColumn,
+			CategoryColumn:    dbProfile.CategoryColumn,
+			DecimalSeparator:  dbProfile.DecimalSeparator,
+		},
+		CreatedAt: dbProfile.CreatedAt,
+		UpdatedAt: dbProfile.UpdatedAt,
+	}
+}
+
+// scanImportProfile reads a row selected with importProfileColumns.
+func scanImportProfile(row scanner) (*repoEntity.ImportProfile, error) {
+	var dbProfile repoEntity.ImportProfile
+	err := row.Scan(
+		&dbProfile.ID,
+		&dbProfile.UserID,
+		&dbProfile.Name,
+		&dbProfile.Delimiter,
+		&dbProfile.SkipRows,
+		&dbProfile.DateColumn,
+		&dbProfile.DateFormat,
+		&dbProfile.AmountSign,
+		&dbProfile.AmountColumn,
+		&dbProfile.DebitColumn,
+		&dbProfile.CreditColumn,
+		&dbProfile.DescriptionColumn,
+		&dbProfile.CategoryColumn,
+		&dbProfile.DecimalSeparator,
+		&dbProfile.CreatedAt,
+		&dbProfile.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &dbProfile, nil
+}
+
+func (r *ImportProfileRepository) Create(ctx context.Context, profile *entity.ImportProfile) error {
+	dbProfile := toRepoImportProfile(profile)
+
+	// Set timestamps at repository layer
+	now := time.Now()
+	dbProfile.CreatedAt = now
+	dbProfile.UpdatedAt = now
+
+	query := `
+INSERT INTO import_profiles (` + importProfileColumns + `)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+`
+
+	_, err := GetExecutor(ctx, r.db).ExecContext(ctx, query,
+		dbProfile.ID,
+		dbProfile.UserID,
+		dbProfile.Name,
+		dbProfile.Delimiter,
+		dbProfile.SkipRows,
+		dbProfile.DateColumn,
+		dbProfile.DateFormat,
+		dbProfile.AmountSign,
+		dbProfile.AmountColumn,
+		dbProfile.DebitColumn,
+		dbProfile.CreditColumn,
+		dbProfile.DescriptionColumn,
+		dbProfile.CategoryColumn,
+		dbProfile.DecimalSeparator,
+		dbProfile.CreatedAt,
+		dbProfile.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Update domain entity with timestamps
+	profile.CreatedAt = dbProfile.CreatedAt
+	profile.UpdatedAt = dbProfile.UpdatedAt
+
+	return nil
+}
+
+func (r *ImportProfileRepository) GetByID(ctx context.Context, id string) (*entity.ImportProfile, error) {
+	query := `
+SELECT ` + importProfileColumns + `
+FROM import_profiles
+WHERE id = $1
+`
+
+	return r.get(ctx, query, id)
+}
+
+func (r *ImportProfileRepository) GetByName(ctx context.Context, userID, name string) (*entity.ImportProfile, error) {
+	query := `
+SELECT ` + importProfileColumns + `
+FROM import_profiles
+WHERE user_id = $1 AND name = $2
+`
+
+	return r.get(ctx, query, userID, name)
+}
+
+// get runs a query selecting importProfileColumns of at most one row.
+func (r *ImportProfileRepository) get(ctx context.Context, query string, args ...any) (*entity.ImportProfile, error) {
+	dbProfile, err := scanImportProfile(GetExecutor(ctx, r.db).QueryRowContext(ctx, query, args...))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainImportProfile(dbProfile), nil
+}
+
+func (r *ImportProfileRepository) ListByUserID(ctx context.Context, userID string) ([]*entity.ImportProfile, error) {
+	query := `
+SELECT ` + importProfileColumns + `
+FROM import_profiles
+WHERE user_id = $1
+ORDER BY name
+`
+
+	rows, err := GetExecutor(ctx, r.db).QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var profiles []*entity.ImportProfile
+	for rows.Next() {
+		dbProfile, err := scanImportProfile(rows)
+		if err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, toDomainImportProfile(dbProfile))
+	}
+
+	return profiles, rows.Err()
+}
+
+func (r *ImportProfileRepository) Update(ctx context.Context, profile *entity.ImportProfile) error {
+	dbProfile := toRepoImportProfile(profile)
+
+	// Set updated timestamp at repository layer
+	dbProfile.UpdatedAt = time.Now()
+
+	query := `
+UPDATE import_profiles
+SET name = $2, delimiter = $3, skip_rows = $4, date_column = $5, date_format = $6, amount_sign = $7,
+	amount_column = $8, debit_column = $9, credit_column = $10, description_column = $11,
+	category_column = $12, decimal_separator = $13, updated_at = $14
+WHERE id = $1
+`
+
+	result, err := GetExecutor(ctx, r.db).ExecContext(ctx, query,
+		dbProfile.ID,
+		dbProfile.Name,
+		dbProfile.Delimiter,
+		dbProfile.SkipRows,
+		dbProfile.DateColumn,
+		dbProfile.DateFormat,
+		dbProfile.AmountSign,
+		dbProfile.AmountColumn,
+		dbProfile.DebitColumn,
+		dbProfile.CreditColumn,
+		dbProfile.DescriptionColumn,
+		dbProfile.CategoryColumn,
+		dbProfile.DecimalSeparator,
+		dbProfile.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domainerrors.NewErrNotFound("import profile", profile.ID)
+	}
+
+	// Update domain entity with new timestamp
+	profile.UpdatedAt = dbProfile.UpdatedAt
+
+	return nil
+}
+
+func (r *ImportProfileRepository) Delete(ctx context.Context, id string) error {
+	query := `DELETE FROM import_profiles WHERE id = $1`
+
+	result, err := GetExecutor(ctx, r.db).ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domainerrors.NewErrNotFound("import profile", id)
+	}
+
+	return nil
+}
+
+// Compile-time interface check
+var _ interfaces.ImportProfileRepository = (*ImportProfileRepository)(nil)
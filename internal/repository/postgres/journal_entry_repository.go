@@ -0,0 +1,225 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/interfaces"
+	repoEntity "accounting/internal/repository/entity"
+)
+
+// journalEntryColumns lists the columns read by JournalEntryRepository.list, in order.
+const journalEntryColumns = `e.id, e.user_id, e.date, e.description,
+       p.id, p.line_no, p.account_id, p.direction, p.amount, p.currency, p.memo`
+
+type JournalEntryRepository struct {
+	db *sql.DB
+}
+
+func NewJournalEntryRepository(db *sql.DB) interfaces.JournalEntryRepository {
+	return &JournalEntryRepository{db: db}
+}
+
+// Mapper: Domain Entity -> Repository Entities
+func toRepoJournalEntry(entry *entity.JournalEntry) (*repoEntity.JournalEntry, []*repoEntity.Posting) {
+	dbEntry := &repoEntity.JournalEntry{
+		ID:          entry.ID,
+		UserID:      entry.UserID,
+		Date:        entry.Date,
+		Description: entry.Description,
+	}
+
+	dbPostings := make([]*repoEntity.Posting, 0, len(entry.Postings))
+	for i, posting := range entry.Postings {
+		dbPostings = append(dbPostings, &repoEntity.Posting{
+			ID:        posting.ID,
+			EntryID:   entry.ID,
+			LineNo:    i + 1,
+			AccountID: posting.AccountID,
+			Direction: string(posting.Direction),
+			Amount:    posting.Amount.String(),
+			Currency:  posting.Amount.Currency(),
+			Memo:      posting.Memo,
+		})
+	}
+
+	return dbEntry, dbPostings
+}
+
+// Mapper: Repository Entity -> Domain Entity
+func toDomainPosting(dbPosting *repoEntity.Posting) (*entity.Posting, error) {
+	amount, err := parseAmount(dbPosting.Amount, dbPosting.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("parsing amount of posting %s: %w", dbPosting.ID, err)
+	}
+
+	return &entity.Posting{
+		ID:        dbPosting.ID,
+		AccountID: dbPosting.AccountID,
+		Direction: constant.PostingDirection(dbPosting.Direction),
+		Amount:    amount,
+		Memo:      dbPosting.Memo,
+	}, nil
+}
+
+func (r *JournalEntryRepository) Create(ctx context.Context, entry *entity.JournalEntry) error {
+	dbEntry, dbPostings := toRepoJournalEntry(entry)
+
+	// Set timestamps at repository layer
+	now := time.Now()
+	dbEntry.CreatedAt = now
+	dbEntry.UpdatedAt = now
+
+	executor := GetExecutor(ctx, r.db)
+
+	query := `
+INSERT INTO journal_entries (id, user_id, date, description, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+`
+	_, err := executor.ExecContext(ctx, query,
+		dbEntry.ID,
+		dbEntry.UserID,
+		dbEntry.Date,
+		dbEntry.Description,
+		dbEntry.CreatedAt,
+		dbEntry.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	postingQuery := `
+INSERT INTO journal_postings (id, entry_id, line_no, account_id, direction, amount, currency, memo)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+`
+	for _, dbPosting := range dbPostings {
+		_, err := executor.ExecContext(ctx, postingQuery,
+			dbPosting.ID,
+			dbPosting.EntryID,
+			dbPosting.LineNo,
+			dbPosting.AccountID,
+			dbPosting.Direction,
+			dbPosting.Amount,
+			dbPosting.Currency,
+			dbPosting.Memo,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *JournalEntryRepository) GetByID(ctx context.Context, id string) (*entity.JournalEntry, error) {
+	query := `
+SELECT ` + journalEntryColumns + `
+FROM journal_entries e
+JOIN journal_postings p ON p.entry_id = e.id
+WHERE e.id = $1
+ORDER BY p.line_no
+`
+
+	entries, err := r.list(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	return entries[0], nil
+}
+
+func (r *JournalEntryRepository) ListByUserID(ctx context.Context, userID string) ([]*entity.JournalEntry, error) {
+	query := `
+SELECT ` + journalEntryColumns + `
+FROM journal_entries e
+JOIN journal_postings p ON p.entry_id = e.id
+WHERE e.user_id = $1
+ORDER BY e.date DESC, e.created_at DESC, e.id, p.line_no
+`
+
+	return r.list(ctx, query, userID)
+}
+
+// list reads entries joined with their postings. Rows must be grouped by entry.
+func (r *JournalEntryRepository) list(ctx context.Context, query string, args ...any) ([]*entity.JournalEntry, error) {
+	rows, err := GetExecutor(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []*entity.JournalEntry
+	var current *entity.JournalEntry
+	for rows.Next() {
+		var dbEntry repoEntity.JournalEntry
+		var dbPosting repoEntity.Posting
+		var description, memo sql.NullString
+		err := rows.Scan(
+			&dbEntry.ID,
+			&dbEntry.UserID,
+			&dbEntry.Date,
+			&description,
+			&dbPosting.ID,
+			&dbPosting.LineNo,
+			&dbPosting.AccountID,
+			&dbPosting.Direction,
+			&dbPosting.Amount,
+			&dbPosting.Currency,
+			&memo,
+		)
+		if err != nil {
+			return nil, err
+		}
+		dbEntry.Description = description.String
+		dbPosting.Memo = memo.String
+
+		if current == nil || current.ID != dbEntry.ID {
+			current = &entity.JournalEntry{
+				ID:          dbEntry.ID,
+				UserID:      dbEntry.UserID,
+				Date:        dbEntry.Date,
+				Description: dbEntry.Description,
+			}
+			entries = append(entries, current)
+		}
+
+		posting, err := toDomainPosting(&dbPosting)
+		if err != nil {
+			return nil, err
+		}
+		current.Postings = append(current.Postings, posting)
+	}
+
+	return entries, rows.Err()
+}
+
+func (r *JournalEntryRepository) Delete(ctx context.Context, id string) error {
+	// Postings are removed by ON DELETE CASCADE
+	query := `DELETE FROM journal_entries WHERE id = $1`
+
+	result, err := GetExecutor(ctx, r.db).ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domainerrors.NewErrNotFound("journal entry", id)
+	}
+
+	return nil
+}
+
+// Compile-time interface check
+var _ interfaces.JournalEntryRepository = (*JournalEntryRepository)(nil)
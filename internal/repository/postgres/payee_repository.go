@@ -0,0 +1,222 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/interfaces"
+	repoEntity "accounting/internal/repository/entity"
+)
+
+// payeeColumns lists the columns read by scanPayee, in order.
+const payeeColumns = `id, user_id, name, aliases, default_category_id, created_at, updated_at`
+
+type PayeeRepository struct {
+	db *sql.DB
+}
+
+func NewPayeeRepository(db *sql.DB) interfaces.PayeeRepository {
+	return &PayeeRepository{db: db}
+}
+
+// Mapper: Domain Entity -> Repository Entity
+func toRepoPayee(payee *entity.Payee) *repoEntity.Payee {
+	aliases := payee.Aliases
+	if aliases == nil {
+		aliases = []string{}
+	}
+	return &repoEntity.Payee{
+		ID:                payee.ID,
+		UserID:            payee.UserID,
+		Name:              payee.Name,
+		Aliases:           aliases,
+		DefaultCategoryID: toNullString(payee.DefaultCategoryID),
+		CreatedAt:         payee.CreatedAt,
+		UpdatedAt:         payee.UpdatedAt,
+	}
+}
+
+// Mapper: Repository Entity -> Domain Entity
+func toDomainPayee(dbPayee *repoEntity.Payee) *entity.Payee {
+	return &entity.Payee{
+		ID:                dbPayee.ID,
+		UserID:            dbPayee.UserID,
+		Name:              dbPayee.Name,
+		Aliases:           dbPayee.Aliases,
+		DefaultCategoryID: dbPayee.DefaultCategoryID.String,
+		CreatedAt:         dbPayee.CreatedAt,
+		UpdatedAt:         dbPayee.UpdatedAt,
+	}
+}
+
+// scanPayee reads a row selected with payeeColumns.
+func scanPayee(row scanner) (*repoEntity.Payee, error) {
+	var dbPayee repoEntity.Payee
+	err := row.Scan(
+		&dbPayee.ID,
+		&dbPayee.UserID,
+		&dbPayee.Name,
+		&dbPayee.Aliases,
+		&dbPayee.DefaultCategoryID,
+		&dbPayee.CreatedAt,
+		&dbPayee.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &dbPayee, nil
+}
+
+func (r *PayeeRepository) Create(ctx context.Context, payee *entity.Payee) error {
+	dbPayee := toRepoPayee(payee)
+
+	// Set timestamps at repository layer
+	now := time.Now()
+	dbPayee.CreatedAt = now
+	dbPayee.UpdatedAt = now
+
+	query := `
+INSERT INTO payees (` + payeeColumns + `)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+`
+
+	_, err := GetExecutor(ctx, r.db).ExecContext(ctx, query,
+		dbPayee.ID,
+		dbPayee.UserID,
+		dbPayee.Name,
+		dbPayee.Aliases,
+		dbPayee.DefaultCategoryID,
+		dbPayee.CreatedAt,
+		dbPayee.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	// Update domain entity with timestamps
+	payee.CreatedAt = dbPayee.CreatedAt
+	payee.UpdatedAt = dbPayee.UpdatedAt
+
+	return nil
+}
+
+func (r *PayeeRepository) GetByID(ctx context.Context, id string) (*entity.Payee, error) {
+	query := `
+SELECT ` + payeeColumns + `
+FROM payees
+WHERE id = $1
+`
+
+	return r.get(ctx, query, id)
+}
+
+func (r *PayeeRepository) GetByName(ctx context.Context, userID, name string) (*entity.Payee, error) {
+	query := `
+SELECT ` + payeeColumns + `
+FROM payees
+WHERE user_id = $1 AND LOWER(name) = LOWER($2)
+`
+
+	return r.get(ctx, query, userID, name)
+}
+
+// get runs a query selecting payeeColumns of at most one row.
+func (r *PayeeRepository) get(ctx context.Context, query string, args ...any) (*entity.Payee, error) {
+	dbPayee, err := scanPayee(GetExecutor(ctx, r.db).QueryRowContext(ctx, query, args...))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toDomainPayee(dbPayee), nil
+}
+
+func (r *PayeeRepository) ListByUserID(ctx context.Context, userID string) ([]*entity.Payee, error) {
+	query := `
+SELECT ` + payeeColumns + `
+FROM payees
+WHERE user_id = $1
+ORDER BY name
+`
+
+	rows, err := GetExecutor(ctx, r.db).QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var payees []*entity.Payee
+	for rows.Next() {
+		dbPayee, err := scanPayee(rows)
+		if err != nil {
+			return nil, err
+		}
+		payees = append(payees, toDomainPayee(dbPayee))
+	}
+
+	return payees, rows.Err()
+}
+
+func (r *PayeeRepository) Update(ctx context.Context, payee *entity.Payee) error {
+	dbPayee := toRepoPayee(payee)
+
+	// Set updated timestamp at repository layer
+	dbPayee.UpdatedAt = time.Now()
+
+	query := `
+UPDATE payees
+SET name = $2, aliases = $3, default_category_id = $4, updated_at = $5
+WHERE id = $1
+`
+
+	result, err := GetExecutor(ctx, r.db).ExecContext(ctx, query,
+		dbPayee.ID,
+		dbPayee.Name,
+		dbPayee.Aliases,
+		dbPayee.DefaultCategoryID,
+		dbPayee.UpdatedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domainerrors.NewErrNotFound("payee", payee.ID)
+	}
+
+	// Update domain entity with new timestamp
+	payee.UpdatedAt = dbPayee.UpdatedAt
+
+	return nil
+}
+
+func (r *PayeeRepository) Delete(ctx context.Context, id string) error {
+	query := `DELETE FROM payees WHERE id = $1`
+
+	result, err := GetExecutor(ctx, r.db).ExecContext(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domainerrors.NewErrNotFound("payee", id)
+	}
+
+	return nil
+}
+
+// Compile-time interface check
+var _ interfaces.PayeeRepository = (*PayeeRepository)(nil)
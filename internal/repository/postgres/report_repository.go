@@ -0,0 +1,217 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	"accounting/internal/domain/interfaces"
+	repoEntity "accounting/internal/repository/entity"
+)
+
+type ReportRepository struct {
+	db *sql.DB
+}
+
+func NewReportRepository(db *sql.DB) interfaces.ReportRepository {
+	return &ReportRepository{db: db}
+}
+
+// Mapper: Repository Entity -> Domain Entity
+func toDomainCategoryTotal(dbTotal *repoEntity.CategoryTotal) (*entity.CategoryTotal, error) {
+	income, err := parseAmount(dbTotal.Income, dbTotal.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("parsing income of category %q: %w", dbTotal.CategoryID, err)
+	}
+	expense, err := parseAmount(dbTotal.Expense, dbTotal.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("parsing expense of category %q: %w", dbTotal.CategoryID, err)
+	}
+
+	return &entity.CategoryTotal{
+		PeriodStart: dbTotal.PeriodStart,
+		CategoryID:  dbTotal.CategoryID,
+		Income:      income,
+		Expense:     expense,
+	}, nil
+}
+
+func (r *ReportRepository) SumByCategory(ctx context.Context, userID string, from, to time.Time, granularity constant.Granularity) ([]*entity.CategoryTotal, error) {
+	// Split transactions count once per split, under the split's category
+	query := `
+SELECT date_trunc($4, t.date) AS period_start,
+       t.currency,
+       COALESCE(CASE WHEN s.id IS NULL THEN t.category_id ELSE s.category_id END::text, ''),
+       COALESCE(SUM(COALESCE(s.amount, t.amount)) FILTER (WHERE t.type = 'INCOME'), 0),
+       COALESCE(SUM(COALESCE(s.amount, t.amount)) FILTER (WHERE t.type = 'EXPENSE'), 0)
+FROM transactions t
+JOIN accounts a ON a.id = t.account_id
+LEFT JOIN transaction_splits s ON s.transaction_id = t.id
+WHERE a.user_id = $1
+  AND t.date >= $2
+  AND t.date < $3
+  AND t.type IN ('INCOME', 'EXPENSE')
+GROUP BY 1, 2, 3
+ORDER BY 1, 2, 3
+`
+
+	rows, err := GetExecutor(ctx, r.db).QueryContext(ctx, query, userID, from, to, string(granularity))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var totals []*entity.CategoryTotal
+	for rows.Next() {
+		var dbTotal repoEntity.CategoryTotal
+		if err := rows.Scan(
+			&dbTotal.PeriodStart,
+			&dbTotal.Currency,
+			&dbTotal.CategoryID,
+			&dbTotal.Income,
+			&dbTotal.Expense,
+		); err != nil {
+			return nil, err
+		}
+		total, err := toDomainCategoryTotal(&dbTotal)
+		if err != nil {
+			return nil, err
+		}
+		totals = append(totals, total)
+	}
+
+	return totals, rows.Err()
+}
+
+// Mapper: Repository Entity -> Domain Entity
+func toDomainTagTotal(dbTotal *repoEntity.TagTotal) (*entity.TagTotal, error) {
+	income, err := parseAmount(dbTotal.Income, dbTotal.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("parsing income of tag %q: %w", dbTotal.Tag, err)
+	}
+	expense, err := parseAmount(dbTotal.Expense, dbTotal.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("parsing expense of tag %q: %w", dbTotal.Tag, err)
+	}
+
+	return &entity.TagTotal{
+		Tag:     dbTotal.Tag,
+		Income:  income,
+		Expense: expense,
+	}, nil
+}
+
+func (r *ReportRepository) SumByTag(ctx context.Context, userID string, from, to time.Time) ([]*entity.TagTotal, error) {
+	query := `
+SELECT tg.name,
+       t.currency,
+       COALESCE(SUM(t.amount) FILTER (WHERE t.type = 'INCOME'), 0),
+       COALESCE(SUM(t.amount) FILTER (WHERE t.type = 'EXPENSE'), 0)
+FROM transactions t
+JOIN transaction_tags tt ON tt.transaction_id = t.id
+JOIN tags tg ON tg.id = tt.tag_id
+WHERE tg.user_id = $1
+  AND t.date >= $2
+  AND t.date < $3
+  AND t.type IN ('INCOME', 'EXPENSE')
+GROUP BY 1, 2
+ORDER BY 1, 2
+`
+
+	rows, err := GetExecutor(ctx, r.db).QueryContext(ctx, query, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var totals []*entity.TagTotal
+	for rows.Next() {
+		var dbTotal repoEntity.TagTotal
+		if err := rows.Scan(
+			&dbTotal.Tag,
+			&dbTotal.Currency,
+			&dbTotal.Income,
+			&dbTotal.Expense,
+		); err != nil {
+			return nil, err
+		}
+		total, err := toDomainTagTotal(&dbTotal)
+		if err != nil {
+			return nil, err
+		}
+		totals = append(totals, total)
+	}
+
+	return totals, rows.Err()
+}
+
+// Mapper: Repository Entity -> Domain Entity
+func toDomainPayeeTotal(dbTotal *repoEntity.PayeeTotal) (*entity.PayeeTotal, error) {
+	income, err := parseAmount(dbTotal.Income, dbTotal.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("parsing income of payee %q: %w", dbTotal.Payee, err)
+	}
+	expense, err := parseAmount(dbTotal.Expense, dbTotal.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("parsing expense of payee %q: %w", dbTotal.Payee, err)
+	}
+
+	return &entity.PayeeTotal{
+		PayeeID: dbTotal.PayeeID,
+		Payee:   dbTotal.Payee,
+		Income:  income,
+		Expense: expense,
+	}, nil
+}
+
+func (r *ReportRepository) SumByPayee(ctx context.Context, userID string, from, to time.Time) ([]*entity.PayeeTotal, error) {
+	query := `
+SELECT COALESCE(p.id::text, ''),
+       COALESCE(p.name, ''),
+       t.currency,
+       COALESCE(SUM(t.amount) FILTER (WHERE t.type = 'INCOME'), 0),
+       COALESCE(SUM(t.amount) FILTER (WHERE t.type = 'EXPENSE'), 0)
+FROM transactions t
+JOIN accounts a ON a.id = t.account_id
+LEFT JOIN payees p ON p.id = t.payee_id
+WHERE a.user_id = $1
+  AND t.date >= $2
+  AND t.date < $3
+  AND t.type IN ('INCOME', 'EXPENSE')
+GROUP BY 1, 2, 3
+ORDER BY COALESCE(p.id::text, '') = '', 2, 3
+`
+
+	rows, err := GetExecutor(ctx, r.db).QueryContext(ctx, query, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var totals []*entity.PayeeTotal
+	for rows.Next() {
+		var dbTotal repoEntity.PayeeTotal
+		if err := rows.Scan(
+			&dbTotal.PayeeID,
+			&dbTotal.Payee,
+			&dbTotal.Currency,
+			&dbTotal.Income,
+			&dbTotal.Expense,
+		); err != nil {
+			return nil, err
+		}
+		total, err := toDomainPayeeTotal(&dbTotal)
+		if err != nil {
+			return nil, err
+		}
+		totals = append(totals, total)
+	}
+
+	return totals, rows.Err()
+}
+
+// Compile-time interface check
+var _ interfaces.ReportRepository = (*ReportRepository)(nil)
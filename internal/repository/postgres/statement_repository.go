@@ -0,0 +1,142 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	"accounting/internal/domain/interfaces"
+	repoEntity "accounting/internal/repository/entity"
+)
+
+type StatementRepository struct {
+	db *sql.DB
+}
+
+func NewStatementRepository(db *sql.DB) interfaces.StatementRepository {
+	return &StatementRepository{db: db}
+}
+
+// Mapper: Repository Entity -> Domain Entity
+func toDomainAccountMovement(dbMovement *repoEntity.AccountMovement) (*entity.AccountMovement, error) {
+	netDebit, err := parseAmount(dbMovement.NetDebit, dbMovement.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("parsing movement of account %s: %w", dbMovement.AccountID, err)
+	}
+
+	return &entity.AccountMovement{
+		AccountID: dbMovement.AccountID,
+		NetDebit:  netDebit,
+	}, nil
+}
+
+// Mapper: Repository Entity -> Domain Entity
+func toDomainCategoryMovement(dbMovement *repoEntity.CategoryMovement) (*entity.CategoryMovement, error) {
+	amount, err := parseAmount(dbMovement.Amount, dbMovement.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("parsing movement of category %q: %w", dbMovement.Category, err)
+	}
+
+	return &entity.CategoryMovement{
+		Type:     constant.TransactionType(dbMovement.Type),
+		Category: dbMovement.Category,
+		Amount:   amount,
+	}, nil
+}
+
+func (r *StatementRepository) SumAccountMovements(ctx context.Context, userID string, from, to time.Time) ([]*entity.AccountMovement, error) {
+	query := `
+WITH movements AS (
+    SELECT t.account_id,
+           CASE WHEN t.type = 'EXPENSE' OR t.transfer_direction = 'OUT' THEN -t.amount ELSE t.amount END AS net_debit
+    FROM transactions t
+    JOIN accounts a ON a.id = t.account_id
+    WHERE a.user_id = $1 AND t.date >= $2 AND t.date < $3
+    UNION ALL
+    SELECT p.account_id,
+           CASE WHEN p.direction = 'DEBIT' THEN p.amount ELSE -p.amount END
+    FROM journal_postings p
+    JOIN journal_entries e ON e.id = p.entry_id
+    WHERE e.user_id = $1 AND e.date >= $2 AND e.date < $3
+)
+SELECT a.id, a.currency, SUM(m.net_debit)
+FROM movements m
+JOIN accounts a ON a.id = m.account_id
+GROUP BY a.id, a.currency
+ORDER BY a.id
+`
+
+	rows, err := GetExecutor(ctx, r.db).QueryContext(ctx, query, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var movements []*entity.AccountMovement
+	for rows.Next() {
+		var dbMovement repoEntity.AccountMovement
+		if err := rows.Scan(
+			&dbMovement.AccountID,
+			&dbMovement.Currency,
+			&dbMovement.NetDebit,
+		); err != nil {
+			return nil, err
+		}
+		movement, err := toDomainAccountMovement(&dbMovement)
+		if err != nil {
+			return nil, err
+		}
+		movements = append(movements, movement)
+	}
+
+	return movements, rows.Err()
+}
+
+func (r *StatementRepository) SumCategoryMovements(ctx context.Context, userID string, from, to time.Time) ([]*entity.CategoryMovement, error) {
+	// Split transactions count once per split, under the split's category
+	query := `
+SELECT t.currency,
+       t.type,
+       COALESCE(c.name, ''),
+       SUM(CASE WHEN t.transfer_direction = 'OUT' THEN -t.amount ELSE COALESCE(s.amount, t.amount) END)
+FROM transactions t
+JOIN accounts a ON a.id = t.account_id
+LEFT JOIN transaction_splits s ON s.transaction_id = t.id
+LEFT JOIN categories c ON c.id = CASE WHEN s.id IS NULL THEN t.category_id ELSE s.category_id END
+WHERE a.user_id = $1 AND t.date >= $2 AND t.date < $3
+GROUP BY 1, 2, 3
+ORDER BY 1, 2, 3
+`
+
+	rows, err := GetExecutor(ctx, r.db).QueryContext(ctx, query, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var movements []*entity.CategoryMovement
+	for rows.Next() {
+		var dbMovement repoEntity.CategoryMovement
+		if err := rows.Scan(
+			&dbMovement.Currency,
+			&dbMovement.Type,
+			&dbMovement.Category,
+			&dbMovement.Amount,
+		); err != nil {
+			return nil, err
+		}
+		movement, err := toDomainCategoryMovement(&dbMovement)
+		if err != nil {
+			return nil, err
+		}
+		movements = append(movements, movement)
+	}
+
+	return movements, rows.Err()
+}
+
+// Compile-time interface check
+var _ interfaces.StatementRepository = (*StatementRepository)(nil)
@@ -3,15 +3,33 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"strings"
 	"time"
 
 	"accounting/internal/domain/constant"
 	"accounting/internal/domain/entity"
 	domainerrors "accounting/internal/domain/errors"
 	"accounting/internal/domain/interfaces"
+	"accounting/internal/domain/money"
 	repoEntity "accounting/internal/repository/entity"
+
+	"github.com/lib/pq"
 )
 
+// likeEscaper escapes the LIKE wildcards in user-supplied search text.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// transactionColumns lists the columns read by scanTransaction, in order. The
+// names of the payee, the category and the tags are looked up along with the
+// transaction.
+const transactionColumns = `id, account_id, amount, currency, description, payee_id,
+	(SELECT p.name FROM payees p WHERE p.id = transactions.payee_id) AS payee, date, type, category_id,
+	(SELECT c.name FROM categories c WHERE c.id = transactions.category_id) AS category,
+	ARRAY(SELECT tg.name FROM transaction_tags tt JOIN tags tg ON tg.id = tt.tag_id
+	      WHERE tt.transaction_id = transactions.id ORDER BY tg.name) AS tags,
+	transfer_id, transfer_direction, original_amount, original_currency, exchange_rate, external_id, created_at`
+
 type TransactionRepository struct {
 	db *sql.DB
 }
@@ -23,29 +41,147 @@ func NewTransactionRepository(db *sql.DB) interfaces.TransactionRepository {
 // Mapper: Domain Entity -> Repository Entity
 func toRepoTransaction(transaction *entity.Transaction) *repoEntity.Transaction {
 	return &repoEntity.Transaction{
-		ID:          transaction.ID,
-		AccountID:   transaction.AccountID,
-		Amount:      transaction.Amount,
-		Currency:    transaction.Currency,
-		Description: transaction.Description,
-		Date:        transaction.Date,
-		Type:        string(transaction.Type),
-		Category:    transaction.Category,
+		ID:                transaction.ID,
+		AccountID:         transaction.AccountID,
+		Amount:            transaction.Amount.String(),
+		Currency:          transaction.Amount.Currency(),
+		Description:       transaction.Description,
+		PayeeID:           toNullString(transaction.PayeeID),
+		Date:              transaction.Date,
+		Type:              string(transaction.Type),
+		CategoryID:        toNullString(transaction.CategoryID),
+		Tags:              transaction.Tags,
+		TransferID:        toNullString(transaction.TransferID),
+		TransferDirection: toNullString(string(transaction.TransferDirection)),
+		OriginalAmount:    sql.NullString{String: transaction.OriginalAmount.String(), Valid: transaction.OriginalAmount.Currency() != ""},
+		OriginalCurrency:  toNullString(transaction.OriginalAmount.Currency()),
+		ExchangeRate:      toNullString(transaction.ExchangeRate.String()),
+		ExternalID:        toNullString(transaction.ExternalID),
 	}
 }
 
 // Mapper: Repository Entity -> Domain Entity
-func toDomainTransaction(dbTransaction *repoEntity.Transaction) *entity.Transaction {
+func toDomainTransaction(dbTransaction *repoEntity.Transaction) (*entity.Transaction, error) {
+	amount, err := parseAmount(dbTransaction.Amount, dbTransaction.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("parsing amount of transaction %s: %w", dbTransaction.ID, err)
+	}
+
+	var originalAmount money.Money
+	if dbTransaction.OriginalAmount.Valid {
+		originalAmount, err = parseAmount(dbTransaction.OriginalAmount.String, dbTransaction.OriginalCurrency.String)
+		if err != nil {
+			return nil, fmt.Errorf("parsing original amount of transaction %s: %w", dbTransaction.ID, err)
+		}
+	}
+
+	var exchangeRate money.Rate
+	if dbTransaction.ExchangeRate.Valid {
+		exchangeRate, err = money.ParseRate(dbTransaction.ExchangeRate.String)
+		if err != nil {
+			return nil, fmt.Errorf("parsing exchange rate of transaction %s: %w", dbTransaction.ID, err)
+		}
+	}
+
+	var runningBalance money.Money
+	if dbTransaction.RunningBalance.Valid {
+		runningBalance, err = parseAmount(dbTransaction.RunningBalance.String, dbTransaction.Currency)
+		if err != nil {
+			return nil, fmt.Errorf("parsing running balance of transaction %s: %w", dbTransaction.ID, err)
+		}
+	}
+
 	return &entity.Transaction{
-		ID:          dbTransaction.ID,
-		AccountID:   dbTransaction.AccountID,
-		Amount:      dbTransaction.Amount,
-		Currency:    dbTransaction.Currency,
-		Description: dbTransaction.Description,
-		Date:        dbTransaction.Date,
-		Type:        constant.TransactionType(dbTransaction.Type),
-		Category:    dbTransaction.Category,
+		ID:                dbTransaction.ID,
+		AccountID:         dbTransaction.AccountID,
+		Amount:            amount,
+		Description:       dbTransaction.Description,
+		PayeeID:           dbTransaction.PayeeID.String,
+		Payee:             dbTransaction.Payee.String,
+		Date:              dbTransaction.Date,
+		Type:              constant.TransactionType(dbTransaction.Type),
+		CategoryID:        dbTransaction.CategoryID.String,
+		Category:          dbTransaction.Category.String,
+		Tags:              dbTransaction.Tags,
+		TransferID:        dbTransaction.TransferID.String,
+		TransferDirection: constant.TransferDirection(dbTransaction.TransferDirection.String),
+		ExternalID:        dbTransaction.ExternalID.String,
+		OriginalAmount:    originalAmount,
+		ExchangeRate:      exchangeRate,
+		RunningBalance:    runningBalance,
+	}, nil
+}
+
+// Mapper: Domain Entity -> Repository Entities
+func toRepoTransactionSplits(transaction *entity.Transaction) []*repoEntity.TransactionSplit {
+	dbSplits := make([]*repoEntity.TransactionSplit, 0, len(transaction.Splits))
+	for i, split := range transaction.Splits {
+		dbSplits = append(dbSplits, &repoEntity.TransactionSplit{
+			ID:            split.ID,
+			TransactionID: transaction.ID,
+			LineNo:        i + 1,
+			Amount:        split.Amount.String(),
+			Currency:      split.Amount.Currency(),
+			CategoryID:    toNullString(split.CategoryID),
+			Memo:          split.Memo,
+		})
+	}
+	return dbSplits
+}
+
+// Mapper: Repository Entity -> Domain Entity
+func toDomainTransactionSplit(dbSplit *repoEntity.TransactionSplit) (*entity.Split, error) {
+	amount, err := parseAmount(dbSplit.Amount, dbSplit.Currency)
+	if err != nil {
+		return nil, fmt.Errorf("parsing amount of split %s: %w", dbSplit.ID, err)
+	}
+
+	return &entity.Split{
+		ID:         dbSplit.ID,
+		Amount:     amount,
+		CategoryID: dbSplit.CategoryID.String,
+		Category:   dbSplit.Category.String,
+		Memo:       dbSplit.Memo,
+	}, nil
+}
+
+// scanner is implemented by both *sql.Row and *sql.Rows.
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+// scanTransaction reads a row selected with transactionColumns, followed by
+// running_balance when runningBalance is set.
+func scanTransaction(row scanner, runningBalance bool) (*repoEntity.Transaction, error) {
+	var dbTransaction repoEntity.Transaction
+	dest := []any{
+		&dbTransaction.ID,
+		&dbTransaction.AccountID,
+		&dbTransaction.Amount,
+		&dbTransaction.Currency,
+		&dbTransaction.Description,
+		&dbTransaction.PayeeID,
+		&dbTransaction.Payee,
+		&dbTransaction.Date,
+		&dbTransaction.Type,
+		&dbTransaction.CategoryID,
+		&dbTransaction.Category,
+		&dbTransaction.Tags,
+		&dbTransaction.TransferID,
+		&dbTransaction.TransferDirection,
+		&dbTransaction.OriginalAmount,
+		&dbTransaction.OriginalCurrency,
+		&dbTransaction.ExchangeRate,
+		&dbTransaction.ExternalID,
+		&dbTransaction.CreatedAt,
+	}
+	if runningBalance {
+		dest = append(dest, &dbTransaction.RunningBalance)
+	}
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
 	}
+	return &dbTransaction, nil
 }
 
 func (r *TransactionRepository) Create(ctx context.Context, transaction *entity.Transaction) error {
@@ -57,45 +193,63 @@ func (r *TransactionRepository) Create(ctx context.Context, transaction *entity.
 	dbTransaction.UpdatedAt = now
 
 	query := `
-INSERT INTO transactions (id, account_id, amount, currency, description, date, type, category, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+INSERT INTO transactions (id, account_id, amount, currency, description, payee_id, date, type, category_id, transfer_id,
+                          transfer_direction, original_amount, original_currency, exchange_rate, external_id, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)
 `
 
-	_, err := r.db.ExecContext(ctx, query,
+	_, err := GetExecutor(ctx, r.db).ExecContext(ctx, query,
 		dbTransaction.ID,
 		dbTransaction.AccountID,
 		dbTransaction.Amount,
 		dbTransaction.Currency,
 		dbTransaction.Description,
+		dbTransaction.PayeeID,
 		dbTransaction.Date,
 		dbTransaction.Type,
-		dbTransaction.Category,
+		dbTransaction.CategoryID,
+		dbTransaction.TransferID,
+		dbTransaction.TransferDirection,
+		dbTransaction.OriginalAmount,
+		dbTransaction.OriginalCurrency,
+		dbTransaction.ExchangeRate,
+		dbTransaction.ExternalID,
 		dbTransaction.CreatedAt,
 		dbTransaction.UpdatedAt,
 	)
+	if err != nil {
+		return err
+	}
 
-	return err
+	if err := r.saveSplits(ctx, transaction); err != nil {
+		return err
+	}
+	return r.saveTags(ctx, dbTransaction)
 }
 
 func (r *TransactionRepository) GetByID(ctx context.Context, id string) (*entity.Transaction, error) {
 	query := `
-SELECT id, account_id, amount, currency, description, date, type, category
+SELECT ` + transactionColumns + `
 FROM transactions
 WHERE id = $1
 `
 
-	var dbTransaction repoEntity.Transaction
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
-		&dbTransaction.ID,
-		&dbTransaction.AccountID,
-		&dbTransaction.Amount,
-		&dbTransaction.Currency,
-		&dbTransaction.Description,
-		&dbTransaction.Date,
-		&dbTransaction.Type,
-		&dbTransaction.Category,
-	)
+	return r.get(ctx, query, id)
+}
+
+func (r *TransactionRepository) GetByExternalID(ctx context.Context, accountID, externalID string) (*entity.Transaction, error) {
+	query := `
+SELECT ` + transactionColumns + `
+FROM transactions
+WHERE account_id = $1 AND external_id = $2
+`
+
+	return r.get(ctx, query, accountID, externalID)
+}
 
+// get runs a query selecting transactionColumns of at most one row.
+func (r *TransactionRepository) get(ctx context.Context, query string, args ...any) (*entity.Transaction, error) {
+	dbTransaction, err := scanTransaction(GetExecutor(ctx, r.db).QueryRowContext(ctx, query, args...), false)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -103,43 +257,295 @@ WHERE id = $1
 		return nil, err
 	}
 
-	return toDomainTransaction(&dbTransaction), nil
+	transaction, err := toDomainTransaction(dbTransaction)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.loadSplits(ctx, []*entity.Transaction{transaction}); err != nil {
+		return nil, err
+	}
+	return transaction, nil
+}
+
+func (r *TransactionRepository) ListSimilar(ctx context.Context, accountID string, transactionType constant.TransactionType, amount money.Money, from, to time.Time) ([]*entity.Transaction, error) {
+	query := `
+SELECT ` + transactionColumns + `
+FROM transactions
+WHERE account_id = $1 AND type = $2 AND date >= $3 AND date <= $4
+  AND ((amount = $5 AND currency = $6) OR (original_amount = $5 AND original_currency = $6))
+ORDER BY date, created_at, id
+`
+
+	dbTransactions, err := r.query(ctx, false, query, accountID, string(transactionType), from, to, amount.String(), amount.Currency())
+	if err != nil {
+		return nil, err
+	}
+	return r.toDomainTransactions(ctx, dbTransactions)
+}
+
+func (r *TransactionRepository) ListByAccountID(ctx context.Context, accountID string, filter entity.TransactionFilter, page entity.PageRequest) ([]*entity.Transaction, string, error) {
+	sort := filter.Sort
+	if sort == "" {
+		sort = constant.TransactionSortDateDesc
+	}
+	cursor, err := decodeCursor(page.Cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	if cursor != nil && (cursor.Sort != string(sort) || cursor.Date == nil || (sort.ByAmount() && cursor.Amount == "")) {
+		return nil, "", errInvalidCursor
+	}
+
+	args := []any{accountID}
+	arg := func(value any) string {
+		args = append(args, value)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	query := `
+SELECT ` + transactionColumns + `
+FROM transactions
+WHERE account_id = $1`
+	if filter.RunningBalance {
+		query = runningBalanceQuery(arg(pq.Array(creditNormalTypes())))
+	}
+	if !filter.From.IsZero() {
+		query += `
+  AND date >= ` + arg(filter.From)
+	}
+	if !filter.To.IsZero() {
+		query += `
+  AND date < ` + arg(filter.To)
+	}
+	if filter.Type != "" {
+		query += `
+  AND type = ` + arg(string(filter.Type))
+	}
+	if len(filter.CategoryIDs) > 0 {
+		// A split transaction matches when any of its splits does
+		tree := `
+    WITH RECURSIVE tree AS (
+        SELECT id FROM categories WHERE id = ANY(` + arg(pq.Array(filter.CategoryIDs)) + `)
+        UNION ALL
+        SELECT c.id FROM categories c JOIN tree ON c.parent_id = tree.id
+    )
+    SELECT id FROM tree`
+		query += `
+  AND (category_id IN (` + tree + `
+  ) OR id IN (
+    SELECT s.transaction_id FROM transaction_splits s WHERE s.category_id IN (` + tree + `
+    )
+  ))`
+	}
+	if len(filter.Tags) > 0 {
+		// Tag names are unique per user, so matching every tag means matching as many names
+		tagged := `SELECT COUNT(*) FROM transaction_tags tt JOIN tags tg ON tg.id = tt.tag_id
+          WHERE tt.transaction_id = transactions.id AND tg.name = ANY(` + arg(pq.Array(filter.Tags)) + `)`
+		if filter.TagMatch == constant.TagMatchAll {
+			query += `
+  AND (` + tagged + `) = ` + arg(len(filter.Tags))
+		} else {
+			query += `
+  AND (` + tagged + `) > 0`
+		}
+	}
+	if filter.MinAmount != "" {
+		query += `
+  AND amount >= ` + arg(filter.MinAmount)
+	}
+	if filter.MaxAmount != "" {
+		query += `
+  AND amount <= ` + arg(filter.MaxAmount)
+	}
+	if filter.Description != "" {
+		query += `
+  AND description ILIKE ` + arg("%"+likeEscaper.Replace(filter.Description)+"%")
+	}
+
+	// Keyset columns, in sort order; the cursor continues strictly after its row
+	direction, comparison := "DESC", "<"
+	if sort.Ascending() {
+		direction, comparison = "ASC", ">"
+	}
+	keys := []string{"date", "created_at", "id"}
+	if sort.ByAmount() {
+		keys = append([]string{"amount"}, keys...)
+	}
+	if cursor != nil {
+		values := []string{arg(*cursor.Date), arg(cursor.CreatedAt), arg(cursor.ID)}
+		if sort.ByAmount() {
+			values = append([]string{arg(cursor.Amount)}, values...)
+		}
+		query += `
+  AND (` + strings.Join(keys, ", ") + `) ` + comparison + ` (` + strings.Join(values, ", ") + `)`
+	}
+	query += `
+ORDER BY ` + strings.Join(keys, " "+direction+", ") + " " + direction
+	limit, args := limitClause(page.Limit, args)
+
+	dbTransactions, err := r.query(ctx, filter.RunningBalance, query+limit, args...)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var nextCursor string
+	if page.Limit > 0 && len(dbTransactions) > page.Limit {
+		dbTransactions = dbTransactions[:page.Limit]
+		last := dbTransactions[page.Limit-1]
+		next := pageCursor{Sort: string(sort), Date: &last.Date, CreatedAt: last.CreatedAt, ID: last.ID}
+		if sort.ByAmount() {
+			next.Amount = last.Amount
+		}
+		nextCursor = encodeCursor(next)
+	}
+
+	transactions, err := r.toDomainTransactions(ctx, dbTransactions)
+	if err != nil {
+		return nil, "", err
+	}
+	return transactions, nextCursor, nil
+}
+
+// runningBalanceQuery starts the account listing with each transaction's
+// running balance. The window runs over every transaction and journal posting
+// of the account in date order before any filter or cursor applies, so a
+// filtered page still shows the balance the account really had. Postings
+// increase the balance on the account's normal side, given by the
+// creditNormalTypes placeholder.
+func runningBalanceQuery(creditNormalTypes string) string {
+	return `
+WITH ledger AS (
+    SELECT id, date, created_at,
+           CASE WHEN type = 'EXPENSE' OR transfer_direction = 'OUT' THEN -amount ELSE amount END AS effect
+    FROM transactions
+    WHERE account_id = $1
+    UNION ALL
+    SELECT p.id, e.date, e.created_at,
+           CASE WHEN (p.direction = 'DEBIT') <> (a.type = ANY(` + creditNormalTypes + `)) THEN p.amount ELSE -p.amount END
+    FROM journal_postings p
+    JOIN journal_entries e ON e.id = p.entry_id
+    JOIN accounts a ON a.id = p.account_id
+    WHERE p.account_id = $1
+),
+running AS (
+    SELECT id AS ledger_id,
+           SUM(effect) OVER (ORDER BY date, created_at, id) AS running_balance
+    FROM ledger
+)
+SELECT ` + transactionColumns + `, running_balance
+FROM transactions
+JOIN running ON ledger_id = id
+WHERE account_id = $1`
+}
+
+// creditNormalTypes returns the account types whose balance credits increase.
+func creditNormalTypes() []string {
+	var types []string
+	for _, accountType := range constant.AccountTypes {
+		if accountType.IsCreditNormal() {
+			types = append(types, string(accountType))
+		}
+	}
+	return types
+}
+
+func (r *TransactionRepository) ListRuleCandidates(ctx context.Context, userID string, uncategorizedOnly bool, page entity.PageRequest) ([]*entity.Transaction, string, error) {
+	cursor, err := decodeCursor(page.Cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	if cursor != nil && (cursor.Sort != "" || cursor.Date == nil) {
+		return nil, "", errInvalidCursor
+	}
+
+	// Transfer legs are not income or expenses and carry no category of their
+	// own, and split transactions take theirs from the splits
+	query := `
+SELECT ` + transactionColumns + `
+FROM transactions
+WHERE account_id IN (SELECT id FROM accounts WHERE user_id = $1)
+  AND transfer_id IS NULL
+  AND NOT EXISTS (SELECT 1 FROM transaction_splits s WHERE s.transaction_id = transactions.id)
+  AND (NOT $2 OR category_id IS NULL)`
+	args := []any{userID, uncategorizedOnly}
+	if cursor != nil {
+		query += `
+  AND (date, created_at, id) > ($3, $4, $5)`
+		args = append(args, *cursor.Date, cursor.CreatedAt, cursor.ID)
+	}
+	query += `
+ORDER BY date, created_at, id`
+	limit, args := limitClause(page.Limit, args)
+
+	dbTransactions, err := r.query(ctx, false, query+limit, args...)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var nextCursor string
+	if page.Limit > 0 && len(dbTransactions) > page.Limit {
+		dbTransactions = dbTransactions[:page.Limit]
+		last := dbTransactions[page.Limit-1]
+		nextCursor = encodeCursor(pageCursor{Date: &last.Date, CreatedAt: last.CreatedAt, ID: last.ID})
+	}
+
+	transactions, err := r.toDomainTransactions(ctx, dbTransactions)
+	if err != nil {
+		return nil, "", err
+	}
+	return transactions, nextCursor, nil
 }
 
-func (r *TransactionRepository) ListByAccountID(ctx context.Context, accountID string) ([]*entity.Transaction, error) {
+func (r *TransactionRepository) ListByTransferID(ctx context.Context, transferID string) ([]*entity.Transaction, error) {
 	query := `
-SELECT id, account_id, amount, currency, description, date, type, category
+SELECT ` + transactionColumns + `
 FROM transactions
-WHERE account_id = $1
-ORDER BY date DESC, created_at DESC
+WHERE transfer_id = $1
+ORDER BY transfer_direction DESC
 `
 
-	rows, err := r.db.QueryContext(ctx, query, accountID)
+	dbTransactions, err := r.query(ctx, false, query, transferID)
+	if err != nil {
+		return nil, err
+	}
+	return r.toDomainTransactions(ctx, dbTransactions)
+}
+
+// query runs a query selecting transactionColumns, and running_balance when
+// runningBalance is set, and scans every row.
+func (r *TransactionRepository) query(ctx context.Context, runningBalance bool, query string, args ...any) ([]*repoEntity.Transaction, error) {
+	rows, err := GetExecutor(ctx, r.db).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var transactions []*entity.Transaction
+	var dbTransactions []*repoEntity.Transaction
 	for rows.Next() {
-		var dbTransaction repoEntity.Transaction
-		err := rows.Scan(
-			&dbTransaction.ID,
-			&dbTransaction.AccountID,
-			&dbTransaction.Amount,
-			&dbTransaction.Currency,
-			&dbTransaction.Description,
-			&dbTransaction.Date,
-			&dbTransaction.Type,
-			&dbTransaction.Category,
-		)
+		dbTransaction, err := scanTransaction(rows, runningBalance)
 		if err != nil {
 			return nil, err
 		}
-		transactions = append(transactions, toDomainTransaction(&dbTransaction))
+		dbTransactions = append(dbTransactions, dbTransaction)
 	}
 
-	return transactions, rows.Err()
+	return dbTransactions, rows.Err()
+}
+
+// toDomainTransactions maps the rows and fills in their splits.
+func (r *TransactionRepository) toDomainTransactions(ctx context.Context, dbTransactions []*repoEntity.Transaction) ([]*entity.Transaction, error) {
+	transactions := make([]*entity.Transaction, 0, len(dbTransactions))
+	for _, dbTransaction := range dbTransactions {
+		transaction, err := toDomainTransaction(dbTransaction)
+		if err != nil {
+			return nil, err
+		}
+		transactions = append(transactions, transaction)
+	}
+	if err := r.loadSplits(ctx, transactions); err != nil {
+		return nil, err
+	}
+	return transactions, nil
 }
 
 func (r *TransactionRepository) Update(ctx context.Context, transaction *entity.Transaction) error {
@@ -150,19 +556,27 @@ func (r *TransactionRepository) Update(ctx context.Context, transaction *entity.
 
 	query := `
 UPDATE transactions
-SET account_id = $2, amount = $3, currency = $4, description = $5, date = $6, type = $7, category = $8, updated_at = $9
+SET account_id = $2, amount = $3, currency = $4, description = $5, payee_id = $6, date = $7, type = $8,
+    category_id = $9, transfer_id = $10, transfer_direction = $11, original_amount = $12, original_currency = $13,
+    exchange_rate = $14, updated_at = $15
 WHERE id = $1
 `
 
-	result, err := r.db.ExecContext(ctx, query,
+	result, err := GetExecutor(ctx, r.db).ExecContext(ctx, query,
 		dbTransaction.ID,
 		dbTransaction.AccountID,
 		dbTransaction.Amount,
 		dbTransaction.Currency,
 		dbTransaction.Description,
+		dbTransaction.PayeeID,
 		dbTransaction.Date,
 		dbTransaction.Type,
-		dbTransaction.Category,
+		dbTransaction.CategoryID,
+		dbTransaction.TransferID,
+		dbTransaction.TransferDirection,
+		dbTransaction.OriginalAmount,
+		dbTransaction.OriginalCurrency,
+		dbTransaction.ExchangeRate,
 		dbTransaction.UpdatedAt,
 	)
 	if err != nil {
@@ -177,13 +591,133 @@ WHERE id = $1
 		return domainerrors.NewErrNotFound("transaction", transaction.ID)
 	}
 
+	if err := r.saveSplits(ctx, transaction); err != nil {
+		return err
+	}
+	return r.saveTags(ctx, dbTransaction)
+}
+
+// saveSplits replaces the splits of a transaction with its Splits.
+func (r *TransactionRepository) saveSplits(ctx context.Context, transaction *entity.Transaction) error {
+	executor := GetExecutor(ctx, r.db)
+
+	query := `DELETE FROM transaction_splits WHERE transaction_id = $1`
+	if _, err := executor.ExecContext(ctx, query, transaction.ID); err != nil {
+		return err
+	}
+
+	query = `
+INSERT INTO transaction_splits (id, transaction_id, line_no, amount, currency, category_id, memo)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+`
+	for _, dbSplit := range toRepoTransactionSplits(transaction) {
+		_, err := executor.ExecContext(ctx, query,
+			dbSplit.ID,
+			dbSplit.TransactionID,
+			dbSplit.LineNo,
+			dbSplit.Amount,
+			dbSplit.Currency,
+			dbSplit.CategoryID,
+			dbSplit.Memo,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// loadSplits fills in the splits of the transactions.
+func (r *TransactionRepository) loadSplits(ctx context.Context, transactions []*entity.Transaction) error {
+	if len(transactions) == 0 {
+		return nil
+	}
+	byID := make(map[string]*entity.Transaction, len(transactions))
+	ids := make([]string, 0, len(transactions))
+	for _, transaction := range transactions {
+		byID[transaction.ID] = transaction
+		ids = append(ids, transaction.ID)
+	}
+
+	query := `
+SELECT s.id, s.transaction_id, s.line_no, s.amount, s.currency, s.category_id, c.name, s.memo
+FROM transaction_splits s
+LEFT JOIN categories c ON c.id = s.category_id
+WHERE s.transaction_id = ANY($1)
+ORDER BY s.transaction_id, s.line_no
+`
+
+	rows, err := GetExecutor(ctx, r.db).QueryContext(ctx, query, pq.Array(ids))
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var dbSplit repoEntity.TransactionSplit
+		if err := rows.Scan(
+			&dbSplit.ID,
+			&dbSplit.TransactionID,
+			&dbSplit.LineNo,
+			&dbSplit.Amount,
+			&dbSplit.Currency,
+			&dbSplit.CategoryID,
+			&dbSplit.Category,
+			&dbSplit.Memo,
+		); err != nil {
+			return err
+		}
+		split, err := toDomainTransactionSplit(&dbSplit)
+		if err != nil {
+			return err
+		}
+		transaction := byID[dbSplit.TransactionID]
+		transaction.Splits = append(transaction.Splits, split)
+	}
+
+	return rows.Err()
+}
+
+// saveTags replaces the tags on a transaction with its Tags, creating those
+// the account's user does not have yet.
+func (r *TransactionRepository) saveTags(ctx context.Context, dbTransaction *repoEntity.Transaction) error {
+	executor := GetExecutor(ctx, r.db)
+
+	query := `DELETE FROM transaction_tags WHERE transaction_id = $1`
+	if _, err := executor.ExecContext(ctx, query, dbTransaction.ID); err != nil {
+		return err
+	}
+	if len(dbTransaction.Tags) == 0 {
+		return nil
+	}
+
+	query = `
+INSERT INTO tags (id, user_id, name, created_at)
+SELECT gen_random_uuid(), a.user_id, name, $3
+FROM accounts a, unnest($2::text[]) AS name
+WHERE a.id = $1
+ON CONFLICT (user_id, name) DO NOTHING
+`
+	if _, err := executor.ExecContext(ctx, query, dbTransaction.AccountID, dbTransaction.Tags, time.Now()); err != nil {
+		return err
+	}
+
+	query = `
+INSERT INTO transaction_tags (transaction_id, tag_id)
+SELECT $1, tg.id
+FROM tags tg
+JOIN accounts a ON a.user_id = tg.user_id
+WHERE a.id = $2 AND tg.name = ANY($3)
+`
+	_, err := executor.ExecContext(ctx, query, dbTransaction.ID, dbTransaction.AccountID, dbTransaction.Tags)
+	return err
+}
+
 func (r *TransactionRepository) Delete(ctx context.Context, id string) error {
 	query := `DELETE FROM transactions WHERE id = $1`
 
-	result, err := r.db.ExecContext(ctx, query, id)
+	result, err := GetExecutor(ctx, r.db).ExecContext(ctx, query, id)
 	if err != nil {
 		return err
 	}
@@ -199,5 +733,24 @@ func (r *TransactionRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
+// parseAmount reads a DECIMAL column into Money. The column scale is the
+// largest minor unit of any currency, so trailing zeros the currency does
+// not use are dropped before parsing.
+func parseAmount(value, currency string) (money.Money, error) {
+	if whole, frac, ok := strings.Cut(value, "."); ok {
+		frac = strings.TrimRight(frac, "0")
+		value = whole
+		if frac != "" {
+			value += "." + frac
+		}
+	}
+	return money.Parse(value, currency)
+}
+
+// toNullString maps an empty string to SQL NULL.
+func toNullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
 // Compile-time interface check
 var _ interfaces.TransactionRepository = (*TransactionRepository)(nil)
@@ -22,7 +22,12 @@ func NewTxManager(db *sql.DB) interfaces.TransactionManager {
 }
 
 // WithTx executes the given function within a database transaction.
+// If ctx already carries a transaction, fn joins it instead of starting a new one.
 func (tm *TxManager) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	if GetTxFromContext(ctx) != nil {
+		return fn(ctx)
+	}
+
 	tx, err := tm.db.BeginTx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("beginning transaction: %w", err)
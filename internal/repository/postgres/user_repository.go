@@ -22,22 +22,24 @@ func NewUserRepository(db *sql.DB) interfaces.UserRepository {
 // Mapper: Domain Entity -> Repository Entity
 func toRepoUser(user *entity.User) *repoEntity.User {
 	return &repoEntity.User{
-		ID:        user.ID,
-		Name:      user.Name,
-		Email:     user.Email,
-		CreatedAt: user.CreatedAt,
-		UpdatedAt: user.UpdatedAt,
+		ID:           user.ID,
+		Name:         user.Name,
+		Email:        user.Email,
+		BaseCurrency: user.BaseCurrency,
+		CreatedAt:    user.CreatedAt,
+		UpdatedAt:    user.UpdatedAt,
 	}
 }
 
 // Mapper: Repository Entity -> Domain Entity
 func toDomainUser(dbUser *repoEntity.User) *entity.User {
 	return &entity.User{
-		ID:        dbUser.ID,
-		Name:      dbUser.Name,
-		Email:     dbUser.Email,
-		CreatedAt: dbUser.CreatedAt,
-		UpdatedAt: dbUser.UpdatedAt,
+		ID:           dbUser.ID,
+		Name:         dbUser.Name,
+		Email:        dbUser.Email,
+		BaseCurrency: dbUser.BaseCurrency,
+		CreatedAt:    dbUser.CreatedAt,
+		UpdatedAt:    dbUser.UpdatedAt,
 	}
 }
 
@@ -50,14 +52,15 @@ func (r *UserRepository) Create(ctx context.Context, user *entity.User) error {
 	dbUser.UpdatedAt = now
 
 	query := `
-		INSERT INTO users (id, name, email, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO users (id, name, email, base_currency, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
 	`
 
-	_, err := r.db.ExecContext(ctx, query,
+	_, err := GetExecutor(ctx, r.db).ExecContext(ctx, query,
 		dbUser.ID,
 		dbUser.Name,
 		dbUser.Email,
+		dbUser.BaseCurrency,
 		dbUser.CreatedAt,
 		dbUser.UpdatedAt,
 	)
@@ -75,16 +78,17 @@ func (r *UserRepository) Create(ctx context.Context, user *entity.User) error {
 
 func (r *UserRepository) GetByID(ctx context.Context, id string) (*entity.User, error) {
 	query := `
-SELECT id, name, email, created_at, updated_at
+SELECT id, name, email, base_currency, created_at, updated_at
 FROM users
 WHERE id = $1
 `
 
 	var dbUser repoEntity.User
-	err := r.db.QueryRowContext(ctx, query, id).Scan(
+	err := GetExecutor(ctx, r.db).QueryRowContext(ctx, query, id).Scan(
 		&dbUser.ID,
 		&dbUser.Name,
 		&dbUser.Email,
+		&dbUser.BaseCurrency,
 		&dbUser.CreatedAt,
 		&dbUser.UpdatedAt,
 	)
@@ -101,16 +105,17 @@ WHERE id = $1
 
 func (r *UserRepository) GetByEmail(ctx context.Context, email string) (*entity.User, error) {
 	query := `
-SELECT id, name, email, created_at, updated_at
+SELECT id, name, email, base_currency, created_at, updated_at
 FROM users
 WHERE email = $1
 `
 
 	var dbUser repoEntity.User
-	err := r.db.QueryRowContext(ctx, query, email).Scan(
+	err := GetExecutor(ctx, r.db).QueryRowContext(ctx, query, email).Scan(
 		&dbUser.ID,
 		&dbUser.Name,
 		&dbUser.Email,
+		&dbUser.BaseCurrency,
 		&dbUser.CreatedAt,
 		&dbUser.UpdatedAt,
 	)
@@ -133,14 +138,15 @@ func (r *UserRepository) Update(ctx context.Context, user *entity.User) error {
 
 	query := `
 		UPDATE users
-		SET name = $2, email = $3, updated_at = $4
+		SET name = $2, email = $3, base_currency = $4, updated_at = $5
 		WHERE id = $1
 	`
 
-	result, err := r.db.ExecContext(ctx, query,
+	result, err := GetExecutor(ctx, r.db).ExecContext(ctx, query,
 		dbUser.ID,
 		dbUser.Name,
 		dbUser.Email,
+		dbUser.BaseCurrency,
 		dbUser.UpdatedAt,
 	)
 	if err != nil {
@@ -164,7 +170,7 @@ func (r *UserRepository) Update(ctx context.Context, user *entity.User) error {
 func (r *UserRepository) Delete(ctx context.Context, id string) error {
 	query := `DELETE FROM users WHERE id = $1`
 
-	result, err := r.db.ExecContext(ctx, query, id)
+	result, err := GetExecutor(ctx, r.db).ExecContext(ctx, query, id)
 	if err != nil {
 		return err
 	}
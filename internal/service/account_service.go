@@ -1,38 +1,54 @@
 package service
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"time"
 
 	"accounting/internal/domain/constant"
 	"accounting/internal/domain/entity"
 	domainerrors "accounting/internal/domain/errors"
 	"accounting/internal/domain/interfaces"
+	"accounting/internal/domain/money"
 
 	"github.com/google/uuid"
 )
 
+// accountCodePattern matches chart-of-accounts codes such as 1000 or 4100.10.
+var accountCodePattern = regexp.MustCompile(`^[0-9A-Za-z][0-9A-Za-z.\-]{0,19}$`)
+
 type AccountService struct {
 	accountRepo interfaces.AccountRepository
 	userRepo    interfaces.UserRepository
+	txManager   interfaces.TransactionManager
+	converter   interfaces.CurrencyConverter
 }
 
-func NewAccountService(accountRepo interfaces.AccountRepository, userRepo interfaces.UserRepository) *AccountService {
+func NewAccountService(accountRepo interfaces.AccountRepository, userRepo interfaces.UserRepository, txManager interfaces.TransactionManager, converter interfaces.CurrencyConverter) *AccountService {
 	return &AccountService{
 		accountRepo: accountRepo,
 		userRepo:    userRepo,
+		txManager:   txManager,
+		converter:   converter,
 	}
 }
 
-func (s *AccountService) CreateAccount(ctx context.Context, userID, name string, accountType constant.AccountType, currency string) (*entity.Account, error) {
+func (s *AccountService) CreateAccount(ctx context.Context, userID, name string, accountType constant.AccountType, currency, parentID, code string) (*entity.Account, error) {
 	if userID == "" {
 		return nil, domainerrors.NewErrInvalidInput("user_id", "user ID is required")
 	}
 	if name == "" {
 		return nil, domainerrors.NewErrInvalidInput("name", "account name is required")
 	}
-	if currency == "" {
-		return nil, domainerrors.NewErrInvalidInput("currency", "currency is required")
+	if err := validateCurrency("currency", currency); err != nil {
+		return nil, err
+	}
+	if code != "" && !accountCodePattern.MatchString(code) {
+		return nil, domainerrors.NewErrInvalidInput("code", "code must be 1-20 letters, digits, dots or dashes")
 	}
 
 	// Verify user exists
@@ -47,12 +63,21 @@ func (s *AccountService) CreateAccount(ctx context.Context, userID, name string,
 	account := &entity.Account{
 		ID:       uuid.New().String(),
 		UserID:   userID,
+		ParentID: parentID,
+		Code:     code,
 		Name:     name,
 		Type:     accountType,
-		Balance:  0.0,
+		Balance:  money.Zero(currency),
 		Currency: currency,
 	}
 
+	if err := s.checkParent(ctx, account); err != nil {
+		return nil, err
+	}
+	if err := s.checkCodeUnique(ctx, account); err != nil {
+		return nil, err
+	}
+
 	if err := s.accountRepo.Create(ctx, account); err != nil {
 		return nil, fmt.Errorf("creating account: %w", err)
 	}
@@ -64,38 +89,311 @@ func (s *AccountService) GetAccount(ctx context.Context, id string) (*entity.Acc
 	return s.accountRepo.GetByID(ctx, id)
 }
 
-func (s *AccountService) ListUserAccounts(ctx context.Context, userID string) ([]*entity.Account, error) {
-	return s.accountRepo.ListByUserID(ctx, userID)
+func (s *AccountService) ListUserAccounts(ctx context.Context, userID string, page entity.PageRequest) ([]*entity.Account, string, error) {
+	page, err := pageRequest(page)
+	if err != nil {
+		return nil, "", err
+	}
+	return s.accountRepo.ListByUserID(ctx, userID, page)
 }
 
-func (s *AccountService) UpdateAccount(ctx context.Context, id, name string, accountType constant.AccountType, currency string) (*entity.Account, error) {
-	account, err := s.accountRepo.GetByID(ctx, id)
+func (s *AccountService) GetAccountTree(ctx context.Context, userID, baseCurrency string) ([]*entity.AccountNode, error) {
+	if baseCurrency != "" {
+		if err := validateCurrency("base_currency", baseCurrency); err != nil {
+			return nil, err
+		}
+	}
+
+	accounts, _, err := s.accountRepo.ListByUserID(ctx, userID, entity.PageRequest{})
 	if err != nil {
-		return nil, fmt.Errorf("getting account: %w", err)
+		return nil, fmt.Errorf("listing accounts: %w", err)
 	}
-	if account == nil {
-		return nil, domainerrors.NewErrNotFound("account", id)
+
+	nodes := make(map[string]*entity.AccountNode, len(accounts))
+	for _, account := range accounts {
+		nodes[account.ID] = &entity.AccountNode{Account: account}
+	}
+
+	var roots []*entity.AccountNode
+	for _, account := range accounts {
+		node := nodes[account.ID]
+		if parent, ok := nodes[account.ParentID]; ok {
+			parent.Children = append(parent.Children, node)
+		} else {
+			roots = append(roots, node)
+		}
 	}
 
-	if name != "" {
-		account.Name = name
+	sortAccountNodes(roots)
+	for _, root := range roots {
+		if _, err := rollUpBalances(root); err != nil {
+			return nil, err
+		}
+		if baseCurrency != "" {
+			if err := s.totalInBaseCurrency(ctx, root, baseCurrency, time.Now()); err != nil {
+				return nil, err
+			}
+		}
 	}
-	if accountType != "" {
-		account.Type = accountType
+
+	return roots, nil
+}
+
+func (s *AccountService) UpdateAccount(ctx context.Context, id, name string, accountType constant.AccountType, currency, parentID, code string) (*entity.Account, error) {
+	if code != "" && !accountCodePattern.MatchString(code) {
+		return nil, domainerrors.NewErrInvalidInput("code", "code must be 1-20 letters, digits, dots or dashes")
 	}
 	if currency != "" {
-		account.Currency = currency
+		if err := validateCurrency("currency", currency); err != nil {
+			return nil, err
+		}
 	}
 
-	if err := s.accountRepo.Update(ctx, account); err != nil {
-		return nil, fmt.Errorf("updating account: %w", err)
+	var account *entity.Account
+	// Lock the row so the balance written back cannot overwrite a concurrent update
+	err := s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		account, err = s.accountRepo.GetByIDForUpdate(ctx, id)
+		if err != nil {
+			return fmt.Errorf("getting account: %w", err)
+		}
+		if account == nil {
+			return domainerrors.NewErrNotFound("account", id)
+		}
+
+		if name != "" {
+			account.Name = name
+		}
+		if accountType != "" && accountType != account.Type {
+			if err := s.checkChildrenClass(ctx, account.ID, accountType.Class()); err != nil {
+				return err
+			}
+			// The history is replayed on the normal side of the type, so only an
+			// unused account may move to the other side
+			if accountType.IsCreditNormal() != account.Type.IsCreditNormal() {
+				if err := s.checkUnused(ctx, account.ID, "type", "an account with transactions or journal postings cannot change between debit-normal and credit-normal types"); err != nil {
+					return err
+				}
+			}
+			account.Type = accountType
+		}
+		if currency != "" && currency != account.Currency {
+			// The history stays in the old currency, so only an unused account may change it
+			if err := s.checkUnused(ctx, account.ID, "currency", "the currency of an account with transactions or journal postings cannot change"); err != nil {
+				return err
+			}
+			balance, err := account.Balance.WithCurrency(currency)
+			if err != nil {
+				return domainerrors.NewErrInvalidInput("currency", err.Error())
+			}
+			account.Balance = balance
+			account.Currency = currency
+		}
+		if parentID != "" {
+			account.ParentID = parentID
+		}
+		if code != "" && code != account.Code {
+			account.Code = code
+			if err := s.checkCodeUnique(ctx, account); err != nil {
+				return err
+			}
+		}
+		if err := s.checkParent(ctx, account); err != nil {
+			return err
+		}
+
+		if err := s.accountRepo.Update(ctx, account); err != nil {
+			return fmt.Errorf("updating account: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return account, nil
 }
 
 func (s *AccountService) DeleteAccount(ctx context.Context, id string) error {
-	return s.accountRepo.Delete(ctx, id)
+	// Lock the row so no transfer or journal entry can reach the account while it is checked
+	return s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		account, err := s.accountRepo.GetByIDForUpdate(ctx, id)
+		if err != nil {
+			return fmt.Errorf("getting account: %w", err)
+		}
+		if account == nil {
+			return domainerrors.NewErrNotFound("account", id)
+		}
+
+		children, err := s.accountRepo.ListByParentID(ctx, id)
+		if err != nil {
+			return fmt.Errorf("listing sub-accounts: %w", err)
+		}
+		if len(children) > 0 {
+			return domainerrors.NewErrInvalidInput("id", "account has sub-accounts; move or delete them first")
+		}
+
+		// Deleting the account deletes its rows only, which would leave the
+		// other half of its transfers and journal entries behind
+		activity, err := s.accountRepo.GetActivity(ctx, id)
+		if err != nil {
+			return fmt.Errorf("getting account activity: %w", err)
+		}
+		if activity.TransferLegs > 0 || activity.Postings > 0 {
+			return domainerrors.NewErrInvalidInput("id", "account has transfers or journal postings; delete them first")
+		}
+
+		return s.accountRepo.Delete(ctx, id)
+	})
+}
+
+// checkUnused verifies that the account has no transactions or journal
+// postings, reporting message on field otherwise.
+func (s *AccountService) checkUnused(ctx context.Context, accountID, field, message string) error {
+	activity, err := s.accountRepo.GetActivity(ctx, accountID)
+	if err != nil {
+		return fmt.Errorf("getting account activity: %w", err)
+	}
+	if activity.Transactions > 0 || activity.Postings > 0 {
+		return domainerrors.NewErrInvalidInput(field, message)
+	}
+	return nil
+}
+
+// checkParent verifies that the account's parent exists, belongs to the same
+// user, has the same accounting class and is not one of its descendants.
+func (s *AccountService) checkParent(ctx context.Context, account *entity.Account) error {
+	if account.ParentID == "" {
+		return nil
+	}
+	if account.ParentID == account.ID {
+		return domainerrors.NewErrInvalidInput("parent_id", "an account cannot be its own parent")
+	}
+
+	parent, err := s.accountRepo.GetByID(ctx, account.ParentID)
+	if err != nil {
+		return fmt.Errorf("getting parent account: %w", err)
+	}
+	if parent == nil {
+		return domainerrors.NewErrNotFound("account", account.ParentID)
+	}
+	if parent.UserID != account.UserID {
+		return domainerrors.NewErrInvalidInput("parent_id", "parent account belongs to another user")
+	}
+	if parent.Type.Class() != account.Type.Class() {
+		return domainerrors.NewErrInvalidInput("parent_id", fmt.Sprintf("parent account class %s does not match %s", parent.Type.Class(), account.Type.Class()))
+	}
+
+	// Walk up from the parent; reaching the account again means a cycle
+	for ancestor := parent; ancestor.ParentID != ""; {
+		if ancestor.ParentID == account.ID {
+			return domainerrors.NewErrInvalidInput("parent_id", "parent account is a descendant of the account")
+		}
+		ancestor, err = s.accountRepo.GetByID(ctx, ancestor.ParentID)
+		if err != nil {
+			return fmt.Errorf("getting ancestor account: %w", err)
+		}
+		if ancestor == nil {
+			break
+		}
+	}
+
+	return nil
+}
+
+// checkChildrenClass verifies that every sub-account of the account has the given class.
+func (s *AccountService) checkChildrenClass(ctx context.Context, id string, class constant.AccountClass) error {
+	children, err := s.accountRepo.ListByParentID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("listing sub-accounts: %w", err)
+	}
+	for _, child := range children {
+		if child.Type.Class() != class {
+			return domainerrors.NewErrInvalidInput("type", fmt.Sprintf("sub-account %s has class %s", child.ID, child.Type.Class()))
+		}
+	}
+	return nil
+}
+
+// checkCodeUnique verifies that no other account of the user uses the account's code.
+func (s *AccountService) checkCodeUnique(ctx context.Context, account *entity.Account) error {
+	if account.Code == "" {
+		return nil
+	}
+	existing, err := s.accountRepo.GetByCode(ctx, account.UserID, account.Code)
+	if err != nil {
+		return fmt.Errorf("checking account code: %w", err)
+	}
+	if existing != nil && existing.ID != account.ID {
+		return domainerrors.NewErrDuplicateAccountCode(account.UserID, account.Code)
+	}
+	return nil
+}
+
+// sortAccountNodes orders sibling nodes by code, then name, recursively.
+// Accounts without a code sort after coded ones.
+func sortAccountNodes(nodes []*entity.AccountNode) {
+	slices.SortFunc(nodes, func(a, b *entity.AccountNode) int {
+		if (a.Account.Code == "") != (b.Account.Code == "") {
+			if a.Account.Code == "" {
+				return 1
+			}
+			return -1
+		}
+		return cmp.Or(
+			cmp.Compare(a.Account.Code, b.Account.Code),
+			cmp.Compare(a.Account.Name, b.Account.Name),
+		)
+	})
+	for _, node := range nodes {
+		sortAccountNodes(node.Children)
+	}
+}
+
+// rollUpBalances fills RolledUpBalances for the node and its descendants and
+// returns the node's totals keyed by currency.
+func rollUpBalances(node *entity.AccountNode) (map[string]money.Money, error) {
+	totals := map[string]money.Money{node.Account.Currency: node.Account.Balance}
+	for _, child := range node.Children {
+		childTotals, err := rollUpBalances(child)
+		if err != nil {
+			return nil, err
+		}
+		for currency, amount := range childTotals {
+			total, ok := totals[currency]
+			if !ok {
+				total = money.Zero(currency)
+			}
+			if totals[currency], err = total.Add(amount); err != nil {
+				return nil, fmt.Errorf("rolling up balance of account %s: %w", node.Account.ID, err)
+			}
+		}
+	}
+
+	node.RolledUpBalances = make([]money.Money, 0, len(totals))
+	for _, currency := range slices.Sorted(maps.Keys(totals)) {
+		node.RolledUpBalances = append(node.RolledUpBalances, totals[currency])
+	}
+
+	return totals, nil
+}
+
+// totalInBaseCurrency fills RolledUpTotal for the node and its descendants
+// using the exchange rates effective on date.
+func (s *AccountService) totalInBaseCurrency(ctx context.Context, node *entity.AccountNode, baseCurrency string, date time.Time) error {
+	total, err := convertTotal(ctx, s.converter, node.RolledUpBalances, baseCurrency, date)
+	if err != nil {
+		return err
+	}
+	node.RolledUpTotal = total
+
+	for _, child := range node.Children {
+		if err := s.totalInBaseCurrency(ctx, child, baseCurrency, date); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // Compile-time interface check
@@ -8,6 +8,7 @@ import (
 	"accounting/internal/domain/constant"
 	"accounting/internal/domain/entity"
 	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/money"
 )
 
 func TestCreateAccountSuccess(t *testing.T) {
@@ -16,7 +17,7 @@ func TestCreateAccountSuccess(t *testing.T) {
 	userRepo := &MockUserRepository{
 		userToReturn: testUser,
 	}
-	service := NewAccountService(accountRepo, userRepo)
+	service := NewAccountService(accountRepo, userRepo, &MockTransactionManager{}, newTestConverter())
 
 	account, err := service.CreateAccount(
 		context.Background(),
@@ -24,6 +25,8 @@ func TestCreateAccountSuccess(t *testing.T) {
 		"Checking Account",
 		constant.AccountTypeChecking,
 		"USD",
+		"",
+		"",
 	)
 
 	if err != nil {
@@ -50,8 +53,8 @@ func TestCreateAccountSuccess(t *testing.T) {
 		t.Errorf("expected currency %q, got %q", "USD", account.Currency)
 	}
 
-	if account.Balance != 0.0 {
-		t.Errorf("expected initial balance 0.0, got %f", account.Balance)
+	if account.Balance != money.Zero("USD") {
+		t.Errorf("expected initial balance 0.00 USD, got %s", account.Balance)
 	}
 
 	if accountRepo.createCalls != 1 {
@@ -66,7 +69,7 @@ func TestCreateAccountSuccess(t *testing.T) {
 func TestCreateAccountUserNotFound(t *testing.T) {
 	accountRepo := &MockAccountRepository{}
 	userRepo := &MockUserRepository{}
-	service := NewAccountService(accountRepo, userRepo)
+	service := NewAccountService(accountRepo, userRepo, &MockTransactionManager{}, newTestConverter())
 
 	account, err := service.CreateAccount(
 		context.Background(),
@@ -74,6 +77,8 @@ func TestCreateAccountUserNotFound(t *testing.T) {
 		"Checking Account",
 		constant.AccountTypeChecking,
 		"USD",
+		"",
+		"",
 	)
 
 	if account != nil {
@@ -89,7 +94,7 @@ func TestCreateAccountUserNotFound(t *testing.T) {
 func TestCreateAccountInvalidUserID(t *testing.T) {
 	accountRepo := &MockAccountRepository{}
 	userRepo := &MockUserRepository{}
-	service := NewAccountService(accountRepo, userRepo)
+	service := NewAccountService(accountRepo, userRepo, &MockTransactionManager{}, newTestConverter())
 
 	account, err := service.CreateAccount(
 		context.Background(),
@@ -97,6 +102,8 @@ func TestCreateAccountInvalidUserID(t *testing.T) {
 		"Checking Account",
 		constant.AccountTypeChecking,
 		"USD",
+		"",
+		"",
 	)
 
 	if account != nil {
@@ -115,7 +122,7 @@ func TestCreateAccountInvalidName(t *testing.T) {
 	userRepo := &MockUserRepository{
 		userToReturn: testUser,
 	}
-	service := NewAccountService(accountRepo, userRepo)
+	service := NewAccountService(accountRepo, userRepo, &MockTransactionManager{}, newTestConverter())
 
 	account, err := service.CreateAccount(
 		context.Background(),
@@ -123,6 +130,8 @@ func TestCreateAccountInvalidName(t *testing.T) {
 		"",
 		constant.AccountTypeChecking,
 		"USD",
+		"",
+		"",
 	)
 
 	if account != nil {
@@ -141,7 +150,7 @@ func TestCreateAccountInvalidCurrency(t *testing.T) {
 	userRepo := &MockUserRepository{
 		userToReturn: testUser,
 	}
-	service := NewAccountService(accountRepo, userRepo)
+	service := NewAccountService(accountRepo, userRepo, &MockTransactionManager{}, newTestConverter())
 
 	account, err := service.CreateAccount(
 		context.Background(),
@@ -149,6 +158,8 @@ func TestCreateAccountInvalidCurrency(t *testing.T) {
 		"Checking Account",
 		constant.AccountTypeChecking,
 		"",
+		"",
+		"",
 	)
 
 	if account != nil {
@@ -161,13 +172,32 @@ func TestCreateAccountInvalidCurrency(t *testing.T) {
 	}
 }
 
+func TestCreateAccountUnknownCurrency(t *testing.T) {
+	accountRepo := &MockAccountRepository{}
+	userRepo := &MockUserRepository{
+		userToReturn: NewTestUser(),
+	}
+	service := NewAccountService(accountRepo, userRepo, &MockTransactionManager{}, newTestConverter())
+
+	_, err := service.CreateAccount(context.Background(), "test-user-123", "Checking Account", constant.AccountTypeChecking, "ABC", "", "")
+
+	var invalidErr *domainerrors.ErrInvalidInput
+	if !errors.As(err, &invalidErr) {
+		t.Errorf("expected ErrInvalidInput, got %T", err)
+	}
+
+	if accountRepo.createCalls != 0 {
+		t.Errorf("expected no create call, got %d", accountRepo.createCalls)
+	}
+}
+
 func TestGetAccountSuccess(t *testing.T) {
 	testAccount := NewTestAccount()
 	accountRepo := &MockAccountRepository{
 		accountToReturn: testAccount,
 	}
 	userRepo := &MockUserRepository{}
-	service := NewAccountService(accountRepo, userRepo)
+	service := NewAccountService(accountRepo, userRepo, &MockTransactionManager{}, newTestConverter())
 
 	account, err := service.GetAccount(context.Background(), "test-account-123")
 
@@ -191,7 +221,7 @@ func TestGetAccountSuccess(t *testing.T) {
 func TestGetAccountNotFound(t *testing.T) {
 	accountRepo := &MockAccountRepository{}
 	userRepo := &MockUserRepository{}
-	service := NewAccountService(accountRepo, userRepo)
+	service := NewAccountService(accountRepo, userRepo, &MockTransactionManager{}, newTestConverter())
 
 	account, err := service.GetAccount(context.Background(), "nonexistent-account")
 
@@ -210,7 +240,7 @@ func TestUpdateAccountSuccess(t *testing.T) {
 		accountToReturn: testAccount,
 	}
 	userRepo := &MockUserRepository{}
-	service := NewAccountService(accountRepo, userRepo)
+	service := NewAccountService(accountRepo, userRepo, &MockTransactionManager{}, newTestConverter())
 
 	updatedAccount, err := service.UpdateAccount(
 		context.Background(),
@@ -218,6 +248,8 @@ func TestUpdateAccountSuccess(t *testing.T) {
 		"Updated Account",
 		constant.AccountTypeSavings,
 		"EUR",
+		"",
+		"",
 	)
 
 	if err != nil {
@@ -251,7 +283,7 @@ func TestUpdateAccountPartial(t *testing.T) {
 		accountToReturn: testAccount,
 	}
 	userRepo := &MockUserRepository{}
-	service := NewAccountService(accountRepo, userRepo)
+	service := NewAccountService(accountRepo, userRepo, &MockTransactionManager{}, newTestConverter())
 
 	updatedAccount, err := service.UpdateAccount(
 		context.Background(),
@@ -259,6 +291,8 @@ func TestUpdateAccountPartial(t *testing.T) {
 		"Updated Account",
 		"",
 		"",
+		"",
+		"",
 	)
 
 	if err != nil {
@@ -281,7 +315,7 @@ func TestUpdateAccountPartial(t *testing.T) {
 func TestUpdateAccountNotFound(t *testing.T) {
 	accountRepo := &MockAccountRepository{}
 	userRepo := &MockUserRepository{}
-	service := NewAccountService(accountRepo, userRepo)
+	service := NewAccountService(accountRepo, userRepo, &MockTransactionManager{}, newTestConverter())
 
 	updatedAccount, err := service.UpdateAccount(
 		context.Background(),
@@ -289,6 +323,8 @@ func TestUpdateAccountNotFound(t *testing.T) {
 		"Updated Account",
 		constant.AccountTypeSavings,
 		"EUR",
+		"",
+		"",
 	)
 
 	if updatedAccount != nil {
@@ -301,10 +337,85 @@ func TestUpdateAccountNotFound(t *testing.T) {
 	}
 }
 
+func TestUpdateAccountCurrencyOfUsedAccount(t *testing.T) {
+	tests := []struct {
+		name     string
+		activity entity.AccountActivity
+	}{
+		{"transactions", entity.AccountActivity{Transactions: 2}},
+		{"journal postings", entity.AccountActivity{Postings: 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accountRepo := &MockAccountRepository{
+				accountToReturn:  NewTestAccount(),
+				activityToReturn: tt.activity,
+			}
+			service := NewAccountService(accountRepo, &MockUserRepository{}, &MockTransactionManager{}, newTestConverter())
+
+			_, err := service.UpdateAccount(context.Background(), "test-account-123", "", "", "EUR", "", "")
+
+			var invalidErr *domainerrors.ErrInvalidInput
+			if !errors.As(err, &invalidErr) || invalidErr.Field != "currency" {
+				t.Fatalf("expected invalid currency, got %v", err)
+			}
+			if accountRepo.updateCalls != 0 {
+				t.Errorf("expected no update call, got %d", accountRepo.updateCalls)
+			}
+
+			// Keeping the currency, or leaving it out, is still allowed
+			if _, err := service.UpdateAccount(context.Background(), "test-account-123", "Renamed", "", "USD", "", ""); err != nil {
+				t.Errorf("expected no error keeping the currency, got %v", err)
+			}
+		})
+	}
+}
+
+func TestUpdateAccountTypeOfUsedAccount(t *testing.T) {
+	tests := []struct {
+		name     string
+		activity entity.AccountActivity
+	}{
+		{"transactions", entity.AccountActivity{Transactions: 2}},
+		{"journal postings", entity.AccountActivity{Postings: 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accountRepo := &MockAccountRepository{
+				accountToReturn:  NewTestAccount(),
+				activityToReturn: tt.activity,
+			}
+			service := NewAccountService(accountRepo, &MockUserRepository{}, &MockTransactionManager{}, newTestConverter())
+
+			// A credit-normal type would replay the history with the opposite sign
+			_, err := service.UpdateAccount(context.Background(), "test-account-123", "", constant.AccountTypeEquity, "", "", "")
+
+			var invalidErr *domainerrors.ErrInvalidInput
+			if !errors.As(err, &invalidErr) || invalidErr.Field != "type" {
+				t.Fatalf("expected invalid type, got %v", err)
+			}
+			if accountRepo.updateCalls != 0 {
+				t.Errorf("expected no update call, got %d", accountRepo.updateCalls)
+			}
+
+			// Another debit-normal type is still allowed
+			updated, err := service.UpdateAccount(context.Background(), "test-account-123", "", constant.AccountTypeSavings, "", "", "")
+			if err != nil || updated.Type != constant.AccountTypeSavings {
+				t.Errorf("expected the account to become SAVINGS, got %+v, %v", updated, err)
+			}
+		})
+	}
+}
+
 func TestDeleteAccountSuccess(t *testing.T) {
-	accountRepo := &MockAccountRepository{}
+	accountRepo := &MockAccountRepository{
+		accountToReturn:  NewTestAccount(),
+		activityToReturn: entity.AccountActivity{Transactions: 3},
+	}
 	userRepo := &MockUserRepository{}
-	service := NewAccountService(accountRepo, userRepo)
+	service := NewAccountService(accountRepo, userRepo, &MockTransactionManager{}, newTestConverter())
 
 	err := service.DeleteAccount(context.Background(), "test-account-123")
 
@@ -317,6 +428,51 @@ func TestDeleteAccountSuccess(t *testing.T) {
 	}
 }
 
+func TestDeleteAccountWithTransfersOrPostings(t *testing.T) {
+	tests := []struct {
+		name     string
+		activity entity.AccountActivity
+	}{
+		{"transfer legs", entity.AccountActivity{Transactions: 2, TransferLegs: 1}},
+		{"journal postings", entity.AccountActivity{Postings: 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accountRepo := &MockAccountRepository{
+				accountToReturn:  NewTestAccount(),
+				activityToReturn: tt.activity,
+			}
+			service := NewAccountService(accountRepo, &MockUserRepository{}, &MockTransactionManager{}, newTestConverter())
+
+			err := service.DeleteAccount(context.Background(), "test-account-123")
+
+			var invalidErr *domainerrors.ErrInvalidInput
+			if !errors.As(err, &invalidErr) || invalidErr.Field != "id" {
+				t.Fatalf("expected invalid id, got %v", err)
+			}
+			if accountRepo.deleteCalls != 0 {
+				t.Errorf("expected no delete call, got %d", accountRepo.deleteCalls)
+			}
+		})
+	}
+}
+
+func TestDeleteAccountNotFound(t *testing.T) {
+	accountRepo := &MockAccountRepository{}
+	service := NewAccountService(accountRepo, &MockUserRepository{}, &MockTransactionManager{}, newTestConverter())
+
+	err := service.DeleteAccount(context.Background(), "nonexistent-account")
+
+	var notFoundErr *domainerrors.ErrNotFound
+	if !errors.As(err, &notFoundErr) {
+		t.Errorf("expected ErrNotFound, got %T", err)
+	}
+	if accountRepo.deleteCalls != 0 {
+		t.Errorf("expected no delete call, got %d", accountRepo.deleteCalls)
+	}
+}
+
 func TestListUserAccountsSuccess(t *testing.T) {
 	accounts := []*entity.Account{
 		NewTestAccount(),
@@ -325,17 +481,18 @@ func TestListUserAccountsSuccess(t *testing.T) {
 			UserID:   "test-user-123",
 			Name:     "Savings",
 			Type:     constant.AccountTypeSavings,
-			Balance:  5000.00,
+			Balance:  money.MustParse("5000.00", "USD"),
 			Currency: "USD",
 		},
 	}
 	accountRepo := &MockAccountRepository{
 		accountsListToReturn: accounts,
+		nextCursorToReturn:   "next-page",
 	}
 	userRepo := &MockUserRepository{}
-	service := NewAccountService(accountRepo, userRepo)
+	service := NewAccountService(accountRepo, userRepo, &MockTransactionManager{}, newTestConverter())
 
-	result, err := service.ListUserAccounts(context.Background(), "test-user-123")
+	result, nextCursor, err := service.ListUserAccounts(context.Background(), "test-user-123", entity.PageRequest{Limit: 2})
 
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
@@ -345,7 +502,50 @@ func TestListUserAccountsSuccess(t *testing.T) {
 		t.Errorf("expected 2 accounts, got %d", len(result))
 	}
 
+	if nextCursor != "next-page" {
+		t.Errorf("expected next cursor %q, got %q", "next-page", nextCursor)
+	}
+
+	if accountRepo.lastPage.Limit != 2 {
+		t.Errorf("expected limit 2 to reach the repository, got %d", accountRepo.lastPage.Limit)
+	}
+
 	if accountRepo.listByUserIDCalls != 1 {
 		t.Errorf("expected 1 listByUserID call, got %d", accountRepo.listByUserIDCalls)
 	}
 }
+
+func TestListUserAccountsDefaultLimit(t *testing.T) {
+	accountRepo := &MockAccountRepository{}
+	service := NewAccountService(accountRepo, &MockUserRepository{}, &MockTransactionManager{}, newTestConverter())
+
+	_, _, err := service.ListUserAccounts(context.Background(), "test-user-123", entity.PageRequest{Cursor: "abc"})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if accountRepo.lastPage.Limit != entity.DefaultPageLimit {
+		t.Errorf("expected default limit %d, got %d", entity.DefaultPageLimit, accountRepo.lastPage.Limit)
+	}
+
+	if accountRepo.lastPage.Cursor != "abc" {
+		t.Errorf("expected cursor %q, got %q", "abc", accountRepo.lastPage.Cursor)
+	}
+}
+
+func TestListUserAccountsLimitTooLarge(t *testing.T) {
+	accountRepo := &MockAccountRepository{}
+	service := NewAccountService(accountRepo, &MockUserRepository{}, &MockTransactionManager{}, newTestConverter())
+
+	_, _, err := service.ListUserAccounts(context.Background(), "test-user-123", entity.PageRequest{Limit: entity.MaxPageLimit + 1})
+
+	var invalidErr *domainerrors.ErrInvalidInput
+	if !errors.As(err, &invalidErr) {
+		t.Errorf("expected ErrInvalidInput, got %T", err)
+	}
+
+	if accountRepo.listByUserIDCalls != 0 {
+		t.Errorf("expected no listByUserID call, got %d", accountRepo.listByUserIDCalls)
+	}
+}
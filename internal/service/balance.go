@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/domain/money"
+)
+
+// lockAccounts loads and row-locks the given accounts, keyed by ID.
+// Accounts are locked in ID order so concurrent callers cannot deadlock.
+func lockAccounts(ctx context.Context, accountRepo interfaces.AccountRepository, ids ...string) (map[string]*entity.Account, error) {
+	sorted := slices.Clone(ids)
+	slices.Sort(sorted)
+	sorted = slices.Compact(sorted)
+
+	accounts := make(map[string]*entity.Account, len(sorted))
+	for _, id := range sorted {
+		account, err := accountRepo.GetByIDForUpdate(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("verifying account: %w", err)
+		}
+		if account == nil {
+			return nil, domainerrors.NewErrNotFound("account", id)
+		}
+		accounts[id] = account
+	}
+
+	return accounts, nil
+}
+
+// saveAccounts persists the balances of accounts loaded by lockAccounts.
+func saveAccounts(ctx context.Context, accountRepo interfaces.AccountRepository, accounts map[string]*entity.Account) error {
+	ids := slices.Sorted(maps.Keys(accounts))
+	for _, id := range ids {
+		if err := accountRepo.Update(ctx, accounts[id]); err != nil {
+			return fmt.Errorf("updating account balance: %w", err)
+		}
+	}
+	return nil
+}
+
+// denominate sets the transaction amount to source expressed in the account's
+// currency. When the currencies differ, source is converted at the rate
+// effective on the transaction date and kept as the original amount.
+func denominate(ctx context.Context, converter interfaces.CurrencyConverter, transaction *entity.Transaction, source money.Money, account *entity.Account) error {
+	if source.Currency() == account.Currency {
+		transaction.Amount = source
+		transaction.OriginalAmount = money.Money{}
+		transaction.ExchangeRate = money.Rate{}
+		return nil
+	}
+
+	converted, rate, err := converter.Convert(ctx, source, account.Currency, transaction.Date)
+	if err != nil {
+		return err
+	}
+	if !converted.IsPositive() {
+		return domainerrors.NewErrInvalidInput("amount", "amount is zero once converted to account currency "+account.Currency)
+	}
+
+	transaction.Amount = converted
+	transaction.OriginalAmount = source
+	transaction.ExchangeRate = rate
+	return nil
+}
+
+// sourceAmount returns the amount a transaction was entered with, before any conversion.
+func sourceAmount(transaction *entity.Transaction) money.Money {
+	if transaction.OriginalAmount.Currency() != "" {
+		return transaction.OriginalAmount
+	}
+	return transaction.Amount
+}
+
+// convertTotal converts each amount into currency at the rates effective on
+// date and returns their sum.
+func convertTotal(ctx context.Context, converter interfaces.CurrencyConverter, amounts []money.Money, currency string, date time.Time) (money.Money, error) {
+	total := money.Zero(currency)
+	for _, amount := range amounts {
+		converted, _, err := converter.Convert(ctx, amount, currency, date)
+		if err != nil {
+			return money.Money{}, err
+		}
+		if total, err = total.Add(converted); err != nil {
+			return money.Money{}, fmt.Errorf("totalling in %s: %w", currency, err)
+		}
+	}
+	return total, nil
+}
+
+// calendarDay truncates a timestamp to the start of its calendar day in UTC,
+// the day that exchange rates and balance snapshots are keyed by.
+func calendarDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+// balanceEffect returns the signed amount a transaction adds to its account balance.
+func balanceEffect(transaction *entity.Transaction) money.Money {
+	switch transaction.Type {
+	case constant.TransactionTypeIncome:
+		return transaction.Amount
+	case constant.TransactionTypeExpense:
+		return transaction.Amount.Negate()
+	case constant.TransactionTypeTransfer:
+		if transaction.TransferDirection == constant.TransferDirectionOut {
+			return transaction.Amount.Negate()
+		}
+		return transaction.Amount
+	default:
+		return money.Zero(transaction.Amount.Currency())
+	}
+}
+
+// applyBalanceEffect adds the transaction's effect to the account balance,
+// or removes it when reverse is true.
+func applyBalanceEffect(account *entity.Account, transaction *entity.Transaction, reverse bool) error {
+	effect := balanceEffect(transaction)
+	if reverse {
+		effect = effect.Negate()
+	}
+
+	balance, err := account.Balance.Add(effect)
+	if err != nil {
+		return fmt.Errorf("calculating account balance: %w", err)
+	}
+	account.Balance = balance
+	return nil
+}
+
+// postingEffect returns the signed amount a journal posting adds to the balance
+// of its account. Debits increase debit-normal accounts and credits increase
+// credit-normal ones.
+func postingEffect(posting *entity.Posting, account *entity.Account) money.Money {
+	increases := posting.Direction == constant.PostingDirectionDebit
+	if account.Type.IsCreditNormal() {
+		increases = !increases
+	}
+	if increases {
+		return posting.Amount
+	}
+	return posting.Amount.Negate()
+}
+
+// applyPostingEffect adds the posting's effect to the account balance,
+// or removes it when reverse is true.
+func applyPostingEffect(account *entity.Account, posting *entity.Posting, reverse bool) error {
+	effect := postingEffect(posting, account)
+	if reverse {
+		effect = effect.Negate()
+	}
+
+	balance, err := account.Balance.Add(effect)
+	if err != nil {
+		return fmt.Errorf("calculating account balance: %w", err)
+	}
+	account.Balance = balance
+	return nil
+}
+
+// movementEffect returns the signed amount a day's movement adds to the
+// account balance, treating the day's postings like postingEffect does.
+func movementEffect(movement *entity.DailyMovement, account *entity.Account) (money.Money, error) {
+	postings, err := movement.Debits.Sub(movement.Credits)
+	if err != nil {
+		return money.Money{}, err
+	}
+	if account.Type.IsCreditNormal() {
+		postings = postings.Negate()
+	}
+	return movement.Net.Add(postings)
+}
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/domain/money"
+)
+
+// MaxBalanceHistoryDays is the longest range GetDailyBalances accepts.
+const MaxBalanceHistoryDays = 3660
+
+type BalanceService struct {
+	accountRepo interfaces.AccountRepository
+	balanceRepo interfaces.BalanceRepository
+	txManager   interfaces.TransactionManager
+}
+
+func NewBalanceService(accountRepo interfaces.AccountRepository, balanceRepo interfaces.BalanceRepository, txManager interfaces.TransactionManager) *BalanceService {
+	return &BalanceService{
+		accountRepo: accountRepo,
+		balanceRepo: balanceRepo,
+		txManager:   txManager,
+	}
+}
+
+func (s *BalanceService) GetBalanceAsOf(ctx context.Context, accountID string, day time.Time) (*entity.DailyBalance, error) {
+	if day.IsZero() {
+		return nil, domainerrors.NewErrInvalidInput("as_of", "as_of is required")
+	}
+	day = calendarDay(day)
+
+	balances, err := s.replay(ctx, accountID, day, day)
+	if err != nil {
+		return nil, err
+	}
+	return balances[0], nil
+}
+
+func (s *BalanceService) GetDailyBalances(ctx context.Context, accountID string, from, to time.Time) ([]*entity.DailyBalance, error) {
+	if from.IsZero() {
+		return nil, domainerrors.NewErrInvalidInput("from", "from is required")
+	}
+	if to.IsZero() {
+		return nil, domainerrors.NewErrInvalidInput("to", "to is required")
+	}
+	from, to = calendarDay(from), calendarDay(to)
+	if to.Before(from) {
+		return nil, domainerrors.NewErrInvalidInput("to", "to must not be before from")
+	}
+	if to.Sub(from) >= MaxBalanceHistoryDays*24*time.Hour {
+		return nil, domainerrors.NewErrInvalidInput("to", fmt.Sprintf("the range may span at most %d days", MaxBalanceHistoryDays))
+	}
+
+	return s.replay(ctx, accountID, from, to)
+}
+
+// replay returns the end-of-day balances of the account for the days in
+// [from, to], replaying the history booked after the latest snapshot before
+// from. Month-end balances of elapsed days met on the way are stored as
+// snapshots, so the next replay starts from there.
+func (s *BalanceService) replay(ctx context.Context, accountID string, from, to time.Time) ([]*entity.DailyBalance, error) {
+	account, err := s.accountRepo.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("getting account: %w", err)
+	}
+	if account == nil {
+		return nil, domainerrors.NewErrNotFound("account", accountID)
+	}
+
+	balances, snapshots, err := s.replayAccount(ctx, account, from, to)
+	if err != nil || len(snapshots) == 0 {
+		return balances, err
+	}
+
+	// Storing snapshots locks the account row: writers lock it too, so no
+	// booking can slip in between reading the history and storing a snapshot
+	// of it. The history is replayed again as it may have changed meanwhile.
+	err = s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		account, err := s.accountRepo.GetByIDForUpdate(ctx, accountID)
+		if err != nil {
+			return fmt.Errorf("getting account: %w", err)
+		}
+		if account == nil {
+			return domainerrors.NewErrNotFound("account", accountID)
+		}
+
+		if balances, snapshots, err = s.replayAccount(ctx, account, from, to); err != nil {
+			return err
+		}
+		for _, snapshot := range snapshots {
+			if err := s.balanceRepo.UpsertSnapshot(ctx, snapshot); err != nil {
+				return fmt.Errorf("storing balance snapshot: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return balances, nil
+}
+
+// replayAccount computes the balances replay returns, together with the
+// month-end snapshots of elapsed days that are not stored yet.
+func (s *BalanceService) replayAccount(ctx context.Context, account *entity.Account, from, to time.Time) ([]*entity.DailyBalance, []*entity.BalanceSnapshot, error) {
+	balance := money.Zero(account.Currency)
+	var start time.Time
+	snapshot, err := s.balanceRepo.GetLatestSnapshot(ctx, account.ID, account.Currency, from.AddDate(0, 0, -1))
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting balance snapshot: %w", err)
+	}
+	if snapshot != nil {
+		balance = snapshot.Balance
+		start = snapshot.Date.AddDate(0, 0, 1)
+	}
+
+	end := to.AddDate(0, 0, 1)
+	movements, err := s.balanceRepo.ListDailyMovements(ctx, account.ID, account.Currency, start, end)
+	if err != nil {
+		return nil, nil, fmt.Errorf("listing balance movements: %w", err)
+	}
+	if start.IsZero() {
+		start = from
+		if len(movements) > 0 && movements[0].Date.Before(from) {
+			start = movements[0].Date
+		}
+	}
+
+	// Snapshots are stored for every month end replayed through and dropped
+	// from a day onwards when the history changes, so those up to the latest
+	// one are all in place
+	stored, err := s.balanceRepo.GetLatestSnapshot(ctx, account.ID, account.Currency, to)
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting balance snapshot: %w", err)
+	}
+
+	var balances []*entity.DailyBalance
+	var snapshots []*entity.BalanceSnapshot
+	today := calendarDay(time.Now())
+	next := 0
+	for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+		for ; next < len(movements) && !movements[next].Date.After(day); next++ {
+			effect, err := movementEffect(movements[next], account)
+			if err != nil {
+				return nil, nil, fmt.Errorf("replaying balance of account %s: %w", account.ID, err)
+			}
+			if balance, err = balance.Add(effect); err != nil {
+				return nil, nil, fmt.Errorf("replaying balance of account %s: %w", account.ID, err)
+			}
+		}
+
+		if !day.Before(from) {
+			balances = append(balances, &entity.DailyBalance{Date: day, Balance: balance})
+		}
+		isMonthEnd := day.AddDate(0, 0, 1).Day() == 1
+		if isMonthEnd && day.Before(today) && (stored == nil || day.After(stored.Date)) {
+			snapshots = append(snapshots, &entity.BalanceSnapshot{AccountID: account.ID, Date: day, Balance: balance})
+		}
+	}
+	return balances, snapshots, nil
+}
+
+// Compile-time interface check
+var _ interfaces.BalanceService = (*BalanceService)(nil)
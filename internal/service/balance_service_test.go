@@ -0,0 +1,252 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/money"
+)
+
+func testDay(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+func newTestMovement(date time.Time, net, debits, credits string) *entity.DailyMovement {
+	return &entity.DailyMovement{
+		Date:    date,
+		Net:     money.MustParse(net, "USD"),
+		Debits:  money.MustParse(debits, "USD"),
+		Credits: money.MustParse(credits, "USD"),
+	}
+}
+
+func newTestBalanceService(account *entity.Account, balanceRepo *MockBalanceRepository) *BalanceService {
+	accountRepo := &MockAccountRepository{accountToReturn: account}
+	return NewBalanceService(accountRepo, balanceRepo, &MockTransactionManager{})
+}
+
+func TestGetBalanceAsOfReplaysHistory(t *testing.T) {
+	balanceRepo := &MockBalanceRepository{
+		movements: []*entity.DailyMovement{
+			newTestMovement(testDay(2024, 1, 5), "100", "0", "0"),
+			newTestMovement(testDay(2024, 1, 20), "-30", "0", "0"),
+			newTestMovement(testDay(2024, 2, 3), "50", "0", "0"),
+		},
+	}
+	service := newTestBalanceService(NewTestAccount(), balanceRepo)
+
+	tests := []struct {
+		asOf time.Time
+		want string
+	}{
+		{testDay(2023, 12, 31), "0"},
+		{testDay(2024, 1, 5), "100"},
+		{testDay(2024, 1, 31), "70"},
+		{time.Date(2024, 2, 10, 15, 30, 0, 0, time.UTC), "120"},
+	}
+	for _, tt := range tests {
+		balance, err := service.GetBalanceAsOf(context.Background(), "test-account-123", tt.asOf)
+		if err != nil {
+			t.Fatalf("as of %s: expected no error, got %v", tt.asOf, err)
+		}
+		if balance.Balance != money.MustParse(tt.want, "USD") {
+			t.Errorf("as of %s: expected balance %s, got %s", tt.asOf, tt.want, balance.Balance)
+		}
+		if !balance.Date.Equal(calendarDay(tt.asOf)) {
+			t.Errorf("expected date %s, got %s", calendarDay(tt.asOf), balance.Date)
+		}
+	}
+}
+
+func TestGetBalanceAsOfStoresMonthEndSnapshots(t *testing.T) {
+	balanceRepo := &MockBalanceRepository{
+		movements: []*entity.DailyMovement{
+			newTestMovement(testDay(2024, 1, 5), "100", "0", "0"),
+			newTestMovement(testDay(2024, 2, 3), "50", "0", "0"),
+		},
+	}
+	service := newTestBalanceService(NewTestAccount(), balanceRepo)
+
+	if _, err := service.GetBalanceAsOf(context.Background(), "test-account-123", testDay(2024, 3, 15)); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(balanceRepo.snapshots) != 2 {
+		t.Fatalf("expected snapshots for January and February, got %d", len(balanceRepo.snapshots))
+	}
+	if !balanceRepo.snapshots[1].Date.Equal(testDay(2024, 2, 29)) || balanceRepo.snapshots[1].Balance != money.MustParse("150", "USD") {
+		t.Errorf("expected 150.00 on 2024-02-29, got %s on %s", balanceRepo.snapshots[1].Balance, balanceRepo.snapshots[1].Date)
+	}
+
+	// A later query starts from the snapshot instead of the beginning
+	balance, err := service.GetBalanceAsOf(context.Background(), "test-account-123", testDay(2024, 3, 20))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !balanceRepo.lastMovementsFrom.Equal(testDay(2024, 3, 1)) {
+		t.Errorf("expected replay from 2024-03-01, got %s", balanceRepo.lastMovementsFrom)
+	}
+	if balance.Balance != money.MustParse("150", "USD") {
+		t.Errorf("expected balance 150.00, got %s", balance.Balance)
+	}
+}
+
+func TestGetDailyBalancesLocksOnlyToStoreSnapshots(t *testing.T) {
+	balanceRepo := &MockBalanceRepository{
+		movements: []*entity.DailyMovement{
+			newTestMovement(testDay(2024, 1, 5), "100", "0", "0"),
+			newTestMovement(testDay(2024, 2, 3), "50", "0", "0"),
+		},
+	}
+	accountRepo := &MockAccountRepository{accountToReturn: NewTestAccount()}
+	service := NewBalanceService(accountRepo, balanceRepo, &MockTransactionManager{})
+
+	if _, err := service.GetDailyBalances(context.Background(), "test-account-123", testDay(2024, 1, 1), testDay(2024, 3, 15)); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if accountRepo.getByIDForUpdateCalls != 1 || balanceRepo.upsertSnapshotCalls != 2 {
+		t.Fatalf("expected one lock to store two snapshots, got %d locks and %d snapshots", accountRepo.getByIDForUpdateCalls, balanceRepo.upsertSnapshotCalls)
+	}
+
+	// Reading the same range again finds the snapshots stored, in and after it
+	for _, day := range []time.Time{testDay(2024, 1, 1), testDay(2024, 2, 10), testDay(2024, 3, 20)} {
+		balances, err := service.GetDailyBalances(context.Background(), "test-account-123", day, testDay(2024, 3, 20))
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if last := balances[len(balances)-1]; last.Balance != money.MustParse("150", "USD") {
+			t.Errorf("expected balance 150.00, got %s", last.Balance)
+		}
+	}
+	if accountRepo.getByIDForUpdateCalls != 1 || balanceRepo.upsertSnapshotCalls != 2 {
+		t.Errorf("expected reads not to lock the account, got %d locks and %d snapshots", accountRepo.getByIDForUpdateCalls, balanceRepo.upsertSnapshotCalls)
+	}
+}
+
+func TestGetBalanceAsOfStartsFromSnapshot(t *testing.T) {
+	balanceRepo := &MockBalanceRepository{
+		movements: []*entity.DailyMovement{
+			newTestMovement(testDay(2024, 1, 5), "100", "0", "0"),
+			newTestMovement(testDay(2024, 2, 3), "50", "0", "0"),
+		},
+		snapshots: []*entity.BalanceSnapshot{
+			{AccountID: "test-account-123", Date: testDay(2024, 1, 31), Balance: money.MustParse("1000", "USD")},
+		},
+	}
+	service := newTestBalanceService(NewTestAccount(), balanceRepo)
+
+	balance, err := service.GetBalanceAsOf(context.Background(), "test-account-123", testDay(2024, 2, 10))
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if balance.Balance != money.MustParse("1050", "USD") {
+		t.Errorf("expected balance 1050.00 from the snapshot, got %s", balance.Balance)
+	}
+	if !balanceRepo.lastMovementsFrom.Equal(testDay(2024, 2, 1)) {
+		t.Errorf("expected replay from 2024-02-01, got %s", balanceRepo.lastMovementsFrom)
+	}
+}
+
+func TestGetBalanceAsOfAppliesPostings(t *testing.T) {
+	tests := []struct {
+		accountType constant.AccountType
+		want        string
+	}{
+		{constant.AccountTypeChecking, "-15"},
+		{constant.AccountTypeIncome, "15"},
+	}
+	for _, tt := range tests {
+		account := NewTestAccount()
+		account.Type = tt.accountType
+		balanceRepo := &MockBalanceRepository{
+			movements: []*entity.DailyMovement{
+				newTestMovement(testDay(2024, 1, 5), "0", "25", "40"),
+			},
+		}
+		service := newTestBalanceService(account, balanceRepo)
+
+		balance, err := service.GetBalanceAsOf(context.Background(), "test-account-123", testDay(2024, 1, 5))
+
+		if err != nil {
+			t.Fatalf("%s: expected no error, got %v", tt.accountType, err)
+		}
+		if balance.Balance != money.MustParse(tt.want, "USD") {
+			t.Errorf("%s: expected balance %s, got %s", tt.accountType, tt.want, balance.Balance)
+		}
+	}
+}
+
+func TestGetBalanceAsOfAccountNotFound(t *testing.T) {
+	service := newTestBalanceService(nil, &MockBalanceRepository{})
+
+	_, err := service.GetBalanceAsOf(context.Background(), "missing-account", testDay(2024, 1, 5))
+
+	var notFoundErr *domainerrors.ErrNotFound
+	if !errors.As(err, &notFoundErr) {
+		t.Errorf("expected ErrNotFound, got %T", err)
+	}
+}
+
+func TestGetDailyBalancesSuccess(t *testing.T) {
+	balanceRepo := &MockBalanceRepository{
+		movements: []*entity.DailyMovement{
+			newTestMovement(testDay(2024, 1, 5), "100", "0", "0"),
+			newTestMovement(testDay(2024, 1, 31), "-30", "0", "0"),
+			newTestMovement(testDay(2024, 2, 2), "50", "0", "0"),
+		},
+	}
+	service := newTestBalanceService(NewTestAccount(), balanceRepo)
+
+	balances, err := service.GetDailyBalances(context.Background(), "test-account-123", testDay(2024, 1, 30), testDay(2024, 2, 2))
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	want := []string{"100", "70", "70", "120"}
+	if len(balances) != len(want) {
+		t.Fatalf("expected %d balances, got %d", len(want), len(balances))
+	}
+	for i, balance := range balances {
+		if !balance.Date.Equal(testDay(2024, 1, 30).AddDate(0, 0, i)) {
+			t.Errorf("balance %d: expected date %s, got %s", i, testDay(2024, 1, 30).AddDate(0, 0, i), balance.Date)
+		}
+		if balance.Balance != money.MustParse(want[i], "USD") {
+			t.Errorf("balance %d: expected %s, got %s", i, want[i], balance.Balance)
+		}
+	}
+}
+
+func TestGetDailyBalancesInvalidRange(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to time.Time
+	}{
+		{"missing from", time.Time{}, testDay(2024, 1, 1)},
+		{"to before from", testDay(2024, 2, 1), testDay(2024, 1, 1)},
+		{"too long", testDay(2000, 1, 1), testDay(2024, 1, 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			balanceRepo := &MockBalanceRepository{}
+			service := newTestBalanceService(NewTestAccount(), balanceRepo)
+
+			_, err := service.GetDailyBalances(context.Background(), "test-account-123", tt.from, tt.to)
+
+			var invalidErr *domainerrors.ErrInvalidInput
+			if !errors.As(err, &invalidErr) {
+				t.Fatalf("expected ErrInvalidInput, got %T", err)
+			}
+			if balanceRepo.listDailyMovementsCalls != 0 {
+				t.Errorf("expected no listDailyMovements call, got %d", balanceRepo.listDailyMovementsCalls)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"accounting/internal/domain/constant"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/money"
+	"accounting/internal/pkg/iso4217"
+)
+
+// readBooking fills in the direction, amount and date of a row from a bank
+// statement entry that gives a positive amount with a credit/debit mark.
+// The row is dated on the day the entry was booked, or on its value date
+// when the statement leaves out the booking date.
+func (row *statementRow) readBooking(value, currency string, credit bool, bookingDate, valueDate time.Time) error {
+	row.date = bookingDate
+	if row.date.IsZero() {
+		row.date = valueDate
+	}
+	if row.date.IsZero() {
+		return errors.New("the booking date is missing")
+	}
+
+	if !iso4217.IsValid(currency) {
+		return fmt.Errorf("the entry has the unknown currency %q", currency)
+	}
+	amount, err := money.Parse(value, currency)
+	if err != nil {
+		return fmt.Errorf("amount %q is not a valid %s amount", value, currency)
+	}
+	if amount.IsZero() {
+		return errors.New("the amount is zero")
+	}
+
+	row.amount = amount
+	row.transactionType = constant.TransactionTypeExpense
+	if credit {
+		row.transactionType = constant.TransactionTypeIncome
+	}
+	return nil
+}
+
+// checkSingleAccount verifies that the statements of a file, given by their
+// account identifications, all belong to the same account.
+func checkSingleAccount(accounts []string) error {
+	for _, account := range accounts[1:] {
+		if account != accounts[0] {
+			return domainerrors.NewErrInvalidInput("file", fmt.Sprintf("the file holds the statements of the accounts %s and %s; only one can be imported into an account", accounts[0], account))
+		}
+	}
+	return nil
+}
+
+// statementDescription combines the counterparty and the details of a
+// transaction, leaving out details that repeat the counterparty.
+func statementDescription(name, memo string) string {
+	switch {
+	case memo == "" || memo == name:
+		return name
+	case name == "":
+		return memo
+	default:
+		return name + " - " + memo
+	}
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/pkg/camt"
+)
+
+// readCamt053Statement reads the entries of a camt.053 statement. A file may
+// hold several statements, such as one per day, as long as they are all of the
+// same account. Entries may be in other currencies than the account's; those
+// without a currency are in the statement's currency, or in the account's.
+func readCamt053Statement(data io.Reader, accountCurrency string) ([]*statementRow, error) {
+	statements, err := camt.Parse(data)
+	var syntaxErr *camt.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		return nil, domainerrors.NewErrInvalidInput("file", "the file is not a valid camt.053 statement: "+syntaxErr.Error())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading statement: %w", err)
+	}
+
+	accounts := make([]string, 0, len(statements))
+	var entries int
+	for _, statement := range statements {
+		accounts = append(accounts, statement.Account)
+		entries += len(statement.Entries)
+	}
+	if err := checkSingleAccount(accounts); err != nil {
+		return nil, err
+	}
+	if entries > MaxImportRows {
+		return nil, domainerrors.NewErrInvalidInput("file", fmt.Sprintf("a statement may hold at most %d rows", MaxImportRows))
+	}
+
+	rows := make([]*statementRow, 0, entries)
+	for _, statement := range statements {
+		for _, entry := range statement.Entries {
+			row := &statementRow{line: entry.Line, externalID: entry.Reference}
+			row.err = row.readCamt053(entry, accountCurrency)
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+// readCamt053 fills in the row from a statement entry and returns why it
+// cannot be imported, if it cannot. Only booked entries are imported, since
+// pending ones may still change or be cancelled.
+func (row *statementRow) readCamt053(entry *camt.Entry, accountCurrency string) error {
+	if entry.Status != "" && entry.Status != camt.StatusBooked {
+		return fmt.Errorf("the entry is not booked (status %s)", entry.Status)
+	}
+
+	currency := entry.Currency
+	if currency == "" {
+		currency = accountCurrency
+	}
+	if err := row.readBooking(entry.Amount, currency, entry.Credit, entry.BookingDate, entry.ValueDate); err != nil {
+		return err
+	}
+
+	row.description = statementDescription(entry.Counterparty, entry.RemittanceInfo)
+	if row.description == "" {
+		row.description = entry.AdditionalInfo
+	}
+	return nil
+}
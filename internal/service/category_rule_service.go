@@ -0,0 +1,268 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/domain/money"
+
+	"github.com/google/uuid"
+)
+
+type CategoryRuleService struct {
+	ruleRepo        interfaces.CategoryRuleRepository
+	userRepo        interfaces.UserRepository
+	accountRepo     interfaces.AccountRepository
+	categoryRepo    interfaces.CategoryRepository
+	transactionRepo interfaces.TransactionRepository
+	txManager       interfaces.TransactionManager
+}
+
+func NewCategoryRuleService(ruleRepo interfaces.CategoryRuleRepository, userRepo interfaces.UserRepository, accountRepo interfaces.AccountRepository, categoryRepo interfaces.CategoryRepository, transactionRepo interfaces.TransactionRepository, txManager interfaces.TransactionManager) *CategoryRuleService {
+	return &CategoryRuleService{
+		ruleRepo:        ruleRepo,
+		userRepo:        userRepo,
+		accountRepo:     accountRepo,
+		categoryRepo:    categoryRepo,
+		transactionRepo: transactionRepo,
+		txManager:       txManager,
+	}
+}
+
+func (s *CategoryRuleService) CreateRule(ctx context.Context, userID, name string, priority int, conditions entity.RuleConditions, actions entity.RuleActions) (*entity.CategoryRule, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, domainerrors.NewErrInvalidInput("name", "name is required")
+	}
+	if err := checkUser(ctx, s.userRepo, userID); err != nil {
+		return nil, err
+	}
+
+	rule := &entity.CategoryRule{
+		ID:         uuid.New().String(),
+		UserID:     userID,
+		Name:       name,
+		Priority:   priority,
+		Conditions: conditions,
+		Actions:    actions,
+	}
+	if err := s.validateRule(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	if err := s.ruleRepo.Create(ctx, rule); err != nil {
+		return nil, fmt.Errorf("creating category rule: %w", err)
+	}
+
+	return rule, nil
+}
+
+func (s *CategoryRuleService) GetRule(ctx context.Context, id string) (*entity.CategoryRule, error) {
+	return s.ruleRepo.GetByID(ctx, id)
+}
+
+func (s *CategoryRuleService) ListUserRules(ctx context.Context, userID string) ([]*entity.CategoryRule, error) {
+	if err := checkUser(ctx, s.userRepo, userID); err != nil {
+		return nil, err
+	}
+	return s.ruleRepo.ListByUserID(ctx, userID)
+}
+
+func (s *CategoryRuleService) UpdateRule(ctx context.Context, id, name string, priority *int, conditions *entity.RuleConditions, actions *entity.RuleActions) (*entity.CategoryRule, error) {
+	rule, err := s.ruleRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("getting category rule: %w", err)
+	}
+	if rule == nil {
+		return nil, domainerrors.NewErrNotFound("category rule", id)
+	}
+
+	if name = strings.TrimSpace(name); name != "" {
+		rule.Name = name
+	}
+	if priority != nil {
+		rule.Priority = *priority
+	}
+	if conditions != nil {
+		rule.Conditions = *conditions
+	}
+	if actions != nil {
+		rule.Actions = *actions
+	}
+	if err := s.validateRule(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	if err := s.ruleRepo.Update(ctx, rule); err != nil {
+		return nil, fmt.Errorf("updating category rule: %w", err)
+	}
+
+	return rule, nil
+}
+
+func (s *CategoryRuleService) DeleteRule(ctx context.Context, id string) error {
+	return s.ruleRepo.Delete(ctx, id)
+}
+
+func (s *CategoryRuleService) ApplyRules(ctx context.Context, userID string, uncategorizedOnly, dryRun bool) (*entity.RuleApplication, error) {
+	if err := checkUser(ctx, s.userRepo, userID); err != nil {
+		return nil, err
+	}
+
+	matchers, err := loadRuleMatchers(ctx, s.ruleRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Each page is applied in a database transaction of its own, so a long
+	// history is never held locked at once. Rules only change the category,
+	// description and tags, which leaves the date order of the pages intact.
+	result := &entity.RuleApplication{UserID: userID, DryRun: dryRun}
+	page := entity.PageRequest{Limit: entity.MaxPageLimit}
+	for {
+		var nextCursor string
+		err := s.txManager.WithTx(ctx, func(ctx context.Context) error {
+			var transactions []*entity.Transaction
+			var err error
+			transactions, nextCursor, err = s.transactionRepo.ListRuleCandidates(ctx, userID, uncategorizedOnly, page)
+			if err != nil {
+				return fmt.Errorf("listing transactions: %w", err)
+			}
+			return s.applyToPage(ctx, matchers, transactions, result)
+		})
+		if err != nil {
+			return nil, err
+		}
+		if nextCursor == "" {
+			return result, nil
+		}
+		page.Cursor = nextCursor
+	}
+}
+
+// applyToPage applies the first matching rule to each transaction of a page,
+// counting the matches and recording the changes in result, and saves the
+// changed transactions unless result is a dry run.
+func (s *CategoryRuleService) applyToPage(ctx context.Context, matchers []*ruleMatcher, transactions []*entity.Transaction, result *entity.RuleApplication) error {
+	for _, transaction := range transactions {
+		categorized := *transaction
+		rule := applyRuleMatchers(matchers, &categorized)
+		if rule == nil {
+			continue
+		}
+		result.Matched++
+		if categorized.CategoryID == transaction.CategoryID && categorized.Description == transaction.Description && slices.Equal(categorized.Tags, transaction.Tags) {
+			continue
+		}
+
+		result.Changes = append(result.Changes, &entity.RuleChange{
+			TransactionID:       transaction.ID,
+			RuleID:              rule.ID,
+			PreviousCategoryID:  transaction.CategoryID,
+			CategoryID:          categorized.CategoryID,
+			PreviousDescription: transaction.Description,
+			Description:         categorized.Description,
+			PreviousTags:        transaction.Tags,
+			Tags:                categorized.Tags,
+		})
+		if result.DryRun {
+			continue
+		}
+		// Only the category, description and tags change, so balances are unaffected
+		if err := s.transactionRepo.Update(ctx, &categorized); err != nil {
+			return fmt.Errorf("updating transaction: %w", err)
+		}
+	}
+	return nil
+}
+
+// validateRule checks that a rule has conditions and actions that can be
+// applied, and that its account and category belong to its user. A rule
+// setting a category restricted to one type is restricted to that type too.
+// The tags it sets are normalized.
+func (s *CategoryRuleService) validateRule(ctx context.Context, rule *entity.CategoryRule) error {
+	conditions := rule.Conditions
+	if conditions == (entity.RuleConditions{}) {
+		return domainerrors.NewErrInvalidInput("conditions", "a rule needs at least one condition")
+	}
+	actions := rule.Actions
+	if actions.CategoryID == "" && actions.Description == "" && len(actions.Tags) == 0 {
+		return domainerrors.NewErrInvalidInput("actions", "a rule needs at least one action")
+	}
+	var err error
+	if rule.Actions.Tags, err = normalizeTags("tags", actions.Tags); err != nil {
+		return err
+	}
+
+	if conditions.DescriptionPattern != "" {
+		if _, err := regexp.Compile(conditions.DescriptionPattern); err != nil {
+			return domainerrors.NewErrInvalidInput("description_pattern", "description_pattern is not a valid regular expression: "+err.Error())
+		}
+	}
+	if conditions.Type != "" && conditions.Type != constant.TransactionTypeIncome && conditions.Type != constant.TransactionTypeExpense {
+		return domainerrors.NewErrInvalidInput("type", "a rule applies to INCOME or EXPENSE transactions")
+	}
+
+	minAmount, maxAmount := conditions.MinAmount, conditions.MaxAmount
+	for _, bound := range []struct {
+		field  string
+		amount money.Money
+	}{
+		{"min_amount", minAmount},
+		{"max_amount", maxAmount},
+	} {
+		if bound.amount.Currency() == "" {
+			continue
+		}
+		if err := validateCurrency("currency", bound.amount.Currency()); err != nil {
+			return err
+		}
+		if bound.amount.IsNegative() {
+			return domainerrors.NewErrInvalidInput(bound.field, bound.field+" must not be negative")
+		}
+	}
+	if minAmount.Currency() != "" && maxAmount.Currency() != "" {
+		cmp, err := minAmount.Cmp(maxAmount)
+		if err != nil {
+			return domainerrors.NewErrInvalidInput("max_amount", "min_amount and max_amount must be in the same currency")
+		}
+		if cmp > 0 {
+			return domainerrors.NewErrInvalidInput("max_amount", "max_amount must not be less than min_amount")
+		}
+	}
+
+	if rule.Actions.CategoryID != "" {
+		category, err := checkCategory(ctx, s.categoryRepo, "category_id", rule.UserID, rule.Actions.CategoryID, conditions.Type)
+		if err != nil {
+			return err
+		}
+		if conditions.Type == "" {
+			rule.Conditions.Type = category.Type
+		}
+	}
+
+	if conditions.AccountID != "" {
+		account, err := s.accountRepo.GetByID(ctx, conditions.AccountID)
+		if err != nil {
+			return fmt.Errorf("getting account: %w", err)
+		}
+		if account == nil {
+			return domainerrors.NewErrNotFound("account", conditions.AccountID)
+		}
+		if account.UserID != rule.UserID {
+			return domainerrors.NewErrInvalidInput("account_id", "the account belongs to another user")
+		}
+	}
+
+	return nil
+}
+
+// Compile-time interface check
+var _ interfaces.CategoryRuleService = (*CategoryRuleService)(nil)
@@ -0,0 +1,421 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/money"
+)
+
+// newTestRules stores rules of the test user: coffee shops, which are also
+// tagged, large expenses and the salary paid into the test account.
+func newTestRules() *MockCategoryRuleRepository {
+	ruleRepo := &MockCategoryRuleRepository{}
+	for _, rule := range []*entity.CategoryRule{
+		{
+			ID:         "rule-coffee",
+			Name:       "Coffee",
+			Conditions: entity.RuleConditions{DescriptionContains: "starbucks"},
+			Actions:    entity.RuleActions{CategoryID: "category-coffee", Description: "Starbucks", Tags: []string{"coffee"}},
+		},
+		{
+			ID:       "rule-large",
+			Name:     "Large expenses",
+			Priority: 10,
+			Conditions: entity.RuleConditions{
+				MinAmount: money.MustParse("500.00", "USD"),
+				Type:      constant.TransactionTypeExpense,
+			},
+			Actions: entity.RuleActions{CategoryID: "category-large"},
+		},
+		{
+			ID:   "rule-salary",
+			Name: "Salary",
+			Conditions: entity.RuleConditions{
+				DescriptionPattern: `(?i)^acme (corp|inc)\b`,
+				AccountID:          "test-account-123",
+				Type:               constant.TransactionTypeIncome,
+			},
+			Actions: entity.RuleActions{CategoryID: "category-salary"},
+		},
+	} {
+		rule.UserID = "test-user-123"
+		ruleRepo.Create(context.Background(), rule)
+	}
+	return ruleRepo
+}
+
+func TestCreateTransactionAppliesRules(t *testing.T) {
+	tests := []struct {
+		name                string
+		amount              money.Money
+		description         string
+		categoryID          string
+		transactionType     constant.TransactionType
+		expectedCategory    string
+		expectedDescription string
+	}{
+		{
+			name:                "description contains",
+			amount:              money.MustParse("4.50", "USD"),
+			description:         "STARBUCKS #1234 SEATTLE",
+			transactionType:     constant.TransactionTypeExpense,
+			expectedCategory:    "Coffee",
+			expectedDescription: "Starbucks",
+		},
+		{
+			name:                "first matching rule wins",
+			amount:              money.MustParse("600.00", "USD"),
+			description:         "Starbucks catering",
+			transactionType:     constant.TransactionTypeExpense,
+			expectedCategory:    "Coffee",
+			expectedDescription: "Starbucks",
+		},
+		{
+			name:                "amount range",
+			amount:              money.MustParse("500.00", "USD"),
+			description:         "New laptop",
+			transactionType:     constant.TransactionTypeExpense,
+			expectedCategory:    "Large",
+			expectedDescription: "New laptop",
+		},
+		{
+			name:                "amount converted into the range",
+			amount:              money.MustParse("460.00", "EUR"),
+			description:         "New laptop",
+			transactionType:     constant.TransactionTypeExpense,
+			expectedCategory:    "Large",
+			expectedDescription: "New laptop",
+		},
+		{
+			name:                "type",
+			amount:              money.MustParse("900.00", "USD"),
+			description:         "Bonus",
+			transactionType:     constant.TransactionTypeIncome,
+			expectedDescription: "Bonus",
+		},
+		{
+			name:                "pattern and account",
+			amount:              money.MustParse("3000.00", "USD"),
+			description:         "ACME Corp payroll",
+			transactionType:     constant.TransactionTypeIncome,
+			expectedCategory:    "Salary",
+			expectedDescription: "ACME Corp payroll",
+		},
+		{
+			name:                "category given",
+			amount:              money.MustParse("4.50", "USD"),
+			description:         "Starbucks",
+			categoryID:          "category-meeting",
+			transactionType:     constant.TransactionTypeExpense,
+			expectedCategory:    "Meeting",
+			expectedDescription: "Starbucks",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			accountRepo := &MockAccountRepository{accountToReturn: NewTestAccount()}
+			converter := newTestConverter(NewTestExchangeRate("EUR", "USD", "1.1", time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)))
+			service := NewTransactionService(&MockTransactionRepository{}, accountRepo, &MockTransactionManager{}, converter, newTestCategories(), newTestRules(), &MockPayeeRepository{})
+
+			transaction, err := service.CreateTransaction(context.Background(), "test-account-123", tt.amount, tt.description, "", tt.categoryID, nil, nil, tt.transactionType, time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC), false)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if transaction.Category != tt.expectedCategory || transaction.Description != tt.expectedDescription {
+				t.Errorf("expected %q %q, got %q %q", tt.expectedCategory, tt.expectedDescription, transaction.Category, transaction.Description)
+			}
+		})
+	}
+}
+
+func TestCreateTransactionAddsRuleTags(t *testing.T) {
+	accountRepo := &MockAccountRepository{accountToReturn: NewTestAccount()}
+	service := NewTransactionService(&MockTransactionRepository{}, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), newTestRules(), &MockPayeeRepository{})
+
+	transaction, err := service.CreateTransaction(context.Background(), "test-account-123", money.MustParse("4.50", "USD"), "Starbucks", "", "", []string{"Work", "coffee"}, nil, constant.TransactionTypeExpense, time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC), false)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !slices.Equal(transaction.Tags, []string{"coffee", "work"}) {
+		t.Errorf("expected the rule's tag added to those given, got %q", transaction.Tags)
+	}
+}
+
+func TestCreateRuleValidation(t *testing.T) {
+	otherAccount := NewTestAccount()
+	otherAccount.ID = "other-account-456"
+	otherAccount.UserID = "other-user-456"
+	categoryRepo := newTestCategories()
+	otherCategory := NewTestCategory("category-other-user", "Other", "")
+	otherCategory.UserID = "other-user-456"
+	categoryRepo.Create(context.Background(), otherCategory)
+
+	tests := []struct {
+		name       string
+		conditions entity.RuleConditions
+		actions    entity.RuleActions
+		field      string
+	}{
+		{
+			name:    "no condition",
+			actions: entity.RuleActions{CategoryID: "category-coffee"},
+			field:   "conditions",
+		},
+		{
+			name:       "no action",
+			conditions: entity.RuleConditions{DescriptionContains: "starbucks"},
+			field:      "actions",
+		},
+		{
+			name:       "empty tag",
+			conditions: entity.RuleConditions{DescriptionContains: "starbucks"},
+			actions:    entity.RuleActions{Tags: []string{"coffee", " "}},
+			field:      "tags",
+		},
+		{
+			name:       "invalid pattern",
+			conditions: entity.RuleConditions{DescriptionPattern: "(starbucks"},
+			actions:    entity.RuleActions{CategoryID: "category-coffee"},
+			field:      "description_pattern",
+		},
+		{
+			name:       "transfers",
+			conditions: entity.RuleConditions{Type: constant.TransactionTypeTransfer},
+			actions:    entity.RuleActions{CategoryID: "category-savings"},
+			field:      "type",
+		},
+		{
+			name:       "negative amount",
+			conditions: entity.RuleConditions{MinAmount: money.MustParse("-1.00", "USD")},
+			actions:    entity.RuleActions{CategoryID: "category-small"},
+			field:      "min_amount",
+		},
+		{
+			name: "inverted range",
+			conditions: entity.RuleConditions{
+				MinAmount: money.MustParse("10.00", "USD"),
+				MaxAmount: money.MustParse("5.00", "USD"),
+			},
+			actions: entity.RuleActions{CategoryID: "category-small"},
+			field:   "max_amount",
+		},
+		{
+			name: "mixed currencies",
+			conditions: entity.RuleConditions{
+				MinAmount: money.MustParse("5.00", "USD"),
+				MaxAmount: money.MustParse("10.00", "EUR"),
+			},
+			actions: entity.RuleActions{CategoryID: "category-small"},
+			field:   "max_amount",
+		},
+		{
+			name:       "account of another user",
+			conditions: entity.RuleConditions{AccountID: otherAccount.ID},
+			actions:    entity.RuleActions{CategoryID: "category-other"},
+			field:      "account_id",
+		},
+		{
+			name:       "category of another user",
+			conditions: entity.RuleConditions{DescriptionContains: "starbucks"},
+			actions:    entity.RuleActions{CategoryID: otherCategory.ID},
+			field:      "category_id",
+		},
+		{
+			name:       "archived category",
+			conditions: entity.RuleConditions{DescriptionContains: "starbucks"},
+			actions:    entity.RuleActions{CategoryID: "category-archived"},
+			field:      "category_id",
+		},
+		{
+			name:       "category for another type",
+			conditions: entity.RuleConditions{Type: constant.TransactionTypeIncome},
+			actions:    entity.RuleActions{CategoryID: "category-coffee"},
+			field:      "category_id",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ruleRepo := &MockCategoryRuleRepository{}
+			accountRepo := &MockAccountRepository{accountToReturn: otherAccount}
+			userRepo := &MockUserRepository{userToReturn: NewTestUser()}
+			service := NewCategoryRuleService(ruleRepo, userRepo, accountRepo, categoryRepo, &MockTransactionRepository{}, &MockTransactionManager{})
+
+			_, err := service.CreateRule(context.Background(), "test-user-123", "Rule", 0, tt.conditions, tt.actions)
+
+			var invalidErr *domainerrors.ErrInvalidInput
+			if !errors.As(err, &invalidErr) || invalidErr.Field != tt.field {
+				t.Fatalf("expected invalid %s, got %v", tt.field, err)
+			}
+			if ruleRepo.createCalls != 0 {
+				t.Error("expected the rule not to be saved")
+			}
+		})
+	}
+}
+
+func TestCreateAndUpdateRule(t *testing.T) {
+	ruleRepo := &MockCategoryRuleRepository{}
+	userRepo := &MockUserRepository{userToReturn: NewTestUser()}
+	service := NewCategoryRuleService(ruleRepo, userRepo, &MockAccountRepository{}, newTestCategories(), &MockTransactionRepository{}, &MockTransactionManager{})
+
+	rule, err := service.CreateRule(context.Background(), "test-user-123", "  Coffee ", 5,
+		entity.RuleConditions{DescriptionContains: "starbucks"}, entity.RuleActions{CategoryID: "category-coffee"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if rule.Name != "Coffee" || rule.Priority != 5 || ruleRepo.rules[rule.ID] == nil {
+		t.Fatalf("unexpected rule %+v", rule)
+	}
+
+	priority := 0
+	updated, err := service.UpdateRule(context.Background(), rule.ID, "", &priority, nil, &entity.RuleActions{CategoryID: "category-eating-out"})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if updated.Name != "Coffee" || updated.Priority != 0 || updated.Conditions.DescriptionContains != "starbucks" || updated.Actions.CategoryID != "category-eating-out" {
+		t.Errorf("unexpected updated rule %+v", updated)
+	}
+
+	// Tags alone are an action, stored in lower case without repeats
+	updated, err = service.UpdateRule(context.Background(), rule.ID, "", nil, nil, &entity.RuleActions{Tags: []string{" Coffee", "work", "coffee"}})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if updated.Actions.CategoryID != "" || !slices.Equal(ruleRepo.rules[rule.ID].Actions.Tags, []string{"coffee", "work"}) {
+		t.Errorf("expected normalized tags stored, got %+v", ruleRepo.rules[rule.ID].Actions)
+	}
+
+	_, err = service.UpdateRule(context.Background(), rule.ID, "", nil, &entity.RuleConditions{}, nil)
+	var invalidErr *domainerrors.ErrInvalidInput
+	if !errors.As(err, &invalidErr) {
+		t.Fatalf("expected invalid input for a rule without conditions, got %v", err)
+	}
+	if ruleRepo.rules[rule.ID].Conditions.DescriptionContains != "starbucks" {
+		t.Error("expected the invalid update not to be saved")
+	}
+
+	_, err = service.UpdateRule(context.Background(), "missing", "Name", nil, nil, nil)
+	var notFoundErr *domainerrors.ErrNotFound
+	if !errors.As(err, &notFoundErr) {
+		t.Errorf("expected not found, got %v", err)
+	}
+}
+
+func TestApplyRules(t *testing.T) {
+	newTransactions := func() *MockTransactionRepository {
+		transactionRepo := &MockTransactionRepository{}
+		for _, transaction := range []*entity.Transaction{
+			{ID: "coffee", AccountID: "test-account-123", Amount: money.MustParse("4.50", "USD"), Description: "STARBUCKS #1234", Type: constant.TransactionTypeExpense},
+			{ID: "categorized", AccountID: "test-account-123", Amount: money.MustParse("3.20", "USD"), Description: "Starbucks", CategoryID: "category-meeting", Type: constant.TransactionTypeExpense},
+			{ID: "unchanged", AccountID: "test-account-123", Amount: money.MustParse("3000.00", "USD"), Description: "Acme Inc", CategoryID: "category-salary", Type: constant.TransactionTypeIncome},
+			{ID: "transfer", AccountID: "test-account-123", Amount: money.MustParse("800.00", "USD"), Type: constant.TransactionTypeExpense, TransferID: "transfer-1"},
+			{ID: "split", AccountID: "test-account-123", Amount: money.MustParse("9.00", "USD"), Description: "STARBUCKS #1234", Type: constant.TransactionTypeExpense, Splits: newTestSplits("5.00", "4.00")},
+			{ID: "unmatched", AccountID: "test-account-123", Amount: money.MustParse("12.00", "USD"), Description: "Bakery", Type: constant.TransactionTypeExpense},
+		} {
+			transactionRepo.created = append(transactionRepo.created, transaction)
+		}
+		return transactionRepo
+	}
+
+	tests := []struct {
+		name              string
+		uncategorizedOnly bool
+		dryRun            bool
+		matched           int
+		changed           []string
+	}{
+		{name: "dry run", dryRun: true, matched: 3, changed: []string{"coffee", "categorized"}},
+		{name: "apply", matched: 3, changed: []string{"coffee", "categorized"}},
+		{name: "uncategorized only", uncategorizedOnly: true, matched: 1, changed: []string{"coffee"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transactionRepo := newTransactions()
+			userRepo := &MockUserRepository{userToReturn: NewTestUser()}
+			service := NewCategoryRuleService(newTestRules(), userRepo, &MockAccountRepository{}, newTestCategories(), transactionRepo, &MockTransactionManager{})
+
+			result, err := service.ApplyRules(context.Background(), "test-user-123", tt.uncategorizedOnly, tt.dryRun)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if result.Matched != tt.matched || result.DryRun != tt.dryRun || len(result.Changes) != len(tt.changed) {
+				t.Fatalf("expected %d matched and %d changed, got %+v", tt.matched, len(tt.changed), result)
+			}
+			for i, id := range tt.changed {
+				if result.Changes[i].TransactionID != id {
+					t.Errorf("change %d: expected transaction %s, got %s", i, id, result.Changes[i].TransactionID)
+				}
+			}
+			coffee := result.Changes[0]
+			if coffee.RuleID != "rule-coffee" || coffee.PreviousCategoryID != "" || coffee.CategoryID != "category-coffee" ||
+				coffee.PreviousDescription != "STARBUCKS #1234" || coffee.Description != "Starbucks" ||
+				len(coffee.PreviousTags) != 0 || !slices.Equal(coffee.Tags, []string{"coffee"}) {
+				t.Errorf("unexpected change %+v", coffee)
+			}
+
+			expectedUpdates := len(tt.changed)
+			if tt.dryRun {
+				expectedUpdates = 0
+			}
+			if transactionRepo.updateCalls != expectedUpdates {
+				t.Errorf("expected %d updates, got %d", expectedUpdates, transactionRepo.updateCalls)
+			}
+			if transactionRepo.created[0].CategoryID != "" || transactionRepo.created[0].Tags != nil {
+				t.Error("expected the listed transaction to be left as it was")
+			}
+		})
+	}
+}
+
+func TestApplyRulesInPages(t *testing.T) {
+	transactionRepo := &MockTransactionRepository{pageSize: 2}
+	for _, id := range []string{"coffee-1", "coffee-2", "transfer", "coffee-3", "coffee-4", "coffee-5"} {
+		transaction := &entity.Transaction{ID: id, AccountID: "test-account-123", Amount: money.MustParse("4.50", "USD"), Description: "STARBUCKS #1234", Type: constant.TransactionTypeExpense}
+		if id == "transfer" {
+			transaction.TransferID = "transfer-1"
+		}
+		transactionRepo.created = append(transactionRepo.created, transaction)
+	}
+	userRepo := &MockUserRepository{userToReturn: NewTestUser()}
+	txManager := &MockTransactionManager{}
+	service := NewCategoryRuleService(newTestRules(), userRepo, &MockAccountRepository{}, newTestCategories(), transactionRepo, txManager)
+
+	result, err := service.ApplyRules(context.Background(), "test-user-123", true, false)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if result.Matched != 5 || len(result.Changes) != 5 || transactionRepo.updateCalls != 5 {
+		t.Fatalf("expected every coffee on every page to change, got %+v with %d updates", result, transactionRepo.updateCalls)
+	}
+	if result.Changes[4].TransactionID != "coffee-5" {
+		t.Errorf("expected the last change on the last page, got %s", result.Changes[4].TransactionID)
+	}
+	if transactionRepo.listRuleCandidatesCalls != 3 || txManager.commits != 3 {
+		t.Errorf("expected 3 pages each committed on its own, got %d pages and %d commits", transactionRepo.listRuleCandidatesCalls, txManager.commits)
+	}
+}
+
+func TestApplyRulesUnknownUser(t *testing.T) {
+	service := NewCategoryRuleService(newTestRules(), &MockUserRepository{}, &MockAccountRepository{}, newTestCategories(), &MockTransactionRepository{}, &MockTransactionManager{})
+
+	_, err := service.ApplyRules(context.Background(), "missing", false, true)
+
+	var notFoundErr *domainerrors.ErrNotFound
+	if !errors.As(err, &notFoundErr) || !strings.Contains(err.Error(), "user") {
+		t.Errorf("expected user not found, got %v", err)
+	}
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"accounting/internal/domain/entity"
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/domain/money"
+)
+
+// ruleMatcher is a categorization rule ready to be matched against transactions.
+type ruleMatcher struct {
+	rule     *entity.CategoryRule
+	contains string
+	pattern  *regexp.Regexp
+}
+
+// newRuleMatchers prepares rules for matching, keeping their order.
+func newRuleMatchers(rules []*entity.CategoryRule) ([]*ruleMatcher, error) {
+	matchers := make([]*ruleMatcher, 0, len(rules))
+	for _, rule := range rules {
+		matcher := &ruleMatcher{rule: rule, contains: strings.ToLower(rule.Conditions.DescriptionContains)}
+		if rule.Conditions.DescriptionPattern != "" {
+			pattern, err := regexp.Compile(rule.Conditions.DescriptionPattern)
+			if err != nil {
+				return nil, fmt.Errorf("compiling pattern of category rule %s: %w", rule.ID, err)
+			}
+			matcher.pattern = pattern
+		}
+		matchers = append(matchers, matcher)
+	}
+	return matchers, nil
+}
+
+// loadRuleMatchers returns the user's categorization rules in the order they are tried.
+func loadRuleMatchers(ctx context.Context, ruleRepo interfaces.CategoryRuleRepository, userID string) ([]*ruleMatcher, error) {
+	rules, err := ruleRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("getting category rules: %w", err)
+	}
+	return newRuleMatchers(rules)
+}
+
+// matches tells whether the transaction meets every condition of the rule.
+func (m *ruleMatcher) matches(transaction *entity.Transaction) bool {
+	conditions := m.rule.Conditions
+	if conditions.AccountID != "" && conditions.AccountID != transaction.AccountID {
+		return false
+	}
+	if conditions.Type != "" && conditions.Type != transaction.Type {
+		return false
+	}
+	if m.contains != "" && !strings.Contains(strings.ToLower(transaction.Description), m.contains) {
+		return false
+	}
+	if m.pattern != nil && !m.pattern.MatchString(transaction.Description) {
+		return false
+	}
+	return amountInRange(transaction, conditions.MinAmount, conditions.MaxAmount)
+}
+
+// amountInRange tells whether the transaction's amount lies within the
+// inclusive bounds, comparing the amount as stored or as entered, whichever
+// is in the currency of the bounds. Unset bounds match any amount.
+func amountInRange(transaction *entity.Transaction, minAmount, maxAmount money.Money) bool {
+	currency := minAmount.Currency()
+	if currency == "" {
+		currency = maxAmount.Currency()
+	}
+	if currency == "" {
+		return true
+	}
+
+	amount := transaction.Amount
+	if amount.Currency() != currency {
+		amount = transaction.OriginalAmount
+	}
+	if amount.Currency() != currency {
+		return false
+	}
+	if minAmount.Currency() != "" {
+		if cmp, _ := amount.Cmp(minAmount); cmp < 0 {
+			return false
+		}
+	}
+	if maxAmount.Currency() != "" {
+		if cmp, _ := amount.Cmp(maxAmount); cmp > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// applyRuleMatchers applies the actions of the first rule the transaction
+// matches to it and returns that rule, or nil if it matches none.
+func applyRuleMatchers(matchers []*ruleMatcher, transaction *entity.Transaction) *entity.CategoryRule {
+	for _, matcher := range matchers {
+		if !matcher.matches(transaction) {
+			continue
+		}
+		actions := matcher.rule.Actions
+		if actions.CategoryID != "" {
+			transaction.CategoryID = actions.CategoryID
+		}
+		if actions.Description != "" {
+			transaction.Description = actions.Description
+		}
+		if len(actions.Tags) > 0 {
+			// The transaction may share its tags with the one it was copied from
+			tags := append(slices.Clone(transaction.Tags), actions.Tags...)
+			slices.Sort(tags)
+			transaction.Tags = slices.Compact(tags)
+		}
+		return matcher.rule
+	}
+	return nil
+}
+
+// categorize applies the first of the user's rules the transaction matches
+// to it, as it is entered without a category.
+func categorize(ctx context.Context, ruleRepo interfaces.CategoryRuleRepository, userID string, transaction *entity.Transaction) error {
+	matchers, err := loadRuleMatchers(ctx, ruleRepo, userID)
+	if err != nil {
+		return err
+	}
+	applyRuleMatchers(matchers, transaction)
+	return nil
+}
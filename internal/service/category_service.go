@@ -0,0 +1,294 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/interfaces"
+
+	"github.com/google/uuid"
+)
+
+type CategoryService struct {
+	categoryRepo interfaces.CategoryRepository
+	userRepo     interfaces.UserRepository
+	ruleRepo     interfaces.CategoryRuleRepository
+}
+
+func NewCategoryService(categoryRepo interfaces.CategoryRepository, userRepo interfaces.UserRepository, ruleRepo interfaces.CategoryRuleRepository) *CategoryService {
+	return &CategoryService{
+		categoryRepo: categoryRepo,
+		userRepo:     userRepo,
+		ruleRepo:     ruleRepo,
+	}
+}
+
+func (s *CategoryService) CreateCategory(ctx context.Context, userID, name, parentID string, categoryType constant.TransactionType) (*entity.Category, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, domainerrors.NewErrInvalidInput("name", "name is required")
+	}
+	if err := validateCategoryType(categoryType); err != nil {
+		return nil, err
+	}
+	if err := checkUser(ctx, s.userRepo, userID); err != nil {
+		return nil, err
+	}
+
+	category := &entity.Category{
+		ID:       uuid.New().String(),
+		UserID:   userID,
+		ParentID: parentID,
+		Name:     name,
+		Type:     categoryType,
+	}
+	if err := s.checkNameUnique(ctx, category); err != nil {
+		return nil, err
+	}
+	if err := s.checkParent(ctx, category); err != nil {
+		return nil, err
+	}
+
+	if err := s.categoryRepo.Create(ctx, category); err != nil {
+		return nil, fmt.Errorf("creating category: %w", err)
+	}
+
+	return category, nil
+}
+
+func (s *CategoryService) GetCategory(ctx context.Context, id string) (*entity.Category, error) {
+	return s.categoryRepo.GetByID(ctx, id)
+}
+
+func (s *CategoryService) ListUserCategories(ctx context.Context, userID string, includeArchived bool) ([]*entity.Category, error) {
+	if err := checkUser(ctx, s.userRepo, userID); err != nil {
+		return nil, err
+	}
+	return s.categoryRepo.ListByUserID(ctx, userID, includeArchived)
+}
+
+func (s *CategoryService) UpdateCategory(ctx context.Context, id, name string, parentID *string, categoryType *constant.TransactionType, archived *bool) (*entity.Category, error) {
+	category, err := s.categoryRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("getting category: %w", err)
+	}
+	if category == nil {
+		return nil, domainerrors.NewErrNotFound("category", id)
+	}
+
+	// A new name or parent may clash with a sibling
+	checkName := false
+	if name = strings.TrimSpace(name); name != "" && name != category.Name {
+		category.Name = name
+		checkName = true
+	}
+	if categoryType != nil && *categoryType != category.Type {
+		if err := validateCategoryType(*categoryType); err != nil {
+			return nil, err
+		}
+		category.Type = *categoryType
+		if err := s.checkChildrenType(ctx, category); err != nil {
+			return nil, err
+		}
+		if err := s.checkRulesType(ctx, category); err != nil {
+			return nil, err
+		}
+	}
+	if parentID != nil && *parentID != category.ParentID {
+		category.ParentID = *parentID
+		checkName = true
+	}
+	if checkName {
+		if err := s.checkNameUnique(ctx, category); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.checkParent(ctx, category); err != nil {
+		return nil, err
+	}
+	if archived != nil && *archived != category.Archived {
+		if *archived {
+			if err := s.checkNotSetByRule(ctx, category, "archived"); err != nil {
+				return nil, err
+			}
+		}
+		category.Archived = *archived
+	}
+
+	if err := s.categoryRepo.Update(ctx, category); err != nil {
+		return nil, fmt.Errorf("updating category: %w", err)
+	}
+
+	return category, nil
+}
+
+func (s *CategoryService) DeleteCategory(ctx context.Context, id string) error {
+	category, err := s.categoryRepo.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("getting category: %w", err)
+	}
+	if category == nil {
+		return domainerrors.NewErrNotFound("category", id)
+	}
+
+	children, err := s.categoryRepo.ListByParentID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("listing subcategories: %w", err)
+	}
+	if len(children) > 0 {
+		return domainerrors.NewErrInvalidInput("id", "category has subcategories; move or delete them first")
+	}
+	if err := s.checkNotSetByRule(ctx, category, "id"); err != nil {
+		return err
+	}
+
+	return s.categoryRepo.Delete(ctx, id)
+}
+
+// checkNameUnique verifies that no other category of the user under the
+// same parent has the category's name, ignoring case.
+func (s *CategoryService) checkNameUnique(ctx context.Context, category *entity.Category) error {
+	existing, err := s.categoryRepo.GetByName(ctx, category.UserID, category.ParentID, category.Name)
+	if err != nil {
+		return fmt.Errorf("checking category name: %w", err)
+	}
+	if existing != nil && existing.ID != category.ID {
+		return domainerrors.NewErrDuplicateCategory(category.UserID, existing.Name)
+	}
+	return nil
+}
+
+// checkParent verifies that the category's parent exists, belongs to the
+// same user, is not archived, has the category's type if it has one, and is
+// not one of the category's descendants.
+func (s *CategoryService) checkParent(ctx context.Context, category *entity.Category) error {
+	if category.ParentID == "" {
+		return nil
+	}
+	if category.ParentID == category.ID {
+		return domainerrors.NewErrInvalidInput("parent_id", "a category cannot be its own parent")
+	}
+
+	parent, err := s.categoryRepo.GetByID(ctx, category.ParentID)
+	if err != nil {
+		return fmt.Errorf("getting parent category: %w", err)
+	}
+	if parent == nil {
+		return domainerrors.NewErrNotFound("category", category.ParentID)
+	}
+	if parent.UserID != category.UserID {
+		return domainerrors.NewErrInvalidInput("parent_id", "parent category belongs to another user")
+	}
+	if parent.Archived {
+		return domainerrors.NewErrInvalidInput("parent_id", fmt.Sprintf("parent category %q is archived", parent.Name))
+	}
+	if parent.Type != "" && parent.Type != category.Type {
+		return domainerrors.NewErrInvalidInput("parent_id", fmt.Sprintf("parent category %q is for %s transactions only, and so must its subcategories be", parent.Name, parent.Type))
+	}
+
+	// Walk up from the parent; reaching the category again means a cycle
+	for ancestor := parent; ancestor.ParentID != ""; {
+		if ancestor.ParentID == category.ID {
+			return domainerrors.NewErrInvalidInput("parent_id", "parent category is a subcategory of the category")
+		}
+		ancestor, err = s.categoryRepo.GetByID(ctx, ancestor.ParentID)
+		if err != nil {
+			return fmt.Errorf("getting ancestor category: %w", err)
+		}
+		if ancestor == nil {
+			break
+		}
+	}
+
+	return nil
+}
+
+// checkChildrenType verifies that every subcategory of a category restricted
+// to one type has that type too.
+func (s *CategoryService) checkChildrenType(ctx context.Context, category *entity.Category) error {
+	if category.Type == "" {
+		return nil
+	}
+	children, err := s.categoryRepo.ListByParentID(ctx, category.ID)
+	if err != nil {
+		return fmt.Errorf("listing subcategories: %w", err)
+	}
+	for _, child := range children {
+		if child.Type != category.Type {
+			return domainerrors.NewErrInvalidInput("type", fmt.Sprintf("subcategory %q is not for %s transactions only", child.Name, category.Type))
+		}
+	}
+	return nil
+}
+
+// checkRulesType verifies that every categorization rule giving transactions
+// a category restricted to one type only matches transactions of that type.
+func (s *CategoryService) checkRulesType(ctx context.Context, category *entity.Category) error {
+	if category.Type == "" {
+		return nil
+	}
+	rules, err := s.ruleRepo.ListByUserID(ctx, category.UserID)
+	if err != nil {
+		return fmt.Errorf("getting category rules: %w", err)
+	}
+	for _, rule := range rules {
+		if rule.Actions.CategoryID == category.ID && rule.Conditions.Type != category.Type {
+			return domainerrors.NewErrInvalidInput("type", fmt.Sprintf("rule %q sets the category on transactions that are not %s", rule.Name, category.Type))
+		}
+	}
+	return nil
+}
+
+// checkNotSetByRule verifies that none of the user's categorization rules
+// gives transactions the category, reporting the error on field otherwise.
+func (s *CategoryService) checkNotSetByRule(ctx context.Context, category *entity.Category, field string) error {
+	rules, err := s.ruleRepo.ListByUserID(ctx, category.UserID)
+	if err != nil {
+		return fmt.Errorf("getting category rules: %w", err)
+	}
+	for _, rule := range rules {
+		if rule.Actions.CategoryID == category.ID {
+			return domainerrors.NewErrInvalidInput(field, fmt.Sprintf("rule %q sets the category; change or delete the rule first", rule.Name))
+		}
+	}
+	return nil
+}
+
+// validateCategoryType checks that a category type is INCOME, EXPENSE or empty.
+func validateCategoryType(categoryType constant.TransactionType) error {
+	switch categoryType {
+	case "", constant.TransactionTypeIncome, constant.TransactionTypeExpense:
+		return nil
+	}
+	return domainerrors.NewErrInvalidInput("type", "a category is for INCOME or EXPENSE transactions, or both when empty")
+}
+
+// checkCategory returns the category with the given ID after verifying that
+// it belongs to the user and can be given to transactions of the type, or
+// to any transaction when transactionType is empty. Errors are reported on field.
+func checkCategory(ctx context.Context, categoryRepo interfaces.CategoryRepository, field, userID, id string, transactionType constant.TransactionType) (*entity.Category, error) {
+	category, err := categoryRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("getting category: %w", err)
+	}
+	if category == nil {
+		return nil, domainerrors.NewErrNotFound("category", id)
+	}
+	if category.UserID != userID {
+		return nil, domainerrors.NewErrInvalidInput(field, "the category belongs to another user")
+	}
+	if category.Archived {
+		return nil, domainerrors.NewErrInvalidInput(field, fmt.Sprintf("category %q is archived", category.Name))
+	}
+	if transactionType != "" && !category.Allows(transactionType) {
+		return nil, domainerrors.NewErrInvalidInput(field, fmt.Sprintf("category %q is for %s transactions", category.Name, category.Type))
+	}
+	return category, nil
+}
+
+// Compile-time interface check
+var _ interfaces.CategoryService = (*CategoryService)(nil)
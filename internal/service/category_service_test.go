@@ -0,0 +1,279 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"accounting/internal/domain/constant"
+	domainerrors "accounting/internal/domain/errors"
+)
+
+// newTestCategoryService returns a category service over the test categories,
+// with Groceries nested under Food, and the test rules.
+func newTestCategoryService() (*CategoryService, *MockCategoryRepository) {
+	categoryRepo := newTestCategories()
+	groceries := NewTestCategory("category-groceries", "Groceries", constant.TransactionTypeExpense)
+	groceries.ParentID = "category-food"
+	categoryRepo.Create(context.Background(), groceries)
+	categoryRepo.createCalls = 0
+	userRepo := &MockUserRepository{userToReturn: NewTestUser()}
+	return NewCategoryService(categoryRepo, userRepo, newTestRules()), categoryRepo
+}
+
+func TestCreateCategory(t *testing.T) {
+	service, categoryRepo := newTestCategoryService()
+
+	category, err := service.CreateCategory(context.Background(), "test-user-123", "  Bakery ", "category-food", constant.TransactionTypeExpense)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if category.Name != "Bakery" || category.ParentID != "category-food" || categoryRepo.categories[category.ID] == nil {
+		t.Errorf("unexpected category %+v", category)
+	}
+}
+
+func TestCreateCategoryValidation(t *testing.T) {
+	otherCategory := NewTestCategory("category-other-user", "Elsewhere", "")
+	otherCategory.UserID = "other-user-456"
+
+	tests := []struct {
+		name         string
+		categoryName string
+		parentID     string
+		categoryType constant.TransactionType
+		field        string
+	}{
+		{name: "no name", categoryName: " ", field: "name"},
+		{name: "transfers", categoryName: "Savings plan", categoryType: constant.TransactionTypeTransfer, field: "type"},
+		{name: "parent of another user", categoryName: "Bakery", parentID: otherCategory.ID, field: "parent_id"},
+		{name: "archived parent", categoryName: "Bakery", parentID: "category-archived", field: "parent_id"},
+		{name: "parent of another type", categoryName: "Tips", parentID: "category-food", categoryType: constant.TransactionTypeIncome, field: "parent_id"},
+		{name: "untyped under a typed parent", categoryName: "Bakery", parentID: "category-food", field: "parent_id"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, categoryRepo := newTestCategoryService()
+			categoryRepo.Create(context.Background(), otherCategory)
+			categoryRepo.createCalls = 0
+
+			_, err := service.CreateCategory(context.Background(), "test-user-123", tt.categoryName, tt.parentID, tt.categoryType)
+
+			var invalidErr *domainerrors.ErrInvalidInput
+			if !errors.As(err, &invalidErr) || invalidErr.Field != tt.field {
+				t.Fatalf("expected invalid %s, got %v", tt.field, err)
+			}
+			if categoryRepo.createCalls != 0 {
+				t.Error("expected the category not to be saved")
+			}
+		})
+	}
+}
+
+func TestCreateCategoryDuplicateName(t *testing.T) {
+	service, _ := newTestCategoryService()
+
+	_, err := service.CreateCategory(context.Background(), "test-user-123", "FOOD", "", "")
+
+	var duplicateErr *domainerrors.ErrDuplicateCategory
+	if !errors.As(err, &duplicateErr) || duplicateErr.Name != "Food" {
+		t.Errorf("expected a duplicate of Food, got %v", err)
+	}
+}
+
+func TestCreateCategorySameNameUnderAnotherParent(t *testing.T) {
+	service, categoryRepo := newTestCategoryService()
+
+	if _, err := service.CreateCategory(context.Background(), "test-user-123", "groceries", "", ""); err != nil {
+		t.Fatalf("expected a top-level Groceries beside Food's, got %v", err)
+	}
+	if _, err := service.CreateCategory(context.Background(), "test-user-123", "Coffee", "category-food", constant.TransactionTypeExpense); err != nil {
+		t.Fatalf("expected a Coffee under Food beside the top-level one, got %v", err)
+	}
+	if categoryRepo.createCalls != 2 {
+		t.Errorf("expected 2 categories to be created, got %d", categoryRepo.createCalls)
+	}
+
+	_, err := service.CreateCategory(context.Background(), "test-user-123", "GROCERIES", "category-food", constant.TransactionTypeExpense)
+	var duplicateErr *domainerrors.ErrDuplicateCategory
+	if !errors.As(err, &duplicateErr) || duplicateErr.Name != "Groceries" {
+		t.Errorf("expected a duplicate of Groceries under Food, got %v", err)
+	}
+}
+
+func TestUpdateCategory(t *testing.T) {
+	service, categoryRepo := newTestCategoryService()
+
+	parentID := ""
+	archived := true
+	updated, err := service.UpdateCategory(context.Background(), "category-groceries", "Supermarket", &parentID, nil, &archived)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if updated.Name != "Supermarket" || updated.ParentID != "" || !updated.Archived || updated.Type != constant.TransactionTypeExpense {
+		t.Errorf("unexpected updated category %+v", updated)
+	}
+	if stored := categoryRepo.categories["category-groceries"]; stored.Name != "Supermarket" {
+		t.Errorf("expected the update to be saved, got %+v", stored)
+	}
+
+	_, err = service.UpdateCategory(context.Background(), "missing", "Name", nil, nil, nil)
+	var notFoundErr *domainerrors.ErrNotFound
+	if !errors.As(err, &notFoundErr) {
+		t.Errorf("expected not found, got %v", err)
+	}
+}
+
+func TestUpdateCategoryValidation(t *testing.T) {
+	toGroceries := "category-groceries"
+	toItself := "category-food"
+	income := constant.TransactionTypeIncome
+	expense := constant.TransactionTypeExpense
+	archived := true
+
+	tests := []struct {
+		name         string
+		id           string
+		categoryName string
+		parentID     *string
+		categoryType *constant.TransactionType
+		archived     *bool
+		field        string
+	}{
+		{name: "own parent", id: "category-food", parentID: &toItself, field: "parent_id"},
+		{name: "under a subcategory", id: "category-food", parentID: &toGroceries, field: "parent_id"},
+		{name: "type of the subcategories", id: "category-food", categoryType: &income, field: "type"},
+		{name: "type of the parent", id: "category-groceries", categoryType: &income, field: "parent_id"},
+		{name: "type narrower than a rule", id: "category-large", categoryType: &income, field: "type"},
+		{name: "type of another rule", id: "category-salary", categoryType: &expense, field: "type"},
+		{name: "archiving a category a rule sets", id: "category-coffee", archived: &archived, field: "archived"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, categoryRepo := newTestCategoryService()
+
+			_, err := service.UpdateCategory(context.Background(), tt.id, tt.categoryName, tt.parentID, tt.categoryType, tt.archived)
+
+			var invalidErr *domainerrors.ErrInvalidInput
+			if !errors.As(err, &invalidErr) || invalidErr.Field != tt.field {
+				t.Fatalf("expected invalid %s, got %v", tt.field, err)
+			}
+			if categoryRepo.updateCalls != 0 {
+				t.Error("expected the category not to be saved")
+			}
+		})
+	}
+}
+
+func TestUpdateCategoryDuplicateName(t *testing.T) {
+	topLevel := ""
+	underFood := "category-food"
+
+	tests := []struct {
+		name         string
+		id           string
+		categoryName string
+		parentID     *string
+	}{
+		{name: "renamed", id: "category-coffee", categoryName: "food"},
+		{name: "moved", id: "category-groceries", categoryName: "Coffee", parentID: &topLevel},
+		{name: "moved under a parent", id: "category-coffee", categoryName: "groceries", parentID: &underFood},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, categoryRepo := newTestCategoryService()
+
+			_, err := service.UpdateCategory(context.Background(), tt.id, tt.categoryName, tt.parentID, nil, nil)
+
+			var duplicateErr *domainerrors.ErrDuplicateCategory
+			if !errors.As(err, &duplicateErr) {
+				t.Fatalf("expected a duplicate name, got %v", err)
+			}
+			if categoryRepo.updateCalls != 0 {
+				t.Error("expected the category not to be saved")
+			}
+		})
+	}
+
+	service, _ := newTestCategoryService()
+	if _, err := service.UpdateCategory(context.Background(), "category-groceries", "coffee", nil, nil, nil); err != nil {
+		t.Errorf("expected a subcategory to share a top-level category's name, got %v", err)
+	}
+
+	service, _ = newTestCategoryService()
+	if _, err := service.CreateCategory(context.Background(), "test-user-123", "Groceries", "", ""); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	_, err := service.UpdateCategory(context.Background(), "category-groceries", "", &topLevel, nil, nil)
+	var duplicateErr *domainerrors.ErrDuplicateCategory
+	if !errors.As(err, &duplicateErr) {
+		t.Errorf("expected moving beside a namesake to be a duplicate, got %v", err)
+	}
+}
+
+func TestDeleteCategory(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		invalid bool
+	}{
+		{name: "leaf", id: "category-groceries"},
+		{name: "with subcategories", id: "category-food", invalid: true},
+		{name: "set by a rule", id: "category-coffee", invalid: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, categoryRepo := newTestCategoryService()
+
+			err := service.DeleteCategory(context.Background(), tt.id)
+
+			if tt.invalid {
+				var invalidErr *domainerrors.ErrInvalidInput
+				if !errors.As(err, &invalidErr) {
+					t.Fatalf("expected invalid input, got %v", err)
+				}
+				if categoryRepo.deleteCalls != 0 {
+					t.Error("expected the category not to be deleted")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if categoryRepo.categories[tt.id] != nil {
+				t.Error("expected the category to be deleted")
+			}
+		})
+	}
+}
+
+func TestListUserCategories(t *testing.T) {
+	service, _ := newTestCategoryService()
+
+	active, err := service.ListUserCategories(context.Background(), "test-user-123", false)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	for _, category := range active {
+		if category.Archived {
+			t.Errorf("expected archived categories to be left out, got %s", category.Name)
+		}
+	}
+	all, err := service.ListUserCategories(context.Background(), "test-user-123", true)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(all) != len(active)+1 {
+		t.Errorf("expected the archived category to be listed too, got %d of %d", len(all), len(active))
+	}
+
+	_, err = NewCategoryService(newTestCategories(), &MockUserRepository{}, &MockCategoryRuleRepository{}).ListUserCategories(context.Background(), "missing", false)
+	var notFoundErr *domainerrors.ErrNotFound
+	if !errors.As(err, &notFoundErr) {
+		t.Errorf("expected user not found, got %v", err)
+	}
+}
@@ -0,0 +1,199 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/money"
+)
+
+func newTestChartAccount(id, parentID, code string, accountType constant.AccountType, balance, currency string) *entity.Account {
+	return &entity.Account{
+		ID:       id,
+		UserID:   "test-user-123",
+		ParentID: parentID,
+		Code:     code,
+		Name:     id,
+		Type:     accountType,
+		Balance:  money.MustParse(balance, currency),
+		Currency: currency,
+	}
+}
+
+func TestAccountTypeClass(t *testing.T) {
+	tests := map[constant.AccountType]constant.AccountClass{
+		constant.AccountTypeChecking:   constant.AccountClassAsset,
+		constant.AccountTypeSavings:    constant.AccountClassAsset,
+		constant.AccountTypeCash:       constant.AccountClassAsset,
+		constant.AccountTypeInvestment: constant.AccountClassAsset,
+		constant.AccountTypeCreditCard: constant.AccountClassLiability,
+		constant.AccountTypeEquity:     constant.AccountClassEquity,
+		constant.AccountTypeIncome:     constant.AccountClassIncome,
+		constant.AccountTypeExpense:    constant.AccountClassExpense,
+	}
+
+	for _, accountType := range constant.AccountTypes {
+		if got := accountType.Class(); got != tests[accountType] {
+			t.Errorf("%s.Class() = %s, want %s", accountType, got, tests[accountType])
+		}
+	}
+}
+
+func TestCreateAccountWithParentAndCode(t *testing.T) {
+	parent := newTestChartAccount("expenses", "", "5000", constant.AccountTypeExpense, "0.00", "USD")
+	accountRepo := newTestAccountRepo(parent)
+	service := NewAccountService(accountRepo, &MockUserRepository{userToReturn: NewTestUser()}, &MockTransactionManager{}, newTestConverter())
+
+	account, err := service.CreateAccount(context.Background(), "test-user-123", "Groceries", constant.AccountTypeExpense, "USD", parent.ID, "5100")
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if account.ParentID != parent.ID || account.Code != "5100" {
+		t.Errorf("expected parent %q and code 5100, got %q and %q", parent.ID, account.ParentID, account.Code)
+	}
+}
+
+func TestCreateAccountParentClassMismatch(t *testing.T) {
+	parent := newTestChartAccount("assets", "", "1000", constant.AccountTypeChecking, "0.00", "USD")
+	accountRepo := newTestAccountRepo(parent)
+	service := NewAccountService(accountRepo, &MockUserRepository{userToReturn: NewTestUser()}, &MockTransactionManager{}, newTestConverter())
+
+	_, err := service.CreateAccount(context.Background(), "test-user-123", "Groceries", constant.AccountTypeExpense, "USD", parent.ID, "")
+
+	var invalidErr *domainerrors.ErrInvalidInput
+	if !errors.As(err, &invalidErr) {
+		t.Errorf("expected ErrInvalidInput, got %T", err)
+	}
+
+	if accountRepo.createCalls != 0 {
+		t.Errorf("expected no create call, got %d", accountRepo.createCalls)
+	}
+}
+
+func TestCreateAccountParentNotFound(t *testing.T) {
+	service := NewAccountService(newTestAccountRepo(), &MockUserRepository{userToReturn: NewTestUser()}, &MockTransactionManager{}, newTestConverter())
+
+	_, err := service.CreateAccount(context.Background(), "test-user-123", "Groceries", constant.AccountTypeExpense, "USD", "missing", "")
+
+	var notFoundErr *domainerrors.ErrNotFound
+	if !errors.As(err, &notFoundErr) {
+		t.Errorf("expected ErrNotFound, got %T", err)
+	}
+}
+
+func TestCreateAccountDuplicateCode(t *testing.T) {
+	existing := newTestChartAccount("groceries", "", "5100", constant.AccountTypeExpense, "0.00", "USD")
+	service := NewAccountService(newTestAccountRepo(existing), &MockUserRepository{userToReturn: NewTestUser()}, &MockTransactionManager{}, newTestConverter())
+
+	_, err := service.CreateAccount(context.Background(), "test-user-123", "Dining", constant.AccountTypeExpense, "USD", "", "5100")
+
+	var dupErr *domainerrors.ErrDuplicateAccountCode
+	if !errors.As(err, &dupErr) {
+		t.Errorf("expected ErrDuplicateAccountCode, got %T", err)
+	}
+}
+
+func TestCreateAccountInvalidCode(t *testing.T) {
+	service := NewAccountService(newTestAccountRepo(), &MockUserRepository{userToReturn: NewTestUser()}, &MockTransactionManager{}, newTestConverter())
+
+	_, err := service.CreateAccount(context.Background(), "test-user-123", "Dining", constant.AccountTypeExpense, "USD", "", "51 00")
+
+	var invalidErr *domainerrors.ErrInvalidInput
+	if !errors.As(err, &invalidErr) {
+		t.Errorf("expected ErrInvalidInput, got %T", err)
+	}
+}
+
+func TestUpdateAccountRejectsCycle(t *testing.T) {
+	root := newTestChartAccount("expenses", "", "5000", constant.AccountTypeExpense, "0.00", "USD")
+	child := newTestChartAccount("food", root.ID, "5100", constant.AccountTypeExpense, "0.00", "USD")
+	grandchild := newTestChartAccount("groceries", child.ID, "5110", constant.AccountTypeExpense, "0.00", "USD")
+	accountRepo := newTestAccountRepo(root, child, grandchild)
+	service := NewAccountService(accountRepo, &MockUserRepository{}, &MockTransactionManager{}, newTestConverter())
+
+	_, err := service.UpdateAccount(context.Background(), root.ID, "", "", "", grandchild.ID, "")
+
+	var invalidErr *domainerrors.ErrInvalidInput
+	if !errors.As(err, &invalidErr) {
+		t.Errorf("expected ErrInvalidInput, got %T", err)
+	}
+
+	if accountRepo.updateCalls != 0 {
+		t.Errorf("expected no update call, got %d", accountRepo.updateCalls)
+	}
+}
+
+func TestUpdateAccountTypeMustMatchChildren(t *testing.T) {
+	parent := newTestChartAccount("expenses", "", "5000", constant.AccountTypeExpense, "0.00", "USD")
+	child := newTestChartAccount("food", parent.ID, "5100", constant.AccountTypeExpense, "0.00", "USD")
+	service := NewAccountService(newTestAccountRepo(parent, child), &MockUserRepository{}, &MockTransactionManager{}, newTestConverter())
+
+	_, err := service.UpdateAccount(context.Background(), parent.ID, "", constant.AccountTypeIncome, "", "", "")
+
+	var invalidErr *domainerrors.ErrInvalidInput
+	if !errors.As(err, &invalidErr) {
+		t.Errorf("expected ErrInvalidInput, got %T", err)
+	}
+}
+
+func TestDeleteAccountWithChildren(t *testing.T) {
+	parent := newTestChartAccount("expenses", "", "5000", constant.AccountTypeExpense, "0.00", "USD")
+	child := newTestChartAccount("food", parent.ID, "5100", constant.AccountTypeExpense, "0.00", "USD")
+	accountRepo := newTestAccountRepo(parent, child)
+	service := NewAccountService(accountRepo, &MockUserRepository{}, &MockTransactionManager{}, newTestConverter())
+
+	err := service.DeleteAccount(context.Background(), parent.ID)
+
+	var invalidErr *domainerrors.ErrInvalidInput
+	if !errors.As(err, &invalidErr) {
+		t.Errorf("expected ErrInvalidInput, got %T", err)
+	}
+
+	if accountRepo.deleteCalls != 0 {
+		t.Errorf("expected no delete call, got %d", accountRepo.deleteCalls)
+	}
+}
+
+func TestGetAccountTreeRollsUpBalances(t *testing.T) {
+	expenses := newTestChartAccount("expenses", "", "5000", constant.AccountTypeExpense, "0.00", "USD")
+	food := newTestChartAccount("food", expenses.ID, "5100", constant.AccountTypeExpense, "10.00", "USD")
+	groceries := newTestChartAccount("groceries", food.ID, "5110", constant.AccountTypeExpense, "250.00", "USD")
+	dining := newTestChartAccount("dining", food.ID, "5120", constant.AccountTypeExpense, "40.00", "EUR")
+	rent := newTestChartAccount("rent", expenses.ID, "5200", constant.AccountTypeExpense, "900.00", "USD")
+	checking := newTestChartAccount("checking", "", "1000", constant.AccountTypeChecking, "1000.00", "USD")
+	accountRepo := &MockAccountRepository{
+		accountsListToReturn: []*entity.Account{rent, dining, groceries, food, checking, expenses},
+	}
+	service := NewAccountService(accountRepo, &MockUserRepository{}, &MockTransactionManager{}, newTestConverter())
+
+	roots, err := service.GetAccountTree(context.Background(), "test-user-123", "")
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(roots) != 2 || roots[0].Account.ID != checking.ID || roots[1].Account.ID != expenses.ID {
+		t.Fatalf("expected roots [checking expenses] ordered by code, got %d roots", len(roots))
+	}
+
+	root := roots[1]
+	if len(root.Children) != 2 || root.Children[0].Account.ID != food.ID {
+		t.Fatalf("expected food then rent under expenses")
+	}
+
+	want := []money.Money{money.MustParse("40.00", "EUR"), money.MustParse("1160.00", "USD")}
+	if len(root.RolledUpBalances) != len(want) || root.RolledUpBalances[0] != want[0] || root.RolledUpBalances[1] != want[1] {
+		t.Errorf("expected rolled-up balances %v, got %v", want, root.RolledUpBalances)
+	}
+
+	foodNode := root.Children[0]
+	if foodNode.RolledUpBalances[1] != money.MustParse("260.00", "USD") {
+		t.Errorf("expected food USD total 260.00, got %s", foodNode.RolledUpBalances[1])
+	}
+}
@@ -0,0 +1,319 @@
+package service
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/money"
+)
+
+// statementRow is a row of a bank statement read into the fields of a
+// transaction, or the reason it could not be read.
+type statementRow struct {
+	// line is the 1-based line of the row in the statement.
+	line            int
+	transactionType constant.TransactionType
+	// amount is positive; transactionType carries the direction.
+	amount      money.Money
+	date        time.Time
+	description string
+	// category is the name of one of the user's categories, matched ignoring case.
+	category string
+	// externalID is the bank's identifier of the transaction, if the format has one.
+	externalID string
+	err        error
+}
+
+// normalizeCSVMapping validates a mapping and fills in the default delimiter
+// and decimal separator.
+func normalizeCSVMapping(mapping entity.CSVMapping) (entity.CSVMapping, error) {
+	if mapping.Delimiter == "" {
+		mapping.Delimiter = ","
+	}
+	if mapping.DecimalSeparator == "" {
+		mapping.DecimalSeparator = "."
+	}
+
+	delimiter, size := utf8.DecodeRuneInString(mapping.Delimiter)
+	if size != len(mapping.Delimiter) || delimiter == '"' || delimiter == '\r' || delimiter == '\n' || delimiter == utf8.RuneError {
+		return mapping, domainerrors.NewErrInvalidInput("delimiter", "delimiter must be a single character other than a quote or line break")
+	}
+	if mapping.SkipRows < 0 {
+		return mapping, domainerrors.NewErrInvalidInput("skip_rows", "skip_rows must not be negative")
+	}
+	if mapping.DecimalSeparator != "." && mapping.DecimalSeparator != "," {
+		return mapping, domainerrors.NewErrInvalidInput("decimal_separator", `decimal_separator must be "." or ","`)
+	}
+	if strings.TrimSpace(mapping.DateColumn) == "" {
+		return mapping, domainerrors.NewErrInvalidInput("date_column", "date_column is required")
+	}
+	if _, err := dateLayout(mapping.DateFormat); err != nil {
+		return mapping, err
+	}
+
+	switch mapping.AmountSign {
+	case constant.AmountSignSigned, constant.AmountSignInverted:
+		if strings.TrimSpace(mapping.AmountColumn) == "" {
+			return mapping, domainerrors.NewErrInvalidInput("amount_column", fmt.Sprintf("amount_column is required with the %s amount sign", mapping.AmountSign))
+		}
+	case constant.AmountSignDebitCredit:
+		if strings.TrimSpace(mapping.DebitColumn) == "" || strings.TrimSpace(mapping.CreditColumn) == "" {
+			return mapping, domainerrors.NewErrInvalidInput("debit_column", fmt.Sprintf("debit_column and credit_column are required with the %s amount sign", mapping.AmountSign))
+		}
+	case "":
+		return mapping, domainerrors.NewErrInvalidInput("amount_sign", "amount_sign is required")
+	default:
+		return mapping, domainerrors.NewErrInvalidInput("amount_sign", fmt.Sprintf("unknown amount sign %q", mapping.AmountSign))
+	}
+
+	return mapping, nil
+}
+
+// dateLayout converts a date format spelled with the tokens YYYY, YY, MM and
+// DD into a time layout.
+func dateLayout(format string) (string, error) {
+	layout := strings.NewReplacer("YYYY", "2006", "YY", "06", "MM", "01", "DD", "02").Replace(format)
+	hasYear := strings.Contains(layout, "2006") || strings.Contains(layout, "06")
+	if !hasYear || !strings.Contains(layout, "01") || !strings.Contains(layout, "02") || strings.IndexFunc(layout, unicode.IsLetter) >= 0 {
+		return "", domainerrors.NewErrInvalidInput("date_format", "date_format must spell the date with YYYY or YY, MM and DD, e.g. DD.MM.YYYY")
+	}
+	return layout, nil
+}
+
+// readCSVStatement reads the rows of a CSV statement with a normalized
+// mapping. Problems with the file as a whole, such as a missing column, are
+// returned as an error; problems with a single row are recorded on the row.
+func readCSVStatement(data io.Reader, mapping entity.CSVMapping, currency string) ([]*statementRow, error) {
+	buffered := bufio.NewReader(data)
+	for i := 0; i < mapping.SkipRows; i++ {
+		if _, err := buffered.ReadString('\n'); err == io.EOF {
+			return nil, domainerrors.NewErrInvalidInput("file", "the file ends before the header row")
+		} else if err != nil {
+			return nil, fmt.Errorf("reading statement: %w", err)
+		}
+	}
+
+	reader := csv.NewReader(buffered)
+	reader.Comma, _ = utf8.DecodeRuneInString(mapping.Delimiter)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil, domainerrors.NewErrInvalidInput("file", "the file has no header row")
+	}
+	if err != nil {
+		return nil, invalidCSV(err, mapping.SkipRows)
+	}
+	columns, err := mapColumns(header, mapping)
+	if err != nil {
+		return nil, err
+	}
+	layout, _ := dateLayout(mapping.DateFormat)
+
+	var rows []*statementRow
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, invalidCSV(err, mapping.SkipRows)
+		}
+		if isBlankRecord(record) {
+			continue
+		}
+		if len(rows) == MaxImportRows {
+			return nil, domainerrors.NewErrInvalidInput("file", fmt.Sprintf("a statement may hold at most %d rows", MaxImportRows))
+		}
+
+		line, _ := reader.FieldPos(0)
+		row := &statementRow{line: mapping.SkipRows + line}
+		row.err = row.read(record, columns, mapping, layout, currency)
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// csvColumns holds the index of every mapped column in a record, -1 for
+// optional columns the mapping leaves out.
+type csvColumns struct {
+	date, amount, debit, credit, description, category int
+}
+
+// mapColumns finds the mapped columns in the header row.
+func mapColumns(header []string, mapping entity.CSVMapping) (csvColumns, error) {
+	names := make([]string, len(header))
+	for i, name := range header {
+		names[i] = normalizeColumn(name)
+	}
+	if len(names) > 0 {
+		// Spreadsheet programs often start UTF-8 files with a byte order mark
+		names[0] = strings.TrimPrefix(names[0], "\ufeff")
+	}
+
+	var missing []string
+	find := func(column string, required bool) int {
+		if column == "" && !required {
+			return -1
+		}
+		index := slices.Index(names, normalizeColumn(column))
+		if index < 0 {
+			missing = append(missing, column)
+		}
+		return index
+	}
+
+	columns := csvColumns{
+		date:        find(mapping.DateColumn, true),
+		amount:      -1,
+		debit:       -1,
+		credit:      -1,
+		description: find(mapping.DescriptionColumn, false),
+		category:    find(mapping.CategoryColumn, false),
+	}
+	if mapping.AmountSign == constant.AmountSignDebitCredit {
+		columns.debit = find(mapping.DebitColumn, true)
+		columns.credit = find(mapping.CreditColumn, true)
+	} else {
+		columns.amount = find(mapping.AmountColumn, true)
+	}
+
+	if len(missing) > 0 {
+		quoted := make([]string, len(missing))
+		for i, column := range missing {
+			quoted[i] = strconv.Quote(column)
+		}
+		return columns, domainerrors.NewErrInvalidInput("file", "the header row has no column "+strings.Join(quoted, ", "))
+	}
+	return columns, nil
+}
+
+// read fills in the row from a record and returns why it cannot be imported, if it cannot.
+func (row *statementRow) read(record []string, columns csvColumns, mapping entity.CSVMapping, layout, currency string) error {
+	field := func(index int) string {
+		if index < 0 || index >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[index])
+	}
+
+	value := field(columns.date)
+	if value == "" {
+		return errors.New("the date is missing")
+	}
+	date, err := time.Parse(layout, value)
+	if err != nil {
+		return fmt.Errorf("date %q does not match the format %s", value, mapping.DateFormat)
+	}
+	row.date = date
+
+	var amount money.Money
+	switch mapping.AmountSign {
+	case constant.AmountSignDebitCredit:
+		debit, credit := field(columns.debit), field(columns.credit)
+		if (debit == "") == (credit == "") {
+			return errors.New("exactly one of the debit and credit amounts must be given")
+		}
+		if debit != "" {
+			amount, err = parseStatementAmount(debit, mapping.DecimalSeparator, currency)
+			amount = amount.Abs().Negate()
+		} else {
+			amount, err = parseStatementAmount(credit, mapping.DecimalSeparator, currency)
+			amount = amount.Abs()
+		}
+	default:
+		amount, err = parseStatementAmount(field(columns.amount), mapping.DecimalSeparator, currency)
+		if mapping.AmountSign == constant.AmountSignInverted {
+			amount = amount.Negate()
+		}
+	}
+	if err != nil {
+		return err
+	}
+	if amount.IsZero() {
+		return errors.New("the amount is zero")
+	}
+
+	row.transactionType = constant.TransactionTypeIncome
+	if amount.IsNegative() {
+		row.transactionType = constant.TransactionTypeExpense
+	}
+	row.amount = amount.Abs()
+	row.description = field(columns.description)
+	row.category = field(columns.category)
+	return nil
+}
+
+// parseStatementAmount reads an amount as banks write it, with an optional
+// thousands separator and a sign given by a leading or trailing minus or by
+// parentheses.
+func parseStatementAmount(value, decimalSeparator, currency string) (money.Money, error) {
+	if value == "" {
+		return money.Money{}, errors.New("the amount is missing")
+	}
+
+	thousandsSeparator := ","
+	if decimalSeparator == "," {
+		thousandsSeparator = "."
+	}
+	normalized := strings.NewReplacer(
+		" ", "", "\u00a0", "", "\u202f", "", "'", "",
+		thousandsSeparator, "",
+		decimalSeparator, ".",
+	).Replace(value)
+
+	negative := false
+	if strings.HasPrefix(normalized, "(") && strings.HasSuffix(normalized, ")") {
+		negative = true
+		normalized = normalized[1 : len(normalized)-1]
+	} else if strings.HasSuffix(normalized, "-") {
+		negative = true
+		normalized = strings.TrimSuffix(normalized, "-")
+	}
+
+	amount, err := money.Parse(normalized, currency)
+	if err != nil {
+		return money.Money{}, fmt.Errorf("amount %q is not a valid %s amount", value, currency)
+	}
+	if negative {
+		amount = amount.Negate()
+	}
+	return amount, nil
+}
+
+// invalidCSV reports a malformed CSV file, counting lines from the top of the file.
+func invalidCSV(err error, skippedRows int) error {
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return domainerrors.NewErrInvalidInput("file", fmt.Sprintf("line %d is not valid CSV: %v", skippedRows+parseErr.Line, parseErr.Err))
+	}
+	return fmt.Errorf("reading statement: %w", err)
+}
+
+func normalizeColumn(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+func isBlankRecord(record []string) bool {
+	for _, field := range record {
+		if strings.TrimSpace(field) != "" {
+			return false
+		}
+	}
+	return true
+}
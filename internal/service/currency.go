@@ -0,0 +1,17 @@
+package service
+
+import (
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/pkg/iso4217"
+)
+
+// validateCurrency rejects empty codes and codes missing from the ISO 4217 registry.
+func validateCurrency(field, code string) error {
+	if code == "" {
+		return domainerrors.NewErrInvalidInput(field, "currency is required")
+	}
+	if !iso4217.IsValid(code) {
+		return domainerrors.NewErrInvalidInput(field, "unknown ISO 4217 currency code "+code)
+	}
+	return nil
+}
@@ -0,0 +1,206 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	"accounting/internal/domain/money"
+)
+
+var testRateDay = time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+func TestCreateTransactionConvertsToAccountCurrency(t *testing.T) {
+	testAccount := NewTestAccount()
+	accountRepo := &MockAccountRepository{accountToReturn: testAccount}
+	service := NewTransactionService(&MockTransactionRepository{}, accountRepo, &MockTransactionManager{},
+		newTestConverter(NewTestExchangeRate("EUR", "USD", "1.0845", testRateDay)), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+
+	transaction, err := service.CreateTransaction(
+		context.Background(),
+		testAccount.ID,
+		money.MustParse("50.00", "EUR"),
+		"Dinner in Paris",
+		"",
+		"category-food",
+		nil,
+		nil,
+		constant.TransactionTypeExpense,
+		testRateDay.Add(20*time.Hour),
+		false,
+	)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if transaction.Amount != money.MustParse("54.23", "USD") {
+		t.Errorf("expected converted amount 54.23 USD, got %s %s", transaction.Amount, transaction.Amount.Currency())
+	}
+
+	if transaction.OriginalAmount != money.MustParse("50.00", "EUR") {
+		t.Errorf("expected original amount 50.00 EUR, got %s %s", transaction.OriginalAmount, transaction.OriginalAmount.Currency())
+	}
+
+	if transaction.ExchangeRate.String() != "1.0845" {
+		t.Errorf("expected rate 1.0845, got %s", transaction.ExchangeRate)
+	}
+
+	if testAccount.Balance != money.MustParse("945.77", "USD") {
+		t.Errorf("expected balance 945.77, got %s", testAccount.Balance)
+	}
+}
+
+func TestCreateTransactionSameCurrencyRecordsNoConversion(t *testing.T) {
+	accountRepo := &MockAccountRepository{accountToReturn: NewTestAccount()}
+	service := NewTransactionService(&MockTransactionRepository{}, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+
+	transaction, err := service.CreateTransaction(context.Background(), "test-account-123", money.MustParse("10.00", "USD"), "", "", "", nil, nil, constant.TransactionTypeIncome, time.Now(), false)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if !transaction.OriginalAmount.IsZero() || transaction.OriginalAmount.Currency() != "" || !transaction.ExchangeRate.IsZero() {
+		t.Errorf("expected no conversion to be recorded, got %s at %s", transaction.OriginalAmount, transaction.ExchangeRate)
+	}
+}
+
+func TestCreateTransactionConvertsSplits(t *testing.T) {
+	accountRepo := &MockAccountRepository{accountToReturn: NewTestAccount()}
+	service := NewTransactionService(&MockTransactionRepository{}, accountRepo, &MockTransactionManager{},
+		newTestConverter(NewTestExchangeRate("EUR", "USD", "1.0845", testRateDay)), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+
+	splits := []*entity.Split{
+		{Amount: money.MustParse("49.95", "EUR"), CategoryID: "category-food"},
+		{Amount: money.MustParse("0.05", "EUR"), CategoryID: "category-housing"},
+	}
+	transaction, err := service.CreateTransaction(context.Background(), "test-account-123", money.MustParse("50.00", "EUR"), "Dinner in Paris", "", "", nil, splits, constant.TransactionTypeExpense, testRateDay.Add(20*time.Hour), false)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	// 49.95 EUR converts to 54.17 USD, the last split takes up the rest of 54.23 USD
+	for i, want := range []string{"54.17", "0.06"} {
+		if amount := transaction.Splits[i].Amount; amount != money.MustParse(want, "USD") {
+			t.Errorf("split %d: expected %s USD, got %s %s", i, want, amount, amount.Currency())
+		}
+	}
+}
+
+func TestUpdateTransactionDateReconvertsOriginalAmount(t *testing.T) {
+	testAccount := NewTestAccount()
+	testAccount.Balance = money.MustParse("945.77", "USD")
+	testTransaction := NewTestTransaction()
+	testTransaction.Date = testRateDay
+	testTransaction.Amount = money.MustParse("54.23", "USD")
+	testTransaction.OriginalAmount = money.MustParse("50.00", "EUR")
+	testTransaction.ExchangeRate = money.MustParseRate("1.0845")
+
+	transactionRepo := &MockTransactionRepository{transactionToReturn: testTransaction}
+	accountRepo := &MockAccountRepository{accountToReturn: testAccount}
+	service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(
+		NewTestExchangeRate("EUR", "USD", "1.0845", testRateDay),
+		NewTestExchangeRate("EUR", "USD", "1.1", testRateDay.AddDate(0, 0, 7)),
+	), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+
+	updated, err := service.UpdateTransaction(context.Background(), testTransaction.ID, "", money.Money{}, "", "", "", nil, nil, "", testRateDay.AddDate(0, 0, 8))
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if updated.Amount != money.MustParse("55.00", "USD") || updated.ExchangeRate.String() != "1.1" {
+		t.Errorf("expected 55.00 USD at 1.1, got %s at %s", updated.Amount, updated.ExchangeRate)
+	}
+
+	if testAccount.Balance != money.MustParse("945.00", "USD") {
+		t.Errorf("expected balance 945.00, got %s", testAccount.Balance)
+	}
+}
+
+func TestUpdateTransactionDescriptionKeepsRecordedRate(t *testing.T) {
+	testTransaction := NewTestTransaction()
+	testTransaction.Amount = money.MustParse("54.23", "USD")
+	testTransaction.OriginalAmount = money.MustParse("50.00", "EUR")
+	testTransaction.ExchangeRate = money.MustParseRate("1.0845")
+
+	transactionRepo := &MockTransactionRepository{transactionToReturn: testTransaction}
+	accountRepo := &MockAccountRepository{accountToReturn: NewTestAccount()}
+	// No rates are stored, so any attempt to convert again would fail
+	service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+
+	updated, err := service.UpdateTransaction(context.Background(), testTransaction.ID, "", money.Money{}, "Renamed", "", "", nil, nil, "", time.Time{})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if updated.Amount != money.MustParse("54.23", "USD") || updated.ExchangeRate.String() != "1.0845" {
+		t.Errorf("expected 54.23 USD at 1.0845 to be kept, got %s at %s", updated.Amount, updated.ExchangeRate)
+	}
+}
+
+func TestCreateTransferBetweenCurrencies(t *testing.T) {
+	checking, savings := newTestTransferAccounts()
+	savings.Currency = "EUR"
+	savings.Balance = money.MustParse("200.00", "EUR")
+	accountRepo := &MockAccountRepository{
+		accountsToReturn: map[string]*entity.Account{
+			checking.ID: checking,
+			savings.ID:  savings,
+		},
+	}
+	service := NewTransferService(&MockTransactionRepository{}, accountRepo, &MockTransactionManager{},
+		newTestConverter(NewTestExchangeRate("EUR", "USD", "1.25", testRateDay)))
+
+	transfer, err := service.CreateTransfer(context.Background(), checking.ID, savings.ID, money.MustParse("100.00", "USD"), "", testRateDay)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if transfer.Credit.Amount != money.MustParse("80.00", "EUR") || transfer.Credit.OriginalAmount != money.MustParse("100.00", "USD") {
+		t.Errorf("expected credit leg of 80.00 EUR from 100.00 USD, got %s from %s", transfer.Credit.Amount, transfer.Credit.OriginalAmount)
+	}
+
+	if checking.Balance != money.MustParse("900.00", "USD") {
+		t.Errorf("expected source balance 900.00 USD, got %s", checking.Balance)
+	}
+
+	if savings.Balance != money.MustParse("280.00", "EUR") {
+		t.Errorf("expected destination balance 280.00 EUR, got %s", savings.Balance)
+	}
+}
+
+func TestAccountTreeTotalsInBaseCurrency(t *testing.T) {
+	parent := NewTestAccount()
+	child := NewTestAccount()
+	child.ID = "test-account-456"
+	child.ParentID = parent.ID
+	child.Currency = "EUR"
+	child.Balance = money.MustParse("100.00", "EUR")
+	accountRepo := &MockAccountRepository{accountsListToReturn: []*entity.Account{parent, child}}
+	service := NewAccountService(accountRepo, &MockUserRepository{}, &MockTransactionManager{},
+		newTestConverter(NewTestExchangeRate("EUR", "USD", "1.1", testRateDay)))
+
+	roots, err := service.GetAccountTree(context.Background(), parent.UserID, "USD")
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(roots) != 1 {
+		t.Fatalf("expected 1 root, got %d", len(roots))
+	}
+
+	if roots[0].RolledUpTotal != money.MustParse("1110.00", "USD") {
+		t.Errorf("expected root total 1110.00 USD, got %s", roots[0].RolledUpTotal)
+	}
+
+	if roots[0].Children[0].RolledUpTotal != money.MustParse("110.00", "USD") {
+		t.Errorf("expected child total 110.00 USD, got %s", roots[0].Children[0].RolledUpTotal)
+	}
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	"accounting/internal/domain/money"
+)
+
+// DuplicateWindowDays is how many days apart two transactions may be dated
+// and still count as likely duplicates, allowing for a bank booking a
+// purchase a few days after it was entered by hand.
+const DuplicateWindowDays = 3
+
+// FindDuplicates returns the account's transactions that are likely
+// duplicates of the given one, oldest first: of the same type and amount as
+// entered, dated within DuplicateWindowDays, with the same normalized
+// description. Transactions that both have an external ID are never likely
+// duplicates, since the bank tells them apart by it.
+func (s *TransactionService) FindDuplicates(ctx context.Context, accountID, externalID string, amount money.Money, description string, transactionType constant.TransactionType, date time.Time) ([]*entity.Transaction, error) {
+	similar, err := s.transactionRepo.ListSimilar(ctx, accountID, transactionType, amount,
+		date.AddDate(0, 0, -DuplicateWindowDays), date.AddDate(0, 0, DuplicateWindowDays))
+	if err != nil {
+		return nil, fmt.Errorf("finding duplicate transactions: %w", err)
+	}
+
+	normalized := normalizeDescription(description)
+	var duplicates []*entity.Transaction
+	for _, t := range similar {
+		if externalID != "" && t.ExternalID != "" {
+			continue
+		}
+		if normalizeDescription(t.Description) == normalized {
+			duplicates = append(duplicates, t)
+		}
+	}
+	return duplicates, nil
+}
+
+// normalizeDescription reduces a description to its lower-case words, so
+// that differences in case, punctuation and spacing do not matter.
+func normalizeDescription(description string) string {
+	words := strings.FieldsFunc(strings.ToLower(description), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/domain/money"
+)
+
+type ExchangeRateService struct {
+	rateRepo  interfaces.ExchangeRateRepository
+	txManager interfaces.TransactionManager
+}
+
+func NewExchangeRateService(rateRepo interfaces.ExchangeRateRepository, txManager interfaces.TransactionManager) *ExchangeRateService {
+	return &ExchangeRateService{
+		rateRepo:  rateRepo,
+		txManager: txManager,
+	}
+}
+
+func (s *ExchangeRateService) SetRates(ctx context.Context, rates []*entity.ExchangeRate) error {
+	if len(rates) == 0 {
+		return domainerrors.NewErrInvalidInput("rates", "at least one rate is required")
+	}
+	for i, rate := range rates {
+		field := fmt.Sprintf("rates[%d]", i)
+		if err := validateCurrency(field, rate.BaseCurrency); err != nil {
+			return err
+		}
+		if err := validateCurrency(field, rate.QuoteCurrency); err != nil {
+			return err
+		}
+		if rate.BaseCurrency == rate.QuoteCurrency {
+			return domainerrors.NewErrInvalidInput(field, "base and quote currencies must differ")
+		}
+		if rate.Date.IsZero() {
+			return domainerrors.NewErrInvalidInput(field, "date is required")
+		}
+		if rate.Rate.IsZero() {
+			return domainerrors.NewErrInvalidInput(field, "rate is required")
+		}
+		rate.Date = calendarDay(rate.Date)
+	}
+
+	// A batch loaded from a file is stored completely or not at all
+	return s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		for _, rate := range rates {
+			if err := s.rateRepo.Upsert(ctx, rate); err != nil {
+				return fmt.Errorf("storing exchange rate: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+func (s *ExchangeRateService) GetRate(ctx context.Context, baseCurrency, quoteCurrency string, date time.Time) (*entity.ExchangeRate, error) {
+	date = calendarDay(date)
+	if baseCurrency == quoteCurrency {
+		return &entity.ExchangeRate{BaseCurrency: baseCurrency, QuoteCurrency: quoteCurrency, Date: date, Rate: money.OneRate()}, nil
+	}
+
+	direct, err := s.rateRepo.GetEffective(ctx, baseCurrency, quoteCurrency, date)
+	if err != nil {
+		return nil, fmt.Errorf("getting exchange rate: %w", err)
+	}
+	inverse, err := s.rateRepo.GetEffective(ctx, quoteCurrency, baseCurrency, date)
+	if err != nil {
+		return nil, fmt.Errorf("getting exchange rate: %w", err)
+	}
+
+	// Use whichever direction was quoted most recently, preferring the direct pair
+	if inverse == nil || direct != nil && !direct.Date.Before(inverse.Date) {
+		return direct, nil
+	}
+	return &entity.ExchangeRate{
+		BaseCurrency:  baseCurrency,
+		QuoteCurrency: quoteCurrency,
+		Date:          inverse.Date,
+		Rate:          inverse.Rate.Inverse(),
+	}, nil
+}
+
+func (s *ExchangeRateService) Convert(ctx context.Context, amount money.Money, currency string, date time.Time) (money.Money, money.Rate, error) {
+	if amount.Currency() == currency {
+		return amount, money.OneRate(), nil
+	}
+
+	rate, err := s.GetRate(ctx, amount.Currency(), currency, date)
+	if err != nil {
+		return money.Money{}, money.Rate{}, err
+	}
+	if rate == nil {
+		return money.Money{}, money.Rate{}, domainerrors.NewErrInvalidInput("currency",
+			fmt.Sprintf("no exchange rate from %s to %s on %s", amount.Currency(), currency, calendarDay(date).Format(time.DateOnly)))
+	}
+
+	converted, err := amount.Convert(rate.Rate, currency)
+	if err != nil {
+		return money.Money{}, money.Rate{}, fmt.Errorf("converting amount: %w", err)
+	}
+	return converted, rate.Rate, nil
+}
+
+// Compile-time interface check
+var _ interfaces.ExchangeRateService = (*ExchangeRateService)(nil)
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/money"
+)
+
+func TestGetRateUsesLatestRateOnOrBeforeDate(t *testing.T) {
+	service := newTestConverter(
+		NewTestExchangeRate("EUR", "USD", "1.05", time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)),
+		NewTestExchangeRate("EUR", "USD", "1.10", time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)),
+	)
+
+	rate, err := service.GetRate(context.Background(), "EUR", "USD", time.Date(2024, 3, 9, 15, 30, 0, 0, time.UTC))
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if rate == nil || rate.Rate.String() != "1.05" {
+		t.Errorf("expected rate 1.05, got %v", rate)
+	}
+}
+
+func TestGetRateFallsBackToInverse(t *testing.T) {
+	service := newTestConverter(
+		NewTestExchangeRate("USD", "EUR", "0.8", time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)),
+	)
+
+	rate, err := service.GetRate(context.Background(), "EUR", "USD", time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC))
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if rate == nil || rate.Rate.String() != "1.25" {
+		t.Errorf("expected inverse rate 1.25, got %v", rate)
+	}
+}
+
+func TestGetRateUnknownPair(t *testing.T) {
+	service := newTestConverter()
+
+	rate, err := service.GetRate(context.Background(), "EUR", "JPY", time.Now())
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if rate != nil {
+		t.Errorf("expected nil rate, got %v", rate)
+	}
+}
+
+func TestConvertWithoutRate(t *testing.T) {
+	service := newTestConverter()
+
+	_, _, err := service.Convert(context.Background(), money.MustParse("10.00", "EUR"), "USD", time.Now())
+
+	var invalidErr *domainerrors.ErrInvalidInput
+	if !errors.As(err, &invalidErr) {
+		t.Errorf("expected ErrInvalidInput, got %T", err)
+	}
+}
+
+func TestSetRatesReplacesSameDay(t *testing.T) {
+	repo := &MockExchangeRateRepository{}
+	service := NewExchangeRateService(repo, &MockTransactionManager{})
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	if err := service.SetRates(context.Background(), []*entity.ExchangeRate{NewTestExchangeRate("EUR", "USD", "1.05", day)}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := service.SetRates(context.Background(), []*entity.ExchangeRate{NewTestExchangeRate("EUR", "USD", "1.07", day)}); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(repo.rates) != 1 || repo.rates[0].Rate.String() != "1.07" {
+		t.Errorf("expected a single rate of 1.07, got %v", repo.rates)
+	}
+}
+
+func TestSetRatesRejectsSameCurrency(t *testing.T) {
+	repo := &MockExchangeRateRepository{}
+	service := NewExchangeRateService(repo, &MockTransactionManager{})
+
+	err := service.SetRates(context.Background(), []*entity.ExchangeRate{NewTestExchangeRate("USD", "USD", "1", time.Now())})
+
+	var invalidErr *domainerrors.ErrInvalidInput
+	if !errors.As(err, &invalidErr) {
+		t.Errorf("expected ErrInvalidInput, got %T", err)
+	}
+	if repo.upsertCalls != 0 {
+		t.Errorf("expected no upserts, got %d", repo.upsertCalls)
+	}
+}
+
+func TestSetRatesFailureStoresNothing(t *testing.T) {
+	repo := &MockExchangeRateRepository{}
+	txManager := &MockTransactionManager{}
+	service := NewExchangeRateService(repo, txManager)
+	day := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	rates := []*entity.ExchangeRate{
+		NewTestExchangeRate("EUR", "USD", "1.05", day),
+		NewTestExchangeRate("GBP", "USD", "1.25", day),
+	}
+	failing := &failingAfterRepo{MockExchangeRateRepository: repo, failAfter: 1}
+	service.rateRepo = failing
+
+	if err := service.SetRates(context.Background(), rates); err == nil {
+		t.Fatal("expected error when storing a rate fails")
+	}
+
+	if txManager.rollbacks != 1 {
+		t.Errorf("expected 1 rollback, got %d", txManager.rollbacks)
+	}
+	if len(repo.rates) != 0 {
+		t.Errorf("expected no stored rates, got %d", len(repo.rates))
+	}
+}
+
+// failingAfterRepo fails every upsert after the first failAfter ones
+type failingAfterRepo struct {
+	*MockExchangeRateRepository
+	failAfter int
+}
+
+func (f *failingAfterRepo) Upsert(ctx context.Context, rate *entity.ExchangeRate) error {
+	if f.upsertCalls >= f.failAfter {
+		f.upsertCalls++
+		return errors.New("connection reset")
+	}
+	return f.MockExchangeRateRepository.Upsert(ctx, rate)
+}
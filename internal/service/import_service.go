@@ -0,0 +1,339 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/interfaces"
+
+	"github.com/google/uuid"
+)
+
+// MaxImportRows caps the number of rows a single statement import may hold.
+const MaxImportRows = 10000
+
+type ImportService struct {
+	profileRepo  interfaces.ImportProfileRepository
+	accountRepo  interfaces.AccountRepository
+	userRepo     interfaces.UserRepository
+	transactions *TransactionService
+	categoryRepo interfaces.CategoryRepository
+	ruleRepo     interfaces.CategoryRuleRepository
+}
+
+func NewImportService(profileRepo interfaces.ImportProfileRepository, accountRepo interfaces.AccountRepository, userRepo interfaces.UserRepository, transactions *TransactionService, categoryRepo interfaces.CategoryRepository, ruleRepo interfaces.CategoryRuleRepository) *ImportService {
+	return &ImportService{
+		profileRepo:  profileRepo,
+		accountRepo:  accountRepo,
+		userRepo:     userRepo,
+		transactions: transactions,
+		categoryRepo: categoryRepo,
+		ruleRepo:     ruleRepo,
+	}
+}
+
+func (s *ImportService) CreateProfile(ctx context.Context, userID, name string, mapping entity.CSVMapping) (*entity.ImportProfile, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, domainerrors.NewErrInvalidInput("name", "name is required")
+	}
+	mapping, err := normalizeCSVMapping(mapping)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkUser(ctx, s.userRepo, userID); err != nil {
+		return nil, err
+	}
+
+	profile := &entity.ImportProfile{
+		ID:      uuid.New().String(),
+		UserID:  userID,
+		Name:    name,
+		Mapping: mapping,
+	}
+	if err := s.checkProfileNameUnique(ctx, profile); err != nil {
+		return nil, err
+	}
+
+	if err := s.profileRepo.Create(ctx, profile); err != nil {
+		return nil, fmt.Errorf("creating import profile: %w", err)
+	}
+
+	return profile, nil
+}
+
+func (s *ImportService) GetProfile(ctx context.Context, id string) (*entity.ImportProfile, error) {
+	return s.profileRepo.GetByID(ctx, id)
+}
+
+func (s *ImportService) ListUserProfiles(ctx context.Context, userID string) ([]*entity.ImportProfile, error) {
+	if err := checkUser(ctx, s.userRepo, userID); err != nil {
+		return nil, err
+	}
+	return s.profileRepo.ListByUserID(ctx, userID)
+}
+
+func (s *ImportService) UpdateProfile(ctx context.Context, id, name string, mapping *entity.CSVMapping) (*entity.ImportProfile, error) {
+	profile, err := s.profileRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("getting import profile: %w", err)
+	}
+	if profile == nil {
+		return nil, domainerrors.NewErrNotFound("import profile", id)
+	}
+
+	if name = strings.TrimSpace(name); name != "" {
+		profile.Name = name
+	}
+	if mapping != nil {
+		profile.Mapping, err = normalizeCSVMapping(*mapping)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := s.checkProfileNameUnique(ctx, profile); err != nil {
+		return nil, err
+	}
+
+	if err := s.profileRepo.Update(ctx, profile); err != nil {
+		return nil, fmt.Errorf("updating import profile: %w", err)
+	}
+
+	return profile, nil
+}
+
+func (s *ImportService) DeleteProfile(ctx context.Context, id string) error {
+	return s.profileRepo.Delete(ctx, id)
+}
+
+func (s *ImportService) ImportCSV(ctx context.Context, accountID, profileID string, mapping *entity.CSVMapping, data io.Reader) (*entity.ImportResult, error) {
+	account, err := s.getAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	var csvMapping entity.CSVMapping
+	switch {
+	case profileID != "":
+		profile, err := s.profileRepo.GetByID(ctx, profileID)
+		if err != nil {
+			return nil, fmt.Errorf("getting import profile: %w", err)
+		}
+		if profile == nil {
+			return nil, domainerrors.NewErrNotFound("import profile", profileID)
+		}
+		if profile.UserID != account.UserID {
+			return nil, domainerrors.NewErrInvalidInput("profile_id", "the import profile belongs to another user")
+		}
+		csvMapping = profile.Mapping
+	case mapping != nil:
+		csvMapping, err = normalizeCSVMapping(*mapping)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, domainerrors.NewErrInvalidInput("profile_id", "an import profile or a mapping is required")
+	}
+
+	rows, err := readCSVStatement(data, csvMapping, account.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.importRows(ctx, account, rows)
+}
+
+func (s *ImportService) ImportOFX(ctx context.Context, accountID string, data io.Reader) (*entity.ImportResult, error) {
+	account, err := s.getAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := readOFXStatement(data, account.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.importRows(ctx, account, rows)
+}
+
+func (s *ImportService) ImportCamt053(ctx context.Context, accountID string, data io.Reader) (*entity.ImportResult, error) {
+	account, err := s.getAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := readCamt053Statement(data, account.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.importRows(ctx, account, rows)
+}
+
+func (s *ImportService) ImportMT940(ctx context.Context, accountID string, data io.Reader) (*entity.ImportResult, error) {
+	account, err := s.getAccount(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := readMT940Statement(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.importRows(ctx, account, rows)
+}
+
+// importRows creates a transaction for every row that was read successfully.
+// Rows are created one by one, so that a row the transaction service rejects
+// is reported without undoing the others; any other error ends the import.
+// Rows with an external ID already recorded on the account are skipped, and
+// rows that are likely duplicates of a transaction on the account are
+// flagged instead of imported. A transaction is the duplicate of one row at
+// most, and the rows of the statement are not duplicates of each other,
+// since a statement may well list the same purchase twice. A row's category
+// names one of the user's categories; rows without one are categorized and
+// tagged by the user's rules before duplicates are looked for, as rules may
+// rewrite their description.
+func (s *ImportService) importRows(ctx context.Context, account *entity.Account, rows []*statementRow) (*entity.ImportResult, error) {
+	accountID := account.ID
+	result := &entity.ImportResult{
+		AccountID: accountID,
+		Rows:      make([]*entity.ImportRow, 0, len(rows)),
+	}
+	matchers, err := loadRuleMatchers(ctx, s.ruleRepo, account.UserID)
+	if err != nil {
+		return nil, err
+	}
+	// matched holds the transactions created or flagged as a duplicate so far
+	matched := make(map[string]bool)
+	// categoryIDs caches the ID of each category named in the statement by its lowercased name
+	categoryIDs := make(map[string]string)
+
+	for _, row := range rows {
+		outcome := &entity.ImportRow{Line: row.line}
+		result.Rows = append(result.Rows, outcome)
+
+		if row.err != nil {
+			outcome.Status = constant.ImportRowStatusFailed
+			outcome.Error = row.err.Error()
+			result.Failed++
+			continue
+		}
+
+		var categoryID string
+		var tags []string
+		if row.category != "" {
+			key := strings.ToLower(row.category)
+			var cached bool
+			if categoryID, cached = categoryIDs[key]; !cached {
+				// Names are only unique under a parent, so a name shared by
+				// several categories identifies none of them
+				categories, err := s.categoryRepo.ListByName(ctx, account.UserID, row.category)
+				if err != nil {
+					return nil, fmt.Errorf("listing categories: %w", err)
+				}
+				if len(categories) == 1 {
+					categoryID = categories[0].ID
+				}
+				categoryIDs[key] = categoryID
+			}
+			if categoryID == "" {
+				outcome.Status = constant.ImportRowStatusFailed
+				outcome.Error = fmt.Sprintf("unknown or ambiguous category %q", row.category)
+				result.Failed++
+				continue
+			}
+		} else {
+			categorized := &entity.Transaction{
+				AccountID:   accountID,
+				Amount:      row.amount,
+				Description: row.description,
+				Date:        row.date,
+				Type:        row.transactionType,
+			}
+			applyRuleMatchers(matchers, categorized)
+			row.description, categoryID, tags = categorized.Description, categorized.CategoryID, categorized.Tags
+		}
+
+		duplicates, err := s.transactions.FindDuplicates(ctx, accountID, row.externalID, row.amount, row.description, row.transactionType, row.date)
+		if err != nil {
+			return nil, fmt.Errorf("importing line %d: %w", row.line, err)
+		}
+		if i := slices.IndexFunc(duplicates, func(t *entity.Transaction) bool { return !matched[t.ID] }); i >= 0 {
+			matched[duplicates[i].ID] = true
+			outcome.Status = constant.ImportRowStatusDuplicate
+			outcome.TransactionID = duplicates[i].ID
+			outcome.Error = "the row looks like a transaction already recorded on the account"
+			result.Duplicates++
+			continue
+		}
+
+		// Duplicates were looked for above, leaving out the transactions of this
+		// import, and the rules were applied to the rows without a category
+		transaction, err := s.transactions.importTransaction(ctx, accountID, row.externalID, row.amount, row.description, categoryID, tags, row.transactionType, row.date)
+		var duplicateErr *domainerrors.ErrDuplicateTransaction
+		if errors.As(err, &duplicateErr) {
+			outcome.Status = constant.ImportRowStatusSkipped
+			outcome.TransactionID = duplicateErr.ExistingID
+			outcome.Error = "the transaction was already imported"
+			result.Skipped++
+			continue
+		}
+		var invalidErr *domainerrors.ErrInvalidInput
+		if errors.As(err, &invalidErr) {
+			outcome.Status = constant.ImportRowStatusFailed
+			outcome.Error = invalidErr.Message
+			result.Failed++
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("importing line %d: %w", row.line, err)
+		}
+
+		matched[transaction.ID] = true
+		outcome.Status = constant.ImportRowStatusImported
+		outcome.TransactionID = transaction.ID
+		result.Imported++
+	}
+
+	return result, nil
+}
+
+// getAccount returns the account statements are imported into.
+func (s *ImportService) getAccount(ctx context.Context, accountID string) (*entity.Account, error) {
+	if accountID == "" {
+		return nil, domainerrors.NewErrInvalidInput("account_id", "account ID is required")
+	}
+	account, err := s.accountRepo.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("getting account: %w", err)
+	}
+	if account == nil {
+		return nil, domainerrors.NewErrNotFound("account", accountID)
+	}
+	return account, nil
+}
+
+// checkProfileNameUnique verifies that no other profile of the user has the profile's name.
+func (s *ImportService) checkProfileNameUnique(ctx context.Context, profile *entity.ImportProfile) error {
+	existing, err := s.profileRepo.GetByName(ctx, profile.UserID, profile.Name)
+	if err != nil {
+		return fmt.Errorf("checking import profile name: %w", err)
+	}
+	if existing != nil && existing.ID != profile.ID {
+		return domainerrors.NewErrDuplicateImportProfile(profile.UserID, profile.Name)
+	}
+	return nil
+}
+
+// Compile-time interface check
+var _ interfaces.ImportService = (*ImportService)(nil)
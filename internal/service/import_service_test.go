@@ -0,0 +1,937 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/money"
+)
+
+// newTestImportService returns an import service that creates transactions in
+// the test account through a real transaction service.
+func newTestImportService(profiles *MockImportProfileRepository) (*ImportService, *MockTransactionRepository, *entity.Account) {
+	account := NewTestAccount()
+	accountRepo := &MockAccountRepository{accountToReturn: account}
+	transactionRepo := &MockTransactionRepository{}
+	transactions := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+	userRepo := &MockUserRepository{userToReturn: NewTestUser()}
+	return NewImportService(profiles, accountRepo, userRepo, transactions, newTestCategories(), &MockCategoryRuleRepository{}), transactionRepo, account
+}
+
+func TestImportCSV(t *testing.T) {
+	service, transactionRepo, account := newTestImportService(&MockImportProfileRepository{})
+	statement := "Account statement;Checking\n" +
+		"Period;March 2024\n" +
+		"Booking date;Text;Amount;Category\n" +
+		"01.03.2024;Salary March;3.000,00;Salary\n" +
+		"05.03.2024;\"Rent; March\";-1.234,56;housing\n" +
+		";;;\n" +
+		"2024-03-07;Groceries;-20,00;Food\n" +
+		"08.03.2024;Refund;0,00;\n" +
+		"09.03.2024;Coffee;-3,5x;Food\n" +
+		"10.03.2024;Flowers;-15,00;Presents\n"
+	mapping := &entity.CSVMapping{
+		Delimiter:         ";",
+		SkipRows:          2,
+		DateColumn:        " booking DATE ",
+		DateFormat:        "DD.MM.YYYY",
+		AmountSign:        constant.AmountSignSigned,
+		AmountColumn:      "Amount",
+		DescriptionColumn: "Text",
+		CategoryColumn:    "Category",
+		DecimalSeparator:  ",",
+	}
+
+	result, err := service.ImportCSV(context.Background(), account.ID, "", mapping, strings.NewReader(statement))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if result.Imported != 2 || result.Failed != 4 || len(result.Rows) != 6 {
+		t.Fatalf("expected 2 imported and 4 failed rows, got %+v", result)
+	}
+	expected := []struct {
+		line   int
+		status constant.ImportRowStatus
+		error  string
+	}{
+		{4, constant.ImportRowStatusImported, ""},
+		{5, constant.ImportRowStatusImported, ""},
+		{7, constant.ImportRowStatusFailed, `date "2024-03-07" does not match the format DD.MM.YYYY`},
+		{8, constant.ImportRowStatusFailed, "the amount is zero"},
+		{9, constant.ImportRowStatusFailed, `amount "-3,5x" is not a valid USD amount`},
+		{10, constant.ImportRowStatusFailed, `unknown or ambiguous category "Presents"`},
+	}
+	for i, want := range expected {
+		row := result.Rows[i]
+		if row.Line != want.line || row.Status != want.status || row.Error != want.error {
+			t.Errorf("row %d: expected line %d %s %q, got line %d %s %q", i, want.line, want.status, want.error, row.Line, row.Status, row.Error)
+		}
+	}
+
+	if len(transactionRepo.created) != 2 {
+		t.Fatalf("expected 2 transactions, got %d", len(transactionRepo.created))
+	}
+	rent := transactionRepo.created[1]
+	if result.Rows[1].TransactionID != rent.ID {
+		t.Errorf("expected row 5 to report transaction %s, got %s", rent.ID, result.Rows[1].TransactionID)
+	}
+	if rent.Type != constant.TransactionTypeExpense || rent.Amount != money.MustParse("1234.56", "USD") ||
+		rent.Description != "Rent; March" || rent.Category != "Housing" || !rent.Date.Equal(time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected rent transaction %+v", rent)
+	}
+	if transactionRepo.created[0].Type != constant.TransactionTypeIncome {
+		t.Errorf("expected the salary to be income, got %s", transactionRepo.created[0].Type)
+	}
+	if account.Balance != money.MustParse("2765.44", "USD") {
+		t.Errorf("expected balance 2765.44, got %s", account.Balance)
+	}
+}
+
+func TestImportCSVAmountSigns(t *testing.T) {
+	tests := []struct {
+		name      string
+		mapping   entity.CSVMapping
+		statement string
+		expected  []constant.TransactionType
+		failed    string
+	}{
+		{
+			name: "inverted",
+			mapping: entity.CSVMapping{
+				DateColumn:   "Date",
+				DateFormat:   "MM/DD/YYYY",
+				AmountSign:   constant.AmountSignInverted,
+				AmountColumn: "Amount",
+			},
+			statement: "Date,Amount\n03/01/2024,\"1,250.00\"\n03/02/2024,(40.00)\n",
+			expected:  []constant.TransactionType{constant.TransactionTypeExpense, constant.TransactionTypeIncome},
+		},
+		{
+			name: "debit and credit columns",
+			mapping: entity.CSVMapping{
+				Delimiter:    "\t",
+				DateColumn:   "Date",
+				DateFormat:   "YYYY-MM-DD",
+				AmountSign:   constant.AmountSignDebitCredit,
+				DebitColumn:  "Debit",
+				CreditColumn: "Credit",
+			},
+			statement: "Date\tDebit\tCredit\n2024-03-01\t12.50\t\n2024-03-02\t\t100.00\n2024-03-03\t1.00\t2.00\n",
+			expected:  []constant.TransactionType{constant.TransactionTypeExpense, constant.TransactionTypeIncome},
+			failed:    "exactly one of the debit and credit amounts must be given",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, transactionRepo, account := newTestImportService(&MockImportProfileRepository{})
+
+			result, err := service.ImportCSV(context.Background(), account.ID, "", &tt.mapping, strings.NewReader(tt.statement))
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if len(transactionRepo.created) != len(tt.expected) {
+				t.Fatalf("expected %d transactions, got %d: %+v", len(tt.expected), len(transactionRepo.created), result.Rows)
+			}
+			for i, transactionType := range tt.expected {
+				if transactionRepo.created[i].Type != transactionType {
+					t.Errorf("transaction %d: expected %s, got %s", i, transactionType, transactionRepo.created[i].Type)
+				}
+			}
+			if tt.failed != "" && (result.Failed != 1 || result.Rows[len(result.Rows)-1].Error != tt.failed) {
+				t.Errorf("expected the last row to fail with %q, got %+v", tt.failed, result.Rows[len(result.Rows)-1])
+			}
+		})
+	}
+}
+
+func TestImportCSVWithProfile(t *testing.T) {
+	profiles := &MockImportProfileRepository{profiles: map[string]*entity.ImportProfile{
+		"bank": {
+			ID:     "bank",
+			UserID: "test-user-123",
+			Name:   "Bank",
+			Mapping: entity.CSVMapping{
+				Delimiter:        ",",
+				DateColumn:       "Date",
+				DateFormat:       "YYYY-MM-DD",
+				AmountSign:       constant.AmountSignSigned,
+				AmountColumn:     "Amount",
+				DecimalSeparator: ".",
+			},
+		},
+		"other": {ID: "other", UserID: "other-user", Name: "Other"},
+	}}
+	service, transactionRepo, account := newTestImportService(profiles)
+
+	result, err := service.ImportCSV(context.Background(), account.ID, "bank", nil, strings.NewReader("\ufeffDate,Amount\n2024-03-01,-9.99\n"))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Imported != 1 || len(transactionRepo.created) != 1 {
+		t.Errorf("expected one imported row, got %+v", result)
+	}
+
+	_, err = service.ImportCSV(context.Background(), account.ID, "missing", nil, strings.NewReader(""))
+	var notFoundErr *domainerrors.ErrNotFound
+	if !errors.As(err, &notFoundErr) {
+		t.Errorf("expected ErrNotFound for an unknown profile, got %v", err)
+	}
+
+	_, err = service.ImportCSV(context.Background(), account.ID, "other", nil, strings.NewReader(""))
+	var invalidErr *domainerrors.ErrInvalidInput
+	if !errors.As(err, &invalidErr) || invalidErr.Field != "profile_id" {
+		t.Errorf("expected ErrInvalidInput on profile_id for another user's profile, got %v", err)
+	}
+}
+
+func TestImportCSVInvalidInput(t *testing.T) {
+	valid := entity.CSVMapping{
+		DateColumn:   "Date",
+		DateFormat:   "YYYY-MM-DD",
+		AmountSign:   constant.AmountSignSigned,
+		AmountColumn: "Amount",
+	}
+	withChange := func(change func(*entity.CSVMapping)) *entity.CSVMapping {
+		mapping := valid
+		change(&mapping)
+		return &mapping
+	}
+
+	tests := []struct {
+		name          string
+		mapping       *entity.CSVMapping
+		statement     string
+		expectedField string
+	}{
+		{"no mapping", nil, "Date,Amount\n", "profile_id"},
+		{"bad date format", withChange(func(m *entity.CSVMapping) { m.DateFormat = "DD MMM YYYY" }), "", "date_format"},
+		{"missing amount column", withChange(func(m *entity.CSVMapping) { m.AmountColumn = "" }), "", "amount_column"},
+		{"unknown amount sign", withChange(func(m *entity.CSVMapping) { m.AmountSign = "NEGATIVE" }), "", "amount_sign"},
+		{"bad delimiter", withChange(func(m *entity.CSVMapping) { m.Delimiter = ";;" }), "", "delimiter"},
+		{"bad decimal separator", withChange(func(m *entity.CSVMapping) { m.DecimalSeparator = "'" }), "", "decimal_separator"},
+		{"empty file", &valid, "", "file"},
+		{"column not in header", &valid, "Date,Value\n2024-03-01,1.00\n", "file"},
+		{"header skipped", withChange(func(m *entity.CSVMapping) { m.SkipRows = 3 }), "Date,Amount\n", "file"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, transactionRepo, account := newTestImportService(&MockImportProfileRepository{})
+
+			_, err := service.ImportCSV(context.Background(), account.ID, "", tt.mapping, strings.NewReader(tt.statement))
+
+			var invalidErr *domainerrors.ErrInvalidInput
+			if !errors.As(err, &invalidErr) || invalidErr.Field != tt.expectedField {
+				t.Fatalf("expected ErrInvalidInput on %s, got %v", tt.expectedField, err)
+			}
+			if len(transactionRepo.created) != 0 {
+				t.Errorf("expected no transactions, got %d", len(transactionRepo.created))
+			}
+		})
+	}
+}
+
+func TestImportCSVAccountNotFound(t *testing.T) {
+	accountRepo := &MockAccountRepository{}
+	transactions := NewTransactionService(&MockTransactionRepository{}, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+	service := NewImportService(&MockImportProfileRepository{}, accountRepo, &MockUserRepository{}, transactions, newTestCategories(), &MockCategoryRuleRepository{})
+
+	_, err := service.ImportCSV(context.Background(), "missing", "", &entity.CSVMapping{}, strings.NewReader(""))
+
+	var notFoundErr *domainerrors.ErrNotFound
+	if !errors.As(err, &notFoundErr) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestCreateImportProfile(t *testing.T) {
+	profiles := &MockImportProfileRepository{}
+	service, _, _ := newTestImportService(profiles)
+	mapping := entity.CSVMapping{
+		DateColumn:   "Date",
+		DateFormat:   "DD/MM/YY",
+		AmountSign:   constant.AmountSignSigned,
+		AmountColumn: "Amount",
+	}
+
+	profile, err := service.CreateProfile(context.Background(), "test-user-123", "  My bank ", mapping)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if profile.Name != "My bank" || profile.Mapping.Delimiter != "," || profile.Mapping.DecimalSeparator != "." {
+		t.Errorf("expected a trimmed name and default separators, got %+v", profile)
+	}
+	if profiles.createCalls != 1 {
+		t.Errorf("expected 1 create call, got %d", profiles.createCalls)
+	}
+
+	_, err = service.CreateProfile(context.Background(), "test-user-123", "My bank", mapping)
+	var dupErr *domainerrors.ErrDuplicateImportProfile
+	if !errors.As(err, &dupErr) {
+		t.Errorf("expected ErrDuplicateImportProfile, got %v", err)
+	}
+
+	_, err = service.CreateProfile(context.Background(), "test-user-123", "", mapping)
+	var invalidErr *domainerrors.ErrInvalidInput
+	if !errors.As(err, &invalidErr) || invalidErr.Field != "name" {
+		t.Errorf("expected ErrInvalidInput on name, got %v", err)
+	}
+}
+
+func TestCreateImportProfileUserNotFound(t *testing.T) {
+	transactions := NewTransactionService(&MockTransactionRepository{}, &MockAccountRepository{}, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+	service := NewImportService(&MockImportProfileRepository{}, &MockAccountRepository{}, &MockUserRepository{}, transactions, newTestCategories(), &MockCategoryRuleRepository{})
+
+	_, err := service.CreateProfile(context.Background(), "missing", "Bank", entity.CSVMapping{
+		DateColumn:   "Date",
+		DateFormat:   "YYYY-MM-DD",
+		AmountSign:   constant.AmountSignSigned,
+		AmountColumn: "Amount",
+	})
+
+	var notFoundErr *domainerrors.ErrNotFound
+	if !errors.As(err, &notFoundErr) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestUpdateImportProfile(t *testing.T) {
+	mapping := entity.CSVMapping{
+		Delimiter:        ",",
+		DateColumn:       "Date",
+		DateFormat:       "YYYY-MM-DD",
+		AmountSign:       constant.AmountSignSigned,
+		AmountColumn:     "Amount",
+		DecimalSeparator: ".",
+	}
+	profiles := &MockImportProfileRepository{profiles: map[string]*entity.ImportProfile{
+		"first":  {ID: "first", UserID: "test-user-123", Name: "First", Mapping: mapping},
+		"second": {ID: "second", UserID: "test-user-123", Name: "Second", Mapping: mapping},
+	}}
+	service, _, _ := newTestImportService(profiles)
+
+	card := mapping
+	card.AmountSign = constant.AmountSignInverted
+	profile, err := service.UpdateProfile(context.Background(), "first", "", &card)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if profile.Name != "First" || profile.Mapping.AmountSign != constant.AmountSignInverted {
+		t.Errorf("expected the mapping to be replaced and the name kept, got %+v", profile)
+	}
+
+	_, err = service.UpdateProfile(context.Background(), "first", "Second", nil)
+	var dupErr *domainerrors.ErrDuplicateImportProfile
+	if !errors.As(err, &dupErr) {
+		t.Errorf("expected ErrDuplicateImportProfile, got %v", err)
+	}
+
+	_, err = service.UpdateProfile(context.Background(), "missing", "Name", nil)
+	var notFoundErr *domainerrors.ErrNotFound
+	if !errors.As(err, &notFoundErr) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestParseStatementAmount(t *testing.T) {
+	tests := []struct {
+		value            string
+		decimalSeparator string
+		expected         string
+	}{
+		{"1234.56", ".", "1234.56"},
+		{"1,234.56", ".", "1234.56"},
+		{"1.234,56", ",", "1234.56"},
+		{"1 234,56", ",", "1234.56"},
+		{"1'234.56", ".", "1234.56"},
+		{"-12.00", ".", "-12.00"},
+		{"12.00-", ".", "-12.00"},
+		{"(12.00)", ".", "-12.00"},
+		{"+7", ".", "7.00"},
+	}
+
+	for _, tt := range tests {
+		amount, err := parseStatementAmount(tt.value, tt.decimalSeparator, "USD")
+		if err != nil {
+			t.Errorf("%q: expected no error, got %v", tt.value, err)
+			continue
+		}
+		if amount.String() != tt.expected {
+			t.Errorf("%q: expected %s, got %s", tt.value, tt.expected, amount)
+		}
+	}
+
+	for _, value := range []string{"", "12,345", "1,2,3", "abc"} {
+		if _, err := parseStatementAmount(value, ",", "USD"); err == nil {
+			t.Errorf("%q: expected an error", value)
+		}
+	}
+}
+
+// testOFXStatement is an OFX 1.x statement of three transactions, the last
+// with an amount that cannot be read.
+const testOFXStatement = `OFXHEADER:100
+DATA:OFXSGML
+VERSION:102
+
+<OFX>
+<BANKMSGSRSV1><STMTTRNRS><STMTRS>
+<CURDEF>USD
+<BANKACCTFROM><BANKID>121000248<ACCTID>1234567890<ACCTTYPE>CHECKING</BANKACCTFROM>
+<BANKTRANLIST>
+<STMTTRN><TRNTYPE>CREDIT<DTPOSTED>20240301<TRNAMT>2500.00<FITID>A1<NAME>ACME PAYROLL</STMTTRN>
+<STMTTRN><TRNTYPE>DEBIT<DTPOSTED>20240304230000[-5:EST]<TRNAMT>-42.17<FITID>A2<NAME>CORNER GROCERY<MEMO>POS PURCHASE</STMTTRN>
+<STMTTRN><TRNTYPE>DEBIT<DTPOSTED>20240305<TRNAMT>-4.2.1<FITID>A3<NAME>COFFEE</STMTTRN>
+</BANKTRANLIST>
+</STMTRS></STMTTRNRS></BANKMSGSRSV1>
+</OFX>
+`
+
+func TestImportOFX(t *testing.T) {
+	service, transactionRepo, account := newTestImportService(&MockImportProfileRepository{})
+
+	result, err := service.ImportOFX(context.Background(), account.ID, strings.NewReader(testOFXStatement))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if result.Imported != 2 || result.Failed != 1 || result.Skipped != 0 {
+		t.Fatalf("expected 2 imported and 1 failed rows, got %+v", result)
+	}
+	if row := result.Rows[2]; row.Line != 12 || row.Error != `"-4.2.1" is not an OFX amount` {
+		t.Errorf("unexpected failed row %+v", row)
+	}
+
+	salary, grocery := transactionRepo.created[0], transactionRepo.created[1]
+	if salary.Type != constant.TransactionTypeIncome || salary.Amount != money.MustParse("2500.00", "USD") ||
+		salary.ExternalID != "A1" || salary.Description != "ACME PAYROLL" {
+		t.Errorf("unexpected salary transaction %+v", salary)
+	}
+	if grocery.Type != constant.TransactionTypeExpense || grocery.Amount != money.MustParse("42.17", "USD") ||
+		grocery.ExternalID != "A2" || grocery.Description != "CORNER GROCERY - POS PURCHASE" {
+		t.Errorf("unexpected grocery transaction %+v", grocery)
+	}
+	// The date is the day the bank posted the transaction, in the bank's time zone
+	if !grocery.Date.Equal(time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected the grocery transaction on 2024-03-04, got %s", grocery.Date)
+	}
+	if account.Balance != money.MustParse("3457.83", "USD") {
+		t.Errorf("expected balance 3457.83, got %s", account.Balance)
+	}
+}
+
+func TestImportOFXTwiceSkipsImportedTransactions(t *testing.T) {
+	service, transactionRepo, account := newTestImportService(&MockImportProfileRepository{})
+
+	first, err := service.ImportOFX(context.Background(), account.ID, strings.NewReader(testOFXStatement))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	second, err := service.ImportOFX(context.Background(), account.ID, strings.NewReader(testOFXStatement))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if second.Imported != 0 || second.Skipped != 2 || second.Failed != 1 {
+		t.Fatalf("expected 2 skipped and 1 failed rows, got %+v", second)
+	}
+	for i := range 2 {
+		row := second.Rows[i]
+		if row.Status != constant.ImportRowStatusSkipped || row.TransactionID != first.Rows[i].TransactionID {
+			t.Errorf("row %d: expected to be skipped as transaction %s, got %+v", i, first.Rows[i].TransactionID, row)
+		}
+	}
+	if len(transactionRepo.created) != 2 {
+		t.Errorf("expected 2 transactions, got %d", len(transactionRepo.created))
+	}
+	if account.Balance != money.MustParse("3457.83", "USD") {
+		t.Errorf("expected balance 3457.83, got %s", account.Balance)
+	}
+}
+
+func TestImportOFXConvertsStatementCurrency(t *testing.T) {
+	account := NewTestAccount()
+	accountRepo := &MockAccountRepository{accountToReturn: account}
+	transactionRepo := &MockTransactionRepository{}
+	converter := newTestConverter(&entity.ExchangeRate{
+		BaseCurrency:  "EUR",
+		QuoteCurrency: "USD",
+		Date:          time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		Rate:          money.MustParseRate("1.1"),
+	})
+	transactions := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, converter, newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+	service := NewImportService(&MockImportProfileRepository{}, accountRepo, &MockUserRepository{}, transactions, newTestCategories(), &MockCategoryRuleRepository{})
+
+	statement := `<?xml version="1.0" encoding="UTF-8"?>
+<?OFX OFXHEADER="200" VERSION="220"?>
+<OFX><CREDITCARDMSGSRSV1><CCSTMTTRNRS><CCSTMTRS>
+  <CURDEF>EUR</CURDEF>
+  <CCACCTFROM><ACCTID>4111</ACCTID></CCACCTFROM>
+  <BANKTRANLIST>
+    <STMTTRN><TRNTYPE>DEBIT</TRNTYPE><DTPOSTED>20240302</DTPOSTED><TRNAMT>-10,00</TRNAMT><FITID>CC-1</FITID><NAME>Caf&#233;</NAME></STMTTRN>
+  </BANKTRANLIST>
+</CCSTMTRS></CCSTMTTRNRS></CREDITCARDMSGSRSV1></OFX>`
+
+	result, err := service.ImportOFX(context.Background(), account.ID, strings.NewReader(statement))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Imported != 1 {
+		t.Fatalf("expected 1 imported row, got %+v", result.Rows[0])
+	}
+
+	transaction := transactionRepo.created[0]
+	if transaction.Amount != money.MustParse("11.00", "USD") || transaction.OriginalAmount != money.MustParse("10.00", "EUR") {
+		t.Errorf("expected 10.00 EUR converted to 11.00 USD, got %s from %s", transaction.Amount, transaction.OriginalAmount)
+	}
+	if transaction.Description != "Café" {
+		t.Errorf("expected description %q, got %q", "Café", transaction.Description)
+	}
+}
+
+func TestImportOFXInvalidFile(t *testing.T) {
+	statementOf := func(accounts int) string {
+		return "<OFX><BANKMSGSRSV1>" + strings.Repeat("<STMTTRNRS><STMTRS><CURDEF>USD</STMTRS></STMTTRNRS>", accounts) + "</BANKMSGSRSV1></OFX>"
+	}
+	tests := []struct {
+		name      string
+		statement string
+		message   string
+	}{
+		{"not OFX", "Date,Amount\n", "the file is not valid OFX: line 1: the file is not an OFX document"},
+		{"malformed", "<OFX>\n<STMTRS>\n</OFX>\n", "the file is not valid OFX: line 3: <STMTRS> is not closed"},
+		{"no statement", "<OFX><SIGNONMSGSRSV1></SIGNONMSGSRSV1></OFX>", "the file holds no bank or credit card statement"},
+		{"several accounts", statementOf(2), "the file holds the statements of 2 accounts; only one can be imported into an account"},
+		{"unknown currency", strings.Replace(statementOf(1), "USD", "XYZ", 1), "the statement has the unknown currency XYZ"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, transactionRepo, account := newTestImportService(&MockImportProfileRepository{})
+
+			_, err := service.ImportOFX(context.Background(), account.ID, strings.NewReader(tt.statement))
+			var invalidErr *domainerrors.ErrInvalidInput
+			if !errors.As(err, &invalidErr) || invalidErr.Field != "file" || invalidErr.Message != tt.message {
+				t.Fatalf("expected invalid file %q, got %v", tt.message, err)
+			}
+			if transactionRepo.createCalls != 0 {
+				t.Errorf("expected no transaction, got %d", transactionRepo.createCalls)
+			}
+		})
+	}
+}
+
+// newTestBankImportService returns an import service for the test account,
+// which is in USD, that converts EUR at 1.1.
+func newTestBankImportService() (*ImportService, *MockTransactionRepository, *entity.Account) {
+	account := NewTestAccount()
+	accountRepo := &MockAccountRepository{accountToReturn: account}
+	transactionRepo := &MockTransactionRepository{}
+	converter := newTestConverter(&entity.ExchangeRate{
+		BaseCurrency:  "EUR",
+		QuoteCurrency: "USD",
+		Date:          time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		Rate:          money.MustParseRate("1.1"),
+	})
+	transactions := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, converter, newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+	return NewImportService(&MockImportProfileRepository{}, accountRepo, &MockUserRepository{}, transactions, newTestCategories(), &MockCategoryRuleRepository{}), transactionRepo, account
+}
+
+const testCamt053Statement = `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.02">
+<BkToCstmrStmt><Stmt>
+<Id>1</Id>
+<Acct><Id><IBAN>DE89370400440532013000</IBAN></Id><Ccy>USD</Ccy></Acct>
+<Ntry>
+  <Amt Ccy="EUR">100.00</Amt><CdtDbtInd>CRDT</CdtDbtInd><Sts>BOOK</Sts>
+  <BookgDt><Dt>2024-03-05</Dt></BookgDt><ValDt><Dt>2024-03-06</Dt></ValDt>
+  <AcctSvcrRef>R1</AcctSvcrRef>
+  <NtryDtls><TxDtls><RltdPties><Dbtr><Nm>Globex</Nm></Dbtr></RltdPties><RmtInf><Ustrd>Invoice 17</Ustrd></RmtInf></TxDtls></NtryDtls>
+</Ntry>
+<Ntry>
+  <Amt Ccy="USD">20.00</Amt><CdtDbtInd>DBIT</CdtDbtInd><Sts>BOOK</Sts>
+  <ValDt><Dt>2024-03-07</Dt></ValDt>
+  <AcctSvcrRef>R2</AcctSvcrRef>
+  <AddtlNtryInf>Account fee</AddtlNtryInf>
+</Ntry>
+<Ntry>
+  <Amt Ccy="USD">3.00</Amt><CdtDbtInd>DBIT</CdtDbtInd><Sts>PDNG</Sts>
+  <ValDt><Dt>2024-03-08</Dt></ValDt>
+</Ntry>
+</Stmt></BkToCstmrStmt>
+</Document>`
+
+func TestImportCamt053(t *testing.T) {
+	service, transactionRepo, account := newTestBankImportService()
+
+	result, err := service.ImportCamt053(context.Background(), account.ID, strings.NewReader(testCamt053Statement))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if result.Imported != 2 || result.Failed != 1 {
+		t.Fatalf("expected 2 imported and 1 failed rows, got %+v", result)
+	}
+	if row := result.Rows[2]; row.Line != 18 || row.Error != "the entry is not booked (status PDNG)" {
+		t.Errorf("unexpected failed row %+v", row)
+	}
+
+	invoice, fee := transactionRepo.created[0], transactionRepo.created[1]
+	// The EUR entry is converted into the account's currency and dated on its booking date
+	if invoice.Type != constant.TransactionTypeIncome || invoice.Amount != money.MustParse("110.00", "USD") ||
+		invoice.OriginalAmount != money.MustParse("100.00", "EUR") || invoice.ExternalID != "R1" || invoice.Description != "Globex - Invoice 17" {
+		t.Errorf("unexpected invoice transaction %+v", invoice)
+	}
+	if !invoice.Date.Equal(time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected the invoice transaction on its booking date 2024-03-05, got %s", invoice.Date)
+	}
+	// Without a booking date the entry is dated on its value date
+	if fee.Type != constant.TransactionTypeExpense || fee.Amount != money.MustParse("20.00", "USD") ||
+		fee.ExternalID != "R2" || fee.Description != "Account fee" {
+		t.Errorf("unexpected fee transaction %+v", fee)
+	}
+	if !fee.Date.Equal(time.Date(2024, 3, 7, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected the fee transaction on its value date 2024-03-07, got %s", fee.Date)
+	}
+	if account.Balance != money.MustParse("1090.00", "USD") {
+		t.Errorf("expected balance 1090.00, got %s", account.Balance)
+	}
+
+	again, err := service.ImportCamt053(context.Background(), account.ID, strings.NewReader(testCamt053Statement))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if again.Imported != 0 || again.Skipped != 2 || len(transactionRepo.created) != 2 {
+		t.Errorf("expected the booked entries to be skipped on a second import, got %+v", again)
+	}
+}
+
+const testMT940Statement = `:20:1
+:25:DEUTDEFF/0532013000
+:28C:1/1
+:60F:C240301USD0,00
+:61:2403010304C50,00NTRFNONREF//REF1
+:86:Refund
+:61:240305RC5,00NMSCINV-9
+:62F:C240305USD45,00
+-
+:20:2
+:25:DEUTDEFF/0532013000
+:28C:1/1
+:60F:C240301EUR0,00
+:61:240306D10,00NTRFNONREF//REF2
+:86:166?00UEBERWEISUNG?20EREF+HB-77?21SVWZ+Room 12?32Hotel Berlin
+:62F:D240306EUR10,00
+-
+`
+
+func TestImportMT940(t *testing.T) {
+	service, transactionRepo, account := newTestBankImportService()
+
+	result, err := service.ImportMT940(context.Background(), account.ID, strings.NewReader(testMT940Statement))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Imported != 3 || result.Failed != 0 {
+		t.Fatalf("expected 3 imported rows, got %+v", result)
+	}
+
+	refund, reversal, hotel := transactionRepo.created[0], transactionRepo.created[1], transactionRepo.created[2]
+	if refund.Type != constant.TransactionTypeIncome || refund.Amount != money.MustParse("50.00", "USD") ||
+		refund.ExternalID != "REF1" || refund.Description != "Refund" {
+		t.Errorf("unexpected refund transaction %+v", refund)
+	}
+	if !refund.Date.Equal(time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected the refund transaction on its entry date 2024-03-04, got %s", refund.Date)
+	}
+	// RC, the reversal of a credit, debits the account
+	if reversal.Type != constant.TransactionTypeExpense || reversal.Amount != money.MustParse("5.00", "USD") ||
+		reversal.ExternalID != "" || reversal.Description != "INV-9" {
+		t.Errorf("unexpected reversal transaction %+v", reversal)
+	}
+	if hotel.Type != constant.TransactionTypeExpense || hotel.Amount != money.MustParse("11.00", "USD") ||
+		hotel.OriginalAmount != money.MustParse("10.00", "EUR") || hotel.Description != "Hotel Berlin - Room 12" {
+		t.Errorf("unexpected hotel transaction %+v", hotel)
+	}
+	if account.Balance != money.MustParse("1034.00", "USD") {
+		t.Errorf("expected balance 1034.00, got %s", account.Balance)
+	}
+}
+
+func TestImportBankStatementInvalidFile(t *testing.T) {
+	tests := []struct {
+		name      string
+		format    string
+		statement string
+		message   string
+	}{
+		{"camt.053 not XML", "camt053", "Date,Amount\n", "the file is not a valid camt.053 statement: line 1: the file is not an XML document"},
+		{
+			"camt.053 several accounts", "camt053",
+			"<Document><BkToCstmrStmt><Stmt><Acct><Id><IBAN>A</IBAN></Id></Acct></Stmt><Stmt><Acct><Id><IBAN>B</IBAN></Id></Acct></Stmt></BkToCstmrStmt></Document>",
+			"the file holds the statements of the accounts A and B; only one can be imported into an account",
+		},
+		{"MT940 malformed", "mt940", ":20:1\n:60F:C240301USD0,00\n:61:240301X5,00NMSCNONREF\n", `the file is not a valid MT940 statement: line 3: invalid statement line "240301X5,00NMSCNONREF"`},
+		{
+			"MT940 several accounts", "mt940",
+			":20:1\n:25:A\n:60F:C240301USD0,00\n-\n:20:2\n:25:B\n:60F:C240301USD0,00\n-\n",
+			"the file holds the statements of the accounts A and B; only one can be imported into an account",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, transactionRepo, account := newTestImportService(&MockImportProfileRepository{})
+
+			var err error
+			if tt.format == "camt053" {
+				_, err = service.ImportCamt053(context.Background(), account.ID, strings.NewReader(tt.statement))
+			} else {
+				_, err = service.ImportMT940(context.Background(), account.ID, strings.NewReader(tt.statement))
+			}
+			var invalidErr *domainerrors.ErrInvalidInput
+			if !errors.As(err, &invalidErr) || invalidErr.Field != "file" || invalidErr.Message != tt.message {
+				t.Fatalf("expected invalid file %q, got %v", tt.message, err)
+			}
+			if transactionRepo.createCalls != 0 {
+				t.Errorf("expected no transaction, got %d", transactionRepo.createCalls)
+			}
+		})
+	}
+}
+
+func TestImportCSVFlagsDuplicates(t *testing.T) {
+	service, transactionRepo, account := newTestImportService(&MockImportProfileRepository{})
+	mapping := &entity.CSVMapping{
+		DateColumn:        "Date",
+		DateFormat:        "YYYY-MM-DD",
+		AmountSign:        constant.AmountSignSigned,
+		AmountColumn:      "Amount",
+		DescriptionColumn: "Description",
+	}
+
+	// Entered by hand before the statement arrived
+	manual, err := service.transactions.CreateTransaction(context.Background(), account.ID, money.MustParse("12.00", "USD"), "Bakery", "", "", nil, nil, constant.TransactionTypeExpense, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), false)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// The same purchase listed twice in one statement is two purchases
+	first := "Date,Amount,Description\n" +
+		"2024-03-02,-12.00,BAKERY\n" +
+		"2024-03-04,-3.50,Coffee\n" +
+		"2024-03-04,-3.50,Coffee\n"
+	result, err := service.ImportCSV(context.Background(), account.ID, "", mapping, strings.NewReader(first))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Imported != 2 || result.Duplicates != 1 {
+		t.Fatalf("expected 2 imported rows and 1 duplicate, got %+v", result)
+	}
+	if row := result.Rows[0]; row.Status != constant.ImportRowStatusDuplicate || row.TransactionID != manual.ID {
+		t.Errorf("expected the bakery row flagged as a duplicate of %s, got %+v", manual.ID, row)
+	}
+
+	// An overlapping statement flags the rows already imported, each matching
+	// one transaction, and imports the rest
+	second := "Date,Amount,Description\n" +
+		"2024-03-04,-3.50,Coffee\n" +
+		"2024-03-04,-3.50,Coffee\n" +
+		"2024-03-05,-3.50,Coffee\n" +
+		"2024-03-06,-20.00,Books\n"
+	result, err = service.ImportCSV(context.Background(), account.ID, "", mapping, strings.NewReader(second))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if result.Imported != 2 || result.Duplicates != 2 {
+		t.Fatalf("expected 2 imported rows and 2 duplicates, got %+v", result)
+	}
+	if result.Rows[0].TransactionID == result.Rows[1].TransactionID {
+		t.Errorf("expected the duplicate rows to match different transactions, got %s twice", result.Rows[0].TransactionID)
+	}
+	if len(transactionRepo.created) != 5 {
+		t.Errorf("expected 5 transactions, got %d", len(transactionRepo.created))
+	}
+}
+
+func TestImportCSVAppliesRules(t *testing.T) {
+	account := NewTestAccount()
+	accountRepo := &MockAccountRepository{accountToReturn: account}
+	transactionRepo := &MockTransactionRepository{}
+	rules := newTestRules()
+	transactions := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), rules, &MockPayeeRepository{})
+	service := NewImportService(&MockImportProfileRepository{}, accountRepo, &MockUserRepository{}, transactions, newTestCategories(), rules)
+	mapping := &entity.CSVMapping{
+		DateColumn:        "Date",
+		DateFormat:        "YYYY-MM-DD",
+		AmountSign:        constant.AmountSignSigned,
+		AmountColumn:      "Amount",
+		DescriptionColumn: "Description",
+		CategoryColumn:    "Category",
+	}
+
+	// Entered by hand, and renamed by the coffee rule
+	manual, err := transactions.CreateTransaction(context.Background(), account.ID, money.MustParse("4.50", "USD"), "Starbucks Seattle", "", "", nil, nil, constant.TransactionTypeExpense, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), false)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	statement := "Date,Amount,Description,Category\n" +
+		"2024-03-02,-4.50,STARBUCKS #1234,\n" +
+		"2024-03-04,-3.20,STARBUCKS #1234,\n" +
+		"2024-03-04,-3.20,STARBUCKS #1234,Meeting\n" +
+		"2024-03-05,-750.00,Furniture store,\n"
+	result, err := service.ImportCSV(context.Background(), account.ID, "", mapping, strings.NewReader(statement))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if result.Imported != 3 || result.Duplicates != 1 {
+		t.Fatalf("expected 3 imported rows and 1 duplicate, got %+v", result)
+	}
+	if row := result.Rows[0]; row.Status != constant.ImportRowStatusDuplicate || row.TransactionID != manual.ID {
+		t.Errorf("expected the first row flagged as a duplicate of %s once renamed, got %+v", manual.ID, row)
+	}
+	expected := []struct {
+		category, description string
+		tags                  []string
+	}{
+		{"Coffee", "Starbucks", []string{"coffee"}},
+		{"Coffee", "Starbucks", []string{"coffee"}},
+		{"Meeting", "STARBUCKS #1234", nil},
+		{"Large", "Furniture store", nil},
+	}
+	if len(transactionRepo.created) != len(expected) {
+		t.Fatalf("expected %d transactions, got %d", len(expected), len(transactionRepo.created))
+	}
+	for i, want := range expected {
+		created := transactionRepo.created[i]
+		if created.Category != want.category || created.Description != want.description || !slices.Equal(created.Tags, want.tags) {
+			t.Errorf("transaction %d: expected %q %q %q, got %q %q %q", i, want.category, want.description, want.tags, created.Category, created.Description, created.Tags)
+		}
+	}
+}
+
+func TestImportCSVAppliesRulesOnce(t *testing.T) {
+	account := NewTestAccount()
+	accountRepo := &MockAccountRepository{accountToReturn: account}
+	transactionRepo := &MockTransactionRepository{}
+	rules := &MockCategoryRuleRepository{}
+	// Had the rules been applied twice, the renamed row would match the second rule
+	for _, rule := range []*entity.CategoryRule{
+		{ID: "rule-rename", Name: "Rename", Conditions: entity.RuleConditions{DescriptionContains: "amzn"}, Actions: entity.RuleActions{Description: "Amazon"}},
+		{ID: "rule-shopping", Name: "Shopping", Priority: 1, Conditions: entity.RuleConditions{DescriptionContains: "amazon"}, Actions: entity.RuleActions{CategoryID: "category-shopping"}},
+	} {
+		rule.UserID = "test-user-123"
+		rules.Create(context.Background(), rule)
+	}
+	transactions := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), rules, &MockPayeeRepository{})
+	service := NewImportService(&MockImportProfileRepository{}, accountRepo, &MockUserRepository{}, transactions, newTestCategories(), rules)
+	mapping := &entity.CSVMapping{
+		DateColumn:        "Date",
+		DateFormat:        "YYYY-MM-DD",
+		AmountSign:        constant.AmountSignSigned,
+		AmountColumn:      "Amount",
+		DescriptionColumn: "Description",
+	}
+
+	statement := "Date,Amount,Description\n2024-03-02,-25.00,AMZN Mktp 1234\n"
+	result, err := service.ImportCSV(context.Background(), account.ID, "", mapping, strings.NewReader(statement))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if result.Imported != 1 || len(transactionRepo.created) != 1 {
+		t.Fatalf("expected 1 imported row, got %+v", result)
+	}
+	if created := transactionRepo.created[0]; created.Description != "Amazon" || created.CategoryID != "" {
+		t.Errorf("expected only the first matching rule applied, got %q in category %q", created.Description, created.CategoryID)
+	}
+}
+
+func TestImportCSVAmbiguousCategory(t *testing.T) {
+	account := NewTestAccount()
+	accountRepo := &MockAccountRepository{accountToReturn: account}
+	transactionRepo := &MockTransactionRepository{}
+	categories := newTestCategories()
+	// Names are unique under a parent only, so Food and Housing may have an
+	// Other besides the top-level one
+	for _, parentID := range []string{"category-food", "category-housing"} {
+		other := NewTestCategory("category-other-"+parentID, "Other", constant.TransactionTypeExpense)
+		other.ParentID = parentID
+		categories.Create(context.Background(), other)
+	}
+	transactions := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), categories, &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+	service := NewImportService(&MockImportProfileRepository{}, accountRepo, &MockUserRepository{}, transactions, categories, &MockCategoryRuleRepository{})
+	mapping := &entity.CSVMapping{
+		DateColumn:        "Date",
+		DateFormat:        "YYYY-MM-DD",
+		AmountSign:        constant.AmountSignSigned,
+		AmountColumn:      "Amount",
+		DescriptionColumn: "Description",
+		CategoryColumn:    "Category",
+	}
+
+	statement := "Date,Amount,Description,Category\n2024-03-02,-25.00,Lunch,Eating out\n2024-03-03,-5.00,Misc,other\n"
+	result, err := service.ImportCSV(context.Background(), account.ID, "", mapping, strings.NewReader(statement))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if result.Imported != 1 || result.Failed != 1 || len(transactionRepo.created) != 1 {
+		t.Fatalf("expected 1 imported and 1 failed row, got %+v", result)
+	}
+	if row := result.Rows[1]; row.Error != `unknown or ambiguous category "other"` {
+		t.Errorf("expected the shared name to be ambiguous, got %q", row.Error)
+	}
+}
+
+func TestImportCSVMatchesPayees(t *testing.T) {
+	account := NewTestAccount()
+	accountRepo := &MockAccountRepository{accountToReturn: account}
+	transactionRepo := &MockTransactionRepository{}
+	transactions := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, newTestPayees())
+	service := NewImportService(&MockImportProfileRepository{}, accountRepo, &MockUserRepository{}, transactions, newTestCategories(), &MockCategoryRuleRepository{})
+	mapping := &entity.CSVMapping{
+		DateColumn:        "Date",
+		DateFormat:        "YYYY-MM-DD",
+		AmountSign:        constant.AmountSignSigned,
+		AmountColumn:      "Amount",
+		DescriptionColumn: "Description",
+		CategoryColumn:    "Category",
+	}
+
+	statement := "Date,Amount,Description,Category\n" +
+		"2024-03-02,-42.10,TESCO STORES 3021,\n" +
+		"2024-03-03,-3.20,SBUX 0042 LONDON,Meeting\n" +
+		"2024-03-04,-12.00,Corner shop,\n"
+	result, err := service.ImportCSV(context.Background(), account.ID, "", mapping, strings.NewReader(statement))
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if result.Imported != 3 {
+		t.Fatalf("expected 3 imported rows, got %+v", result)
+	}
+	expected := []struct{ payee, category string }{
+		{"Tesco", "Food"},
+		{"Starbucks", "Meeting"},
+		{"", ""},
+	}
+	for i, want := range expected {
+		created := transactionRepo.created[i]
+		if created.Payee != want.payee || created.Category != want.category {
+			t.Errorf("transaction %d: expected %q %q, got %q %q", i, want.payee, want.category, created.Payee, created.Category)
+		}
+	}
+}
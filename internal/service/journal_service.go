@@ -0,0 +1,270 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/domain/money"
+
+	"github.com/google/uuid"
+)
+
+type JournalService struct {
+	journalRepo     interfaces.JournalEntryRepository
+	accountRepo     interfaces.AccountRepository
+	txManager       interfaces.TransactionManager
+	transactionRepo interfaces.TransactionRepository
+}
+
+func NewJournalService(journalRepo interfaces.JournalEntryRepository, accountRepo interfaces.AccountRepository, txManager interfaces.TransactionManager, transactionRepo interfaces.TransactionRepository) *JournalService {
+	return &JournalService{
+		journalRepo:     journalRepo,
+		accountRepo:     accountRepo,
+		txManager:       txManager,
+		transactionRepo: transactionRepo,
+	}
+}
+
+func (s *JournalService) CreateEntry(ctx context.Context, userID string, date time.Time, description string, postings []*entity.Posting) (*entity.JournalEntry, error) {
+	if userID == "" {
+		return nil, domainerrors.NewErrInvalidInput("user_id", "user ID is required")
+	}
+	if err := validatePostings(postings); err != nil {
+		return nil, err
+	}
+
+	// Use provided date or default to now
+	if date.IsZero() {
+		date = time.Now()
+	}
+
+	entry := &entity.JournalEntry{
+		ID:          uuid.New().String(),
+		UserID:      userID,
+		Date:        date,
+		Description: description,
+		Postings:    postings,
+	}
+	for _, posting := range entry.Postings {
+		posting.ID = uuid.New().String()
+	}
+
+	// The entry and every balance it touches must commit or roll back together
+	err := s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		accounts, err := lockAccounts(ctx, s.accountRepo, postingAccountIDs(entry.Postings)...)
+		if err != nil {
+			return err
+		}
+
+		for _, posting := range entry.Postings {
+			account := accounts[posting.AccountID]
+			if account.UserID != userID {
+				return domainerrors.NewErrInvalidInput("postings", "account "+account.ID+" does not belong to the user")
+			}
+			if posting.Amount.Currency() != account.Currency {
+				return domainerrors.NewErrInvalidInput("postings", "currency must match account currency "+account.Currency)
+			}
+			if err := applyPostingEffect(account, posting, false); err != nil {
+				return err
+			}
+		}
+
+		if err := s.journalRepo.Create(ctx, entry); err != nil {
+			return fmt.Errorf("creating journal entry: %w", err)
+		}
+
+		return saveAccounts(ctx, s.accountRepo, accounts)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+func (s *JournalService) GetEntry(ctx context.Context, id string) (*entity.JournalEntry, error) {
+	entry, err := s.journalRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("getting journal entry: %w", err)
+	}
+	return entry, nil
+}
+
+func (s *JournalService) ListUserEntries(ctx context.Context, userID string) ([]*entity.JournalEntry, error) {
+	entries, err := s.journalRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("listing journal entries: %w", err)
+	}
+	return entries, nil
+}
+
+func (s *JournalService) DeleteEntry(ctx context.Context, id string) error {
+	return s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		entry, err := s.journalRepo.GetByID(ctx, id)
+		if err != nil {
+			return fmt.Errorf("getting journal entry: %w", err)
+		}
+		if entry == nil {
+			return domainerrors.NewErrNotFound("journal entry", id)
+		}
+
+		accounts, err := lockAccounts(ctx, s.accountRepo, postingAccountIDs(entry.Postings)...)
+		if err != nil {
+			return err
+		}
+		for _, posting := range entry.Postings {
+			if err := applyPostingEffect(accounts[posting.AccountID], posting, true); err != nil {
+				return err
+			}
+		}
+
+		if err := s.journalRepo.Delete(ctx, id); err != nil {
+			return fmt.Errorf("deleting journal entry: %w", err)
+		}
+
+		return saveAccounts(ctx, s.accountRepo, accounts)
+	})
+}
+
+// PreviewTransactionEntry returns the journal entry an INCOME or EXPENSE
+// transaction amounts to against one of the user's category accounts, an
+// INCOME account for income and an EXPENSE account for expenses, in the
+// currency of the transaction's account. Nothing is recorded: the
+// transaction already moved the account's balance.
+func (s *JournalService) PreviewTransactionEntry(ctx context.Context, transactionID, categoryAccountID string) (*entity.JournalEntry, error) {
+	transaction, err := s.transactionRepo.GetByID(ctx, transactionID)
+	if err != nil {
+		return nil, fmt.Errorf("getting transaction: %w", err)
+	}
+	if transaction == nil {
+		return nil, domainerrors.NewErrNotFound("transaction", transactionID)
+	}
+
+	account, err := s.accountRepo.GetByID(ctx, transaction.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("getting account: %w", err)
+	}
+	if account == nil {
+		return nil, domainerrors.NewErrNotFound("account", transaction.AccountID)
+	}
+	categoryAccount, err := s.accountRepo.GetByID(ctx, categoryAccountID)
+	if err != nil {
+		return nil, fmt.Errorf("getting category account: %w", err)
+	}
+	if categoryAccount == nil {
+		return nil, domainerrors.NewErrNotFound("account", categoryAccountID)
+	}
+	if categoryAccount.UserID != account.UserID {
+		return nil, domainerrors.NewErrInvalidInput("category_account_id", "the category account belongs to another user")
+	}
+
+	entry, err := journalEntryFromTransaction(transaction, account.UserID, categoryAccountID)
+	if err != nil {
+		return nil, err
+	}
+	want := constant.AccountTypeExpense
+	if transaction.Type == constant.TransactionTypeIncome {
+		want = constant.AccountTypeIncome
+	}
+	if categoryAccount.Type != want {
+		return nil, domainerrors.NewErrInvalidInput("category_account_id", fmt.Sprintf("a %s transaction needs an %s account, not %s", transaction.Type, want, categoryAccount.Type))
+	}
+	if categoryAccount.Currency != transaction.Amount.Currency() {
+		return nil, domainerrors.NewErrInvalidInput("category_account_id", "currency must match the transaction currency "+transaction.Amount.Currency())
+	}
+
+	return entry, nil
+}
+
+// journalEntryFromTransaction expresses an INCOME or EXPENSE transaction as a
+// journal entry against the given income or expense category account. Income
+// debits the money account and credits the category; expense does the reverse.
+func journalEntryFromTransaction(transaction *entity.Transaction, userID, categoryAccountID string) (*entity.JournalEntry, error) {
+	var debitAccountID, creditAccountID string
+	switch transaction.Type {
+	case constant.TransactionTypeIncome:
+		debitAccountID, creditAccountID = transaction.AccountID, categoryAccountID
+	case constant.TransactionTypeExpense:
+		debitAccountID, creditAccountID = categoryAccountID, transaction.AccountID
+	default:
+		return nil, domainerrors.NewErrInvalidInput("type", "only income and expense transactions map to a category account")
+	}
+
+	return &entity.JournalEntry{
+		UserID:      userID,
+		Date:        transaction.Date,
+		Description: transaction.Description,
+		Postings: []*entity.Posting{
+			{AccountID: debitAccountID, Direction: constant.PostingDirectionDebit, Amount: transaction.Amount, Memo: transaction.Category},
+			{AccountID: creditAccountID, Direction: constant.PostingDirectionCredit, Amount: transaction.Amount, Memo: transaction.Category},
+		},
+	}, nil
+}
+
+// validatePostings checks the shape of an entry: at least two positive
+// postings whose debits equal their credits in every currency.
+func validatePostings(postings []*entity.Posting) error {
+	if len(postings) < 2 {
+		return domainerrors.NewErrInvalidInput("postings", "a journal entry needs at least two postings")
+	}
+
+	totals := make(map[string]money.Money)
+	for _, posting := range postings {
+		if posting.AccountID == "" {
+			return domainerrors.NewErrInvalidInput("postings", "account ID is required")
+		}
+		if !posting.Amount.IsPositive() {
+			return domainerrors.NewErrInvalidInput("postings", "amount must be greater than zero")
+		}
+		if err := validateCurrency("postings", posting.Amount.Currency()); err != nil {
+			return err
+		}
+
+		signed := posting.Amount
+		switch posting.Direction {
+		case constant.PostingDirectionDebit:
+		case constant.PostingDirectionCredit:
+			signed = signed.Negate()
+		default:
+			return domainerrors.NewErrInvalidInput("postings", "direction must be DEBIT or CREDIT")
+		}
+
+		currency := posting.Amount.Currency()
+		total, ok := totals[currency]
+		if !ok {
+			total = money.Zero(currency)
+		}
+		total, err := total.Add(signed)
+		if err != nil {
+			return domainerrors.NewErrInvalidInput("postings", err.Error())
+		}
+		totals[currency] = total
+	}
+
+	for _, currency := range slices.Sorted(maps.Keys(totals)) {
+		if !totals[currency].IsZero() {
+			return domainerrors.NewErrInvalidInput("postings", "debits and credits differ by "+totals[currency].String()+" "+currency)
+		}
+	}
+
+	return nil
+}
+
+// postingAccountIDs returns the account IDs referenced by the postings.
+func postingAccountIDs(postings []*entity.Posting) []string {
+	ids := make([]string, 0, len(postings))
+	for _, posting := range postings {
+		ids = append(ids, posting.AccountID)
+	}
+	return ids
+}
+
+// Compile-time interface check
+var _ interfaces.JournalService = (*JournalService)(nil)
@@ -0,0 +1,379 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/money"
+)
+
+// newTestLedgerAccounts returns a checking account with 1000.00, an expense
+// category account and an income category account, all owned by the test user
+func newTestLedgerAccounts() (checking, groceries, salary *entity.Account) {
+	checking = NewTestAccount()
+	groceries = &entity.Account{
+		ID:       "test-expense-account",
+		UserID:   "test-user-123",
+		Name:     "Groceries",
+		Type:     constant.AccountTypeExpense,
+		Balance:  money.Zero("USD"),
+		Currency: "USD",
+	}
+	salary = &entity.Account{
+		ID:       "test-income-account",
+		UserID:   "test-user-123",
+		Name:     "Salary",
+		Type:     constant.AccountTypeIncome,
+		Balance:  money.Zero("USD"),
+		Currency: "USD",
+	}
+	return checking, groceries, salary
+}
+
+func newTestAccountRepo(accounts ...*entity.Account) *MockAccountRepository {
+	repo := &MockAccountRepository{accountsToReturn: make(map[string]*entity.Account)}
+	for _, account := range accounts {
+		repo.accountsToReturn[account.ID] = account
+	}
+	return repo
+}
+
+func debit(accountID, amount string) *entity.Posting {
+	return &entity.Posting{AccountID: accountID, Direction: constant.PostingDirectionDebit, Amount: money.MustParse(amount, "USD")}
+}
+
+func credit(accountID, amount string) *entity.Posting {
+	return &entity.Posting{AccountID: accountID, Direction: constant.PostingDirectionCredit, Amount: money.MustParse(amount, "USD")}
+}
+
+func TestCreateEntrySuccess(t *testing.T) {
+	checking, groceries, _ := newTestLedgerAccounts()
+	journalRepo := &MockJournalEntryRepository{}
+	txManager := &MockTransactionManager{}
+	service := NewJournalService(journalRepo, newTestAccountRepo(checking, groceries), txManager, &MockTransactionRepository{})
+
+	entry, err := service.CreateEntry(
+		context.Background(),
+		"test-user-123",
+		time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		"Weekly shopping",
+		[]*entity.Posting{debit(groceries.ID, "80.00"), credit(checking.ID, "80.00")},
+	)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if entry.ID == "" || entry.Postings[0].ID == "" || entry.Postings[1].ID == "" {
+		t.Error("expected entry and postings to get IDs")
+	}
+
+	if groceries.Balance != money.MustParse("80.00", "USD") {
+		t.Errorf("expected expense balance 80.00, got %s", groceries.Balance)
+	}
+
+	if checking.Balance != money.MustParse("920.00", "USD") {
+		t.Errorf("expected checking balance 920.00, got %s", checking.Balance)
+	}
+
+	if len(journalRepo.created) != 1 || txManager.commits != 1 {
+		t.Errorf("expected 1 persisted entry in 1 commit, got %d entries and %d commits", len(journalRepo.created), txManager.commits)
+	}
+}
+
+func TestCreateEntryMultiplePostings(t *testing.T) {
+	checking, groceries, salary := newTestLedgerAccounts()
+	service := NewJournalService(&MockJournalEntryRepository{}, newTestAccountRepo(checking, groceries, salary), &MockTransactionManager{}, &MockTransactionRepository{})
+
+	// Paycheck of 3000.00 with 200.00 spent at the same time
+	_, err := service.CreateEntry(
+		context.Background(),
+		"test-user-123",
+		time.Now(),
+		"",
+		[]*entity.Posting{
+			debit(checking.ID, "2800.00"),
+			debit(groceries.ID, "200.00"),
+			credit(salary.ID, "3000.00"),
+		},
+	)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if checking.Balance != money.MustParse("3800.00", "USD") {
+		t.Errorf("expected checking balance 3800.00, got %s", checking.Balance)
+	}
+
+	if salary.Balance != money.MustParse("3000.00", "USD") {
+		t.Errorf("expected income balance 3000.00, got %s", salary.Balance)
+	}
+}
+
+func TestCreateEntryInvalidPostings(t *testing.T) {
+	tests := []struct {
+		name     string
+		postings []*entity.Posting
+	}{
+		{"single posting", []*entity.Posting{debit("a", "10.00")}},
+		{"unbalanced", []*entity.Posting{debit("a", "10.00"), credit("b", "9.99")}},
+		{"zero amount", []*entity.Posting{debit("a", "0.00"), credit("b", "0.00")}},
+		{"missing account", []*entity.Posting{debit("", "10.00"), credit("b", "10.00")}},
+		{"unknown direction", []*entity.Posting{debit("a", "10.00"), {AccountID: "b", Direction: "SIDEWAYS", Amount: money.MustParse("10.00", "USD")}}},
+		{"balanced across currencies only", []*entity.Posting{
+			debit("a", "10.00"),
+			{AccountID: "b", Direction: constant.PostingDirectionCredit, Amount: money.MustParse("10.00", "EUR")},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			journalRepo := &MockJournalEntryRepository{}
+			service := NewJournalService(journalRepo, &MockAccountRepository{}, &MockTransactionManager{}, &MockTransactionRepository{})
+
+			_, err := service.CreateEntry(context.Background(), "test-user-123", time.Now(), "", tt.postings)
+
+			var invalidErr *domainerrors.ErrInvalidInput
+			if !errors.As(err, &invalidErr) {
+				t.Errorf("expected ErrInvalidInput, got %T", err)
+			}
+
+			if journalRepo.createCalls != 0 {
+				t.Errorf("expected no create call, got %d", journalRepo.createCalls)
+			}
+		})
+	}
+}
+
+func TestCreateEntryAccountOfAnotherUser(t *testing.T) {
+	checking, groceries, _ := newTestLedgerAccounts()
+	groceries.UserID = "another-user"
+	service := NewJournalService(&MockJournalEntryRepository{}, newTestAccountRepo(checking, groceries), &MockTransactionManager{}, &MockTransactionRepository{})
+
+	_, err := service.CreateEntry(context.Background(), "test-user-123", time.Now(), "",
+		[]*entity.Posting{debit(groceries.ID, "10.00"), credit(checking.ID, "10.00")})
+
+	var invalidErr *domainerrors.ErrInvalidInput
+	if !errors.As(err, &invalidErr) {
+		t.Errorf("expected ErrInvalidInput, got %T", err)
+	}
+}
+
+func TestCreateEntryCurrencyMismatch(t *testing.T) {
+	checking, groceries, _ := newTestLedgerAccounts()
+	checking.Currency = "EUR"
+	checking.Balance = money.MustParse("1000.00", "EUR")
+	service := NewJournalService(&MockJournalEntryRepository{}, newTestAccountRepo(checking, groceries), &MockTransactionManager{}, &MockTransactionRepository{})
+
+	_, err := service.CreateEntry(context.Background(), "test-user-123", time.Now(), "",
+		[]*entity.Posting{debit(groceries.ID, "10.00"), credit(checking.ID, "10.00")})
+
+	var invalidErr *domainerrors.ErrInvalidInput
+	if !errors.As(err, &invalidErr) {
+		t.Errorf("expected ErrInvalidInput, got %T", err)
+	}
+}
+
+func TestCreateEntryAccountNotFound(t *testing.T) {
+	checking, _, _ := newTestLedgerAccounts()
+	service := NewJournalService(&MockJournalEntryRepository{}, newTestAccountRepo(checking), &MockTransactionManager{}, &MockTransactionRepository{})
+
+	_, err := service.CreateEntry(context.Background(), "test-user-123", time.Now(), "",
+		[]*entity.Posting{debit("missing-account", "10.00"), credit(checking.ID, "10.00")})
+
+	var notFoundErr *domainerrors.ErrNotFound
+	if !errors.As(err, &notFoundErr) {
+		t.Errorf("expected ErrNotFound, got %T", err)
+	}
+}
+
+func TestCreateEntryFailureRollsBack(t *testing.T) {
+	checking, groceries, _ := newTestLedgerAccounts()
+	accountRepo := newTestAccountRepo(checking, groceries)
+	accountRepo.lastUpdateErr = errors.New("connection reset")
+	journalRepo := &MockJournalEntryRepository{}
+	txManager := &MockTransactionManager{}
+	service := NewJournalService(journalRepo, accountRepo, txManager, &MockTransactionRepository{})
+
+	_, err := service.CreateEntry(context.Background(), "test-user-123", time.Now(), "",
+		[]*entity.Posting{debit(groceries.ID, "10.00"), credit(checking.ID, "10.00")})
+
+	if err == nil {
+		t.Fatal("expected error when balance update fails")
+	}
+
+	if txManager.rollbacks != 1 || len(journalRepo.created) != 0 {
+		t.Errorf("expected rollback without orphan entry, got %d rollbacks and %d entries", txManager.rollbacks, len(journalRepo.created))
+	}
+}
+
+func TestDeleteEntryReversesPostings(t *testing.T) {
+	checking, groceries, _ := newTestLedgerAccounts()
+	groceries.Balance = money.MustParse("80.00", "USD")
+	journalRepo := &MockJournalEntryRepository{
+		entryToReturn: &entity.JournalEntry{
+			ID:       "test-entry-123",
+			UserID:   "test-user-123",
+			Postings: []*entity.Posting{debit(groceries.ID, "80.00"), credit(checking.ID, "80.00")},
+		},
+	}
+	service := NewJournalService(journalRepo, newTestAccountRepo(checking, groceries), &MockTransactionManager{}, &MockTransactionRepository{})
+
+	err := service.DeleteEntry(context.Background(), "test-entry-123")
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if journalRepo.deleteCalls != 1 {
+		t.Errorf("expected 1 delete call, got %d", journalRepo.deleteCalls)
+	}
+
+	if groceries.Balance != money.Zero("USD") {
+		t.Errorf("expected expense balance 0.00, got %s", groceries.Balance)
+	}
+
+	if checking.Balance != money.MustParse("1080.00", "USD") {
+		t.Errorf("expected checking balance 1080.00, got %s", checking.Balance)
+	}
+}
+
+func TestDeleteEntryNotFound(t *testing.T) {
+	journalRepo := &MockJournalEntryRepository{}
+	service := NewJournalService(journalRepo, &MockAccountRepository{}, &MockTransactionManager{}, &MockTransactionRepository{})
+
+	err := service.DeleteEntry(context.Background(), "missing-entry")
+
+	var notFoundErr *domainerrors.ErrNotFound
+	if !errors.As(err, &notFoundErr) {
+		t.Errorf("expected ErrNotFound, got %T", err)
+	}
+
+	if journalRepo.deleteCalls != 0 {
+		t.Errorf("expected no delete call, got %d", journalRepo.deleteCalls)
+	}
+}
+
+func TestJournalEntryFromTransactionMatchesBalanceEffect(t *testing.T) {
+	for _, transactionType := range []constant.TransactionType{constant.TransactionTypeIncome, constant.TransactionTypeExpense} {
+		t.Run(string(transactionType), func(t *testing.T) {
+			checking, groceries, salary := newTestLedgerAccounts()
+			category := groceries
+			if transactionType == constant.TransactionTypeIncome {
+				category = salary
+			}
+
+			transaction := NewTestTransaction()
+			transaction.Type = transactionType
+
+			entry, err := journalEntryFromTransaction(transaction, checking.UserID, category.ID)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if err := validatePostings(entry.Postings); err != nil {
+				t.Fatalf("expected balanced entry, got %v", err)
+			}
+
+			accounts := map[string]*entity.Account{checking.ID: checking, category.ID: category}
+			for _, posting := range entry.Postings {
+				if err := applyPostingEffect(accounts[posting.AccountID], posting, false); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+
+			want, _ := money.MustParse("1000.00", "USD").Add(balanceEffect(transaction))
+			if checking.Balance != want {
+				t.Errorf("expected checking balance %s, got %s", want, checking.Balance)
+			}
+
+			if category.Balance != transaction.Amount {
+				t.Errorf("expected category balance %s, got %s", transaction.Amount, category.Balance)
+			}
+		})
+	}
+}
+
+func TestJournalEntryFromTransferRejected(t *testing.T) {
+	transaction := NewTestTransaction()
+	transaction.Type = constant.TransactionTypeTransfer
+
+	_, err := journalEntryFromTransaction(transaction, "test-user-123", "test-expense-account")
+
+	var invalidErr *domainerrors.ErrInvalidInput
+	if !errors.As(err, &invalidErr) {
+		t.Errorf("expected ErrInvalidInput, got %T", err)
+	}
+}
+
+func TestPreviewTransactionEntry(t *testing.T) {
+	checking, groceries, salary := newTestLedgerAccounts()
+	transaction := NewTestTransaction()
+	transactionRepo := &MockTransactionRepository{transactionToReturn: transaction}
+	journalRepo := &MockJournalEntryRepository{}
+	service := NewJournalService(journalRepo, newTestAccountRepo(checking, groceries, salary), &MockTransactionManager{}, transactionRepo)
+
+	entry, err := service.PreviewTransactionEntry(context.Background(), transaction.ID, groceries.ID)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if entry.UserID != checking.UserID || len(entry.Postings) != 2 {
+		t.Fatalf("unexpected entry %+v", entry)
+	}
+	if debit := entry.Postings[0]; debit.AccountID != groceries.ID || debit.Direction != constant.PostingDirectionDebit || debit.Amount != transaction.Amount {
+		t.Errorf("expected the expense account debited with %s, got %+v", transaction.Amount, debit)
+	}
+	if credit := entry.Postings[1]; credit.AccountID != checking.ID || credit.Direction != constant.PostingDirectionCredit {
+		t.Errorf("expected the checking account credited, got %+v", credit)
+	}
+	if journalRepo.createCalls != 0 || checking.Balance != money.MustParse("1000.00", "USD") {
+		t.Error("expected nothing to be recorded")
+	}
+}
+
+func TestPreviewTransactionEntryInvalidCategoryAccount(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(groceries *entity.Account)
+	}{
+		{"income account for an expense", func(groceries *entity.Account) { groceries.Type = constant.AccountTypeIncome }},
+		{"money account", func(groceries *entity.Account) { groceries.Type = constant.AccountTypeSavings }},
+		{"another user's account", func(groceries *entity.Account) { groceries.UserID = "other-user-456" }},
+		{"another currency", func(groceries *entity.Account) { groceries.Currency = "EUR" }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checking, groceries, _ := newTestLedgerAccounts()
+			tt.change(groceries)
+			transactionRepo := &MockTransactionRepository{transactionToReturn: NewTestTransaction()}
+			service := NewJournalService(&MockJournalEntryRepository{}, newTestAccountRepo(checking, groceries), &MockTransactionManager{}, transactionRepo)
+
+			_, err := service.PreviewTransactionEntry(context.Background(), "test-transaction-123", groceries.ID)
+
+			var invalidErr *domainerrors.ErrInvalidInput
+			if !errors.As(err, &invalidErr) || invalidErr.Field != "category_account_id" {
+				t.Errorf("expected invalid category_account_id, got %v", err)
+			}
+		})
+	}
+}
+
+func TestPreviewTransactionEntryNotFound(t *testing.T) {
+	checking, groceries, _ := newTestLedgerAccounts()
+	service := NewJournalService(&MockJournalEntryRepository{}, newTestAccountRepo(checking, groceries), &MockTransactionManager{}, &MockTransactionRepository{})
+
+	_, err := service.PreviewTransactionEntry(context.Background(), "missing", groceries.ID)
+
+	var notFoundErr *domainerrors.ErrNotFound
+	if !errors.As(err, &notFoundErr) {
+		t.Errorf("expected ErrNotFound, got %T", err)
+	}
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/pkg/mt940"
+)
+
+// mt940NoReference is the reference MT940 gives a transaction that has none.
+const mt940NoReference = "NONREF"
+
+// readMT940Statement reads the statement lines of an MT940 file. A file may
+// hold several statements, such as one per day or one per currency of a
+// multi-currency account, as long as they are all of the same account. The
+// amounts of a statement are in the currency of its opening balance.
+func readMT940Statement(data io.Reader) ([]*statementRow, error) {
+	statements, err := mt940.Parse(data)
+	var syntaxErr *mt940.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		return nil, domainerrors.NewErrInvalidInput("file", "the file is not a valid MT940 statement: "+syntaxErr.Error())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading statement: %w", err)
+	}
+
+	accounts := make([]string, 0, len(statements))
+	var transactions int
+	for _, statement := range statements {
+		accounts = append(accounts, statement.Account)
+		transactions += len(statement.Transactions)
+	}
+	if err := checkSingleAccount(accounts); err != nil {
+		return nil, err
+	}
+	if transactions > MaxImportRows {
+		return nil, domainerrors.NewErrInvalidInput("file", fmt.Sprintf("a statement may hold at most %d rows", MaxImportRows))
+	}
+
+	rows := make([]*statementRow, 0, transactions)
+	for _, statement := range statements {
+		for _, trn := range statement.Transactions {
+			row := &statementRow{line: trn.Line}
+			// The bank reference identifies the transaction at the bank; the
+			// customer reference is the account owner's and need not be unique
+			if trn.BankReference != mt940NoReference {
+				row.externalID = trn.BankReference
+			}
+			row.err = row.readMT940(trn, statement.Currency)
+			rows = append(rows, row)
+		}
+	}
+	return rows, nil
+}
+
+// readMT940 fills in the row from a statement line and returns why it cannot
+// be imported, if it cannot.
+func (row *statementRow) readMT940(trn *mt940.Transaction, currency string) error {
+	if err := row.readBooking(trn.Amount, currency, trn.Credit, trn.EntryDate, trn.ValueDate); err != nil {
+		return err
+	}
+
+	row.description = statementDescription(trn.Name, trn.Purpose)
+	if row.description == "" && trn.CustomerReference != mt940NoReference {
+		row.description = trn.CustomerReference
+	}
+	return nil
+}
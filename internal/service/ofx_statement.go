@@ -0,0 +1,97 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"accounting/internal/domain/constant"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/money"
+	"accounting/internal/pkg/iso4217"
+	"accounting/internal/pkg/ofx"
+)
+
+// readOFXStatement reads the transactions of an OFX statement. The file must
+// hold the statement of a single account; amounts are in the statement's
+// currency, or in the account's currency when the statement gives none.
+func readOFXStatement(data io.Reader, accountCurrency string) ([]*statementRow, error) {
+	statements, err := ofx.Parse(data)
+	var syntaxErr *ofx.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		return nil, domainerrors.NewErrInvalidInput("file", "the file is not valid OFX: "+syntaxErr.Error())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading statement: %w", err)
+	}
+
+	switch len(statements) {
+	case 0:
+		return nil, domainerrors.NewErrInvalidInput("file", "the file holds no bank or credit card statement")
+	case 1:
+	default:
+		return nil, domainerrors.NewErrInvalidInput("file", fmt.Sprintf("the file holds the statements of %d accounts; only one can be imported into an account", len(statements)))
+	}
+	statement := statements[0]
+
+	currency := statement.Currency
+	if currency == "" {
+		currency = accountCurrency
+	}
+	if !iso4217.IsValid(currency) {
+		return nil, domainerrors.NewErrInvalidInput("file", "the statement has the unknown currency "+currency)
+	}
+	if len(statement.Transactions) > MaxImportRows {
+		return nil, domainerrors.NewErrInvalidInput("file", fmt.Sprintf("a statement may hold at most %d rows", MaxImportRows))
+	}
+
+	rows := make([]*statementRow, 0, len(statement.Transactions))
+	for _, trn := range statement.Transactions {
+		row := &statementRow{line: trn.Line, externalID: trn.FITID}
+		row.err = row.readOFX(trn, currency)
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// readOFX fills in the row from a statement transaction and returns why it
+// cannot be imported, if it cannot.
+func (row *statementRow) readOFX(trn *ofx.Transaction, currency string) error {
+	if trn.FITID == "" {
+		return errors.New("the FITID is missing")
+	}
+
+	if trn.DatePosted == "" {
+		return errors.New("the date is missing")
+	}
+	posted, err := ofx.ParseDateTime(trn.DatePosted)
+	if err != nil {
+		return err
+	}
+	// The date the bank posted the transaction, in the bank's time zone
+	row.date = time.Date(posted.Year(), posted.Month(), posted.Day(), 0, 0, 0, 0, time.UTC)
+
+	if trn.Amount == "" {
+		return errors.New("the amount is missing")
+	}
+	value, err := ofx.ParseAmount(trn.Amount)
+	if err != nil {
+		return err
+	}
+	amount, err := money.Parse(value, currency)
+	if err != nil {
+		return fmt.Errorf("amount %q is not a valid %s amount", trn.Amount, currency)
+	}
+	if amount.IsZero() {
+		return errors.New("the amount is zero")
+	}
+
+	row.transactionType = constant.TransactionTypeIncome
+	if amount.IsNegative() {
+		row.transactionType = constant.TransactionTypeExpense
+	}
+	row.amount = amount.Abs()
+	row.description = statementDescription(trn.Name, trn.Memo)
+	return nil
+}
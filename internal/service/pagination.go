@@ -0,0 +1,20 @@
+package service
+
+import (
+	"fmt"
+
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+)
+
+// pageRequest applies the default page size and rejects sizes outside
+// 1..MaxPageLimit, so API callers can never request an unbounded listing.
+func pageRequest(page entity.PageRequest) (entity.PageRequest, error) {
+	if page.Limit == 0 {
+		page.Limit = entity.DefaultPageLimit
+	}
+	if page.Limit < 0 || page.Limit > entity.MaxPageLimit {
+		return page, domainerrors.NewErrInvalidInput("limit", fmt.Sprintf("limit must be between 1 and %d", entity.MaxPageLimit))
+	}
+	return page, nil
+}
@@ -0,0 +1,277 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/interfaces"
+
+	"github.com/google/uuid"
+)
+
+// maxAliasLength is the longest payee alias, in characters.
+const maxAliasLength = 100
+
+type PayeeService struct {
+	payeeRepo    interfaces.PayeeRepository
+	userRepo     interfaces.UserRepository
+	categoryRepo interfaces.CategoryRepository
+}
+
+func NewPayeeService(payeeRepo interfaces.PayeeRepository, userRepo interfaces.UserRepository, categoryRepo interfaces.CategoryRepository) *PayeeService {
+	return &PayeeService{
+		payeeRepo:    payeeRepo,
+		userRepo:     userRepo,
+		categoryRepo: categoryRepo,
+	}
+}
+
+func (s *PayeeService) CreatePayee(ctx context.Context, userID, name string, aliases []string, defaultCategoryID string) (*entity.Payee, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, domainerrors.NewErrInvalidInput("name", "name is required")
+	}
+	aliases, err := normalizeAliases(aliases)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkUser(ctx, s.userRepo, userID); err != nil {
+		return nil, err
+	}
+
+	payee := &entity.Payee{
+		ID:                uuid.New().String(),
+		UserID:            userID,
+		Name:              name,
+		Aliases:           aliases,
+		DefaultCategoryID: defaultCategoryID,
+	}
+	if err := s.checkNamesUnique(ctx, payee); err != nil {
+		return nil, err
+	}
+	if payee.DefaultCategoryID != "" {
+		if _, err := checkCategory(ctx, s.categoryRepo, "default_category_id", userID, payee.DefaultCategoryID, ""); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.payeeRepo.Create(ctx, payee); err != nil {
+		return nil, fmt.Errorf("creating payee: %w", err)
+	}
+
+	return payee, nil
+}
+
+func (s *PayeeService) GetPayee(ctx context.Context, id string) (*entity.Payee, error) {
+	return s.payeeRepo.GetByID(ctx, id)
+}
+
+func (s *PayeeService) ListUserPayees(ctx context.Context, userID string) ([]*entity.Payee, error) {
+	if err := checkUser(ctx, s.userRepo, userID); err != nil {
+		return nil, err
+	}
+	return s.payeeRepo.ListByUserID(ctx, userID)
+}
+
+func (s *PayeeService) UpdatePayee(ctx context.Context, id, name string, aliases []string, defaultCategoryID *string) (*entity.Payee, error) {
+	aliases, err := normalizeAliases(aliases)
+	if err != nil {
+		return nil, err
+	}
+
+	payee, err := s.payeeRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("getting payee: %w", err)
+	}
+	if payee == nil {
+		return nil, domainerrors.NewErrNotFound("payee", id)
+	}
+
+	if name = strings.TrimSpace(name); name != "" {
+		payee.Name = name
+	}
+	if aliases != nil {
+		payee.Aliases = aliases
+	}
+	if err := s.checkNamesUnique(ctx, payee); err != nil {
+		return nil, err
+	}
+	if defaultCategoryID != nil && *defaultCategoryID != payee.DefaultCategoryID {
+		if *defaultCategoryID != "" {
+			if _, err := checkCategory(ctx, s.categoryRepo, "default_category_id", payee.UserID, *defaultCategoryID, ""); err != nil {
+				return nil, err
+			}
+		}
+		payee.DefaultCategoryID = *defaultCategoryID
+	}
+
+	if err := s.payeeRepo.Update(ctx, payee); err != nil {
+		return nil, fmt.Errorf("updating payee: %w", err)
+	}
+
+	return payee, nil
+}
+
+func (s *PayeeService) DeletePayee(ctx context.Context, id string) error {
+	payee, err := s.payeeRepo.GetByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("getting payee: %w", err)
+	}
+	if payee == nil {
+		return domainerrors.NewErrNotFound("payee", id)
+	}
+
+	return s.payeeRepo.Delete(ctx, id)
+}
+
+// checkNamesUnique verifies that no other payee of the user has the payee's
+// name, and that none of the payee's name and aliases is the name or an
+// alias of another of the user's payees, ignoring case, so that a
+// description never matches two payees by the same text.
+func (s *PayeeService) checkNamesUnique(ctx context.Context, payee *entity.Payee) error {
+	existing, err := s.payeeRepo.GetByName(ctx, payee.UserID, payee.Name)
+	if err != nil {
+		return fmt.Errorf("checking payee name: %w", err)
+	}
+	if existing != nil && existing.ID != payee.ID {
+		return domainerrors.NewErrDuplicatePayee(payee.UserID, existing.Name)
+	}
+
+	payees, err := s.payeeRepo.ListByUserID(ctx, payee.UserID)
+	if err != nil {
+		return fmt.Errorf("getting payees: %w", err)
+	}
+	// taken maps the lowercased names and aliases of the other payees to their names
+	taken := make(map[string]string)
+	for _, other := range payees {
+		if other.ID == payee.ID {
+			continue
+		}
+		for _, text := range append([]string{other.Name}, other.Aliases...) {
+			taken[strings.ToLower(text)] = other.Name
+		}
+	}
+	if other, ok := taken[strings.ToLower(payee.Name)]; ok {
+		return domainerrors.NewErrInvalidInput("name", fmt.Sprintf("%q is an alias of payee %q", payee.Name, other))
+	}
+	for _, alias := range payee.Aliases {
+		if other, ok := taken[strings.ToLower(alias)]; ok {
+			return domainerrors.NewErrInvalidInput("aliases", fmt.Sprintf("alias %q already names payee %q", alias, other))
+		}
+	}
+	return nil
+}
+
+// normalizeAliases trims the aliases of a payee and drops repeated ones,
+// ignoring case and keeping the first spelling. Nil stays nil, so that
+// updates can tell it apart from removing the aliases.
+func normalizeAliases(aliases []string) ([]string, error) {
+	if aliases == nil {
+		return nil, nil
+	}
+	normalized := make([]string, 0, len(aliases))
+	seen := make(map[string]bool, len(aliases))
+	for _, alias := range aliases {
+		alias = strings.TrimSpace(alias)
+		if alias == "" {
+			return nil, domainerrors.NewErrInvalidInput("aliases", "aliases must not be empty")
+		}
+		if utf8.RuneCountInString(alias) > maxAliasLength {
+			return nil, domainerrors.NewErrInvalidInput("aliases", fmt.Sprintf("alias %q is longer than %d characters", alias, maxAliasLength))
+		}
+		if key := strings.ToLower(alias); !seen[key] {
+			seen[key] = true
+			normalized = append(normalized, alias)
+		}
+	}
+	return normalized, nil
+}
+
+// matchPayee returns the payee whose name or one of whose aliases the
+// description contains, ignoring case, or nil if there is none. When several
+// do, the longest name or alias wins, as the most specific; payees are tried
+// in the given order on a tie.
+func matchPayee(payees []*entity.Payee, description string) *entity.Payee {
+	description = strings.ToLower(description)
+	if description == "" {
+		return nil
+	}
+	var match *entity.Payee
+	var matched int
+	for _, payee := range payees {
+		for _, text := range append([]string{payee.Name}, payee.Aliases...) {
+			if len(text) > matched && strings.Contains(description, strings.ToLower(text)) {
+				match, matched = payee, len(text)
+			}
+		}
+	}
+	return match
+}
+
+// checkPayee returns the payee with the given ID after verifying that it
+// belongs to the user. Errors are reported on field.
+func checkPayee(ctx context.Context, payeeRepo interfaces.PayeeRepository, field, userID, id string) (*entity.Payee, error) {
+	payee, err := payeeRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("getting payee: %w", err)
+	}
+	if payee == nil {
+		return nil, domainerrors.NewErrNotFound("payee", id)
+	}
+	if payee.UserID != userID {
+		return nil, domainerrors.NewErrInvalidInput(field, "the payee belongs to another user")
+	}
+	return payee, nil
+}
+
+// assignPayee sets the payee of a transaction being created: the payee with
+// the given ID, or else the one its description matches, if any. A
+// transaction still without a category or splits then gets the payee's
+// default category, provided the category can be given to it.
+func assignPayee(ctx context.Context, payeeRepo interfaces.PayeeRepository, categoryRepo interfaces.CategoryRepository, userID, payeeID string, transaction *entity.Transaction) error {
+	var payee *entity.Payee
+	if payeeID != "" {
+		var err error
+		if payee, err = checkPayee(ctx, payeeRepo, "payee_id", userID, payeeID); err != nil {
+			return err
+		}
+	} else {
+		payees, err := payeeRepo.ListByUserID(ctx, userID)
+		if err != nil {
+			return fmt.Errorf("getting payees: %w", err)
+		}
+		if payee = matchPayee(payees, transaction.Description); payee == nil {
+			return nil
+		}
+	}
+	transaction.PayeeID = payee.ID
+	transaction.Payee = payee.Name
+
+	return applyDefaultCategory(ctx, categoryRepo, userID, payee, transaction)
+}
+
+// applyDefaultCategory gives a transaction without a category or splits the
+// default category of its payee, unless the category is archived or does not
+// allow the transaction's type.
+func applyDefaultCategory(ctx context.Context, categoryRepo interfaces.CategoryRepository, userID string, payee *entity.Payee, transaction *entity.Transaction) error {
+	if payee.DefaultCategoryID == "" || transaction.CategoryID != "" || len(transaction.Splits) > 0 {
+		return nil
+	}
+	category, err := categoryRepo.GetByID(ctx, payee.DefaultCategoryID)
+	if err != nil {
+		return fmt.Errorf("getting default category: %w", err)
+	}
+	if category == nil || category.UserID != userID || category.Archived || !category.Allows(transaction.Type) {
+		return nil
+	}
+	transaction.CategoryID = category.ID
+	transaction.Category = category.Name
+	return nil
+}
+
+// Compile-time interface check
+var _ interfaces.PayeeService = (*PayeeService)(nil)
@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+)
+
+// newTestPayeeService returns a payee service over the test payees and
+// categories.
+func newTestPayeeService() (*PayeeService, *MockPayeeRepository) {
+	payeeRepo := newTestPayees()
+	userRepo := &MockUserRepository{userToReturn: NewTestUser()}
+	return NewPayeeService(payeeRepo, userRepo, newTestCategories()), payeeRepo
+}
+
+func TestCreatePayee(t *testing.T) {
+	service, payeeRepo := newTestPayeeService()
+
+	payee, err := service.CreatePayee(context.Background(), "test-user-123", " Amazon ", []string{" AMZN Mktp ", "amzn mktp", "Amazon.com"}, "category-shopping")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if payee.Name != "Amazon" || payee.DefaultCategoryID != "category-shopping" || payeeRepo.payees[payee.ID] == nil {
+		t.Errorf("unexpected payee %+v", payee)
+	}
+	if !slices.Equal(payee.Aliases, []string{"AMZN Mktp", "Amazon.com"}) {
+		t.Errorf("expected trimmed aliases without repeats, got %q", payee.Aliases)
+	}
+}
+
+func TestCreatePayeeValidation(t *testing.T) {
+	otherCategory := NewTestCategory("category-other-user", "Elsewhere", "")
+	otherCategory.UserID = "other-user-456"
+
+	tests := []struct {
+		name              string
+		payeeName         string
+		aliases           []string
+		defaultCategoryID string
+		field             string
+	}{
+		{name: "no name", payeeName: " ", field: "name"},
+		{name: "empty alias", payeeName: "Amazon", aliases: []string{"AMZN", " "}, field: "aliases"},
+		{name: "long alias", payeeName: "Amazon", aliases: []string{strings.Repeat("a", maxAliasLength+1)}, field: "aliases"},
+		{name: "name of another payee's alias", payeeName: "sbux", field: "name"},
+		{name: "alias of another payee", payeeName: "Tesco Express", aliases: []string{"Tesco express 1234"}, field: "aliases"},
+		{name: "alias naming another payee", payeeName: "Coffee", aliases: []string{"STARBUCKS"}, field: "aliases"},
+		{name: "default category of another user", payeeName: "Amazon", defaultCategoryID: otherCategory.ID, field: "default_category_id"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payeeRepo := newTestPayees()
+			categoryRepo := newTestCategories()
+			categoryRepo.Create(context.Background(), otherCategory)
+			service := NewPayeeService(payeeRepo, &MockUserRepository{userToReturn: NewTestUser()}, categoryRepo)
+
+			_, err := service.CreatePayee(context.Background(), "test-user-123", tt.payeeName, tt.aliases, tt.defaultCategoryID)
+
+			var invalidErr *domainerrors.ErrInvalidInput
+			if !errors.As(err, &invalidErr) || invalidErr.Field != tt.field {
+				t.Fatalf("expected invalid %s, got %v", tt.field, err)
+			}
+			if payeeRepo.createCalls != 0 {
+				t.Error("expected the payee not to be saved")
+			}
+		})
+	}
+}
+
+func TestCreatePayeeDuplicateName(t *testing.T) {
+	service, _ := newTestPayeeService()
+
+	_, err := service.CreatePayee(context.Background(), "test-user-123", "TESCO", nil, "")
+
+	var duplicateErr *domainerrors.ErrDuplicatePayee
+	if !errors.As(err, &duplicateErr) || duplicateErr.Name != "Tesco" {
+		t.Errorf("expected a duplicate of Tesco, got %v", err)
+	}
+}
+
+func TestUpdatePayee(t *testing.T) {
+	service, payeeRepo := newTestPayeeService()
+
+	noCategory := ""
+	updated, err := service.UpdatePayee(context.Background(), "payee-coffee", "Starbucks Coffee", []string{"SBUX", "Starbucks"}, &noCategory)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if updated.Name != "Starbucks Coffee" || updated.DefaultCategoryID != "" || !slices.Equal(updated.Aliases, []string{"SBUX", "Starbucks"}) {
+		t.Errorf("unexpected updated payee %+v", updated)
+	}
+	if stored := payeeRepo.payees["payee-coffee"]; stored.Name != "Starbucks Coffee" {
+		t.Errorf("expected the update to be saved, got %+v", stored)
+	}
+
+	// Leaving out the aliases keeps them, and an empty list removes them
+	updated, err = service.UpdatePayee(context.Background(), "payee-supermarket", "", nil, nil)
+	if err != nil || len(updated.Aliases) != 2 || updated.DefaultCategoryID != "category-food" {
+		t.Errorf("expected the payee unchanged, got %+v, %v", updated, err)
+	}
+	updated, err = service.UpdatePayee(context.Background(), "payee-supermarket", "", []string{}, nil)
+	if err != nil || len(updated.Aliases) != 0 {
+		t.Errorf("expected the aliases removed, got %+v, %v", updated, err)
+	}
+
+	_, err = service.UpdatePayee(context.Background(), "missing", "Name", nil, nil)
+	var notFoundErr *domainerrors.ErrNotFound
+	if !errors.As(err, &notFoundErr) {
+		t.Errorf("expected not found, got %v", err)
+	}
+}
+
+func TestUpdatePayeeValidation(t *testing.T) {
+	archived := "category-archived"
+
+	tests := []struct {
+		name              string
+		payeeName         string
+		aliases           []string
+		defaultCategoryID *string
+		field             string
+	}{
+		{name: "alias of another payee", aliases: []string{"tesco stores"}, field: "aliases"},
+		{name: "archived default category", defaultCategoryID: &archived, field: "default_category_id"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, payeeRepo := newTestPayeeService()
+
+			_, err := service.UpdatePayee(context.Background(), "payee-coffee", tt.payeeName, tt.aliases, tt.defaultCategoryID)
+
+			var invalidErr *domainerrors.ErrInvalidInput
+			if !errors.As(err, &invalidErr) || invalidErr.Field != tt.field {
+				t.Fatalf("expected invalid %s, got %v", tt.field, err)
+			}
+			if payeeRepo.updateCalls != 0 {
+				t.Error("expected the payee not to be saved")
+			}
+		})
+	}
+}
+
+func TestUpdatePayeeKeepsOwnAliases(t *testing.T) {
+	service, _ := newTestPayeeService()
+
+	// A payee's own name and aliases never conflict with themselves
+	if _, err := service.UpdatePayee(context.Background(), "payee-coffee", "SBUX", []string{"Starbucks"}, nil); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
+
+func TestDeletePayee(t *testing.T) {
+	service, payeeRepo := newTestPayeeService()
+
+	if err := service.DeletePayee(context.Background(), "payee-coffee"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if _, ok := payeeRepo.payees["payee-coffee"]; ok {
+		t.Error("expected the payee to be deleted")
+	}
+
+	err := service.DeletePayee(context.Background(), "missing")
+	var notFoundErr *domainerrors.ErrNotFound
+	if !errors.As(err, &notFoundErr) {
+		t.Errorf("expected not found, got %v", err)
+	}
+}
+
+func TestListUserPayees(t *testing.T) {
+	service, _ := newTestPayeeService()
+
+	payees, err := service.ListUserPayees(context.Background(), "test-user-123")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(payees) != 2 || payees[0].Name != "Starbucks" || payees[1].Name != "Tesco" {
+		t.Errorf("unexpected payees %+v", payees)
+	}
+}
+
+func TestMatchPayee(t *testing.T) {
+	payees, _ := newTestPayees().ListByUserID(context.Background(), "test-user-123")
+	tesco := &entity.Payee{ID: "payee-tesco-bank", Name: "Tesco Bank"}
+	payees = append(payees, tesco)
+
+	tests := []struct {
+		description string
+		want        string
+	}{
+		{description: "CARD PAYMENT SBUX 0042 LONDON", want: "payee-coffee"},
+		{description: "starbucks reserve", want: "payee-coffee"},
+		{description: "TESCO STORES 3021", want: "payee-supermarket"},
+		// The longest text wins over a shorter one that matches too
+		{description: "Tesco Bank credit card repayment", want: "payee-tesco-bank"},
+		{description: "Rent for March", want: ""},
+		{description: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			var got string
+			if payee := matchPayee(payees, tt.description); payee != nil {
+				got = payee.ID
+			}
+			if got != tt.want {
+				t.Errorf("expected payee %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,469 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/domain/money"
+)
+
+type ReportService struct {
+	reportRepo   interfaces.ReportRepository
+	userRepo     interfaces.UserRepository
+	accountRepo  interfaces.AccountRepository
+	categoryRepo interfaces.CategoryRepository
+	balances     interfaces.BalanceService
+	converter    interfaces.CurrencyConverter
+}
+
+func NewReportService(reportRepo interfaces.ReportRepository, userRepo interfaces.UserRepository, accountRepo interfaces.AccountRepository, categoryRepo interfaces.CategoryRepository, balances interfaces.BalanceService, converter interfaces.CurrencyConverter) *ReportService {
+	return &ReportService{
+		reportRepo:   reportRepo,
+		userRepo:     userRepo,
+		accountRepo:  accountRepo,
+		categoryRepo: categoryRepo,
+		balances:     balances,
+		converter:    converter,
+	}
+}
+
+func (s *ReportService) GetSummary(ctx context.Context, userID string, from, to time.Time, granularity constant.Granularity, baseCurrency string) (*entity.Summary, error) {
+	if granularity == "" {
+		granularity = constant.GranularityMonth
+	}
+	if err := validateReportPeriod(from, to, granularity); err != nil {
+		return nil, err
+	}
+	if baseCurrency != "" {
+		if err := validateCurrency("base_currency", baseCurrency); err != nil {
+			return nil, err
+		}
+	}
+	if err := checkUser(ctx, s.userRepo, userID); err != nil {
+		return nil, err
+	}
+
+	totals, err := s.reportRepo.SumByCategory(ctx, userID, from, to, granularity)
+	if err != nil {
+		return nil, fmt.Errorf("summing transactions: %w", err)
+	}
+	// Archived categories still hold the transactions given to them
+	categories, err := s.categoryRepo.ListByUserID(ctx, userID, true)
+	if err != nil {
+		return nil, fmt.Errorf("listing categories: %w", err)
+	}
+	categoriesByID := make(map[string]*entity.Category, len(categories))
+	for _, category := range categories {
+		categoriesByID[category.ID] = category
+	}
+
+	summary := &entity.Summary{
+		UserID:       userID,
+		From:         from,
+		To:           to,
+		Granularity:  granularity,
+		BaseCurrency: baseCurrency,
+	}
+
+	// Totals arrive ordered by period and currency, so each summary's rows are contiguous
+	var period *entity.PeriodSummary
+	var tree *categoryTree
+	for _, total := range totals {
+		if baseCurrency != "" {
+			if total, err = s.convertCategoryTotal(ctx, total, baseCurrency, summaryRateDate(total.PeriodStart, to, granularity)); err != nil {
+				return nil, err
+			}
+		}
+
+		currency := total.Income.Currency()
+		if period == nil || !period.PeriodStart.Equal(total.PeriodStart) || period.Currency != currency {
+			period = &entity.PeriodSummary{
+				PeriodStart: total.PeriodStart,
+				PeriodEnd:   granularity.PeriodEnd(total.PeriodStart),
+				Currency:    currency,
+				Income:      money.Zero(currency),
+				Expense:     money.Zero(currency),
+			}
+			summary.Periods = append(summary.Periods, period)
+			tree = newCategoryTree(period, categoriesByID)
+		}
+
+		if err := tree.add(total); err != nil {
+			return nil, err
+		}
+		if period.Income, err = period.Income.Add(total.Income); err != nil {
+			return nil, err
+		}
+		if period.Expense, err = period.Expense.Add(total.Expense); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, period := range summary.Periods {
+		if period.NetCashFlow, err = period.Income.Sub(period.Expense); err != nil {
+			return nil, err
+		}
+		if err := finishCategorySummaries(period.Categories); err != nil {
+			return nil, err
+		}
+	}
+
+	return summary, nil
+}
+
+func (s *ReportService) GetTagReport(ctx context.Context, userID string, from, to time.Time) (*entity.TagReport, error) {
+	if err := validateReportRange(from, to); err != nil {
+		return nil, err
+	}
+	if err := checkUser(ctx, s.userRepo, userID); err != nil {
+		return nil, err
+	}
+
+	totals, err := s.reportRepo.SumByTag(ctx, userID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("summing tagged transactions: %w", err)
+	}
+
+	report := &entity.TagReport{
+		UserID: userID,
+		From:   from,
+		To:     to,
+		Tags:   make([]*entity.TagSummary, 0, len(totals)),
+	}
+	for _, total := range totals {
+		netCashFlow, err := total.Income.Sub(total.Expense)
+		if err != nil {
+			return nil, err
+		}
+		report.Tags = append(report.Tags, &entity.TagSummary{
+			Tag:         total.Tag,
+			Currency:    total.Income.Currency(),
+			Income:      total.Income,
+			Expense:     total.Expense,
+			NetCashFlow: netCashFlow,
+		})
+	}
+
+	return report, nil
+}
+
+func (s *ReportService) GetPayeeReport(ctx context.Context, userID string, from, to time.Time) (*entity.PayeeReport, error) {
+	if err := validateReportRange(from, to); err != nil {
+		return nil, err
+	}
+	if err := checkUser(ctx, s.userRepo, userID); err != nil {
+		return nil, err
+	}
+
+	totals, err := s.reportRepo.SumByPayee(ctx, userID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("summing transactions by payee: %w", err)
+	}
+
+	report := &entity.PayeeReport{
+		UserID: userID,
+		From:   from,
+		To:     to,
+		Payees: make([]*entity.PayeeSummary, 0, len(totals)),
+	}
+	for _, total := range totals {
+		netCashFlow, err := total.Income.Sub(total.Expense)
+		if err != nil {
+			return nil, err
+		}
+		report.Payees = append(report.Payees, &entity.PayeeSummary{
+			PayeeID:     total.PayeeID,
+			Payee:       total.Payee,
+			Currency:    total.Income.Currency(),
+			Income:      total.Income,
+			Expense:     total.Expense,
+			NetCashFlow: netCashFlow,
+		})
+	}
+
+	return report, nil
+}
+
+// convertCategoryTotal returns the total expressed in currency at the rates
+// effective on date.
+func (s *ReportService) convertCategoryTotal(ctx context.Context, total *entity.CategoryTotal, currency string, date time.Time) (*entity.CategoryTotal, error) {
+	income, _, err := s.converter.Convert(ctx, total.Income, currency, date)
+	if err != nil {
+		return nil, err
+	}
+	expense, _, err := s.converter.Convert(ctx, total.Expense, currency, date)
+	if err != nil {
+		return nil, err
+	}
+	return &entity.CategoryTotal{PeriodStart: total.PeriodStart, CategoryID: total.CategoryID, Income: income, Expense: expense}, nil
+}
+
+// summaryRateDate returns the day whose exchange rates convert a period of a
+// summary: the period's last day, or the summary's last day when the period
+// runs past the end of the summary.
+func summaryRateDate(periodStart, to time.Time, granularity constant.Granularity) time.Time {
+	end := granularity.PeriodEnd(periodStart)
+	if to.Before(end) {
+		end = to
+	}
+	return calendarDay(end.AddDate(0, 0, -1))
+}
+
+// categoryTree builds the breakdown of a period summary by category, nesting
+// subcategories under their parent.
+type categoryTree struct {
+	period     *entity.PeriodSummary
+	categories map[string]*entity.Category
+	nodes      map[string]*entity.CategorySummary
+	// parents maps the ID of each nested category to the ID of its parent
+	parents map[string]string
+}
+
+func newCategoryTree(period *entity.PeriodSummary, categories map[string]*entity.Category) *categoryTree {
+	return &categoryTree{
+		period:     period,
+		categories: categories,
+		nodes:      make(map[string]*entity.CategorySummary),
+		parents:    make(map[string]string),
+	}
+}
+
+// node returns the summary of a category, adding it and its ancestors to the
+// tree when missing. Uncategorized transactions and unknown categories are
+// reported at the top level.
+func (t *categoryTree) node(id string) *entity.CategorySummary {
+	if node, ok := t.nodes[id]; ok {
+		return node
+	}
+
+	currency := t.period.Currency
+	node := &entity.CategorySummary{CategoryID: id, Income: money.Zero(currency), Expense: money.Zero(currency)}
+	t.nodes[id] = node
+	category, ok := t.categories[id]
+	if ok {
+		node.Category = category.Name
+	}
+	if !ok || t.categories[category.ParentID] == nil {
+		t.period.Categories = append(t.period.Categories, node)
+		return node
+	}
+
+	parent := t.node(category.ParentID)
+	parent.Children = append(parent.Children, node)
+	t.parents[id] = category.ParentID
+	return node
+}
+
+// add counts a category total towards the category and each of its ancestors.
+func (t *categoryTree) add(total *entity.CategoryTotal) error {
+	id := total.CategoryID
+	for {
+		node := t.node(id)
+		var err error
+		if node.Income, err = node.Income.Add(total.Income); err != nil {
+			return err
+		}
+		if node.Expense, err = node.Expense.Add(total.Expense); err != nil {
+			return err
+		}
+
+		parentID, ok := t.parents[id]
+		if !ok {
+			return nil
+		}
+		id = parentID
+	}
+}
+
+// finishCategorySummaries fills NetCashFlow and sorts the summaries and their
+// children by name, recursively.
+func finishCategorySummaries(summaries []*entity.CategorySummary) error {
+	slices.SortFunc(summaries, func(a, b *entity.CategorySummary) int {
+		return cmp.Compare(a.Category, b.Category)
+	})
+	for _, summary := range summaries {
+		var err error
+		if summary.NetCashFlow, err = summary.Income.Sub(summary.Expense); err != nil {
+			return err
+		}
+		if err := finishCategorySummaries(summary.Children); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *ReportService) GetNetWorth(ctx context.Context, userID string, from, to time.Time) (*entity.NetWorth, error) {
+	if from.IsZero() {
+		return nil, domainerrors.NewErrInvalidInput("from", "from is required")
+	}
+	if to.IsZero() {
+		return nil, domainerrors.NewErrInvalidInput("to", "to is required")
+	}
+	from, to = calendarDay(from), calendarDay(to)
+	if to.Before(from) {
+		return nil, domainerrors.NewErrInvalidInput("to", "to must not be before from")
+	}
+	if to.Sub(from) >= MaxBalanceHistoryDays*24*time.Hour {
+		return nil, domainerrors.NewErrInvalidInput("to", fmt.Sprintf("the range may span at most %d days", MaxBalanceHistoryDays))
+	}
+
+	user, err := getUser(ctx, s.userRepo, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	all, _, err := s.accountRepo.ListByUserID(ctx, userID, entity.PageRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("listing accounts: %w", err)
+	}
+	var accounts []*entity.Account
+	for _, account := range all {
+		if class := account.Type.Class(); class == constant.AccountClassAsset || class == constant.AccountClassLiability {
+			accounts = append(accounts, account)
+		}
+	}
+
+	netWorth := &entity.NetWorth{UserID: userID, Currency: user.BaseCurrency}
+
+	// The current position comes from the balances kept on the accounts
+	balances := make([]money.Money, len(accounts))
+	for i, account := range accounts {
+		balances[i] = account.Balance
+	}
+	current, converted, err := s.netWorthAt(ctx, accounts, balances, user.BaseCurrency, calendarDay(time.Now()))
+	if err != nil {
+		return nil, err
+	}
+	netWorth.Current = *current
+	for i, account := range accounts {
+		netWorth.Accounts = append(netWorth.Accounts, &entity.NetWorthAccount{
+			AccountID: account.ID,
+			Name:      account.Name,
+			Type:      account.Type,
+			Balance:   account.Balance,
+			Converted: converted[i],
+		})
+	}
+
+	// The history replays each account's stored transactions and postings
+	histories := make([][]*entity.DailyBalance, len(accounts))
+	for i, account := range accounts {
+		if histories[i], err = s.balances.GetDailyBalances(ctx, account.ID, from, to); err != nil {
+			return nil, fmt.Errorf("getting balance history of account %s: %w", account.ID, err)
+		}
+	}
+	for _, date := range monthEnds(from, to) {
+		day := int(date.Sub(from) / (24 * time.Hour))
+		for i, history := range histories {
+			balances[i] = history[day].Balance
+		}
+		point, _, err := s.netWorthAt(ctx, accounts, balances, user.BaseCurrency, date)
+		if err != nil {
+			return nil, err
+		}
+		netWorth.History = append(netWorth.History, point)
+	}
+
+	return netWorth, nil
+}
+
+// netWorthAt totals the balances of the accounts, in the same order, into
+// currency at the rates effective on date. It also returns each converted balance.
+func (s *ReportService) netWorthAt(ctx context.Context, accounts []*entity.Account, balances []money.Money, currency string, date time.Time) (*entity.NetWorthPoint, []money.Money, error) {
+	assets, owed := money.Zero(currency), money.Zero(currency)
+	converted := make([]money.Money, len(accounts))
+	for i, account := range accounts {
+		amount, _, err := s.converter.Convert(ctx, balances[i], currency, date)
+		if err != nil {
+			return nil, nil, err
+		}
+		converted[i] = amount
+
+		// Liability balances are negative while money is owed
+		if account.Type.Class() == constant.AccountClassLiability {
+			owed, err = owed.Sub(amount)
+		} else {
+			assets, err = assets.Add(amount)
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("totalling net worth in %s: %w", currency, err)
+		}
+	}
+
+	netWorth, err := assets.Sub(owed)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &entity.NetWorthPoint{Date: date, Assets: assets, Liabilities: owed, NetWorth: netWorth}, converted, nil
+}
+
+// monthEnds returns the last day of every month overlapping [from, to],
+// capped at to.
+func monthEnds(from, to time.Time) []time.Time {
+	var dates []time.Time
+	for month := from.AddDate(0, 0, 1-from.Day()); ; month = month.AddDate(0, 1, 0) {
+		end := month.AddDate(0, 1, -1)
+		if !end.Before(to) {
+			return append(dates, to)
+		}
+		dates = append(dates, end)
+	}
+}
+
+// checkUser verifies that the user exists.
+func checkUser(ctx context.Context, userRepo interfaces.UserRepository, userID string) error {
+	_, err := getUser(ctx, userRepo, userID)
+	return err
+}
+
+// getUser returns the user, verifying that it exists.
+func getUser(ctx context.Context, userRepo interfaces.UserRepository, userID string) (*entity.User, error) {
+	if userID == "" {
+		return nil, domainerrors.NewErrInvalidInput("user_id", "user ID is required")
+	}
+	user, err := userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("verifying user: %w", err)
+	}
+	if user == nil {
+		return nil, domainerrors.NewErrNotFound("user", userID)
+	}
+	return user, nil
+}
+
+// validateReportPeriod checks that [from, to) is a non-empty range and the
+// granularity is supported.
+func validateReportPeriod(from, to time.Time, granularity constant.Granularity) error {
+	if err := validateReportRange(from, to); err != nil {
+		return err
+	}
+	if !slices.Contains(constant.Granularities, granularity) {
+		return domainerrors.NewErrInvalidInput("granularity", fmt.Sprintf("unknown granularity %q", granularity))
+	}
+	return nil
+}
+
+// validateReportRange checks that [from, to) is a non-empty range.
+func validateReportRange(from, to time.Time) error {
+	if from.IsZero() {
+		return domainerrors.NewErrInvalidInput("from", "from is required")
+	}
+	if to.IsZero() {
+		return domainerrors.NewErrInvalidInput("to", "to is required")
+	}
+	if !from.Before(to) {
+		return domainerrors.NewErrInvalidInput("to", "to must be after from")
+	}
+	return nil
+}
+
+// Compile-time interface check
+var _ interfaces.ReportService = (*ReportService)(nil)
@@ -0,0 +1,510 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/money"
+)
+
+var (
+	january  = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	february = time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	march    = time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func newTestCategoryTotal(periodStart time.Time, categoryID, income, expense, currency string) *entity.CategoryTotal {
+	return &entity.CategoryTotal{
+		PeriodStart: periodStart,
+		CategoryID:  categoryID,
+		Income:      money.MustParse(income, currency),
+		Expense:     money.MustParse(expense, currency),
+	}
+}
+
+// newTestReportCategories stores the test user's categories Food, with the
+// subcategories Groceries and Restaurants, and Salary.
+func newTestReportCategories() *MockCategoryRepository {
+	groceries := NewTestCategory("category-groceries", "Groceries", constant.TransactionTypeExpense)
+	groceries.ParentID = "category-food"
+	restaurants := NewTestCategory("category-restaurants", "Restaurants", constant.TransactionTypeExpense)
+	restaurants.ParentID = "category-food"
+	return newMockCategoryRepository(
+		NewTestCategory("category-food", "Food", constant.TransactionTypeExpense),
+		groceries,
+		restaurants,
+		NewTestCategory("category-salary", "Salary", constant.TransactionTypeIncome),
+	)
+}
+
+func TestGetSummarySuccess(t *testing.T) {
+	reportRepo := &MockReportRepository{
+		categoryTotalsToReturn: []*entity.CategoryTotal{
+			newTestCategoryTotal(january, "category-groceries", "0", "120.50", "USD"),
+			newTestCategoryTotal(january, "category-salary", "3000", "0", "USD"),
+			newTestCategoryTotal(january, "category-groceries", "0", "80", "EUR"),
+			newTestCategoryTotal(february, "category-groceries", "10", "200", "USD"),
+		},
+	}
+	userRepo := &MockUserRepository{userToReturn: NewTestUser()}
+	service := NewReportService(reportRepo, userRepo, &MockAccountRepository{}, newTestReportCategories(), &MockBalanceService{}, newTestConverter())
+
+	summary, err := service.GetSummary(context.Background(), "test-user-123", january, march, "", "")
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if summary.Granularity != constant.GranularityMonth {
+		t.Errorf("expected default granularity %q, got %q", constant.GranularityMonth, summary.Granularity)
+	}
+
+	if reportRepo.lastGranularity != constant.GranularityMonth {
+		t.Errorf("expected repository granularity %q, got %q", constant.GranularityMonth, reportRepo.lastGranularity)
+	}
+
+	if len(summary.Periods) != 3 {
+		t.Fatalf("expected 3 period summaries, got %d", len(summary.Periods))
+	}
+
+	usdJanuary := summary.Periods[0]
+	if usdJanuary.Currency != "USD" || !usdJanuary.PeriodStart.Equal(january) || !usdJanuary.PeriodEnd.Equal(february) {
+		t.Errorf("expected USD January period, got %s %s-%s", usdJanuary.Currency, usdJanuary.PeriodStart, usdJanuary.PeriodEnd)
+	}
+	if usdJanuary.Income != money.MustParse("3000", "USD") {
+		t.Errorf("expected income 3000.00 USD, got %s", usdJanuary.Income)
+	}
+	if usdJanuary.Expense != money.MustParse("120.50", "USD") {
+		t.Errorf("expected expense 120.50 USD, got %s", usdJanuary.Expense)
+	}
+	if usdJanuary.NetCashFlow != money.MustParse("2879.50", "USD") {
+		t.Errorf("expected net cash flow 2879.50 USD, got %s", usdJanuary.NetCashFlow)
+	}
+	if len(usdJanuary.Categories) != 2 {
+		t.Fatalf("expected 2 categories, got %d", len(usdJanuary.Categories))
+	}
+	if usdJanuary.Categories[0].Category != "Food" || usdJanuary.Categories[0].NetCashFlow != money.MustParse("-120.50", "USD") {
+		t.Errorf("expected food net -120.50 USD, got %s %s", usdJanuary.Categories[0].Category, usdJanuary.Categories[0].NetCashFlow)
+	}
+
+	if summary.Periods[1].Currency != "EUR" {
+		t.Errorf("expected a separate EUR summary for January, got %s", summary.Periods[1].Currency)
+	}
+
+	if summary.Periods[2].NetCashFlow != money.MustParse("-190", "USD") {
+		t.Errorf("expected February net cash flow -190.00 USD, got %s", summary.Periods[2].NetCashFlow)
+	}
+}
+
+func TestGetSummaryRollsUpSubcategories(t *testing.T) {
+	reportRepo := &MockReportRepository{
+		categoryTotalsToReturn: []*entity.CategoryTotal{
+			newTestCategoryTotal(january, "", "0", "5", "USD"),
+			newTestCategoryTotal(january, "category-food", "0", "20", "USD"),
+			newTestCategoryTotal(january, "category-restaurants", "0", "60", "USD"),
+			newTestCategoryTotal(january, "category-groceries", "15", "100", "USD"),
+			newTestCategoryTotal(january, "category-deleted", "0", "1", "USD"),
+		},
+	}
+	userRepo := &MockUserRepository{userToReturn: NewTestUser()}
+	service := NewReportService(reportRepo, userRepo, &MockAccountRepository{}, newTestReportCategories(), &MockBalanceService{}, newTestConverter())
+
+	summary, err := service.GetSummary(context.Background(), "test-user-123", january, february, "", "")
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(summary.Periods) != 1 {
+		t.Fatalf("expected 1 period summary, got %d", len(summary.Periods))
+	}
+
+	// Uncategorized and unknown categories sort first, with empty names
+	categories := summary.Periods[0].Categories
+	if len(categories) != 3 || categories[2].CategoryID != "category-food" {
+		t.Fatalf("expected two unnamed categories and food at the top level, got %+v", categories)
+	}
+	food := categories[2]
+	if food.Income != money.MustParse("15", "USD") || food.Expense != money.MustParse("180", "USD") || food.NetCashFlow != money.MustParse("-165", "USD") {
+		t.Errorf("expected food to total its subcategories and itself, got %s %s %s", food.Income, food.Expense, food.NetCashFlow)
+	}
+	if len(food.Children) != 2 || food.Children[0].Category != "Groceries" || food.Children[1].Category != "Restaurants" {
+		t.Fatalf("expected groceries and restaurants under food, got %+v", food.Children)
+	}
+	if food.Children[0].NetCashFlow != money.MustParse("-85", "USD") || food.Children[1].NetCashFlow != money.MustParse("-60", "USD") {
+		t.Errorf("expected subcategory nets -85 and -60, got %s and %s", food.Children[0].NetCashFlow, food.Children[1].NetCashFlow)
+	}
+}
+
+func TestGetSummaryNoActivity(t *testing.T) {
+	reportRepo := &MockReportRepository{}
+	userRepo := &MockUserRepository{userToReturn: NewTestUser()}
+	service := NewReportService(reportRepo, userRepo, &MockAccountRepository{}, newTestCategories(), &MockBalanceService{}, newTestConverter())
+
+	summary, err := service.GetSummary(context.Background(), "test-user-123", january, march, constant.GranularityQuarter, "")
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(summary.Periods) != 0 {
+		t.Errorf("expected no periods, got %d", len(summary.Periods))
+	}
+}
+
+func TestGetSummaryUserNotFound(t *testing.T) {
+	reportRepo := &MockReportRepository{}
+	service := NewReportService(reportRepo, &MockUserRepository{}, &MockAccountRepository{}, newTestCategories(), &MockBalanceService{}, newTestConverter())
+
+	_, err := service.GetSummary(context.Background(), "missing-user", january, march, constant.GranularityMonth, "")
+
+	var notFoundErr *domainerrors.ErrNotFound
+	if !errors.As(err, &notFoundErr) {
+		t.Errorf("expected ErrNotFound, got %T", err)
+	}
+
+	if reportRepo.sumByCategoryCalls != 0 {
+		t.Errorf("expected no sumByCategory call, got %d", reportRepo.sumByCategoryCalls)
+	}
+}
+
+func TestGetSummaryInvalidInput(t *testing.T) {
+	tests := []struct {
+		name        string
+		from, to    time.Time
+		granularity constant.Granularity
+		field       string
+	}{
+		{"missing from", time.Time{}, march, constant.GranularityMonth, "from"},
+		{"missing to", january, time.Time{}, constant.GranularityMonth, "to"},
+		{"to before from", march, january, constant.GranularityMonth, "to"},
+		{"unknown granularity", january, march, "fortnight", "granularity"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reportRepo := &MockReportRepository{}
+			userRepo := &MockUserRepository{userToReturn: NewTestUser()}
+			service := NewReportService(reportRepo, userRepo, &MockAccountRepository{}, newTestCategories(), &MockBalanceService{}, newTestConverter())
+
+			_, err := service.GetSummary(context.Background(), "test-user-123", tt.from, tt.to, tt.granularity, "")
+
+			var invalidErr *domainerrors.ErrInvalidInput
+			if !errors.As(err, &invalidErr) {
+				t.Fatalf("expected ErrInvalidInput, got %T", err)
+			}
+			if invalidErr.Field != tt.field {
+				t.Errorf("expected field %q, got %q", tt.field, invalidErr.Field)
+			}
+		})
+	}
+}
+
+func TestGetSummaryInBaseCurrency(t *testing.T) {
+	reportRepo := &MockReportRepository{
+		categoryTotalsToReturn: []*entity.CategoryTotal{
+			newTestCategoryTotal(january, "category-groceries", "0", "120.50", "USD"),
+			newTestCategoryTotal(january, "category-salary", "3000", "0", "USD"),
+			newTestCategoryTotal(january, "category-groceries", "0", "80", "EUR"),
+			newTestCategoryTotal(february, "category-restaurants", "0", "100", "EUR"),
+		},
+	}
+	userRepo := &MockUserRepository{userToReturn: NewTestUser()}
+	// Each period converts at the rates effective on its last day
+	converter := newTestConverter(
+		NewTestExchangeRate("EUR", "USD", "1.1", january),
+		NewTestExchangeRate("EUR", "USD", "1.2", time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC)),
+		NewTestExchangeRate("EUR", "USD", "1.5", march),
+	)
+	service := NewReportService(reportRepo, userRepo, &MockAccountRepository{}, newTestReportCategories(), &MockBalanceService{}, converter)
+
+	summary, err := service.GetSummary(context.Background(), "test-user-123", january, march, constant.GranularityMonth, "USD")
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if summary.BaseCurrency != "USD" {
+		t.Errorf("expected base currency USD, got %q", summary.BaseCurrency)
+	}
+	if len(summary.Periods) != 2 {
+		t.Fatalf("expected one period summary per month, got %d", len(summary.Periods))
+	}
+
+	januarySummary := summary.Periods[0]
+	if januarySummary.Currency != "USD" || januarySummary.Income != money.MustParse("3000", "USD") || januarySummary.Expense != money.MustParse("208.50", "USD") {
+		t.Errorf("expected January totals 3000.00 and 208.50 USD, got %s %s and %s", januarySummary.Currency, januarySummary.Income, januarySummary.Expense)
+	}
+	food := januarySummary.Categories[0]
+	if food.Category != "Food" || len(food.Children) != 1 || food.Children[0].Expense != money.MustParse("208.50", "USD") {
+		t.Errorf("expected groceries of both currencies under food, got %+v", food)
+	}
+
+	if february := summary.Periods[1]; february.Expense != money.MustParse("120", "USD") || february.NetCashFlow != money.MustParse("-120", "USD") {
+		t.Errorf("expected February expense 120.00 USD, got %s", february.Expense)
+	}
+}
+
+func TestGetSummaryInBaseCurrencyErrors(t *testing.T) {
+	tests := []struct {
+		name         string
+		baseCurrency string
+		field        string
+	}{
+		{"invalid base currency", "dollars", "base_currency"},
+		{"missing exchange rate", "GBP", "currency"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reportRepo := &MockReportRepository{
+				categoryTotalsToReturn: []*entity.CategoryTotal{newTestCategoryTotal(january, "category-food", "0", "80", "EUR")},
+			}
+			userRepo := &MockUserRepository{userToReturn: NewTestUser()}
+			service := NewReportService(reportRepo, userRepo, &MockAccountRepository{}, newTestReportCategories(), &MockBalanceService{}, newTestConverter())
+
+			_, err := service.GetSummary(context.Background(), "test-user-123", january, march, constant.GranularityMonth, tt.baseCurrency)
+
+			var invalidErr *domainerrors.ErrInvalidInput
+			if !errors.As(err, &invalidErr) || invalidErr.Field != tt.field {
+				t.Errorf("expected invalid %s, got %v", tt.field, err)
+			}
+		})
+	}
+}
+
+func TestGetTagReportSuccess(t *testing.T) {
+	reportRepo := &MockReportRepository{
+		tagTotalsToReturn: []*entity.TagTotal{
+			{Tag: "reimbursable", Income: money.MustParse("80", "USD"), Expense: money.MustParse("120.50", "USD")},
+			{Tag: "vacation-2026", Income: money.Zero("EUR"), Expense: money.MustParse("950", "EUR")},
+			{Tag: "vacation-2026", Income: money.Zero("USD"), Expense: money.MustParse("40", "USD")},
+		},
+	}
+	userRepo := &MockUserRepository{userToReturn: NewTestUser()}
+	service := NewReportService(reportRepo, userRepo, &MockAccountRepository{}, newTestCategories(), &MockBalanceService{}, newTestConverter())
+
+	report, err := service.GetTagReport(context.Background(), "test-user-123", january, march)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(report.Tags) != 3 {
+		t.Fatalf("expected 3 tag summaries, got %d", len(report.Tags))
+	}
+	if tag := report.Tags[0]; tag.Tag != "reimbursable" || tag.Currency != "USD" || tag.NetCashFlow != money.MustParse("-40.50", "USD") {
+		t.Errorf("expected reimbursable net -40.50 USD, got %+v", tag)
+	}
+	if tag := report.Tags[1]; tag.Tag != "vacation-2026" || tag.Currency != "EUR" || tag.Expense != money.MustParse("950", "EUR") {
+		t.Errorf("expected vacation-2026 expense 950 EUR, got %+v", tag)
+	}
+}
+
+func TestGetTagReportUserNotFound(t *testing.T) {
+	reportRepo := &MockReportRepository{}
+	service := NewReportService(reportRepo, &MockUserRepository{}, &MockAccountRepository{}, newTestCategories(), &MockBalanceService{}, newTestConverter())
+
+	_, err := service.GetTagReport(context.Background(), "missing-user", january, march)
+
+	var notFoundErr *domainerrors.ErrNotFound
+	if !errors.As(err, &notFoundErr) {
+		t.Errorf("expected ErrNotFound, got %T", err)
+	}
+	if reportRepo.sumByTagCalls != 0 {
+		t.Errorf("expected no sumByTag call, got %d", reportRepo.sumByTagCalls)
+	}
+}
+
+func TestGetTagReportInvalidRange(t *testing.T) {
+	reportRepo := &MockReportRepository{}
+	userRepo := &MockUserRepository{userToReturn: NewTestUser()}
+	service := NewReportService(reportRepo, userRepo, &MockAccountRepository{}, newTestCategories(), &MockBalanceService{}, newTestConverter())
+
+	_, err := service.GetTagReport(context.Background(), "test-user-123", march, january)
+
+	var invalidErr *domainerrors.ErrInvalidInput
+	if !errors.As(err, &invalidErr) || invalidErr.Field != "to" {
+		t.Fatalf("expected invalid to, got %v", err)
+	}
+}
+
+func TestGetPayeeReportSuccess(t *testing.T) {
+	reportRepo := &MockReportRepository{
+		payeeTotalsToReturn: []*entity.PayeeTotal{
+			{PayeeID: "payee-coffee", Payee: "Starbucks", Income: money.Zero("USD"), Expense: money.MustParse("64.30", "USD")},
+			{PayeeID: "payee-supermarket", Payee: "Tesco", Income: money.MustParse("12", "USD"), Expense: money.MustParse("410.75", "USD")},
+			{Income: money.MustParse("3000", "USD"), Expense: money.MustParse("95", "USD")},
+		},
+	}
+	userRepo := &MockUserRepository{userToReturn: NewTestUser()}
+	service := NewReportService(reportRepo, userRepo, &MockAccountRepository{}, newTestCategories(), &MockBalanceService{}, newTestConverter())
+
+	report, err := service.GetPayeeReport(context.Background(), "test-user-123", january, march)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(report.Payees) != 3 {
+		t.Fatalf("expected 3 payee summaries, got %d", len(report.Payees))
+	}
+	if payee := report.Payees[1]; payee.PayeeID != "payee-supermarket" || payee.Payee != "Tesco" || payee.NetCashFlow != money.MustParse("-398.75", "USD") {
+		t.Errorf("expected Tesco net -398.75 USD, got %+v", payee)
+	}
+	if payee := report.Payees[2]; payee.PayeeID != "" || payee.Currency != "USD" || payee.NetCashFlow != money.MustParse("2905", "USD") {
+		t.Errorf("expected transactions without a payee to net 2905 USD, got %+v", payee)
+	}
+}
+
+func TestGetPayeeReportUserNotFound(t *testing.T) {
+	reportRepo := &MockReportRepository{}
+	service := NewReportService(reportRepo, &MockUserRepository{}, &MockAccountRepository{}, newTestCategories(), &MockBalanceService{}, newTestConverter())
+
+	_, err := service.GetPayeeReport(context.Background(), "missing-user", january, march)
+
+	var notFoundErr *domainerrors.ErrNotFound
+	if !errors.As(err, &notFoundErr) {
+		t.Errorf("expected ErrNotFound, got %T", err)
+	}
+	if reportRepo.sumByPayeeCalls != 0 {
+		t.Errorf("expected no sumByPayee call, got %d", reportRepo.sumByPayeeCalls)
+	}
+}
+
+func newTestNetWorthAccounts() []*entity.Account {
+	return []*entity.Account{
+		{ID: "checking", Name: "Checking", Type: constant.AccountTypeChecking, Currency: "USD", Balance: money.MustParse("1000", "USD")},
+		{ID: "savings", Name: "Savings", Type: constant.AccountTypeSavings, Currency: "EUR", Balance: money.MustParse("100", "EUR")},
+		{ID: "card", Name: "Card", Type: constant.AccountTypeCreditCard, Currency: "USD", Balance: money.MustParse("-250", "USD")},
+		{ID: "salary", Name: "Salary", Type: constant.AccountTypeIncome, Currency: "USD", Balance: money.MustParse("5000", "USD")},
+	}
+}
+
+func TestGetNetWorthSuccess(t *testing.T) {
+	accountRepo := &MockAccountRepository{accountsListToReturn: newTestNetWorthAccounts()}
+	balances := &MockBalanceService{changes: map[string][]*entity.DailyBalance{
+		"checking": {
+			{Date: time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC), Balance: money.MustParse("500", "USD")},
+			{Date: time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC), Balance: money.MustParse("800", "USD")},
+		},
+		"savings": {
+			{Date: january, Balance: money.MustParse("100", "EUR")},
+		},
+		"card": {
+			{Date: time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC), Balance: money.MustParse("-100", "USD")},
+		},
+	}}
+	converter := newTestConverter(&entity.ExchangeRate{
+		BaseCurrency:  "EUR",
+		QuoteCurrency: "USD",
+		Date:          time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC),
+		Rate:          money.MustParseRate("1.1"),
+	})
+	userRepo := &MockUserRepository{userToReturn: NewTestUser()}
+	service := NewReportService(&MockReportRepository{}, userRepo, accountRepo, newTestCategories(), balances, converter)
+
+	from := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+	netWorth, err := service.GetNetWorth(context.Background(), "test-user-123", from, to)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if netWorth.Currency != "USD" {
+		t.Errorf("expected base currency USD, got %q", netWorth.Currency)
+	}
+	if got := netWorth.Current; got.Assets.String() != "1110.00" || got.Liabilities.String() != "250.00" || got.NetWorth.String() != "860.00" {
+		t.Errorf("expected current 1110.00 - 250.00 = 860.00, got %s - %s = %s", got.Assets, got.Liabilities, got.NetWorth)
+	}
+	if len(netWorth.Accounts) != 3 {
+		t.Fatalf("expected the income account to be left out, got %d accounts", len(netWorth.Accounts))
+	}
+	if savings := netWorth.Accounts[1]; savings.Balance.String() != "100.00" || savings.Converted.String() != "110.00" {
+		t.Errorf("expected savings 100.00 EUR converted to 110.00 USD, got %s and %s", savings.Balance, savings.Converted)
+	}
+	if balances.getDailyBalancesCalls != 3 {
+		t.Errorf("expected 3 getDailyBalances calls, got %d", balances.getDailyBalancesCalls)
+	}
+
+	expected := []struct {
+		date                          time.Time
+		assets, liabilities, netWorth string
+	}{
+		{time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), "610.00", "0.00", "610.00"},
+		{time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), "910.00", "0.00", "910.00"},
+		{to, "910.00", "100.00", "810.00"},
+	}
+	if len(netWorth.History) != len(expected) {
+		t.Fatalf("expected %d history points, got %d", len(expected), len(netWorth.History))
+	}
+	for i, want := range expected {
+		got := netWorth.History[i]
+		if !got.Date.Equal(want.date) {
+			t.Errorf("point %d: expected date %s, got %s", i, want.date.Format(time.DateOnly), got.Date.Format(time.DateOnly))
+		}
+		if got.Assets.String() != want.assets || got.Liabilities.String() != want.liabilities || got.NetWorth.String() != want.netWorth {
+			t.Errorf("point %d: expected %s - %s = %s, got %s - %s = %s", i,
+				want.assets, want.liabilities, want.netWorth, got.Assets, got.Liabilities, got.NetWorth)
+		}
+	}
+}
+
+func TestGetNetWorthMissingRate(t *testing.T) {
+	accountRepo := &MockAccountRepository{accountsListToReturn: newTestNetWorthAccounts()}
+	userRepo := &MockUserRepository{userToReturn: NewTestUser()}
+	service := NewReportService(&MockReportRepository{}, userRepo, accountRepo, newTestCategories(), &MockBalanceService{}, newTestConverter())
+
+	_, err := service.GetNetWorth(context.Background(), "test-user-123", january, march)
+
+	var invalidErr *domainerrors.ErrInvalidInput
+	if !errors.As(err, &invalidErr) {
+		t.Errorf("expected ErrInvalidInput for the missing EUR rate, got %v", err)
+	}
+}
+
+func TestGetNetWorthUserNotFound(t *testing.T) {
+	accountRepo := &MockAccountRepository{}
+	service := NewReportService(&MockReportRepository{}, &MockUserRepository{}, accountRepo, newTestCategories(), &MockBalanceService{}, newTestConverter())
+
+	_, err := service.GetNetWorth(context.Background(), "missing-user", january, march)
+
+	var notFoundErr *domainerrors.ErrNotFound
+	if !errors.As(err, &notFoundErr) {
+		t.Errorf("expected ErrNotFound, got %T", err)
+	}
+	if accountRepo.listByUserIDCalls != 0 {
+		t.Errorf("expected no listByUserID call, got %d", accountRepo.listByUserIDCalls)
+	}
+}
+
+func TestGetNetWorthInvalidRange(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to time.Time
+		field    string
+	}{
+		{"missing from", time.Time{}, march, "from"},
+		{"missing to", january, time.Time{}, "to"},
+		{"to before from", march, january, "to"},
+		{"range too long", january, january.AddDate(0, 0, MaxBalanceHistoryDays), "to"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userRepo := &MockUserRepository{userToReturn: NewTestUser()}
+			service := NewReportService(&MockReportRepository{}, userRepo, &MockAccountRepository{}, newTestCategories(), &MockBalanceService{}, newTestConverter())
+
+			_, err := service.GetNetWorth(context.Background(), "test-user-123", tt.from, tt.to)
+
+			var invalidErr *domainerrors.ErrInvalidInput
+			if !errors.As(err, &invalidErr) {
+				t.Fatalf("expected ErrInvalidInput, got %T", err)
+			}
+			if invalidErr.Field != tt.field {
+				t.Errorf("expected field %q, got %q", tt.field, invalidErr.Field)
+			}
+		})
+	}
+}
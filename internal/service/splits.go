@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"unicode/utf8"
+
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/domain/money"
+
+	"github.com/google/uuid"
+)
+
+// maxSplitMemoLength is the longest split memo, in characters.
+const maxSplitMemoLength = 255
+
+// errSplitWithCategory rejects a category on the transaction itself once it is split.
+var errSplitWithCategory = domainerrors.NewErrInvalidInput("category_id", "a split transaction takes its categories from its splits")
+
+// newSplits validates the splits of a transaction entered with amount: at
+// least two positive lines in the currency of amount that add up to it. It
+// returns them with fresh IDs.
+func newSplits(splits []*entity.Split, amount money.Money) ([]*entity.Split, error) {
+	if len(splits) < 2 {
+		return nil, domainerrors.NewErrInvalidInput("splits", "a split transaction needs at least two splits")
+	}
+
+	total := money.Zero(amount.Currency())
+	created := make([]*entity.Split, 0, len(splits))
+	for _, split := range splits {
+		if !split.Amount.IsPositive() {
+			return nil, domainerrors.NewErrInvalidInput("splits", "split amounts must be greater than zero")
+		}
+		if split.Amount.Currency() != amount.Currency() {
+			return nil, domainerrors.NewErrInvalidInput("splits", fmt.Sprintf("split amounts must be in %s, the currency of the amount", amount.Currency()))
+		}
+		if utf8.RuneCountInString(split.Memo) > maxSplitMemoLength {
+			return nil, domainerrors.NewErrInvalidInput("splits", fmt.Sprintf("split memos must be at most %d characters", maxSplitMemoLength))
+		}
+		var err error
+		if total, err = total.Add(split.Amount); err != nil {
+			return nil, domainerrors.NewErrInvalidInput("splits", err.Error())
+		}
+
+		created = append(created, &entity.Split{
+			ID:         uuid.New().String(),
+			Amount:     split.Amount,
+			CategoryID: split.CategoryID,
+			Memo:       split.Memo,
+		})
+	}
+	if total != amount {
+		return nil, domainerrors.NewErrInvalidInput("splits", fmt.Sprintf("the splits add up to %s instead of the amount %s", total, amount))
+	}
+
+	return created, nil
+}
+
+// denominateSplits brings the splits of a transaction into the currency of
+// its Amount. Splits entered in the currency of OriginalAmount are converted
+// at the transaction's exchange rate, the last split taking up any rounding
+// difference. Splits already in the account's currency must add up to Amount,
+// which they no longer do once a change of amount, date or account converts
+// it differently.
+func denominateSplits(transaction *entity.Transaction) error {
+	if len(transaction.Splits) == 0 {
+		return nil
+	}
+	currency := transaction.Amount.Currency()
+
+	last := len(transaction.Splits) - 1
+	total := money.Zero(currency)
+	for i, split := range transaction.Splits {
+		if split.Amount.Currency() != currency {
+			if split.Amount.Currency() != transaction.OriginalAmount.Currency() {
+				return domainerrors.NewErrInvalidInput("splits", fmt.Sprintf("split amounts must be in %s or %s", transaction.OriginalAmount.Currency(), currency))
+			}
+			converted, err := split.Amount.Convert(transaction.ExchangeRate, currency)
+			if err != nil {
+				return domainerrors.NewErrInvalidInput("splits", err.Error())
+			}
+			if i == last {
+				if converted, err = transaction.Amount.Sub(total); err != nil {
+					return err
+				}
+			}
+			if !converted.IsPositive() {
+				return domainerrors.NewErrInvalidInput("splits", "a split amount is zero once converted to account currency "+currency)
+			}
+			split.Amount = converted
+		}
+
+		var err error
+		if total, err = total.Add(split.Amount); err != nil {
+			return err
+		}
+	}
+	if total != transaction.Amount {
+		return domainerrors.NewErrInvalidInput("splits", fmt.Sprintf("the splits add up to %s instead of the amount %s; give the splits again with the new amount", total, transaction.Amount))
+	}
+
+	return nil
+}
+
+// checkSplitCategories verifies that the category of every split of a
+// transaction belongs to the user and suits the transaction's type, and
+// fills in the category names.
+func checkSplitCategories(ctx context.Context, categoryRepo interfaces.CategoryRepository, userID string, transaction *entity.Transaction) error {
+	for _, split := range transaction.Splits {
+		if split.CategoryID == "" {
+			split.Category = ""
+			continue
+		}
+		category, err := checkCategory(ctx, categoryRepo, "splits", userID, split.CategoryID, transaction.Type)
+		if err != nil {
+			return err
+		}
+		split.Category = category.Name
+	}
+	return nil
+}
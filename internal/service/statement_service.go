@@ -0,0 +1,392 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/domain/money"
+)
+
+// Names of the statement lines that are not accounts
+const (
+	uncategorizedLine     = "Uncategorized"
+	retainedEarningsLine  = "Retained earnings"
+	currencyTransfersLine = "Transfers between currencies"
+)
+
+type StatementService struct {
+	statementRepo interfaces.StatementRepository
+	accountRepo   interfaces.AccountRepository
+	userRepo      interfaces.UserRepository
+}
+
+func NewStatementService(statementRepo interfaces.StatementRepository, accountRepo interfaces.AccountRepository, userRepo interfaces.UserRepository) *StatementService {
+	return &StatementService{
+		statementRepo: statementRepo,
+		accountRepo:   accountRepo,
+		userRepo:      userRepo,
+	}
+}
+
+func (s *StatementService) GetTrialBalance(ctx context.Context, userID string, asOf time.Time) (*entity.TrialBalance, error) {
+	if asOf.IsZero() {
+		return nil, domainerrors.NewErrInvalidInput("as_of", "as_of is required")
+	}
+	asOf = calendarDay(asOf)
+
+	ledger, err := s.loadLedger(ctx, userID, time.Time{}, asOf.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+
+	sections := make(map[string]*entity.TrialBalanceSection)
+	addLine := func(currency string, line *entity.TrialBalanceLine, netDebit money.Money) error {
+		if netDebit.IsZero() {
+			return nil
+		}
+		section, ok := sections[currency]
+		if !ok {
+			section = &entity.TrialBalanceSection{
+				Currency:    currency,
+				TotalDebit:  money.Zero(currency),
+				TotalCredit: money.Zero(currency),
+			}
+			sections[currency] = section
+		}
+
+		line.Debit, line.Credit = money.Zero(currency), money.Zero(currency)
+		var err error
+		if netDebit.IsPositive() {
+			line.Debit = netDebit
+			section.TotalDebit, err = section.TotalDebit.Add(netDebit)
+		} else {
+			line.Credit = netDebit.Negate()
+			section.TotalCredit, err = section.TotalCredit.Add(line.Credit)
+		}
+		section.Lines = append(section.Lines, line)
+		return err
+	}
+
+	for _, account := range ledger.accounts {
+		line := &entity.TrialBalanceLine{AccountID: account.ID, Code: account.Code, Name: account.Name, Type: account.Type}
+		if err := addLine(account.Currency, line, ledger.netDebit(account)); err != nil {
+			return nil, err
+		}
+	}
+	for _, movement := range ledger.categories {
+		accountType, name, netDebit := categoryLine(movement)
+		if err := addLine(movement.Amount.Currency(), &entity.TrialBalanceLine{Name: name, Type: accountType}, netDebit); err != nil {
+			return nil, err
+		}
+	}
+
+	trialBalance := &entity.TrialBalance{UserID: userID, AsOf: asOf}
+	for _, currency := range slices.Sorted(maps.Keys(sections)) {
+		section := sections[currency]
+		// Category lines follow the accounts of their type
+		slices.SortStableFunc(section.Lines, func(a, b *entity.TrialBalanceLine) int {
+			return cmp.Compare(accountTypeRank(a.Type), accountTypeRank(b.Type))
+		})
+		trialBalance.Sections = append(trialBalance.Sections, section)
+	}
+
+	return trialBalance, nil
+}
+
+func (s *StatementService) GetBalanceSheet(ctx context.Context, userID string, asOf time.Time) (*entity.BalanceSheet, error) {
+	if asOf.IsZero() {
+		return nil, domainerrors.NewErrInvalidInput("as_of", "as_of is required")
+	}
+	asOf = calendarDay(asOf)
+
+	ledger, err := s.loadLedger(ctx, userID, time.Time{}, asOf.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, err
+	}
+
+	sections := make(map[string]*entity.BalanceSheetSection)
+	retainedEarnings := make(map[string]money.Money)
+	sectionFor := func(currency string) *entity.BalanceSheetSection {
+		if section, ok := sections[currency]; ok {
+			return section
+		}
+		section := &entity.BalanceSheetSection{
+			Currency:         currency,
+			Equity:           &entity.StatementGroup{Type: constant.AccountTypeEquity, Total: money.Zero(currency)},
+			TotalAssets:      money.Zero(currency),
+			TotalLiabilities: money.Zero(currency),
+			TotalEquity:      money.Zero(currency),
+		}
+		sections[currency] = section
+		retainedEarnings[currency] = money.Zero(currency)
+		return section
+	}
+
+	for _, account := range ledger.accounts {
+		netDebit := ledger.netDebit(account)
+		if netDebit.IsZero() {
+			continue
+		}
+		section := sectionFor(account.Currency)
+		line := accountLine(account, netDebit)
+		switch account.Type.Class() {
+		case constant.AccountClassAsset:
+			section.Assets, err = addToGroups(section.Assets, account.Type, line)
+		case constant.AccountClassLiability:
+			section.Liabilities, err = addToGroups(section.Liabilities, account.Type, line)
+		case constant.AccountClassEquity:
+			err = addToGroup(section.Equity, line)
+		default:
+			// Income and expense accounts close into retained earnings
+			retainedEarnings[account.Currency], err = retainedEarnings[account.Currency].Sub(netDebit)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, movement := range ledger.categories {
+		currency := movement.Amount.Currency()
+		section := sectionFor(currency)
+		accountType, name, netDebit := categoryLine(movement)
+		if accountType == constant.AccountTypeEquity {
+			err = addToGroup(section.Equity, &entity.StatementLine{Name: name, Amount: netDebit.Negate()})
+		} else {
+			retainedEarnings[currency], err = retainedEarnings[currency].Sub(netDebit)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	balanceSheet := &entity.BalanceSheet{UserID: userID, AsOf: asOf}
+	for _, currency := range slices.Sorted(maps.Keys(sections)) {
+		section := sections[currency]
+		if !retainedEarnings[currency].IsZero() {
+			line := &entity.StatementLine{Name: retainedEarningsLine, Amount: retainedEarnings[currency]}
+			if err := addToGroup(section.Equity, line); err != nil {
+				return nil, err
+			}
+		}
+		if section.TotalAssets, err = groupsTotal(currency, section.Assets); err != nil {
+			return nil, err
+		}
+		if section.TotalLiabilities, err = groupsTotal(currency, section.Liabilities); err != nil {
+			return nil, err
+		}
+		section.TotalEquity = section.Equity.Total
+		balanceSheet.Sections = append(balanceSheet.Sections, section)
+	}
+
+	return balanceSheet, nil
+}
+
+func (s *StatementService) GetIncomeStatement(ctx context.Context, userID string, from, to time.Time) (*entity.IncomeStatement, error) {
+	if from.IsZero() {
+		return nil, domainerrors.NewErrInvalidInput("from", "from is required")
+	}
+	if to.IsZero() {
+		return nil, domainerrors.NewErrInvalidInput("to", "to is required")
+	}
+	if !from.Before(to) {
+		return nil, domainerrors.NewErrInvalidInput("to", "to must be after from")
+	}
+
+	ledger, err := s.loadLedger(ctx, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	sections := make(map[string]*entity.IncomeStatementSection)
+	add := func(currency string, accountType constant.AccountType, line *entity.StatementLine) error {
+		section, ok := sections[currency]
+		if !ok {
+			section = &entity.IncomeStatementSection{
+				Currency: currency,
+				Income:   &entity.StatementGroup{Type: constant.AccountTypeIncome, Total: money.Zero(currency)},
+				Expenses: &entity.StatementGroup{Type: constant.AccountTypeExpense, Total: money.Zero(currency)},
+			}
+			sections[currency] = section
+		}
+		if accountType == constant.AccountTypeIncome {
+			return addToGroup(section.Income, line)
+		}
+		return addToGroup(section.Expenses, line)
+	}
+
+	for _, account := range ledger.accounts {
+		class := account.Type.Class()
+		netDebit := ledger.netDebit(account)
+		if (class != constant.AccountClassIncome && class != constant.AccountClassExpense) || netDebit.IsZero() {
+			continue
+		}
+		if err := add(account.Currency, account.Type, accountLine(account, netDebit)); err != nil {
+			return nil, err
+		}
+	}
+	for _, movement := range ledger.categories {
+		accountType, name, netDebit := categoryLine(movement)
+		if accountType == constant.AccountTypeEquity || netDebit.IsZero() {
+			continue
+		}
+		line := &entity.StatementLine{Name: name, Amount: normalAmount(accountType, netDebit)}
+		if err := add(movement.Amount.Currency(), accountType, line); err != nil {
+			return nil, err
+		}
+	}
+
+	statement := &entity.IncomeStatement{UserID: userID, From: from, To: to}
+	for _, currency := range slices.Sorted(maps.Keys(sections)) {
+		section := sections[currency]
+		if section.NetIncome, err = section.Income.Total.Sub(section.Expenses.Total); err != nil {
+			return nil, err
+		}
+		statement.Sections = append(statement.Sections, section)
+	}
+
+	return statement, nil
+}
+
+// statementLedger is what a user's statements are derived from: their
+// accounts in statement order, the net movement of each account and the
+// transaction totals per category.
+type statementLedger struct {
+	accounts   []*entity.Account
+	netDebits  map[string]money.Money
+	categories []*entity.CategoryMovement
+}
+
+// netDebit returns the account's debits minus credits, zero if it had no bookings.
+func (l *statementLedger) netDebit(account *entity.Account) money.Money {
+	if netDebit, ok := l.netDebits[account.ID]; ok {
+		return netDebit
+	}
+	return money.Zero(account.Currency)
+}
+
+// loadLedger reads the user's accounts and the bookings dated in [from, to).
+func (s *StatementService) loadLedger(ctx context.Context, userID string, from, to time.Time) (*statementLedger, error) {
+	if err := checkUser(ctx, s.userRepo, userID); err != nil {
+		return nil, err
+	}
+
+	accounts, _, err := s.accountRepo.ListByUserID(ctx, userID, entity.PageRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("listing accounts: %w", err)
+	}
+	slices.SortStableFunc(accounts, func(a, b *entity.Account) int {
+		return cmp.Or(
+			cmp.Compare(accountTypeRank(a.Type), accountTypeRank(b.Type)),
+			cmp.Compare(a.Code, b.Code),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+
+	movements, err := s.statementRepo.SumAccountMovements(ctx, userID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("summing account movements: %w", err)
+	}
+	netDebits := make(map[string]money.Money, len(movements))
+	for _, movement := range movements {
+		netDebits[movement.AccountID] = movement.NetDebit
+	}
+
+	categories, err := s.statementRepo.SumCategoryMovements(ctx, userID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("summing category movements: %w", err)
+	}
+
+	return &statementLedger{accounts: accounts, netDebits: netDebits, categories: categories}, nil
+}
+
+// categoryLine returns the type and name of the line a category movement is
+// reported on, with the movement as debits minus credits on that line. The
+// counterpart of income is credited to its category and the counterpart of
+// an expense debited; transfers clear against each other, leaving only what
+// moved between currencies.
+func categoryLine(movement *entity.CategoryMovement) (constant.AccountType, string, money.Money) {
+	name := movement.Category
+	if name == "" {
+		name = uncategorizedLine
+	}
+	switch movement.Type {
+	case constant.TransactionTypeIncome:
+		return constant.AccountTypeIncome, name, movement.Amount.Negate()
+	case constant.TransactionTypeExpense:
+		return constant.AccountTypeExpense, name, movement.Amount
+	default:
+		return constant.AccountTypeEquity, currencyTransfersLine, movement.Amount.Negate()
+	}
+}
+
+// accountLine returns the statement line of an account with the given net movement.
+func accountLine(account *entity.Account, netDebit money.Money) *entity.StatementLine {
+	return &entity.StatementLine{
+		AccountID: account.ID,
+		Code:      account.Code,
+		Name:      account.Name,
+		Amount:    normalAmount(account.Type, netDebit),
+	}
+}
+
+// normalAmount signs a net debit so that it is positive when it increases
+// accounts of the type on a statement: debits for assets and expenses,
+// credits otherwise. Liabilities are shown as the amount owed.
+func normalAmount(accountType constant.AccountType, netDebit money.Money) money.Money {
+	switch accountType.Class() {
+	case constant.AccountClassAsset, constant.AccountClassExpense:
+		return netDebit
+	default:
+		return netDebit.Negate()
+	}
+}
+
+// addToGroups adds the line to the group of the account type, appending the
+// group when the type has none yet.
+func addToGroups(groups []*entity.StatementGroup, accountType constant.AccountType, line *entity.StatementLine) ([]*entity.StatementGroup, error) {
+	for _, group := range groups {
+		if group.Type == accountType {
+			return groups, addToGroup(group, line)
+		}
+	}
+	group := &entity.StatementGroup{Type: accountType, Total: money.Zero(line.Amount.Currency())}
+	return append(groups, group), addToGroup(group, line)
+}
+
+// addToGroup appends the line to the group and adds it to the group total.
+func addToGroup(group *entity.StatementGroup, line *entity.StatementLine) error {
+	total, err := group.Total.Add(line.Amount)
+	if err != nil {
+		return fmt.Errorf("totalling %s: %w", group.Type, err)
+	}
+	group.Lines = append(group.Lines, line)
+	group.Total = total
+	return nil
+}
+
+// groupsTotal adds up the totals of the groups.
+func groupsTotal(currency string, groups []*entity.StatementGroup) (money.Money, error) {
+	total := money.Zero(currency)
+	for _, group := range groups {
+		var err error
+		if total, err = total.Add(group.Total); err != nil {
+			return money.Money{}, err
+		}
+	}
+	return total, nil
+}
+
+// accountTypeRank orders account types as constant.AccountTypes lists them.
+func accountTypeRank(accountType constant.AccountType) int {
+	return slices.Index(constant.AccountTypes, accountType)
+}
+
+// Compile-time interface check
+var _ interfaces.StatementService = (*StatementService)(nil)
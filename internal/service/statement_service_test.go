@@ -0,0 +1,216 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/money"
+)
+
+// newTestStatementService returns a statement service over a small ledger:
+// opening balances, a salary, groceries, a rent journal entry, a card
+// purchase and a transfer of 100 USD that arrived as 90 EUR.
+func newTestStatementService() (*StatementService, *MockStatementRepository) {
+	accounts := []*entity.Account{
+		{ID: "equity", Name: "Opening balances", Type: constant.AccountTypeEquity, Currency: "USD"},
+		{ID: "rent", Name: "Rent", Type: constant.AccountTypeExpense, Currency: "USD"},
+		{ID: "card", Name: "Card", Type: constant.AccountTypeCreditCard, Currency: "USD"},
+		{ID: "savings", Name: "Savings", Type: constant.AccountTypeSavings, Currency: "EUR"},
+		{ID: "checking", Code: "1000", Name: "Checking", Type: constant.AccountTypeChecking, Currency: "USD"},
+		{ID: "unused", Name: "Unused", Type: constant.AccountTypeCash, Currency: "USD"},
+	}
+	statementRepo := &MockStatementRepository{
+		accountMovements: []*entity.AccountMovement{
+			{AccountID: "checking", NetDebit: money.MustParse("500", "USD")},
+			{AccountID: "equity", NetDebit: money.MustParse("-500", "USD")},
+			{AccountID: "card", NetDebit: money.MustParse("-200", "USD")},
+			{AccountID: "rent", NetDebit: money.MustParse("400", "USD")},
+			{AccountID: "savings", NetDebit: money.MustParse("90", "EUR")},
+		},
+		categoryMovements: []*entity.CategoryMovement{
+			{Type: constant.TransactionTypeTransfer, Amount: money.MustParse("90", "EUR")},
+			{Type: constant.TransactionTypeExpense, Category: "Dining", Amount: money.MustParse("200", "USD")},
+			{Type: constant.TransactionTypeExpense, Category: "Groceries", Amount: money.MustParse("300", "USD")},
+			{Type: constant.TransactionTypeIncome, Category: "Salary", Amount: money.MustParse("800", "USD")},
+			{Type: constant.TransactionTypeTransfer, Amount: money.MustParse("-100", "USD")},
+		},
+	}
+	userRepo := &MockUserRepository{userToReturn: NewTestUser()}
+	accountRepo := &MockAccountRepository{accountsListToReturn: accounts}
+	return NewStatementService(statementRepo, accountRepo, userRepo), statementRepo
+}
+
+func TestGetTrialBalance(t *testing.T) {
+	service, statementRepo := newTestStatementService()
+	asOf := time.Date(2024, 12, 31, 15, 0, 0, 0, time.UTC)
+
+	trialBalance, err := service.GetTrialBalance(context.Background(), "test-user-123", asOf)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !statementRepo.lastFrom.IsZero() || !statementRepo.lastTo.Equal(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected everything before 2025-01-01, got %s..%s", statementRepo.lastFrom, statementRepo.lastTo)
+	}
+	if len(trialBalance.Sections) != 2 || trialBalance.Sections[0].Currency != "EUR" {
+		t.Fatalf("expected EUR and USD sections, got %d", len(trialBalance.Sections))
+	}
+
+	for _, section := range trialBalance.Sections {
+		if section.TotalDebit.String() != section.TotalCredit.String() {
+			t.Errorf("%s: expected debits to equal credits, got %s and %s", section.Currency, section.TotalDebit, section.TotalCredit)
+		}
+	}
+
+	usd := trialBalance.Sections[1]
+	if usd.TotalDebit.String() != "1500.00" {
+		t.Errorf("expected USD debits of 1500.00, got %s", usd.TotalDebit)
+	}
+	expected := []struct {
+		name          string
+		debit, credit string
+	}{
+		{"Checking", "500.00", "0.00"},
+		{"Card", "0.00", "200.00"},
+		{"Salary", "0.00", "800.00"},
+		{"Rent", "400.00", "0.00"},
+		{"Dining", "200.00", "0.00"},
+		{"Groceries", "300.00", "0.00"},
+		{"Opening balances", "0.00", "500.00"},
+		{currencyTransfersLine, "100.00", "0.00"},
+	}
+	if len(usd.Lines) != len(expected) {
+		t.Fatalf("expected %d USD lines, got %d", len(expected), len(usd.Lines))
+	}
+	for i, want := range expected {
+		line := usd.Lines[i]
+		if line.Name != want.name || line.Debit.String() != want.debit || line.Credit.String() != want.credit {
+			t.Errorf("line %d: expected %s %s/%s, got %s %s/%s", i, want.name, want.debit, want.credit, line.Name, line.Debit, line.Credit)
+		}
+	}
+}
+
+func TestGetBalanceSheet(t *testing.T) {
+	service, _ := newTestStatementService()
+
+	balanceSheet, err := service.GetBalanceSheet(context.Background(), "test-user-123", time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC))
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(balanceSheet.Sections) != 2 {
+		t.Fatalf("expected 2 sections, got %d", len(balanceSheet.Sections))
+	}
+
+	for _, section := range balanceSheet.Sections {
+		liabilitiesAndEquity, _ := section.TotalLiabilities.Add(section.TotalEquity)
+		if section.TotalAssets.String() != liabilitiesAndEquity.String() {
+			t.Errorf("%s: expected assets %s to equal liabilities and equity %s", section.Currency, section.TotalAssets, liabilitiesAndEquity)
+		}
+	}
+
+	usd := balanceSheet.Sections[1]
+	if len(usd.Assets) != 1 || usd.Assets[0].Type != constant.AccountTypeChecking || usd.TotalAssets.String() != "500.00" {
+		t.Errorf("expected 500.00 in checking as the only asset, got %+v", usd.Assets)
+	}
+	if len(usd.Liabilities) != 1 || usd.TotalLiabilities.String() != "200.00" {
+		t.Errorf("expected 200.00 owed on the card, got %+v", usd.Liabilities)
+	}
+
+	expectedEquity := map[string]string{
+		"Opening balances":    "500.00",
+		currencyTransfersLine: "-100.00",
+		retainedEarningsLine:  "-100.00",
+	}
+	if len(usd.Equity.Lines) != len(expectedEquity) {
+		t.Fatalf("expected %d equity lines, got %d", len(expectedEquity), len(usd.Equity.Lines))
+	}
+	for _, line := range usd.Equity.Lines {
+		if line.Amount.String() != expectedEquity[line.Name] {
+			t.Errorf("expected %s of %s, got %s", line.Name, expectedEquity[line.Name], line.Amount)
+		}
+	}
+}
+
+func TestGetIncomeStatement(t *testing.T) {
+	service, statementRepo := newTestStatementService()
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	statement, err := service.GetIncomeStatement(context.Background(), "test-user-123", from, to)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if !statementRepo.lastFrom.Equal(from) || !statementRepo.lastTo.Equal(to) {
+		t.Errorf("expected range %s..%s, got %s..%s", from, to, statementRepo.lastFrom, statementRepo.lastTo)
+	}
+	if len(statement.Sections) != 1 {
+		t.Fatalf("expected only a USD section, got %d", len(statement.Sections))
+	}
+
+	usd := statement.Sections[0]
+	if usd.Income.Total.String() != "800.00" || len(usd.Income.Lines) != 1 {
+		t.Errorf("expected 800.00 of salary income, got %s in %d lines", usd.Income.Total, len(usd.Income.Lines))
+	}
+	if usd.Expenses.Total.String() != "900.00" || len(usd.Expenses.Lines) != 3 {
+		t.Errorf("expected 900.00 of expenses in 3 lines, got %s in %d lines", usd.Expenses.Total, len(usd.Expenses.Lines))
+	}
+	if usd.Expenses.Lines[0].AccountID != "rent" {
+		t.Errorf("expected the rent account before the categories, got %q", usd.Expenses.Lines[0].Name)
+	}
+	if usd.NetIncome.String() != "-100.00" {
+		t.Errorf("expected net income -100.00, got %s", usd.NetIncome)
+	}
+}
+
+func TestStatementsInvalidInput(t *testing.T) {
+	service, _ := newTestStatementService()
+	ctx := context.Background()
+	january := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	_, trialBalanceErr := service.GetTrialBalance(ctx, "test-user-123", time.Time{})
+	_, balanceSheetErr := service.GetBalanceSheet(ctx, "test-user-123", time.Time{})
+	_, missingFromErr := service.GetIncomeStatement(ctx, "test-user-123", time.Time{}, january)
+	_, emptyRangeErr := service.GetIncomeStatement(ctx, "test-user-123", january, january)
+
+	tests := []struct {
+		name  string
+		err   error
+		field string
+	}{
+		{"trial balance without date", trialBalanceErr, "as_of"},
+		{"balance sheet without date", balanceSheetErr, "as_of"},
+		{"income statement without from", missingFromErr, "from"},
+		{"income statement with empty range", emptyRangeErr, "to"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var invalidErr *domainerrors.ErrInvalidInput
+			if !errors.As(tt.err, &invalidErr) || invalidErr.Field != tt.field {
+				t.Errorf("expected ErrInvalidInput on %q, got %v", tt.field, tt.err)
+			}
+		})
+	}
+}
+
+func TestStatementsUserNotFound(t *testing.T) {
+	statementRepo := &MockStatementRepository{}
+	service := NewStatementService(statementRepo, &MockAccountRepository{}, &MockUserRepository{})
+
+	_, err := service.GetTrialBalance(context.Background(), "missing-user", time.Now())
+
+	var notFoundErr *domainerrors.ErrNotFound
+	if !errors.As(err, &notFoundErr) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+	if statementRepo.sumAccountMovementsCalls != 0 {
+		t.Errorf("expected no sumAccountMovements call, got %d", statementRepo.sumAccountMovementsCalls)
+	}
+}
@@ -3,10 +3,14 @@ package service
 import (
 	"bytes"
 	"context"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"accounting/internal/domain/constant"
 	"accounting/internal/domain/entity"
+	"accounting/internal/domain/money"
 	"accounting/internal/pkg/logger"
 )
 
@@ -67,13 +71,58 @@ func (m *MockUserRepository) Delete(ctx context.Context, id string) error {
 	return m.lastDeleteErr
 }
 
+// mockTxKey is the context key under which MockTransactionManager stores its transaction
+type mockTxKey struct{}
+
+// mockTx records undo actions so mock repositories can emulate a rollback
+type mockTx struct {
+	undo []func()
+}
+
+// onRollback registers an undo action if ctx carries a mock transaction
+func onRollback(ctx context.Context, fn func()) {
+	if tx, ok := ctx.Value(mockTxKey{}).(*mockTx); ok {
+		tx.undo = append(tx.undo, fn)
+	}
+}
+
+// MockTransactionManager is a mock implementation of TransactionManager.
+// On failure it runs the undo actions registered by the mock repositories.
+type MockTransactionManager struct {
+	withTxCalls int
+	commits     int
+	rollbacks   int
+}
+
+func (m *MockTransactionManager) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	m.withTxCalls++
+	if _, ok := ctx.Value(mockTxKey{}).(*mockTx); ok {
+		return fn(ctx)
+	}
+
+	tx := &mockTx{}
+	if err := fn(context.WithValue(ctx, mockTxKey{}, tx)); err != nil {
+		for i := len(tx.undo) - 1; i >= 0; i-- {
+			tx.undo[i]()
+		}
+		m.rollbacks++
+		return err
+	}
+	m.commits++
+	return nil
+}
+
 // MockAccountRepository is a mock implementation of AccountRepository
 type MockAccountRepository struct {
-	createCalls       int
-	getByIDCalls      int
-	listByUserIDCalls int
-	updateCalls       int
-	deleteCalls       int
+	createCalls           int
+	getByIDCalls          int
+	getByIDForUpdateCalls int
+	getByCodeCalls        int
+	listByUserIDCalls     int
+	listByParentIDCalls   int
+	getActivityCalls      int
+	updateCalls           int
+	deleteCalls           int
 
 	lastCreateErr       error
 	lastGetByIDErr      error
@@ -84,6 +133,11 @@ type MockAccountRepository struct {
 	accountToReturn      *entity.Account
 	accountsToReturn     map[string]*entity.Account
 	accountsListToReturn []*entity.Account
+	nextCursorToReturn   string
+	activityToReturn     entity.AccountActivity
+
+	// lastPage is the page requested by the latest ListByUserID call
+	lastPage entity.PageRequest
 }
 
 func (m *MockAccountRepository) Create(ctx context.Context, account *entity.Account) error {
@@ -99,9 +153,45 @@ func (m *MockAccountRepository) GetByID(ctx context.Context, id string) (*entity
 	return m.accountToReturn, m.lastGetByIDErr
 }
 
-func (m *MockAccountRepository) ListByUserID(ctx context.Context, userID string) ([]*entity.Account, error) {
+func (m *MockAccountRepository) GetByIDForUpdate(ctx context.Context, id string) (*entity.Account, error) {
+	m.getByIDForUpdateCalls++
+	if m.accountsToReturn != nil {
+		return m.accountsToReturn[id], m.lastGetByIDErr
+	}
+	return m.accountToReturn, m.lastGetByIDErr
+}
+
+func (m *MockAccountRepository) GetByCode(ctx context.Context, userID, code string) (*entity.Account, error) {
+	m.getByCodeCalls++
+	for _, a := range m.accountsToReturn {
+		if a.UserID == userID && a.Code == code {
+			return a, m.lastGetByIDErr
+		}
+	}
+	return nil, m.lastGetByIDErr
+}
+
+func (m *MockAccountRepository) ListByParentID(ctx context.Context, parentID string) ([]*entity.Account, error) {
+	m.listByParentIDCalls++
+	var children []*entity.Account
+	for _, a := range m.accountsToReturn {
+		if a.ParentID == parentID {
+			children = append(children, a)
+		}
+	}
+	return children, m.lastListByUserIDErr
+}
+
+func (m *MockAccountRepository) ListByUserID(ctx context.Context, userID string, page entity.PageRequest) ([]*entity.Account, string, error) {
 	m.listByUserIDCalls++
-	return m.accountsListToReturn, m.lastListByUserIDErr
+	m.lastPage = page
+	return m.accountsListToReturn, m.nextCursorToReturn, m.lastListByUserIDErr
+}
+
+func (m *MockAccountRepository) GetActivity(ctx context.Context, id string) (*entity.AccountActivity, error) {
+	m.getActivityCalls++
+	activity := m.activityToReturn
+	return &activity, nil
 }
 
 func (m *MockAccountRepository) Update(ctx context.Context, account *entity.Account) error {
@@ -116,11 +206,13 @@ func (m *MockAccountRepository) Delete(ctx context.Context, id string) error {
 
 // MockTransactionRepository is a mock implementation of TransactionRepository
 type MockTransactionRepository struct {
-	createCalls          int
-	getByIDCalls         int
-	listByAccountIDCalls int
-	updateCalls          int
-	deleteCalls          int
+	createCalls             int
+	getByIDCalls            int
+	listByAccountIDCalls    int
+	listByTransferIDCalls   int
+	updateCalls             int
+	deleteCalls             int
+	listRuleCandidatesCalls int
 
 	lastCreateErr          error
 	lastGetByIDErr         error
@@ -131,11 +223,27 @@ type MockTransactionRepository struct {
 	transactionToReturn      *entity.Transaction
 	transactionsToReturn     map[string]*entity.Transaction
 	transactionsListToReturn []*entity.Transaction
+	nextCursorToReturn       string
+
+	// pageSize, when set, overrides the page size ListRuleCandidates is asked for
+	pageSize int
+
+	// lastFilter and lastPage are the arguments of the latest ListByAccountID call
+	lastFilter entity.TransactionFilter
+	lastPage   entity.PageRequest
+
+	// created holds the transactions persisted by Create, minus rolled-back ones
+	created []*entity.Transaction
 }
 
 func (m *MockTransactionRepository) Create(ctx context.Context, transaction *entity.Transaction) error {
 	m.createCalls++
-	return m.lastCreateErr
+	if m.lastCreateErr != nil {
+		return m.lastCreateErr
+	}
+	m.created = append(m.created, transaction)
+	onRollback(ctx, func() { m.created = m.created[:len(m.created)-1] })
+	return nil
 }
 
 func (m *MockTransactionRepository) GetByID(ctx context.Context, id string) (*entity.Transaction, error) {
@@ -146,9 +254,76 @@ func (m *MockTransactionRepository) GetByID(ctx context.Context, id string) (*en
 	return m.transactionToReturn, m.lastGetByIDErr
 }
 
-func (m *MockTransactionRepository) ListByAccountID(ctx context.Context, accountID string) ([]*entity.Transaction, error) {
+func (m *MockTransactionRepository) GetByExternalID(ctx context.Context, accountID, externalID string) (*entity.Transaction, error) {
+	for _, t := range m.created {
+		if t.AccountID == accountID && t.ExternalID == externalID {
+			return t, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *MockTransactionRepository) ListSimilar(ctx context.Context, accountID string, transactionType constant.TransactionType, amount money.Money, from, to time.Time) ([]*entity.Transaction, error) {
+	var similar []*entity.Transaction
+	for _, t := range m.created {
+		if t.AccountID != accountID || t.Type != transactionType || t.Date.Before(from) || t.Date.After(to) {
+			continue
+		}
+		if t.Amount == amount || t.OriginalAmount == amount {
+			similar = append(similar, t)
+		}
+	}
+	return similar, nil
+}
+
+func (m *MockTransactionRepository) ListByAccountID(ctx context.Context, accountID string, filter entity.TransactionFilter, page entity.PageRequest) ([]*entity.Transaction, string, error) {
 	m.listByAccountIDCalls++
-	return m.transactionsListToReturn, m.lastListByAccountIDErr
+	m.lastFilter = filter
+	m.lastPage = page
+	return m.transactionsListToReturn, m.nextCursorToReturn, m.lastListByAccountIDErr
+}
+
+func (m *MockTransactionRepository) ListRuleCandidates(ctx context.Context, userID string, uncategorizedOnly bool, page entity.PageRequest) ([]*entity.Transaction, string, error) {
+	m.listRuleCandidatesCalls++
+	// Every transaction of the mock belongs to the test user, and the cursor
+	// is the index of the next candidate
+	var candidates []*entity.Transaction
+	for _, t := range m.created {
+		if t.TransferID == "" && len(t.Splits) == 0 && (!uncategorizedOnly || t.CategoryID == "") {
+			candidates = append(candidates, t)
+		}
+	}
+	limit := page.Limit
+	if m.pageSize > 0 {
+		limit = m.pageSize
+	}
+	start := 0
+	if page.Cursor != "" {
+		start, _ = strconv.Atoi(page.Cursor)
+	}
+	if end := start + limit; end < len(candidates) {
+		return candidates[start:end], strconv.Itoa(end), nil
+	}
+	return candidates[start:], "", nil
+}
+
+func (m *MockTransactionRepository) ListByTransferID(ctx context.Context, transferID string) ([]*entity.Transaction, error) {
+	m.listByTransferIDCalls++
+	var legs []*entity.Transaction
+	for _, t := range m.transactionsToReturn {
+		if t.TransferID == transferID {
+			legs = append(legs, t)
+		}
+	}
+	for _, t := range m.created {
+		if t.TransferID == transferID {
+			legs = append(legs, t)
+		}
+	}
+	slices.SortFunc(legs, func(a, b *entity.Transaction) int {
+		return strings.Compare(string(b.TransferDirection), string(a.TransferDirection))
+	})
+	return legs, m.lastListByAccountIDErr
 }
 
 func (m *MockTransactionRepository) Update(ctx context.Context, transaction *entity.Transaction) error {
@@ -161,16 +336,546 @@ func (m *MockTransactionRepository) Delete(ctx context.Context, id string) error
 	return m.lastDeleteErr
 }
 
+// MockJournalEntryRepository is a mock implementation of JournalEntryRepository
+type MockJournalEntryRepository struct {
+	createCalls       int
+	getByIDCalls      int
+	listByUserIDCalls int
+	deleteCalls       int
+
+	lastCreateErr       error
+	lastGetByIDErr      error
+	lastListByUserIDErr error
+	lastDeleteErr       error
+
+	entryToReturn       *entity.JournalEntry
+	entriesListToReturn []*entity.JournalEntry
+
+	// created holds the entries persisted by Create, minus rolled-back ones
+	created []*entity.JournalEntry
+}
+
+func (m *MockJournalEntryRepository) Create(ctx context.Context, entry *entity.JournalEntry) error {
+	m.createCalls++
+	if m.lastCreateErr != nil {
+		return m.lastCreateErr
+	}
+	m.created = append(m.created, entry)
+	onRollback(ctx, func() { m.created = m.created[:len(m.created)-1] })
+	return nil
+}
+
+func (m *MockJournalEntryRepository) GetByID(ctx context.Context, id string) (*entity.JournalEntry, error) {
+	m.getByIDCalls++
+	return m.entryToReturn, m.lastGetByIDErr
+}
+
+func (m *MockJournalEntryRepository) ListByUserID(ctx context.Context, userID string) ([]*entity.JournalEntry, error) {
+	m.listByUserIDCalls++
+	return m.entriesListToReturn, m.lastListByUserIDErr
+}
+
+func (m *MockJournalEntryRepository) Delete(ctx context.Context, id string) error {
+	m.deleteCalls++
+	return m.lastDeleteErr
+}
+
+// MockExchangeRateRepository is a mock implementation of ExchangeRateRepository
+// that keeps its rates in memory
+type MockExchangeRateRepository struct {
+	upsertCalls       int
+	getEffectiveCalls int
+
+	lastUpsertErr       error
+	lastGetEffectiveErr error
+
+	rates []*entity.ExchangeRate
+}
+
+func (m *MockExchangeRateRepository) Upsert(ctx context.Context, rate *entity.ExchangeRate) error {
+	m.upsertCalls++
+	if m.lastUpsertErr != nil {
+		return m.lastUpsertErr
+	}
+	snapshot := slices.Clone(m.rates)
+	m.rates = slices.DeleteFunc(m.rates, func(r *entity.ExchangeRate) bool {
+		return r.BaseCurrency == rate.BaseCurrency && r.QuoteCurrency == rate.QuoteCurrency && r.Date.Equal(rate.Date)
+	})
+	m.rates = append(m.rates, rate)
+	onRollback(ctx, func() { m.rates = snapshot })
+	return nil
+}
+
+func (m *MockExchangeRateRepository) GetEffective(ctx context.Context, baseCurrency, quoteCurrency string, date time.Time) (*entity.ExchangeRate, error) {
+	m.getEffectiveCalls++
+	var effective *entity.ExchangeRate
+	for _, r := range m.rates {
+		if r.BaseCurrency != baseCurrency || r.QuoteCurrency != quoteCurrency || r.Date.After(date) {
+			continue
+		}
+		if effective == nil || r.Date.After(effective.Date) {
+			effective = r
+		}
+	}
+	return effective, m.lastGetEffectiveErr
+}
+
+// MockReportRepository is a mock implementation of ReportRepository
+type MockReportRepository struct {
+	sumByCategoryCalls int
+	sumByTagCalls      int
+	sumByPayeeCalls    int
+
+	lastSumByCategoryErr error
+
+	categoryTotalsToReturn []*entity.CategoryTotal
+	tagTotalsToReturn      []*entity.TagTotal
+	payeeTotalsToReturn    []*entity.PayeeTotal
+
+	// lastGranularity is the granularity requested by the latest SumByCategory call
+	lastGranularity constant.Granularity
+}
+
+func (m *MockReportRepository) SumByCategory(ctx context.Context, userID string, from, to time.Time, granularity constant.Granularity) ([]*entity.CategoryTotal, error) {
+	m.sumByCategoryCalls++
+	m.lastGranularity = granularity
+	return m.categoryTotalsToReturn, m.lastSumByCategoryErr
+}
+
+func (m *MockReportRepository) SumByTag(ctx context.Context, userID string, from, to time.Time) ([]*entity.TagTotal, error) {
+	m.sumByTagCalls++
+	return m.tagTotalsToReturn, nil
+}
+
+func (m *MockReportRepository) SumByPayee(ctx context.Context, userID string, from, to time.Time) ([]*entity.PayeeTotal, error) {
+	m.sumByPayeeCalls++
+	return m.payeeTotalsToReturn, nil
+}
+
+// MockStatementRepository is a mock implementation of StatementRepository
+type MockStatementRepository struct {
+	sumAccountMovementsCalls int
+
+	accountMovements  []*entity.AccountMovement
+	categoryMovements []*entity.CategoryMovement
+
+	// lastFrom and lastTo are the range of the latest SumAccountMovements call
+	lastFrom time.Time
+	lastTo   time.Time
+}
+
+func (m *MockStatementRepository) SumAccountMovements(ctx context.Context, userID string, from, to time.Time) ([]*entity.AccountMovement, error) {
+	m.sumAccountMovementsCalls++
+	m.lastFrom, m.lastTo = from, to
+	return m.accountMovements, nil
+}
+
+func (m *MockStatementRepository) SumCategoryMovements(ctx context.Context, userID string, from, to time.Time) ([]*entity.CategoryMovement, error) {
+	return m.categoryMovements, nil
+}
+
+// MockBalanceRepository is a mock implementation of BalanceRepository
+type MockBalanceRepository struct {
+	listDailyMovementsCalls int
+	upsertSnapshotCalls     int
+
+	lastListDailyMovementsErr error
+
+	movements []*entity.DailyMovement
+	snapshots []*entity.BalanceSnapshot
+
+	// lastMovementsFrom is the start of the range of the latest ListDailyMovements call
+	lastMovementsFrom time.Time
+}
+
+func (m *MockBalanceRepository) ListDailyMovements(ctx context.Context, accountID, currency string, from, to time.Time) ([]*entity.DailyMovement, error) {
+	m.listDailyMovementsCalls++
+	m.lastMovementsFrom = from
+	var movements []*entity.DailyMovement
+	for _, movement := range m.movements {
+		if !movement.Date.Before(from) && movement.Date.Before(to) {
+			movements = append(movements, movement)
+		}
+	}
+	return movements, m.lastListDailyMovementsErr
+}
+
+func (m *MockBalanceRepository) GetLatestSnapshot(ctx context.Context, accountID, currency string, date time.Time) (*entity.BalanceSnapshot, error) {
+	var latest *entity.BalanceSnapshot
+	for _, snapshot := range m.snapshots {
+		if snapshot.AccountID == accountID && !snapshot.Date.After(date) && (latest == nil || snapshot.Date.After(latest.Date)) {
+			latest = snapshot
+		}
+	}
+	return latest, nil
+}
+
+func (m *MockBalanceRepository) UpsertSnapshot(ctx context.Context, snapshot *entity.BalanceSnapshot) error {
+	m.upsertSnapshotCalls++
+	m.snapshots = slices.DeleteFunc(m.snapshots, func(s *entity.BalanceSnapshot) bool {
+		return s.AccountID == snapshot.AccountID && s.Date.Equal(snapshot.Date)
+	})
+	m.snapshots = append(m.snapshots, snapshot)
+	return nil
+}
+
+// MockBalanceService is a mock implementation of BalanceService
+type MockBalanceService struct {
+	getDailyBalancesCalls int
+
+	// changes holds, per account ID, the balances the account changes to,
+	// oldest first; the balance is zero before the first change
+	changes map[string][]*entity.DailyBalance
+}
+
+func (m *MockBalanceService) balanceOn(accountID, currency string, day time.Time) money.Money {
+	balance := money.Zero(currency)
+	for _, change := range m.changes[accountID] {
+		if change.Date.After(day) {
+			break
+		}
+		balance = change.Balance
+	}
+	return balance
+}
+
+func (m *MockBalanceService) GetBalanceAsOf(ctx context.Context, accountID string, day time.Time) (*entity.DailyBalance, error) {
+	balances, err := m.GetDailyBalances(ctx, accountID, day, day)
+	if err != nil {
+		return nil, err
+	}
+	return balances[0], nil
+}
+
+func (m *MockBalanceService) GetDailyBalances(ctx context.Context, accountID string, from, to time.Time) ([]*entity.DailyBalance, error) {
+	m.getDailyBalancesCalls++
+	currency := "USD"
+	if changes := m.changes[accountID]; len(changes) > 0 {
+		currency = changes[0].Balance.Currency()
+	}
+	var balances []*entity.DailyBalance
+	for day := from; !day.After(to); day = day.AddDate(0, 0, 1) {
+		balances = append(balances, &entity.DailyBalance{Date: day, Balance: m.balanceOn(accountID, currency, day)})
+	}
+	return balances, nil
+}
+
+// MockImportProfileRepository is a mock implementation of ImportProfileRepository
+type MockImportProfileRepository struct {
+	createCalls int
+	updateCalls int
+	deleteCalls int
+
+	lastDeleteErr error
+
+	// profiles holds the stored profiles by ID
+	profiles map[string]*entity.ImportProfile
+}
+
+func (m *MockImportProfileRepository) Create(ctx context.Context, profile *entity.ImportProfile) error {
+	m.createCalls++
+	if m.profiles == nil {
+		m.profiles = make(map[string]*entity.ImportProfile)
+	}
+	m.profiles[profile.ID] = profile
+	return nil
+}
+
+func (m *MockImportProfileRepository) GetByID(ctx context.Context, id string) (*entity.ImportProfile, error) {
+	// Return a copy, as a database would, so that callers' edits stay unsaved until Update
+	if p, ok := m.profiles[id]; ok {
+		copied := *p
+		return &copied, nil
+	}
+	return nil, nil
+}
+
+func (m *MockImportProfileRepository) GetByName(ctx context.Context, userID, name string) (*entity.ImportProfile, error) {
+	for _, p := range m.profiles {
+		if p.UserID == userID && p.Name == name {
+			return p, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *MockImportProfileRepository) ListByUserID(ctx context.Context, userID string) ([]*entity.ImportProfile, error) {
+	var profiles []*entity.ImportProfile
+	for _, p := range m.profiles {
+		if p.UserID == userID {
+			profiles = append(profiles, p)
+		}
+	}
+	slices.SortFunc(profiles, func(a, b *entity.ImportProfile) int { return strings.Compare(a.Name, b.Name) })
+	return profiles, nil
+}
+
+func (m *MockImportProfileRepository) Update(ctx context.Context, profile *entity.ImportProfile) error {
+	m.updateCalls++
+	m.profiles[profile.ID] = profile
+	return nil
+}
+
+func (m *MockImportProfileRepository) Delete(ctx context.Context, id string) error {
+	m.deleteCalls++
+	return m.lastDeleteErr
+}
+
+// MockCategoryRuleRepository is a mock implementation of CategoryRuleRepository
+type MockCategoryRuleRepository struct {
+	createCalls int
+	updateCalls int
+	deleteCalls int
+
+	lastDeleteErr error
+
+	// rules holds the stored rules by ID
+	rules map[string]*entity.CategoryRule
+}
+
+func (m *MockCategoryRuleRepository) Create(ctx context.Context, rule *entity.CategoryRule) error {
+	m.createCalls++
+	if m.rules == nil {
+		m.rules = make(map[string]*entity.CategoryRule)
+	}
+	m.rules[rule.ID] = rule
+	return nil
+}
+
+func (m *MockCategoryRuleRepository) GetByID(ctx context.Context, id string) (*entity.CategoryRule, error) {
+	// Return a copy, as a database would, so that callers' edits stay unsaved until Update
+	if r, ok := m.rules[id]; ok {
+		copied := *r
+		return &copied, nil
+	}
+	return nil, nil
+}
+
+func (m *MockCategoryRuleRepository) ListByUserID(ctx context.Context, userID string) ([]*entity.CategoryRule, error) {
+	var rules []*entity.CategoryRule
+	for _, r := range m.rules {
+		if r.UserID == userID {
+			rules = append(rules, r)
+		}
+	}
+	slices.SortFunc(rules, func(a, b *entity.CategoryRule) int {
+		if a.Priority != b.Priority {
+			return a.Priority - b.Priority
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+	return rules, nil
+}
+
+func (m *MockCategoryRuleRepository) Update(ctx context.Context, rule *entity.CategoryRule) error {
+	m.updateCalls++
+	m.rules[rule.ID] = rule
+	return nil
+}
+
+func (m *MockCategoryRuleRepository) Delete(ctx context.Context, id string) error {
+	m.deleteCalls++
+	return m.lastDeleteErr
+}
+
+// MockCategoryRepository is a mock implementation of CategoryRepository
+type MockCategoryRepository struct {
+	createCalls int
+	updateCalls int
+	deleteCalls int
+
+	lastDeleteErr error
+
+	// categories holds the stored categories by ID
+	categories map[string]*entity.Category
+}
+
+// newMockCategoryRepository returns a category store holding the categories
+func newMockCategoryRepository(categories ...*entity.Category) *MockCategoryRepository {
+	m := &MockCategoryRepository{categories: make(map[string]*entity.Category)}
+	for _, c := range categories {
+		m.categories[c.ID] = c
+	}
+	return m
+}
+
+func (m *MockCategoryRepository) Create(ctx context.Context, category *entity.Category) error {
+	m.createCalls++
+	if m.categories == nil {
+		m.categories = make(map[string]*entity.Category)
+	}
+	m.categories[category.ID] = category
+	return nil
+}
+
+func (m *MockCategoryRepository) GetByID(ctx context.Context, id string) (*entity.Category, error) {
+	// Return a copy, as a database would, so that callers' edits stay unsaved until Update
+	if c, ok := m.categories[id]; ok {
+		copied := *c
+		return &copied, nil
+	}
+	return nil, nil
+}
+
+func (m *MockCategoryRepository) GetByName(ctx context.Context, userID, parentID, name string) (*entity.Category, error) {
+	for _, c := range m.categories {
+		if c.UserID == userID && c.ParentID == parentID && strings.EqualFold(c.Name, name) {
+			return c, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *MockCategoryRepository) ListByName(ctx context.Context, userID, name string) ([]*entity.Category, error) {
+	return m.list(func(c *entity.Category) bool { return c.UserID == userID && strings.EqualFold(c.Name, name) }), nil
+}
+
+func (m *MockCategoryRepository) ListByUserID(ctx context.Context, userID string, includeArchived bool) ([]*entity.Category, error) {
+	return m.list(func(c *entity.Category) bool { return c.UserID == userID && (includeArchived || !c.Archived) }), nil
+}
+
+func (m *MockCategoryRepository) ListByParentID(ctx context.Context, parentID string) ([]*entity.Category, error) {
+	return m.list(func(c *entity.Category) bool { return c.ParentID == parentID }), nil
+}
+
+func (m *MockCategoryRepository) list(keep func(*entity.Category) bool) []*entity.Category {
+	var categories []*entity.Category
+	for _, c := range m.categories {
+		if keep(c) {
+			categories = append(categories, c)
+		}
+	}
+	slices.SortFunc(categories, func(a, b *entity.Category) int { return strings.Compare(a.Name, b.Name) })
+	return categories
+}
+
+func (m *MockCategoryRepository) Update(ctx context.Context, category *entity.Category) error {
+	m.updateCalls++
+	m.categories[category.ID] = category
+	return nil
+}
+
+func (m *MockCategoryRepository) Delete(ctx context.Context, id string) error {
+	m.deleteCalls++
+	if m.lastDeleteErr != nil {
+		return m.lastDeleteErr
+	}
+	delete(m.categories, id)
+	return nil
+}
+
+// newTestCategories returns a category store holding the test user's
+// categories used across the tests, and an archived one.
+func newTestCategories() *MockCategoryRepository {
+	archived := NewTestCategory("category-archived", "Archived", "")
+	archived.Archived = true
+	return newMockCategoryRepository(
+		NewTestCategory("category-coffee", "Coffee", constant.TransactionTypeExpense),
+		NewTestCategory("category-eating-out", "Eating out", constant.TransactionTypeExpense),
+		NewTestCategory("category-food", "Food", constant.TransactionTypeExpense),
+		NewTestCategory("category-housing", "Housing", constant.TransactionTypeExpense),
+		NewTestCategory("category-large", "Large", ""),
+		NewTestCategory("category-meeting", "Meeting", constant.TransactionTypeExpense),
+		NewTestCategory("category-other", "Other", ""),
+		NewTestCategory("category-salary", "Salary", constant.TransactionTypeIncome),
+		NewTestCategory("category-savings", "Savings", ""),
+		NewTestCategory("category-shopping", "Shopping", constant.TransactionTypeExpense),
+		NewTestCategory("category-small", "Small", constant.TransactionTypeExpense),
+		NewTestCategory("category-transport", "Transport", constant.TransactionTypeExpense),
+		archived,
+	)
+}
+
+// MockPayeeRepository is a mock implementation of PayeeRepository
+type MockPayeeRepository struct {
+	createCalls int
+	updateCalls int
+	deleteCalls int
+
+	// payees holds the stored payees by ID
+	payees map[string]*entity.Payee
+}
+
+// newMockPayeeRepository returns a payee store holding the payees
+func newMockPayeeRepository(payees ...*entity.Payee) *MockPayeeRepository {
+	m := &MockPayeeRepository{payees: make(map[string]*entity.Payee)}
+	for _, p := range payees {
+		m.payees[p.ID] = p
+	}
+	return m
+}
+
+func (m *MockPayeeRepository) Create(ctx context.Context, payee *entity.Payee) error {
+	m.createCalls++
+	if m.payees == nil {
+		m.payees = make(map[string]*entity.Payee)
+	}
+	m.payees[payee.ID] = payee
+	return nil
+}
+
+func (m *MockPayeeRepository) GetByID(ctx context.Context, id string) (*entity.Payee, error) {
+	// Return a copy, as a database would, so that callers' edits stay unsaved until Update
+	if p, ok := m.payees[id]; ok {
+		copied := *p
+		return &copied, nil
+	}
+	return nil, nil
+}
+
+func (m *MockPayeeRepository) GetByName(ctx context.Context, userID, name string) (*entity.Payee, error) {
+	for _, p := range m.payees {
+		if p.UserID == userID && strings.EqualFold(p.Name, name) {
+			return p, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *MockPayeeRepository) ListByUserID(ctx context.Context, userID string) ([]*entity.Payee, error) {
+	var payees []*entity.Payee
+	for _, p := range m.payees {
+		if p.UserID == userID {
+			payees = append(payees, p)
+		}
+	}
+	slices.SortFunc(payees, func(a, b *entity.Payee) int { return strings.Compare(a.Name, b.Name) })
+	return payees, nil
+}
+
+func (m *MockPayeeRepository) Update(ctx context.Context, payee *entity.Payee) error {
+	m.updateCalls++
+	m.payees[payee.ID] = payee
+	return nil
+}
+
+func (m *MockPayeeRepository) Delete(ctx context.Context, id string) error {
+	m.deleteCalls++
+	delete(m.payees, id)
+	return nil
+}
+
+// newTestPayees returns a payee store holding the test user's payees: a
+// coffee chain whose default category is Coffee, and a supermarket whose
+// default category is Food.
+func newTestPayees() *MockPayeeRepository {
+	return newMockPayeeRepository(
+		&entity.Payee{ID: "payee-coffee", UserID: "test-user-123", Name: "Starbucks", Aliases: []string{"SBUX"}, DefaultCategoryID: "category-coffee"},
+		&entity.Payee{ID: "payee-supermarket", UserID: "test-user-123", Name: "Tesco", Aliases: []string{"TESCO STORES", "TESCO EXPRESS 1234"}, DefaultCategoryID: "category-food"},
+	)
+}
+
 // Test entity helpers
 
 // NewTestUser creates a test user with default values
 func NewTestUser() *entity.User {
 	return &entity.User{
-		ID:        "test-user-123",
-		Name:      "Test User",
-		Email:     "test@example.com",
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
+		ID:           "test-user-123",
+		Name:         "Test User",
+		Email:        "test@example.com",
+		BaseCurrency: "USD",
+		CreatedAt:    time.Now(),
+		UpdatedAt:    time.Now(),
 	}
 }
 
@@ -181,7 +886,7 @@ func NewTestAccount() *entity.Account {
 		UserID:   "test-user-123",
 		Name:     "Test Account",
 		Type:     constant.AccountTypeChecking,
-		Balance:  1000.00,
+		Balance:  money.MustParse("1000.00", "USD"),
 		Currency: "USD",
 	}
 }
@@ -191,15 +896,41 @@ func NewTestTransaction() *entity.Transaction {
 	return &entity.Transaction{
 		ID:          "test-transaction-123",
 		AccountID:   "test-account-123",
-		Amount:      100.00,
-		Currency:    "USD",
+		Amount:      money.MustParse("100.00", "USD"),
 		Description: "Test transaction",
 		Date:        time.Now(),
 		Type:        constant.TransactionTypeExpense,
-		Category:    "Test",
+		CategoryID:  "category-food",
+		Category:    "Food",
 	}
 }
 
+// NewTestCategory creates a category of the test user with the given ID,
+// name and type
+func NewTestCategory(id, name string, categoryType constant.TransactionType) *entity.Category {
+	return &entity.Category{
+		ID:     id,
+		UserID: "test-user-123",
+		Name:   name,
+		Type:   categoryType,
+	}
+}
+
+// NewTestExchangeRate creates a rate effective from the given day
+func NewTestExchangeRate(base, quote, rate string, date time.Time) *entity.ExchangeRate {
+	return &entity.ExchangeRate{
+		BaseCurrency:  base,
+		QuoteCurrency: quote,
+		Date:          calendarDay(date),
+		Rate:          money.MustParseRate(rate),
+	}
+}
+
+// newTestConverter returns an exchange rate service over an in-memory store holding rates
+func newTestConverter(rates ...*entity.ExchangeRate) *ExchangeRateService {
+	return NewExchangeRateService(&MockExchangeRateRepository{rates: rates}, &MockTransactionManager{})
+}
+
 // NewTestLogger creates a test logger that writes to a buffer
 func NewTestLogger() *logger.Logger {
 	var buf bytes.Buffer
@@ -3,12 +3,16 @@ package service
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"accounting/internal/domain/constant"
 	"accounting/internal/domain/entity"
 	domainerrors "accounting/internal/domain/errors"
 	"accounting/internal/domain/interfaces"
+	"accounting/internal/domain/money"
 
 	"github.com/google/uuid"
 )
@@ -16,33 +20,91 @@ import (
 type TransactionService struct {
 	transactionRepo interfaces.TransactionRepository
 	accountRepo     interfaces.AccountRepository
+	txManager       interfaces.TransactionManager
+	converter       interfaces.CurrencyConverter
+	categoryRepo    interfaces.CategoryRepository
+	ruleRepo        interfaces.CategoryRuleRepository
+	payeeRepo       interfaces.PayeeRepository
 }
 
-func NewTransactionService(transactionRepo interfaces.TransactionRepository, accountRepo interfaces.AccountRepository) *TransactionService {
+func NewTransactionService(transactionRepo interfaces.TransactionRepository, accountRepo interfaces.AccountRepository, txManager interfaces.TransactionManager, converter interfaces.CurrencyConverter, categoryRepo interfaces.CategoryRepository, ruleRepo interfaces.CategoryRuleRepository, payeeRepo interfaces.PayeeRepository) *TransactionService {
 	return &TransactionService{
 		transactionRepo: transactionRepo,
 		accountRepo:     accountRepo,
+		txManager:       txManager,
+		converter:       converter,
+		categoryRepo:    categoryRepo,
+		ruleRepo:        ruleRepo,
+		payeeRepo:       payeeRepo,
 	}
 }
 
-func (s *TransactionService) CreateTransaction(ctx context.Context, accountID string, amount float64, currency, description, category string, transactionType constant.TransactionType, date time.Time) (*entity.Transaction, error) {
+func (s *TransactionService) CreateTransaction(ctx context.Context, accountID string, amount money.Money, description, payeeID, categoryID string, tags []string, splits []*entity.Split, transactionType constant.TransactionType, date time.Time, allowDuplicate bool) (*entity.Transaction, error) {
+	return s.createTransaction(ctx, accountID, "", amount, description, payeeID, categoryID, tags, splits, transactionType, date, createOptions{allowDuplicate: allowDuplicate})
+}
+
+func (s *TransactionService) CreateImportedTransaction(ctx context.Context, accountID, externalID string, amount money.Money, description, payeeID, categoryID string, tags []string, splits []*entity.Split, transactionType constant.TransactionType, date time.Time, allowDuplicate bool) (*entity.Transaction, error) {
+	if externalID == "" {
+		return nil, domainerrors.NewErrInvalidInput("external_id", "external ID is required")
+	}
+	if len(externalID) > maxExternalIDLength {
+		return nil, errExternalIDTooLong
+	}
+	return s.createTransaction(ctx, accountID, externalID, amount, description, payeeID, categoryID, tags, splits, transactionType, date, createOptions{allowDuplicate: allowDuplicate})
+}
+
+// importTransaction creates a transaction read from a statement row that
+// the user's categorization rules were already applied to, recording its
+// external ID unless it is empty. Likely duplicates are recorded too, as
+// the import looks for them itself.
+func (s *TransactionService) importTransaction(ctx context.Context, accountID, externalID string, amount money.Money, description, categoryID string, tags []string, transactionType constant.TransactionType, date time.Time) (*entity.Transaction, error) {
+	if len(externalID) > maxExternalIDLength {
+		return nil, errExternalIDTooLong
+	}
+	return s.createTransaction(ctx, accountID, externalID, amount, description, "", categoryID, tags, nil, transactionType, date, createOptions{allowDuplicate: true, rulesApplied: true})
+}
+
+// createOptions tune how createTransaction records a transaction.
+type createOptions struct {
+	// allowDuplicate records a transaction that is likely a duplicate of one
+	// already on the account.
+	allowDuplicate bool
+	// rulesApplied tells that the caller applied the user's categorization
+	// rules to the transaction already.
+	rulesApplied bool
+}
+
+// createTransaction creates a transaction, recording its external ID unless
+// it is empty. A transaction without a category or splits is categorized by
+// the user's rules, unless the options tell they were applied already, then
+// gets a payee, given or matched, and the payee's default category if still
+// uncategorized. The categories given or set must suit the transaction.
+// Unless the options allow duplicates, it refuses a transaction that is
+// likely a duplicate of one already on the account.
+func (s *TransactionService) createTransaction(ctx context.Context, accountID, externalID string, amount money.Money, description, payeeID, categoryID string, tags []string, splits []*entity.Split, transactionType constant.TransactionType, date time.Time, opts createOptions) (*entity.Transaction, error) {
 	if accountID == "" {
 		return nil, domainerrors.NewErrInvalidInput("account_id", "account ID is required")
 	}
-	if amount <= 0 {
+	if !amount.IsPositive() {
 		return nil, domainerrors.NewErrInvalidInput("amount", "amount must be greater than zero")
 	}
-	if currency == "" {
-		return nil, domainerrors.NewErrInvalidInput("currency", "currency is required")
+	if err := validateCurrency("currency", amount.Currency()); err != nil {
+		return nil, err
 	}
-
-	// Verify account exists
-	account, err := s.accountRepo.GetByID(ctx, accountID)
+	if transactionType == constant.TransactionTypeTransfer {
+		return nil, errTransferViaTransactions
+	}
+	tags, err := normalizeTags("tags", tags)
 	if err != nil {
-		return nil, fmt.Errorf("verifying account: %w", err)
+		return nil, err
 	}
-	if account == nil {
-		return nil, domainerrors.NewErrNotFound("account", accountID)
+	if len(splits) > 0 {
+		if categoryID != "" {
+			return nil, errSplitWithCategory
+		}
+		if splits, err = newSplits(splits, amount); err != nil {
+			return nil, err
+		}
 	}
 
 	// Use provided date or default to now
@@ -54,28 +116,80 @@ func (s *TransactionService) CreateTransaction(ctx context.Context, accountID st
 		ID:          uuid.New().String(),
 		AccountID:   accountID,
 		Amount:      amount,
-		Currency:    currency,
 		Description: description,
 		Date:        date,
 		Type:        transactionType,
-		Category:    category,
+		CategoryID:  categoryID,
+		Splits:      splits,
+		Tags:        tags,
+		ExternalID:  externalID,
 	}
 
-	if err := s.transactionRepo.Create(ctx, transaction); err != nil {
-		return nil, fmt.Errorf("creating transaction: %w", err)
-	}
+	// The insert and the balance update must commit or roll back together
+	err = s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		accounts, err := lockAccounts(ctx, s.accountRepo, accountID)
+		if err != nil {
+			return err
+		}
+		// The account lock keeps concurrent requests, such as a double submit
+		// or overlapping imports, from recording the same transaction twice
+		if externalID != "" {
+			existing, err := s.transactionRepo.GetByExternalID(ctx, accountID, externalID)
+			if err != nil {
+				return fmt.Errorf("checking external ID: %w", err)
+			}
+			if existing != nil {
+				return domainerrors.NewErrDuplicateTransaction(accountID, externalID, existing.ID)
+			}
+		}
+		if err := denominate(ctx, s.converter, transaction, amount, accounts[accountID]); err != nil {
+			return err
+		}
+		if err := denominateSplits(transaction); err != nil {
+			return err
+		}
+		// Rules may rewrite the description, so duplicates are looked for after
+		userID := accounts[accountID].UserID
+		if categoryID == "" && len(splits) == 0 && !opts.rulesApplied {
+			if err := categorize(ctx, s.ruleRepo, userID, transaction); err != nil {
+				return err
+			}
+		}
+		// Rules may also rewrite the description payees are matched against
+		if err := assignPayee(ctx, s.payeeRepo, s.categoryRepo, userID, payeeID, transaction); err != nil {
+			return err
+		}
+		if transaction.CategoryID != "" {
+			category, err := checkCategory(ctx, s.categoryRepo, "category_id", userID, transaction.CategoryID, transactionType)
+			if err != nil {
+				return err
+			}
+			transaction.Category = category.Name
+		}
+		if err := checkSplitCategories(ctx, s.categoryRepo, userID, transaction); err != nil {
+			return err
+		}
+		if !opts.allowDuplicate {
+			duplicates, err := s.FindDuplicates(ctx, accountID, externalID, amount, transaction.Description, transactionType, date)
+			if err != nil {
+				return err
+			}
+			if len(duplicates) > 0 {
+				return domainerrors.NewErrDuplicateTransaction(accountID, "", duplicates[0].ID)
+			}
+		}
 
-	// Update account balance
-	switch transactionType {
-	case constant.TransactionTypeIncome:
-		account.Balance += amount
-	case constant.TransactionTypeExpense:
-		account.Balance -= amount
-	}
+		if err := s.transactionRepo.Create(ctx, transaction); err != nil {
+			return fmt.Errorf("creating transaction: %w", err)
+		}
 
-	if err := s.accountRepo.Update(ctx, account); err != nil {
-		// TODO: In production, wrap this in a database transaction
-		return nil, fmt.Errorf("updating account balance: %w", err)
+		if err := applyBalanceEffect(accounts[accountID], transaction, false); err != nil {
+			return err
+		}
+		return saveAccounts(ctx, s.accountRepo, accounts)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return transaction, nil
@@ -85,11 +199,228 @@ func (s *TransactionService) GetTransaction(ctx context.Context, id string) (*en
 	return s.transactionRepo.GetByID(ctx, id)
 }
 
-func (s *TransactionService) ListAccountTransactions(ctx context.Context, accountID string) ([]*entity.Transaction, error) {
-	return s.transactionRepo.ListByAccountID(ctx, accountID)
+func (s *TransactionService) ListAccountTransactions(ctx context.Context, accountID string, filter entity.TransactionFilter, page entity.PageRequest) ([]*entity.Transaction, string, error) {
+	page, err := pageRequest(page)
+	if err != nil {
+		return nil, "", err
+	}
+
+	account, err := s.accountRepo.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, "", fmt.Errorf("getting account: %w", err)
+	}
+	if account == nil {
+		return nil, "", domainerrors.NewErrNotFound("account", accountID)
+	}
+	if err := validateTransactionFilter(filter, account.Currency); err != nil {
+		return nil, "", err
+	}
+	if filter.Tags, err = normalizeTags("tag", filter.Tags); err != nil {
+		return nil, "", err
+	}
+
+	return s.transactionRepo.ListByAccountID(ctx, accountID, filter, page)
 }
 
-func (s *TransactionService) UpdateTransaction(ctx context.Context, id string, amount float64, currency, description, category string, transactionType constant.TransactionType, date time.Time) (*entity.Transaction, error) {
+func (s *TransactionService) UpdateTransaction(ctx context.Context, id, accountID string, amount money.Money, description, payeeID, categoryID string, tags []string, splits []*entity.Split, transactionType constant.TransactionType, date time.Time) (*entity.Transaction, error) {
+	tags, err := normalizeTags("tags", tags)
+	if err != nil {
+		return nil, err
+	}
+
+	var transaction *entity.Transaction
+	err = s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		legs, err := s.getLegs(ctx, id)
+		if err != nil {
+			return err
+		}
+		transaction = legs[0]
+		previous := make([]entity.Transaction, len(legs))
+		for i, leg := range legs {
+			previous[i] = *leg
+		}
+
+		if transaction.TransferID != "" {
+			if transactionType != "" && transactionType != constant.TransactionTypeTransfer {
+				return domainerrors.NewErrInvalidInput("type", "the type of a transfer leg cannot be changed")
+			}
+			if categoryID != "" {
+				return domainerrors.NewErrInvalidInput("category_id", "transfer legs have no category")
+			}
+			if payeeID != "" {
+				return domainerrors.NewErrInvalidInput("payee_id", "transfer legs have no payee")
+			}
+			if len(splits) > 0 {
+				return domainerrors.NewErrInvalidInput("splits", "transfer legs have no category to split")
+			}
+		} else if transactionType == constant.TransactionTypeTransfer {
+			return errTransferViaTransactions
+		}
+
+		// The amount as entered, which is converted again if it or the rate date changes
+		source := sourceAmount(transaction)
+		if accountID != "" {
+			transaction.AccountID = accountID
+		}
+		if amount.Currency() != "" {
+			if !amount.IsPositive() {
+				return domainerrors.NewErrInvalidInput("amount", "amount must be greater than zero")
+			}
+			if err := validateCurrency("currency", amount.Currency()); err != nil {
+				return err
+			}
+			source = amount
+		}
+		if description != "" {
+			transaction.Description = description
+		}
+		if payeeID != "" {
+			transaction.PayeeID = payeeID
+		}
+		if categoryID != "" {
+			transaction.CategoryID = categoryID
+		}
+		if tags != nil {
+			transaction.Tags = tags
+		}
+		// Splits are entered in the currency of the amount, like the amount itself
+		if len(splits) > 0 {
+			if transaction.Splits, err = newSplits(splits, source); err != nil {
+				return err
+			}
+		} else if splits != nil {
+			transaction.Splits = nil
+		}
+		if len(transaction.Splits) > 0 {
+			if categoryID != "" {
+				return errSplitWithCategory
+			}
+			transaction.CategoryID = ""
+			transaction.Category = ""
+		}
+		if transactionType != "" {
+			transaction.Type = transactionType
+		}
+		if !date.IsZero() {
+			transaction.Date = date
+		}
+
+		// Keep the other leg of a transfer in step with the edited one
+		for _, sibling := range legs[1:] {
+			if sibling.AccountID == transaction.AccountID {
+				return domainerrors.NewErrInvalidInput("account_id", "both legs of a transfer cannot use the same account")
+			}
+			sibling.Date = transaction.Date
+		}
+
+		// Reverse the previous effects and apply the new ones, possibly on other accounts
+		accountIDs := make([]string, 0, 2*len(legs))
+		for i, leg := range legs {
+			accountIDs = append(accountIDs, previous[i].AccountID, leg.AccountID)
+		}
+		accounts, err := lockAccounts(ctx, s.accountRepo, accountIDs...)
+		if err != nil {
+			return err
+		}
+		// A transfer stays between accounts of the user it was made by
+		if transaction.TransferID != "" {
+			owner := accounts[previous[0].AccountID].UserID
+			for _, leg := range legs {
+				if accounts[leg.AccountID].UserID != owner {
+					return domainerrors.NewErrInvalidInput("account_id", "transfers are only allowed between accounts of the same user")
+				}
+			}
+		}
+		// The payee must be the user's, possibly another user's after a move
+		userID := accounts[transaction.AccountID].UserID
+		if transaction.PayeeID != "" && (payeeID != "" || accountID != "") {
+			payee, err := checkPayee(ctx, s.payeeRepo, "payee_id", userID, transaction.PayeeID)
+			if err != nil {
+				return err
+			}
+			transaction.Payee = payee.Name
+			if payeeID != "" {
+				if err := applyDefaultCategory(ctx, s.categoryRepo, userID, payee, transaction); err != nil {
+					return err
+				}
+			}
+		}
+		// The category must suit the transaction as edited, possibly on another user's account
+		if transaction.CategoryID != "" && (categoryID != "" || transactionType != "" || accountID != "") {
+			category, err := checkCategory(ctx, s.categoryRepo, "category_id", userID, transaction.CategoryID, transaction.Type)
+			if err != nil {
+				return err
+			}
+			transaction.Category = category.Name
+		}
+		if len(transaction.Splits) > 0 && (splits != nil || transactionType != "" || accountID != "") {
+			if err := checkSplitCategories(ctx, s.categoryRepo, userID, transaction); err != nil {
+				return err
+			}
+		}
+		for i, leg := range legs {
+			changed := amount.Currency() != "" || !leg.Date.Equal(previous[i].Date) || leg.AccountID != previous[i].AccountID
+			if changed {
+				if err := denominate(ctx, s.converter, leg, source, accounts[leg.AccountID]); err != nil {
+					return err
+				}
+			}
+			if err := denominateSplits(leg); err != nil {
+				return err
+			}
+			if err := applyBalanceEffect(accounts[previous[i].AccountID], &previous[i], true); err != nil {
+				return err
+			}
+			if err := applyBalanceEffect(accounts[leg.AccountID], leg, false); err != nil {
+				return err
+			}
+			if err := s.transactionRepo.Update(ctx, leg); err != nil {
+				return fmt.Errorf("updating transaction: %w", err)
+			}
+		}
+
+		return saveAccounts(ctx, s.accountRepo, accounts)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
+
+func (s *TransactionService) DeleteTransaction(ctx context.Context, id string) error {
+	return s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		// Deleting either leg of a transfer deletes the whole transfer
+		legs, err := s.getLegs(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		accountIDs := make([]string, 0, len(legs))
+		for _, leg := range legs {
+			accountIDs = append(accountIDs, leg.AccountID)
+		}
+		accounts, err := lockAccounts(ctx, s.accountRepo, accountIDs...)
+		if err != nil {
+			return err
+		}
+
+		for _, leg := range legs {
+			if err := applyBalanceEffect(accounts[leg.AccountID], leg, true); err != nil {
+				return err
+			}
+			if err := s.transactionRepo.Delete(ctx, leg.ID); err != nil {
+				return fmt.Errorf("deleting transaction: %w", err)
+			}
+		}
+
+		return saveAccounts(ctx, s.accountRepo, accounts)
+	})
+}
+
+// getLegs returns the transaction with the given ID followed by the other leg
+// of its transfer, if it belongs to one.
+func (s *TransactionService) getLegs(ctx context.Context, id string) ([]*entity.Transaction, error) {
 	transaction, err := s.transactionRepo.GetByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("getting transaction: %w", err)
@@ -98,38 +429,102 @@ func (s *TransactionService) UpdateTransaction(ctx context.Context, id string, a
 		return nil, domainerrors.NewErrNotFound("transaction", id)
 	}
 
-	// TODO: In production, recalculate account balance if amount or type changed
+	legs := []*entity.Transaction{transaction}
+	if transaction.TransferID == "" {
+		return legs, nil
+	}
 
-	if amount > 0 {
-		transaction.Amount = amount
+	transferLegs, err := s.transactionRepo.ListByTransferID(ctx, transaction.TransferID)
+	if err != nil {
+		return nil, fmt.Errorf("getting transfer legs: %w", err)
 	}
-	if currency != "" {
-		transaction.Currency = currency
+	for _, leg := range transferLegs {
+		if leg.ID != transaction.ID {
+			legs = append(legs, leg)
+		}
 	}
-	if description != "" {
-		transaction.Description = description
+
+	return legs, nil
+}
+
+// validateTransactionFilter checks that the filter's bounds are consistent and
+// its amounts are representable in the account's currency.
+func validateTransactionFilter(filter entity.TransactionFilter, currency string) error {
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		return domainerrors.NewErrInvalidInput("to", "to must be after from")
 	}
-	if category != "" {
-		transaction.Category = category
+	if filter.Type != "" && !slices.Contains(constant.TransactionTypes, filter.Type) {
+		return domainerrors.NewErrInvalidInput("type", fmt.Sprintf("unknown transaction type %q", filter.Type))
 	}
-	if transactionType != "" {
-		transaction.Type = transactionType
+	if filter.Sort != "" && !slices.Contains(constant.TransactionSorts, filter.Sort) {
+		return domainerrors.NewErrInvalidInput("sort", fmt.Sprintf("unknown sort %q", filter.Sort))
 	}
-	if !date.IsZero() {
-		transaction.Date = date
+	if filter.TagMatch != "" && !slices.Contains(constant.TagMatches, filter.TagMatch) {
+		return domainerrors.NewErrInvalidInput("tag_match", fmt.Sprintf("unknown tag match %q", filter.TagMatch))
 	}
 
-	if err := s.transactionRepo.Update(ctx, transaction); err != nil {
-		return nil, fmt.Errorf("updating transaction: %w", err)
+	var bounds []money.Money
+	for _, bound := range []struct{ field, value string }{
+		{"min_amount", filter.MinAmount},
+		{"max_amount", filter.MaxAmount},
+	} {
+		if bound.value == "" {
+			continue
+		}
+		amount, err := money.Parse(bound.value, currency)
+		if err != nil {
+			return domainerrors.NewErrInvalidInput(bound.field, fmt.Sprintf("%s is not a valid %s amount", bound.field, currency))
+		}
+		if amount.IsNegative() {
+			return domainerrors.NewErrInvalidInput(bound.field, bound.field+" must not be negative")
+		}
+		bounds = append(bounds, amount)
+	}
+	if len(bounds) == 2 {
+		if cmp, _ := bounds[0].Cmp(bounds[1]); cmp > 0 {
+			return domainerrors.NewErrInvalidInput("max_amount", "max_amount must not be less than min_amount")
+		}
 	}
 
-	return transaction, nil
+	return nil
 }
 
-func (s *TransactionService) DeleteTransaction(ctx context.Context, id string) error {
-	// TODO: In production, update account balance when deleting transaction
-	return s.transactionRepo.Delete(ctx, id)
+// maxTagLength is the longest tag name, in characters.
+const maxTagLength = 50
+
+// normalizeTags trims and lowercases tag names, dropping repeats, and returns
+// them sorted. A nil slice stays nil so that updates can tell it apart from
+// clearing the tags. Errors are reported on field.
+func normalizeTags(field string, tags []string) ([]string, error) {
+	if tags == nil {
+		return nil, nil
+	}
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			return nil, domainerrors.NewErrInvalidInput(field, "tags must not be empty")
+		}
+		if utf8.RuneCountInString(tag) > maxTagLength {
+			return nil, domainerrors.NewErrInvalidInput(field, fmt.Sprintf("tag %q is longer than %d characters", tag, maxTagLength))
+		}
+		if strings.Contains(tag, ",") {
+			return nil, domainerrors.NewErrInvalidInput(field, fmt.Sprintf("tag %q must not contain a comma", tag))
+		}
+		normalized = append(normalized, tag)
+	}
+	slices.Sort(normalized)
+	return slices.Compact(normalized), nil
 }
 
+// maxExternalIDLength is the longest external ID, in bytes.
+const maxExternalIDLength = 255
+
+// errExternalIDTooLong rejects external IDs longer than maxExternalIDLength.
+var errExternalIDTooLong = domainerrors.NewErrInvalidInput("external_id", fmt.Sprintf("external ID must be at most %d characters", maxExternalIDLength))
+
+// errTransferViaTransactions rejects attempts to create transfer legs one at a time.
+var errTransferViaTransactions = domainerrors.NewErrInvalidInput("type", "transfers must be created through the transfers endpoint")
+
 // Compile-time interface check
 var _ interfaces.TransactionService = (*TransactionService)(nil)
@@ -3,12 +3,15 @@ package service
 import (
 	"context"
 	"errors"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
 	"accounting/internal/domain/constant"
 	"accounting/internal/domain/entity"
 	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/money"
 )
 
 func TestCreateTransactionSuccess(t *testing.T) {
@@ -17,18 +20,21 @@ func TestCreateTransactionSuccess(t *testing.T) {
 	accountRepo := &MockAccountRepository{
 		accountToReturn: testAccount,
 	}
-	service := NewTransactionService(transactionRepo, accountRepo)
+	service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
 
 	transactionDate := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
 	transaction, err := service.CreateTransaction(
 		context.Background(),
 		"test-account-123",
-		50.00,
-		"USD",
+		money.MustParse("50.00", "USD"),
 		"Grocery store",
-		"Food",
+		"",
+		"category-food",
+		nil,
+		nil,
 		constant.TransactionTypeExpense,
 		transactionDate,
+		false,
 	)
 
 	if err != nil {
@@ -43,8 +49,8 @@ func TestCreateTransactionSuccess(t *testing.T) {
 		t.Errorf("expected account ID %q, got %q", "test-account-123", transaction.AccountID)
 	}
 
-	if transaction.Amount != 50.00 {
-		t.Errorf("expected amount 50.00, got %f", transaction.Amount)
+	if transaction.Amount != money.MustParse("50.00", "USD") {
+		t.Errorf("expected amount 50.00, got %s", transaction.Amount)
 	}
 
 	if transaction.Description != "Grocery store" {
@@ -66,76 +72,320 @@ func TestCreateTransactionSuccess(t *testing.T) {
 
 func TestCreateTransactionIncomeUpdatesBalance(t *testing.T) {
 	testAccount := NewTestAccount()
-	testAccount.Balance = 1000.00
+	testAccount.Balance = money.MustParse("1000.00", "USD")
 	transactionRepo := &MockTransactionRepository{}
 	accountRepo := &MockAccountRepository{
 		accountToReturn: testAccount,
 	}
-	service := NewTransactionService(transactionRepo, accountRepo)
+	service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
 
 	_, err := service.CreateTransaction(
 		context.Background(),
 		"test-account-123",
-		250.00,
-		"USD",
+		money.MustParse("250.00", "USD"),
 		"Salary",
-		"Income",
+		"",
+		"category-salary",
+		nil,
+		nil,
 		constant.TransactionTypeIncome,
 		time.Now(),
+		false,
 	)
 
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
 
-	if testAccount.Balance != 1250.00 {
-		t.Errorf("expected balance 1250.00 after income, got %f", testAccount.Balance)
+	if testAccount.Balance != money.MustParse("1250.00", "USD") {
+		t.Errorf("expected balance 1250.00 after income, got %s", testAccount.Balance)
 	}
 }
 
 func TestCreateTransactionExpenseUpdatesBalance(t *testing.T) {
 	testAccount := NewTestAccount()
-	testAccount.Balance = 1000.00
+	testAccount.Balance = money.MustParse("1000.00", "USD")
 	transactionRepo := &MockTransactionRepository{}
 	accountRepo := &MockAccountRepository{
 		accountToReturn: testAccount,
 	}
-	service := NewTransactionService(transactionRepo, accountRepo)
+	service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
 
 	_, err := service.CreateTransaction(
 		context.Background(),
 		"test-account-123",
-		150.00,
-		"USD",
+		money.MustParse("150.00", "USD"),
 		"Gas",
-		"Transport",
+		"",
+		"category-transport",
+		nil,
+		nil,
+		constant.TransactionTypeExpense,
+		time.Now(),
+		false,
+	)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if testAccount.Balance != money.MustParse("850.00", "USD") {
+		t.Errorf("expected balance 850.00 after expense, got %s", testAccount.Balance)
+	}
+}
+
+func TestCreateTransactionCommitsInsertAndBalanceTogether(t *testing.T) {
+	testAccount := NewTestAccount()
+	transactionRepo := &MockTransactionRepository{}
+	accountRepo := &MockAccountRepository{
+		accountToReturn: testAccount,
+	}
+	txManager := &MockTransactionManager{}
+	service := NewTransactionService(transactionRepo, accountRepo, txManager, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+
+	_, err := service.CreateTransaction(
+		context.Background(),
+		"test-account-123",
+		money.MustParse("50.00", "USD"),
+		"Grocery store",
+		"",
+		"category-food",
+		nil,
+		nil,
 		constant.TransactionTypeExpense,
 		time.Now(),
+		false,
 	)
 
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
 
-	if testAccount.Balance != 850.00 {
-		t.Errorf("expected balance 850.00 after expense, got %f", testAccount.Balance)
+	if txManager.commits != 1 {
+		t.Errorf("expected 1 commit, got %d", txManager.commits)
+	}
+
+	if accountRepo.getByIDForUpdateCalls != 1 {
+		t.Errorf("expected account row to be locked once, got %d", accountRepo.getByIDForUpdateCalls)
+	}
+
+	if len(transactionRepo.created) != 1 {
+		t.Errorf("expected 1 persisted transaction, got %d", len(transactionRepo.created))
+	}
+}
+
+func TestCreateTransactionBalanceUpdateFailureLeavesNoOrphan(t *testing.T) {
+	testAccount := NewTestAccount()
+	transactionRepo := &MockTransactionRepository{}
+	accountRepo := &MockAccountRepository{
+		accountToReturn: testAccount,
+		lastUpdateErr:   errors.New("connection reset"),
+	}
+	txManager := &MockTransactionManager{}
+	service := NewTransactionService(transactionRepo, accountRepo, txManager, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+
+	transaction, err := service.CreateTransaction(
+		context.Background(),
+		"test-account-123",
+		money.MustParse("50.00", "USD"),
+		"Grocery store",
+		"",
+		"category-food",
+		nil,
+		nil,
+		constant.TransactionTypeExpense,
+		time.Now(),
+		false,
+	)
+
+	if err == nil {
+		t.Fatal("expected error when balance update fails")
+	}
+
+	if transaction != nil {
+		t.Error("expected nil transaction when balance update fails")
+	}
+
+	if transactionRepo.createCalls != 1 {
+		t.Errorf("expected insert to be attempted once, got %d", transactionRepo.createCalls)
+	}
+
+	if txManager.rollbacks != 1 || txManager.commits != 0 {
+		t.Errorf("expected 1 rollback and no commit, got %d rollbacks and %d commits", txManager.rollbacks, txManager.commits)
+	}
+
+	if len(transactionRepo.created) != 0 {
+		t.Errorf("expected no orphan transaction, got %d", len(transactionRepo.created))
+	}
+}
+
+func TestCreateImportedTransactionRejectsDuplicateExternalID(t *testing.T) {
+	transactionRepo := &MockTransactionRepository{}
+	accountRepo := &MockAccountRepository{accountToReturn: NewTestAccount()}
+	service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+	date := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	first, err := service.CreateImportedTransaction(context.Background(), "test-account-123", "FITID-1", money.MustParse("10.00", "USD"), "Coffee", "", "", nil, nil, constant.TransactionTypeExpense, date, false)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if first.ExternalID != "FITID-1" {
+		t.Errorf("expected external ID %q, got %q", "FITID-1", first.ExternalID)
+	}
+
+	_, err = service.CreateImportedTransaction(context.Background(), "test-account-123", "FITID-1", money.MustParse("10.00", "USD"), "Coffee", "", "", nil, nil, constant.TransactionTypeExpense, date, false)
+	var duplicateErr *domainerrors.ErrDuplicateTransaction
+	if !errors.As(err, &duplicateErr) {
+		t.Fatalf("expected ErrDuplicateTransaction, got %v", err)
+	}
+	if duplicateErr.ExistingID != first.ID {
+		t.Errorf("expected existing transaction %s, got %s", first.ID, duplicateErr.ExistingID)
+	}
+	if len(transactionRepo.created) != 1 || accountRepo.accountToReturn.Balance != money.MustParse("990.00", "USD") {
+		t.Errorf("expected the duplicate to leave no trace, got %d transactions and balance %s", len(transactionRepo.created), accountRepo.accountToReturn.Balance)
+	}
+
+	_, err = service.CreateImportedTransaction(context.Background(), "test-account-123", "", money.MustParse("10.00", "USD"), "Coffee", "", "", nil, nil, constant.TransactionTypeExpense, date, false)
+	var invalidErr *domainerrors.ErrInvalidInput
+	if !errors.As(err, &invalidErr) || invalidErr.Field != "external_id" {
+		t.Errorf("expected invalid external_id, got %v", err)
+	}
+}
+
+func TestCreateTransactionRejectsLikelyDuplicate(t *testing.T) {
+	transactionRepo := &MockTransactionRepository{}
+	accountRepo := &MockAccountRepository{accountToReturn: NewTestAccount()}
+	converter := newTestConverter(&entity.ExchangeRate{
+		BaseCurrency:  "EUR",
+		QuoteCurrency: "USD",
+		Date:          time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		Rate:          money.MustParseRate("1.1"),
+	})
+	service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, converter, newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+	date := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+	create := func(amount money.Money, description string, transactionType constant.TransactionType, date time.Time, allowDuplicate bool) (*entity.Transaction, error) {
+		return service.CreateTransaction(context.Background(), "test-account-123", amount, description, "", "category-other", nil, nil, transactionType, date, allowDuplicate)
+	}
+
+	groceries, err := create(money.MustParse("50.00", "USD"), "Grocery store", constant.TransactionTypeExpense, date, false)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	dinner, err := create(money.MustParse("40.00", "EUR"), "Dinner", constant.TransactionTypeExpense, date, false)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	duplicates := []struct {
+		name        string
+		amount      money.Money
+		description string
+		date        time.Time
+		existingID  string
+	}{
+		{"same transaction", money.MustParse("50.00", "USD"), "Grocery store", date, groceries.ID},
+		{"description differing in case and punctuation", money.MustParse("50.00", "USD"), "  GROCERY-store. ", date.AddDate(0, 0, 3), groceries.ID},
+		{"amount in the same other currency", money.MustParse("40.00", "EUR"), "dinner", date.AddDate(0, 0, -3), dinner.ID},
+		{"amount as converted into the account currency", money.MustParse("44.00", "USD"), "Dinner", date, dinner.ID},
+	}
+	for _, tt := range duplicates {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := create(tt.amount, tt.description, constant.TransactionTypeExpense, tt.date, false)
+			var duplicateErr *domainerrors.ErrDuplicateTransaction
+			if !errors.As(err, &duplicateErr) {
+				t.Fatalf("expected ErrDuplicateTransaction, got %v", err)
+			}
+			if duplicateErr.ExistingID != tt.existingID || duplicateErr.ExternalID != "" {
+				t.Errorf("expected a likely duplicate of %s, got %+v", tt.existingID, duplicateErr)
+			}
+		})
+	}
+	if len(transactionRepo.created) != 2 {
+		t.Fatalf("expected the duplicates to leave no trace, got %d transactions", len(transactionRepo.created))
+	}
+
+	distinct := []struct {
+		name            string
+		amount          money.Money
+		description     string
+		transactionType constant.TransactionType
+		date            time.Time
+	}{
+		{"other amount", money.MustParse("50.01", "USD"), "Grocery store", constant.TransactionTypeExpense, date},
+		{"other description", money.MustParse("50.00", "USD"), "Grocery store 2", constant.TransactionTypeExpense, date},
+		{"other type", money.MustParse("50.00", "USD"), "Grocery store", constant.TransactionTypeIncome, date},
+		{"outside the date window", money.MustParse("50.00", "USD"), "Grocery store", constant.TransactionTypeExpense, date.AddDate(0, 0, DuplicateWindowDays+1)},
+	}
+	for _, tt := range distinct {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := create(tt.amount, tt.description, tt.transactionType, tt.date, false); err != nil {
+				t.Errorf("expected no error, got %v", err)
+			}
+		})
+	}
+
+	// The client may record a duplicate on purpose
+	if _, err := create(money.MustParse("50.00", "USD"), "Grocery store", constant.TransactionTypeExpense, date, true); err != nil {
+		t.Errorf("expected the duplicate to be allowed, got %v", err)
+	}
+}
+
+func TestFindDuplicatesIgnoresTransactionsWithOtherExternalIDs(t *testing.T) {
+	transactionRepo := &MockTransactionRepository{}
+	accountRepo := &MockAccountRepository{accountToReturn: NewTestAccount()}
+	service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+	date := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	amount := money.MustParse("3.50", "USD")
+
+	imported, err := service.CreateImportedTransaction(context.Background(), "test-account-123", "FITID-1", amount, "Coffee", "", "", nil, nil, constant.TransactionTypeExpense, date, false)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// The bank tells two coffees on the same day apart by their IDs
+	duplicates, err := service.FindDuplicates(context.Background(), "test-account-123", "FITID-2", amount, "Coffee", constant.TransactionTypeExpense, date)
+	if err != nil || len(duplicates) != 0 {
+		t.Errorf("expected no duplicates for another external ID, got %v, %v", duplicates, err)
+	}
+	// A coffee entered by hand may be the one the bank imported
+	duplicates, err = service.FindDuplicates(context.Background(), "test-account-123", "", amount, "coffee", constant.TransactionTypeExpense, date.AddDate(0, 0, 1))
+	if err != nil || len(duplicates) != 1 || duplicates[0].ID != imported.ID {
+		t.Errorf("expected the imported transaction as duplicate, got %v, %v", duplicates, err)
+	}
+}
+
+func TestNormalizeDescription(t *testing.T) {
+	tests := map[string]string{
+		"Grocery store":       "grocery store",
+		"  GROCERY-store. ":   "grocery store",
+		"Café\tDu  Monde #12": "café du monde 12",
+		"":                    "",
+		"***":                 "",
+	}
+	for description, want := range tests {
+		if got := normalizeDescription(description); got != want {
+			t.Errorf("normalizeDescription(%q) = %q, want %q", description, got, want)
+		}
 	}
 }
 
 func TestCreateTransactionAccountNotFound(t *testing.T) {
 	transactionRepo := &MockTransactionRepository{}
 	accountRepo := &MockAccountRepository{}
-	service := NewTransactionService(transactionRepo, accountRepo)
+	service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
 
 	transaction, err := service.CreateTransaction(
 		context.Background(),
 		"nonexistent-account",
-		50.00,
-		"USD",
+		money.MustParse("50.00", "USD"),
 		"Test",
+		"",
 		"Test",
+		nil,
+		nil,
 		constant.TransactionTypeExpense,
 		time.Now(),
+		false,
 	)
 
 	if transaction != nil {
@@ -151,17 +401,20 @@ func TestCreateTransactionAccountNotFound(t *testing.T) {
 func TestCreateTransactionInvalidAccountID(t *testing.T) {
 	transactionRepo := &MockTransactionRepository{}
 	accountRepo := &MockAccountRepository{}
-	service := NewTransactionService(transactionRepo, accountRepo)
+	service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
 
 	transaction, err := service.CreateTransaction(
 		context.Background(),
 		"",
-		50.00,
-		"USD",
+		money.MustParse("50.00", "USD"),
 		"Test",
+		"",
 		"Test",
+		nil,
+		nil,
 		constant.TransactionTypeExpense,
 		time.Now(),
+		false,
 	)
 
 	if transaction != nil {
@@ -180,17 +433,20 @@ func TestCreateTransactionInvalidAmount(t *testing.T) {
 	accountRepo := &MockAccountRepository{
 		accountToReturn: testAccount,
 	}
-	service := NewTransactionService(transactionRepo, accountRepo)
+	service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
 
 	transaction, err := service.CreateTransaction(
 		context.Background(),
 		"test-account-123",
-		-50.00,
-		"USD",
+		money.MustParse("-50.00", "USD"),
 		"Test",
+		"",
 		"Test",
+		nil,
+		nil,
 		constant.TransactionTypeExpense,
 		time.Now(),
+		false,
 	)
 
 	if transaction != nil {
@@ -209,17 +465,20 @@ func TestCreateTransactionInvalidCurrency(t *testing.T) {
 	accountRepo := &MockAccountRepository{
 		accountToReturn: testAccount,
 	}
-	service := NewTransactionService(transactionRepo, accountRepo)
+	service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
 
 	transaction, err := service.CreateTransaction(
 		context.Background(),
 		"test-account-123",
-		50.00,
-		"",
+		money.MustParse("50.00", ""),
 		"Test",
+		"",
 		"Test",
+		nil,
+		nil,
 		constant.TransactionTypeExpense,
 		time.Now(),
+		false,
 	)
 
 	if transaction != nil {
@@ -232,13 +491,305 @@ func TestCreateTransactionInvalidCurrency(t *testing.T) {
 	}
 }
 
+func TestCreateTransactionInvalidCategory(t *testing.T) {
+	categoryRepo := newTestCategories()
+	otherCategory := NewTestCategory("category-other-user", "Elsewhere", "")
+	otherCategory.UserID = "other-user-456"
+	categoryRepo.Create(context.Background(), otherCategory)
+
+	tests := []struct {
+		name       string
+		categoryID string
+	}{
+		{"archived", "category-archived"},
+		{"for another type", "category-salary"},
+		{"of another user", otherCategory.ID},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transactionRepo := &MockTransactionRepository{}
+			accountRepo := &MockAccountRepository{accountToReturn: NewTestAccount()}
+			service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), categoryRepo, &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+
+			_, err := service.CreateTransaction(context.Background(), "test-account-123", money.MustParse("50.00", "USD"), "Groceries", "", tt.categoryID, nil, nil, constant.TransactionTypeExpense, time.Now(), false)
+
+			var invalidErr *domainerrors.ErrInvalidInput
+			if !errors.As(err, &invalidErr) || invalidErr.Field != "category_id" {
+				t.Fatalf("expected invalid category_id, got %v", err)
+			}
+			if len(transactionRepo.created) != 0 {
+				t.Error("expected no transaction to be created")
+			}
+		})
+	}
+}
+
+func TestCreateTransactionNormalizesTags(t *testing.T) {
+	transactionRepo := &MockTransactionRepository{}
+	accountRepo := &MockAccountRepository{accountToReturn: NewTestAccount()}
+	service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+
+	tags := []string{" Vacation-2026 ", "reimbursable", "REIMBURSABLE"}
+	transaction, err := service.CreateTransaction(context.Background(), "test-account-123", money.MustParse("50.00", "USD"), "Hotel", "", "category-housing", tags, nil, constant.TransactionTypeExpense, time.Now(), false)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if want := []string{"reimbursable", "vacation-2026"}; !slices.Equal(transaction.Tags, want) {
+		t.Errorf("expected tags %v, got %v", want, transaction.Tags)
+	}
+	if len(transactionRepo.created) != 1 || !slices.Equal(transactionRepo.created[0].Tags, transaction.Tags) {
+		t.Errorf("expected the tags to be stored, got %+v", transactionRepo.created)
+	}
+}
+
+func TestCreateTransactionInvalidTags(t *testing.T) {
+	tests := []struct {
+		name string
+		tags []string
+	}{
+		{"empty", []string{"reimbursable", " "}},
+		{"comma", []string{"tax,deductible"}},
+		{"too long", []string{strings.Repeat("x", 51)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transactionRepo := &MockTransactionRepository{}
+			accountRepo := &MockAccountRepository{accountToReturn: NewTestAccount()}
+			service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+
+			_, err := service.CreateTransaction(context.Background(), "test-account-123", money.MustParse("50.00", "USD"), "Hotel", "", "", tt.tags, nil, constant.TransactionTypeExpense, time.Now(), false)
+
+			var invalidErr *domainerrors.ErrInvalidInput
+			if !errors.As(err, &invalidErr) || invalidErr.Field != "tags" {
+				t.Fatalf("expected invalid tags, got %v", err)
+			}
+			if len(transactionRepo.created) != 0 {
+				t.Error("expected no transaction to be created")
+			}
+		})
+	}
+}
+
+func newTestSplits(amounts ...string) []*entity.Split {
+	categories := []string{"category-food", "category-housing", "category-transport"}
+	splits := make([]*entity.Split, len(amounts))
+	for i, amount := range amounts {
+		splits[i] = &entity.Split{Amount: money.MustParse(amount, "USD"), CategoryID: categories[i%len(categories)]}
+	}
+	return splits
+}
+
+func TestCreateTransactionWithSplits(t *testing.T) {
+	transactionRepo := &MockTransactionRepository{}
+	accountRepo := &MockAccountRepository{accountToReturn: NewTestAccount()}
+	service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+
+	splits := newTestSplits("30.00", "20.00")
+	splits[1].Memo = "Detergent"
+	transaction, err := service.CreateTransaction(context.Background(), "test-account-123", money.MustParse("50.00", "USD"), "Supermarket", "", "", nil, splits, constant.TransactionTypeExpense, time.Now(), false)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if transaction.CategoryID != "" || len(transaction.Splits) != 2 {
+		t.Fatalf("expected two splits and no category, got %+v", transaction)
+	}
+	for i, want := range []entity.Split{
+		{Amount: money.MustParse("30.00", "USD"), CategoryID: "category-food", Category: "Food"},
+		{Amount: money.MustParse("20.00", "USD"), CategoryID: "category-housing", Category: "Housing", Memo: "Detergent"},
+	} {
+		split := transaction.Splits[i]
+		if split.ID == "" || split.Amount != want.Amount || split.CategoryID != want.CategoryID || split.Category != want.Category || split.Memo != want.Memo {
+			t.Errorf("split %d: expected %+v, got %+v", i, want, split)
+		}
+	}
+	if split := transaction.Splits[0]; split == splits[0] {
+		t.Error("expected the splits to be copied")
+	}
+	if len(transactionRepo.created) != 1 || len(transactionRepo.created[0].Splits) != 2 {
+		t.Errorf("expected the splits to be stored, got %+v", transactionRepo.created)
+	}
+}
+
+func TestCreateTransactionInvalidSplits(t *testing.T) {
+	tests := []struct {
+		name       string
+		categoryID string
+		splits     []*entity.Split
+		field      string
+	}{
+		{"single split", "", newTestSplits("50.00"), "splits"},
+		{"wrong total", "", newTestSplits("30.00", "19.99"), "splits"},
+		{"zero amount", "", newTestSplits("50.00", "0.00"), "splits"},
+		{"other currency", "", []*entity.Split{{Amount: money.MustParse("30.00", "USD")}, {Amount: money.MustParse("20.00", "EUR")}}, "splits"},
+		{"memo too long", "", []*entity.Split{{Amount: money.MustParse("30.00", "USD")}, {Amount: money.MustParse("20.00", "USD"), Memo: strings.Repeat("x", 256)}}, "splits"},
+		{"category for another type", "", []*entity.Split{{Amount: money.MustParse("30.00", "USD"), CategoryID: "category-salary"}, {Amount: money.MustParse("20.00", "USD")}}, "splits"},
+		{"category on the transaction", "category-food", newTestSplits("30.00", "20.00"), "category_id"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transactionRepo := &MockTransactionRepository{}
+			accountRepo := &MockAccountRepository{accountToReturn: NewTestAccount()}
+			service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+
+			_, err := service.CreateTransaction(context.Background(), "test-account-123", money.MustParse("50.00", "USD"), "Supermarket", "", tt.categoryID, nil, tt.splits, constant.TransactionTypeExpense, time.Now(), false)
+
+			var invalidErr *domainerrors.ErrInvalidInput
+			if !errors.As(err, &invalidErr) || invalidErr.Field != tt.field {
+				t.Fatalf("expected invalid %s, got %v", tt.field, err)
+			}
+			if len(transactionRepo.created) != 0 {
+				t.Error("expected no transaction to be created")
+			}
+		})
+	}
+}
+
+func TestCreateTransactionAssignsPayee(t *testing.T) {
+	tests := []struct {
+		name             string
+		amount           string
+		description      string
+		payeeID          string
+		categoryID       string
+		transactionType  constant.TransactionType
+		expectedPayee    string
+		expectedCategory string
+	}{
+		{
+			name:             "matched by alias",
+			amount:           "42.10",
+			description:      "CARD PAYMENT TESCO STORES 3021",
+			transactionType:  constant.TransactionTypeExpense,
+			expectedPayee:    "Tesco",
+			expectedCategory: "Food",
+		},
+		{
+			name:             "given payee",
+			amount:           "3.20",
+			description:      "Coffee with Sam",
+			payeeID:          "payee-coffee",
+			transactionType:  constant.TransactionTypeExpense,
+			expectedPayee:    "Starbucks",
+			expectedCategory: "Coffee",
+		},
+		{
+			name:             "category given",
+			amount:           "42.10",
+			description:      "Tesco",
+			categoryID:       "category-shopping",
+			transactionType:  constant.TransactionTypeExpense,
+			expectedPayee:    "Tesco",
+			expectedCategory: "Shopping",
+		},
+		{
+			name:             "category set by a rule",
+			amount:           "600.00",
+			description:      "TESCO EXPRESS 1234",
+			transactionType:  constant.TransactionTypeExpense,
+			expectedPayee:    "Tesco",
+			expectedCategory: "Large",
+		},
+		{
+			name:            "default category of another type",
+			amount:          "12.00",
+			description:     "Tesco refund",
+			transactionType: constant.TransactionTypeIncome,
+			expectedPayee:   "Tesco",
+		},
+		{
+			name:            "no match",
+			amount:          "12.00",
+			description:     "Corner shop",
+			transactionType: constant.TransactionTypeExpense,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transactionRepo := &MockTransactionRepository{}
+			accountRepo := &MockAccountRepository{accountToReturn: NewTestAccount()}
+			service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), newTestRules(), newTestPayees())
+
+			transaction, err := service.CreateTransaction(context.Background(), "test-account-123", money.MustParse(tt.amount, "USD"), tt.description, tt.payeeID, tt.categoryID, nil, nil, tt.transactionType, time.Now(), false)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if transaction.Payee != tt.expectedPayee || transaction.Category != tt.expectedCategory {
+				t.Errorf("expected %q %q, got %q %q", tt.expectedPayee, tt.expectedCategory, transaction.Payee, transaction.Category)
+			}
+			if created := transactionRepo.created[0]; (created.PayeeID == "") != (tt.expectedPayee == "") {
+				t.Errorf("expected the payee to be stored, got %q", created.PayeeID)
+			}
+		})
+	}
+}
+
+func TestCreateTransactionPayeeOfAnotherUser(t *testing.T) {
+	transactionRepo := &MockTransactionRepository{}
+	accountRepo := &MockAccountRepository{accountToReturn: NewTestAccount()}
+	payeeRepo := newMockPayeeRepository(&entity.Payee{ID: "payee-other-user", UserID: "other-user-456", Name: "Elsewhere"})
+	service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, payeeRepo)
+
+	_, err := service.CreateTransaction(context.Background(), "test-account-123", money.MustParse("10.00", "USD"), "Elsewhere", "payee-other-user", "", nil, nil, constant.TransactionTypeExpense, time.Now(), false)
+
+	var invalidErr *domainerrors.ErrInvalidInput
+	if !errors.As(err, &invalidErr) || invalidErr.Field != "payee_id" {
+		t.Fatalf("expected invalid payee_id, got %v", err)
+	}
+	if len(transactionRepo.created) != 0 {
+		t.Error("expected no transaction to be created")
+	}
+}
+
+func TestCreateTransactionWithoutExchangeRate(t *testing.T) {
+	testAccount := NewTestAccount()
+	transactionRepo := &MockTransactionRepository{}
+	accountRepo := &MockAccountRepository{
+		accountToReturn: testAccount,
+	}
+	service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+
+	transaction, err := service.CreateTransaction(
+		context.Background(),
+		"test-account-123",
+		money.MustParse("50.00", "EUR"),
+		"Test",
+		"",
+		"Test",
+		nil,
+		nil,
+		constant.TransactionTypeExpense,
+		time.Now(),
+		false,
+	)
+
+	if transaction != nil {
+		t.Error("expected nil transaction when no exchange rate is known")
+	}
+
+	var invalidErr *domainerrors.ErrInvalidInput
+	if !errors.As(err, &invalidErr) {
+		t.Errorf("expected ErrInvalidInput, got %T", err)
+	}
+
+	if transactionRepo.createCalls != 0 {
+		t.Errorf("expected no create call, got %d", transactionRepo.createCalls)
+	}
+}
+
 func TestGetTransactionSuccess(t *testing.T) {
 	testTransaction := NewTestTransaction()
 	transactionRepo := &MockTransactionRepository{
 		transactionToReturn: testTransaction,
 	}
 	accountRepo := &MockAccountRepository{}
-	service := NewTransactionService(transactionRepo, accountRepo)
+	service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
 
 	transaction, err := service.GetTransaction(context.Background(), "test-transaction-123")
 
@@ -262,7 +813,7 @@ func TestGetTransactionSuccess(t *testing.T) {
 func TestGetTransactionNotFound(t *testing.T) {
 	transactionRepo := &MockTransactionRepository{}
 	accountRepo := &MockAccountRepository{}
-	service := NewTransactionService(transactionRepo, accountRepo)
+	service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
 
 	transaction, err := service.GetTransaction(context.Background(), "nonexistent-transaction")
 
@@ -277,20 +828,26 @@ func TestGetTransactionNotFound(t *testing.T) {
 
 func TestUpdateTransactionSuccess(t *testing.T) {
 	testTransaction := NewTestTransaction()
+	testAccount := NewTestAccount()
 	transactionRepo := &MockTransactionRepository{
 		transactionToReturn: testTransaction,
 	}
-	accountRepo := &MockAccountRepository{}
-	service := NewTransactionService(transactionRepo, accountRepo)
+	accountRepo := &MockAccountRepository{
+		accountToReturn: testAccount,
+	}
+	service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
 
 	newDate := time.Date(2024, 2, 20, 14, 0, 0, 0, time.UTC)
 	updatedTransaction, err := service.UpdateTransaction(
 		context.Background(),
 		"test-transaction-123",
-		200.00,
-		"EUR",
+		"",
+		money.MustParse("200.00", "USD"),
 		"Updated description",
-		"Updated",
+		"",
+		"category-salary",
+		nil,
+		nil,
 		constant.TransactionTypeIncome,
 		newDate,
 	)
@@ -303,39 +860,172 @@ func TestUpdateTransactionSuccess(t *testing.T) {
 		t.Fatal("expected transaction, got nil")
 	}
 
-	if updatedTransaction.Amount != 200.00 {
-		t.Errorf("expected amount 200.00, got %f", updatedTransaction.Amount)
-	}
-
-	if updatedTransaction.Currency != "EUR" {
-		t.Errorf("expected currency %q, got %q", "EUR", updatedTransaction.Currency)
+	if updatedTransaction.Amount != money.MustParse("200.00", "USD") {
+		t.Errorf("expected amount 200.00, got %s", updatedTransaction.Amount)
 	}
 
 	if updatedTransaction.Type != constant.TransactionTypeIncome {
 		t.Errorf("expected type %q, got %q", constant.TransactionTypeIncome, updatedTransaction.Type)
 	}
 
+	// The 100.00 expense is reversed and the 200.00 income applied
+	if testAccount.Balance != money.MustParse("1300.00", "USD") {
+		t.Errorf("expected balance 1300.00, got %s", testAccount.Balance)
+	}
+
 	if transactionRepo.updateCalls != 1 {
 		t.Errorf("expected 1 update call, got %d", transactionRepo.updateCalls)
 	}
+
+	if accountRepo.updateCalls != 1 {
+		t.Errorf("expected 1 account update call, got %d", accountRepo.updateCalls)
+	}
+}
+
+func TestUpdateTransactionAmountRecalculatesBalance(t *testing.T) {
+	testTransaction := NewTestTransaction()
+	testTransaction.Amount = money.MustParse("50.00", "USD")
+	testAccount := NewTestAccount()
+	transactionRepo := &MockTransactionRepository{
+		transactionToReturn: testTransaction,
+	}
+	accountRepo := &MockAccountRepository{
+		accountToReturn: testAccount,
+	}
+	service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+
+	_, err := service.UpdateTransaction(
+		context.Background(),
+		"test-transaction-123",
+		"",
+		money.MustParse("500.00", "USD"),
+		"",
+		"",
+		"",
+		nil,
+		nil,
+		"",
+		time.Time{},
+	)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if testAccount.Balance != money.MustParse("550.00", "USD") {
+		t.Errorf("expected balance 550.00, got %s", testAccount.Balance)
+	}
+}
+
+func TestUpdateTransactionMoveToAnotherAccount(t *testing.T) {
+	testTransaction := NewTestTransaction()
+	source := NewTestAccount()
+	destination := NewTestAccount()
+	destination.ID = "test-account-456"
+	destination.Balance = money.MustParse("200.00", "USD")
+	transactionRepo := &MockTransactionRepository{
+		transactionToReturn: testTransaction,
+	}
+	accountRepo := &MockAccountRepository{
+		accountsToReturn: map[string]*entity.Account{
+			source.ID:      source,
+			destination.ID: destination,
+		},
+	}
+	service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+
+	updatedTransaction, err := service.UpdateTransaction(
+		context.Background(),
+		"test-transaction-123",
+		"test-account-456",
+		money.Money{},
+		"",
+		"",
+		"",
+		nil,
+		nil,
+		"",
+		time.Time{},
+	)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if updatedTransaction.AccountID != "test-account-456" {
+		t.Errorf("expected account ID %q, got %q", "test-account-456", updatedTransaction.AccountID)
+	}
+
+	if source.Balance != money.MustParse("1100.00", "USD") {
+		t.Errorf("expected source balance 1100.00, got %s", source.Balance)
+	}
+
+	if destination.Balance != money.MustParse("100.00", "USD") {
+		t.Errorf("expected destination balance 100.00, got %s", destination.Balance)
+	}
+
+	if accountRepo.updateCalls != 2 {
+		t.Errorf("expected 2 account update calls, got %d", accountRepo.updateCalls)
+	}
+}
+
+func TestUpdateTransactionWithoutExchangeRate(t *testing.T) {
+	testTransaction := NewTestTransaction()
+	transactionRepo := &MockTransactionRepository{
+		transactionToReturn: testTransaction,
+	}
+	accountRepo := &MockAccountRepository{
+		accountToReturn: NewTestAccount(),
+	}
+	txManager := &MockTransactionManager{}
+	service := NewTransactionService(transactionRepo, accountRepo, txManager, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+
+	_, err := service.UpdateTransaction(
+		context.Background(),
+		"test-transaction-123",
+		"",
+		money.MustParse("200.00", "EUR"),
+		"",
+		"",
+		"",
+		nil,
+		nil,
+		"",
+		time.Time{},
+	)
+
+	var invalidErr *domainerrors.ErrInvalidInput
+	if !errors.As(err, &invalidErr) {
+		t.Errorf("expected ErrInvalidInput, got %T", err)
+	}
+
+	if txManager.rollbacks != 1 {
+		t.Errorf("expected 1 rollback, got %d", txManager.rollbacks)
+	}
 }
 
 func TestUpdateTransactionPartial(t *testing.T) {
 	testTransaction := NewTestTransaction()
+	testAccount := NewTestAccount()
 	transactionRepo := &MockTransactionRepository{
 		transactionToReturn: testTransaction,
 	}
-	accountRepo := &MockAccountRepository{}
-	service := NewTransactionService(transactionRepo, accountRepo)
+	accountRepo := &MockAccountRepository{
+		accountToReturn: testAccount,
+	}
+	service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
 
 	updatedTransaction, err := service.UpdateTransaction(
 		context.Background(),
 		"test-transaction-123",
-		0,
 		"",
+		money.Money{},
 		"Updated description",
 		"",
 		"",
+		nil,
+		nil,
+		"",
 		time.Time{},
 	)
 
@@ -347,27 +1037,207 @@ func TestUpdateTransactionPartial(t *testing.T) {
 		t.Errorf("expected description %q, got %q", "Updated description", updatedTransaction.Description)
 	}
 
-	if updatedTransaction.Amount != testTransaction.Amount {
-		t.Errorf("expected amount to remain %f, got %f", testTransaction.Amount, updatedTransaction.Amount)
+	if updatedTransaction.Amount != money.MustParse("100.00", "USD") {
+		t.Errorf("expected amount to remain 100.00, got %s", updatedTransaction.Amount)
+	}
+
+	if updatedTransaction.Type != constant.TransactionTypeExpense {
+		t.Errorf("expected type to remain %q, got %q", constant.TransactionTypeExpense, updatedTransaction.Type)
+	}
+
+	if testAccount.Balance != money.MustParse("1000.00", "USD") {
+		t.Errorf("expected balance to remain 1000.00, got %s", testAccount.Balance)
+	}
+}
+
+func TestUpdateTransactionTags(t *testing.T) {
+	tests := []struct {
+		name string
+		tags []string
+		want []string
+	}{
+		{"nil keeps the tags", nil, []string{"reimbursable"}},
+		{"empty removes the tags", []string{}, []string{}},
+		{"replaces the tags", []string{"Tax-Deductible", "vacation-2026"}, []string{"tax-deductible", "vacation-2026"}},
 	}
 
-	if updatedTransaction.Type != testTransaction.Type {
-		t.Errorf("expected type to remain %q, got %q", testTransaction.Type, updatedTransaction.Type)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testTransaction := NewTestTransaction()
+			testTransaction.Tags = []string{"reimbursable"}
+			transactionRepo := &MockTransactionRepository{transactionToReturn: testTransaction}
+			accountRepo := &MockAccountRepository{accountToReturn: NewTestAccount()}
+			service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+
+			updated, err := service.UpdateTransaction(context.Background(), "test-transaction-123", "", money.Money{}, "", "", "", tt.tags, nil, "", time.Time{})
+
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if !slices.Equal(updated.Tags, tt.want) {
+				t.Errorf("expected tags %v, got %v", tt.want, updated.Tags)
+			}
+			if transactionRepo.updateCalls != 1 {
+				t.Errorf("expected 1 update call, got %d", transactionRepo.updateCalls)
+			}
+		})
+	}
+}
+
+func TestUpdateTransactionSplits(t *testing.T) {
+	tests := []struct {
+		name   string
+		splits []*entity.Split
+		want   []string
+	}{
+		{"nil keeps the splits", nil, []string{"60.00", "40.00"}},
+		{"empty removes the splits", []*entity.Split{}, nil},
+		{"replaces the splits", newTestSplits("50.00", "25.00", "25.00"), []string{"50.00", "25.00", "25.00"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testTransaction := NewTestTransaction()
+			testTransaction.CategoryID, testTransaction.Category = "", ""
+			testTransaction.Splits = newTestSplits("60.00", "40.00")
+			transactionRepo := &MockTransactionRepository{transactionToReturn: testTransaction}
+			accountRepo := &MockAccountRepository{accountToReturn: NewTestAccount()}
+			service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+
+			updated, err := service.UpdateTransaction(context.Background(), "test-transaction-123", "", money.Money{}, "", "", "", nil, tt.splits, "", time.Time{})
+
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if len(updated.Splits) != len(tt.want) {
+				t.Fatalf("expected %d splits, got %d", len(tt.want), len(updated.Splits))
+			}
+			for i, amount := range tt.want {
+				if want := money.MustParse(amount, "USD"); updated.Splits[i].Amount != want {
+					t.Errorf("split %d: expected %s, got %s", i, want, updated.Splits[i].Amount)
+				}
+			}
+			if transactionRepo.updateCalls != 1 {
+				t.Errorf("expected 1 update call, got %d", transactionRepo.updateCalls)
+			}
+		})
+	}
+}
+
+func TestUpdateTransactionSplitClearsCategory(t *testing.T) {
+	transactionRepo := &MockTransactionRepository{transactionToReturn: NewTestTransaction()}
+	accountRepo := &MockAccountRepository{accountToReturn: NewTestAccount()}
+	service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+
+	updated, err := service.UpdateTransaction(context.Background(), "test-transaction-123", "", money.Money{}, "", "", "", nil, newTestSplits("60.00", "40.00"), "", time.Time{})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if updated.CategoryID != "" || updated.Category != "" {
+		t.Errorf("expected the category to be cleared, got %q", updated.CategoryID)
+	}
+	if len(updated.Splits) != 2 || updated.Splits[1].Category != "Housing" {
+		t.Errorf("expected the splits to be set, got %+v", updated.Splits)
+	}
+}
+
+func TestUpdateTransactionAmountOfSplitTransaction(t *testing.T) {
+	testTransaction := NewTestTransaction()
+	testTransaction.CategoryID, testTransaction.Category = "", ""
+	testTransaction.Splits = newTestSplits("60.00", "40.00")
+	transactionRepo := &MockTransactionRepository{transactionToReturn: testTransaction}
+	accountRepo := &MockAccountRepository{accountToReturn: NewTestAccount()}
+	service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+
+	_, err := service.UpdateTransaction(context.Background(), "test-transaction-123", "", money.MustParse("120.00", "USD"), "", "", "", nil, nil, "", time.Time{})
+
+	var invalidErr *domainerrors.ErrInvalidInput
+	if !errors.As(err, &invalidErr) || invalidErr.Field != "splits" {
+		t.Fatalf("expected invalid splits, got %v", err)
+	}
+	if transactionRepo.updateCalls != 0 {
+		t.Errorf("expected no update call, got %d", transactionRepo.updateCalls)
+	}
+
+	splits := newTestSplits("70.00", "50.00")
+	updated, err := service.UpdateTransaction(context.Background(), "test-transaction-123", "", money.MustParse("120.00", "USD"), "", "", "", nil, splits, "", time.Time{})
+	if err != nil {
+		t.Fatalf("expected the amount to change with new splits, got %v", err)
+	}
+	if len(updated.Splits) != 2 || updated.Splits[0].Amount != money.MustParse("70.00", "USD") {
+		t.Errorf("expected the new splits, got %+v", updated.Splits)
+	}
+}
+
+func TestUpdateTransactionPayee(t *testing.T) {
+	testTransaction := NewTestTransaction()
+	testTransaction.CategoryID, testTransaction.Category = "", ""
+	transactionRepo := &MockTransactionRepository{transactionToReturn: testTransaction}
+	accountRepo := &MockAccountRepository{accountToReturn: NewTestAccount()}
+	service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, newTestPayees())
+
+	// Editing the description never matches the transaction to a payee again
+	updated, err := service.UpdateTransaction(context.Background(), "test-transaction-123", "", money.Money{}, "SBUX 0042", "", "", nil, nil, "", time.Time{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if updated.PayeeID != "" {
+		t.Errorf("expected no payee, got %q", updated.PayeeID)
+	}
+
+	// A payee given to an uncategorized transaction brings its default category
+	updated, err = service.UpdateTransaction(context.Background(), "test-transaction-123", "", money.Money{}, "", "payee-coffee", "", nil, nil, "", time.Time{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if updated.PayeeID != "payee-coffee" || updated.Payee != "Starbucks" || updated.Category != "Coffee" {
+		t.Errorf("expected Starbucks and Coffee, got %q %q", updated.Payee, updated.Category)
+	}
+
+	_, err = service.UpdateTransaction(context.Background(), "test-transaction-123", "", money.Money{}, "", "missing", "", nil, nil, "", time.Time{})
+	var notFoundErr *domainerrors.ErrNotFound
+	if !errors.As(err, &notFoundErr) {
+		t.Errorf("expected payee not found, got %v", err)
+	}
+}
+
+func TestUpdateTransactionTypeMustSuitCategory(t *testing.T) {
+	transactionRepo := &MockTransactionRepository{
+		transactionToReturn: NewTestTransaction(),
+	}
+	accountRepo := &MockAccountRepository{
+		accountToReturn: NewTestAccount(),
+	}
+	service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+
+	// Food is for expenses only
+	_, err := service.UpdateTransaction(context.Background(), "test-transaction-123", "", money.Money{}, "", "", "", nil, nil, constant.TransactionTypeIncome, time.Time{})
+
+	var invalidErr *domainerrors.ErrInvalidInput
+	if !errors.As(err, &invalidErr) || invalidErr.Field != "category_id" {
+		t.Fatalf("expected invalid category_id, got %v", err)
+	}
+	if transactionRepo.updateCalls != 0 {
+		t.Errorf("expected no update call, got %d", transactionRepo.updateCalls)
 	}
 }
 
 func TestUpdateTransactionNotFound(t *testing.T) {
 	transactionRepo := &MockTransactionRepository{}
 	accountRepo := &MockAccountRepository{}
-	service := NewTransactionService(transactionRepo, accountRepo)
+	service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
 
 	updatedTransaction, err := service.UpdateTransaction(
 		context.Background(),
 		"nonexistent-transaction",
-		200.00,
-		"EUR",
+		"",
+		money.MustParse("200.00", "EUR"),
 		"Updated",
+		"",
 		"Updated",
+		nil,
+		nil,
 		constant.TransactionTypeIncome,
 		time.Now(),
 	)
@@ -383,9 +1253,14 @@ func TestUpdateTransactionNotFound(t *testing.T) {
 }
 
 func TestDeleteTransactionSuccess(t *testing.T) {
-	transactionRepo := &MockTransactionRepository{}
-	accountRepo := &MockAccountRepository{}
-	service := NewTransactionService(transactionRepo, accountRepo)
+	testAccount := NewTestAccount()
+	transactionRepo := &MockTransactionRepository{
+		transactionToReturn: NewTestTransaction(),
+	}
+	accountRepo := &MockAccountRepository{
+		accountToReturn: testAccount,
+	}
+	service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
 
 	err := service.DeleteTransaction(context.Background(), "test-transaction-123")
 
@@ -396,6 +1271,28 @@ func TestDeleteTransactionSuccess(t *testing.T) {
 	if transactionRepo.deleteCalls != 1 {
 		t.Errorf("expected 1 delete call, got %d", transactionRepo.deleteCalls)
 	}
+
+	// Deleting the 100.00 expense restores the balance
+	if testAccount.Balance != money.MustParse("1100.00", "USD") {
+		t.Errorf("expected balance 1100.00, got %s", testAccount.Balance)
+	}
+}
+
+func TestDeleteTransactionNotFound(t *testing.T) {
+	transactionRepo := &MockTransactionRepository{}
+	accountRepo := &MockAccountRepository{}
+	service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+
+	err := service.DeleteTransaction(context.Background(), "nonexistent-transaction")
+
+	var notFoundErr *domainerrors.ErrNotFound
+	if !errors.As(err, &notFoundErr) {
+		t.Errorf("expected ErrNotFound, got %T", err)
+	}
+
+	if transactionRepo.deleteCalls != 0 {
+		t.Errorf("expected no delete call, got %d", transactionRepo.deleteCalls)
+	}
 }
 
 func TestListAccountTransactionsSuccess(t *testing.T) {
@@ -404,21 +1301,23 @@ func TestListAccountTransactionsSuccess(t *testing.T) {
 		{
 			ID:          "transaction-2",
 			AccountID:   "test-account-123",
-			Amount:      75.00,
-			Currency:    "USD",
+			Amount:      money.MustParse("75.00", "USD"),
 			Description: "Online purchase",
 			Date:        time.Now(),
 			Type:        constant.TransactionTypeExpense,
+			CategoryID:  "category-shopping",
 			Category:    "Shopping",
 		},
 	}
 	transactionRepo := &MockTransactionRepository{
 		transactionsListToReturn: transactions,
 	}
-	accountRepo := &MockAccountRepository{}
-	service := NewTransactionService(transactionRepo, accountRepo)
+	accountRepo := &MockAccountRepository{
+		accountToReturn: NewTestAccount(),
+	}
+	service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
 
-	result, err := service.ListAccountTransactions(context.Background(), "test-account-123")
+	result, nextCursor, err := service.ListAccountTransactions(context.Background(), "test-account-123", entity.TransactionFilter{}, entity.PageRequest{})
 
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
@@ -428,7 +1327,121 @@ func TestListAccountTransactionsSuccess(t *testing.T) {
 		t.Errorf("expected 2 transactions, got %d", len(result))
 	}
 
+	if nextCursor != "" {
+		t.Errorf("expected no next cursor, got %q", nextCursor)
+	}
+
+	if transactionRepo.lastPage.Limit != entity.DefaultPageLimit {
+		t.Errorf("expected default limit %d, got %d", entity.DefaultPageLimit, transactionRepo.lastPage.Limit)
+	}
+
 	if transactionRepo.listByAccountIDCalls != 1 {
 		t.Errorf("expected 1 listByAccountID call, got %d", transactionRepo.listByAccountIDCalls)
 	}
 }
+
+func TestListAccountTransactionsNegativeLimit(t *testing.T) {
+	transactionRepo := &MockTransactionRepository{}
+	service := NewTransactionService(transactionRepo, &MockAccountRepository{}, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+
+	_, _, err := service.ListAccountTransactions(context.Background(), "test-account-123", entity.TransactionFilter{}, entity.PageRequest{Limit: -1})
+
+	var invalidErr *domainerrors.ErrInvalidInput
+	if !errors.As(err, &invalidErr) {
+		t.Errorf("expected ErrInvalidInput, got %T", err)
+	}
+
+	if transactionRepo.listByAccountIDCalls != 0 {
+		t.Errorf("expected no listByAccountID call, got %d", transactionRepo.listByAccountIDCalls)
+	}
+}
+
+func TestListAccountTransactionsPassesFilter(t *testing.T) {
+	transactionRepo := &MockTransactionRepository{}
+	accountRepo := &MockAccountRepository{
+		accountToReturn: NewTestAccount(),
+	}
+	service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+
+	filter := entity.TransactionFilter{
+		From:        time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		To:          time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+		Type:        constant.TransactionTypeExpense,
+		CategoryIDs: []string{"category-groceries"},
+		MinAmount:   "100",
+		MaxAmount:   "100.00",
+		Tags:        []string{"Reimbursable", "vacation-2026", "reimbursable"},
+		TagMatch:    constant.TagMatchAll,
+		Sort:        constant.TransactionSortAmountDesc,
+	}
+	_, _, err := service.ListAccountTransactions(context.Background(), "test-account-123", filter, entity.PageRequest{})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if transactionRepo.lastFilter.Type != constant.TransactionTypeExpense || transactionRepo.lastFilter.MinAmount != "100" {
+		t.Errorf("expected filter to reach the repository, got %+v", transactionRepo.lastFilter)
+	}
+	if want := []string{"reimbursable", "vacation-2026"}; !slices.Equal(transactionRepo.lastFilter.Tags, want) {
+		t.Errorf("expected tags %v, got %v", want, transactionRepo.lastFilter.Tags)
+	}
+}
+
+func TestListAccountTransactionsAccountNotFound(t *testing.T) {
+	transactionRepo := &MockTransactionRepository{}
+	service := NewTransactionService(transactionRepo, &MockAccountRepository{}, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+
+	_, _, err := service.ListAccountTransactions(context.Background(), "missing-account", entity.TransactionFilter{}, entity.PageRequest{})
+
+	var notFoundErr *domainerrors.ErrNotFound
+	if !errors.As(err, &notFoundErr) {
+		t.Errorf("expected ErrNotFound, got %T", err)
+	}
+
+	if transactionRepo.listByAccountIDCalls != 0 {
+		t.Errorf("expected no listByAccountID call, got %d", transactionRepo.listByAccountIDCalls)
+	}
+}
+
+func TestListAccountTransactionsInvalidFilter(t *testing.T) {
+	day := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		filter entity.TransactionFilter
+		field  string
+	}{
+		{"amount not a number", entity.TransactionFilter{MinAmount: "lots"}, "min_amount"},
+		{"amount too precise", entity.TransactionFilter{MaxAmount: "1.001"}, "max_amount"},
+		{"negative amount", entity.TransactionFilter{MinAmount: "-5"}, "min_amount"},
+		{"min above max", entity.TransactionFilter{MinAmount: "50", MaxAmount: "10"}, "max_amount"},
+		{"empty date range", entity.TransactionFilter{From: day, To: day}, "to"},
+		{"unknown type", entity.TransactionFilter{Type: "REFUND"}, "type"},
+		{"unknown sort", entity.TransactionFilter{Sort: "random"}, "sort"},
+		{"unknown tag match", entity.TransactionFilter{Tags: []string{"reimbursable"}, TagMatch: "none"}, "tag_match"},
+		{"empty tag", entity.TransactionFilter{Tags: []string{""}}, "tag"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transactionRepo := &MockTransactionRepository{}
+			accountRepo := &MockAccountRepository{
+				accountToReturn: NewTestAccount(),
+			}
+			service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+
+			_, _, err := service.ListAccountTransactions(context.Background(), "test-account-123", tt.filter, entity.PageRequest{})
+
+			var invalidErr *domainerrors.ErrInvalidInput
+			if !errors.As(err, &invalidErr) {
+				t.Fatalf("expected ErrInvalidInput, got %T", err)
+			}
+			if invalidErr.Field != tt.field {
+				t.Errorf("expected field %q, got %q", tt.field, invalidErr.Field)
+			}
+			if transactionRepo.listByAccountIDCalls != 0 {
+				t.Errorf("expected no listByAccountID call, got %d", transactionRepo.listByAccountIDCalls)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/interfaces"
+	"accounting/internal/domain/money"
+
+	"github.com/google/uuid"
+)
+
+type TransferService struct {
+	transactionRepo interfaces.TransactionRepository
+	accountRepo     interfaces.AccountRepository
+	txManager       interfaces.TransactionManager
+	converter       interfaces.CurrencyConverter
+}
+
+func NewTransferService(transactionRepo interfaces.TransactionRepository, accountRepo interfaces.AccountRepository, txManager interfaces.TransactionManager, converter interfaces.CurrencyConverter) *TransferService {
+	return &TransferService{
+		transactionRepo: transactionRepo,
+		accountRepo:     accountRepo,
+		txManager:       txManager,
+		converter:       converter,
+	}
+}
+
+func (s *TransferService) CreateTransfer(ctx context.Context, fromAccountID, toAccountID string, amount money.Money, description string, date time.Time) (*entity.Transfer, error) {
+	if fromAccountID == "" {
+		return nil, domainerrors.NewErrInvalidInput("from_account_id", "source account ID is required")
+	}
+	if toAccountID == "" {
+		return nil, domainerrors.NewErrInvalidInput("to_account_id", "destination account ID is required")
+	}
+	if fromAccountID == toAccountID {
+		return nil, domainerrors.NewErrInvalidInput("to_account_id", "destination account must differ from source account")
+	}
+	if !amount.IsPositive() {
+		return nil, domainerrors.NewErrInvalidInput("amount", "amount must be greater than zero")
+	}
+	if err := validateCurrency("currency", amount.Currency()); err != nil {
+		return nil, err
+	}
+
+	// Use provided date or default to now
+	if date.IsZero() {
+		date = time.Now()
+	}
+
+	transfer := &entity.Transfer{ID: uuid.New().String()}
+	transfer.Debit = newTransferLeg(transfer.ID, fromAccountID, constant.TransferDirectionOut, amount, description, date)
+	transfer.Credit = newTransferLeg(transfer.ID, toAccountID, constant.TransferDirectionIn, amount, description, date)
+
+	// Both legs and both balances must commit or roll back together
+	err := s.txManager.WithTx(ctx, func(ctx context.Context) error {
+		accounts, err := lockAccounts(ctx, s.accountRepo, fromAccountID, toAccountID)
+		if err != nil {
+			return err
+		}
+		if accounts[fromAccountID].UserID != accounts[toAccountID].UserID {
+			return domainerrors.NewErrInvalidInput("to_account_id", "transfers are only allowed between accounts of the same user")
+		}
+
+		for _, leg := range []*entity.Transaction{transfer.Debit, transfer.Credit} {
+			// Each leg is converted into its own account's currency
+			if err := denominate(ctx, s.converter, leg, amount, accounts[leg.AccountID]); err != nil {
+				return err
+			}
+			if err := s.transactionRepo.Create(ctx, leg); err != nil {
+				return fmt.Errorf("creating transfer leg: %w", err)
+			}
+			if err := applyBalanceEffect(accounts[leg.AccountID], leg, false); err != nil {
+				return err
+			}
+		}
+
+		return saveAccounts(ctx, s.accountRepo, accounts)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transfer, nil
+}
+
+func (s *TransferService) GetTransfer(ctx context.Context, id string) (*entity.Transfer, error) {
+	legs, err := s.transactionRepo.ListByTransferID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("getting transfer legs: %w", err)
+	}
+	if len(legs) == 0 {
+		return nil, nil
+	}
+
+	transfer := &entity.Transfer{ID: id}
+	for _, leg := range legs {
+		switch leg.TransferDirection {
+		case constant.TransferDirectionOut:
+			transfer.Debit = leg
+		case constant.TransferDirectionIn:
+			transfer.Credit = leg
+		}
+	}
+
+	return transfer, nil
+}
+
+// newTransferLeg builds one side of a transfer.
+func newTransferLeg(transferID, accountID string, direction constant.TransferDirection, amount money.Money, description string, date time.Time) *entity.Transaction {
+	return &entity.Transaction{
+		ID:                uuid.New().String(),
+		AccountID:         accountID,
+		Amount:            amount,
+		Description:       description,
+		Date:              date,
+		Type:              constant.TransactionTypeTransfer,
+		TransferID:        transferID,
+		TransferDirection: direction,
+	}
+}
+
+// Compile-time interface check
+var _ interfaces.TransferService = (*TransferService)(nil)
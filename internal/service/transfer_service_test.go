@@ -0,0 +1,335 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"accounting/internal/domain/constant"
+	"accounting/internal/domain/entity"
+	domainerrors "accounting/internal/domain/errors"
+	"accounting/internal/domain/money"
+)
+
+// newTestTransferAccounts returns a checking account with 1000.00 and a savings account with 200.00
+func newTestTransferAccounts() (*entity.Account, *entity.Account) {
+	checking := NewTestAccount()
+	savings := NewTestAccount()
+	savings.ID = "test-account-456"
+	savings.Name = "Savings"
+	savings.Type = constant.AccountTypeSavings
+	savings.Balance = money.MustParse("200.00", "USD")
+	return checking, savings
+}
+
+func TestCreateTransferSuccess(t *testing.T) {
+	checking, savings := newTestTransferAccounts()
+	transactionRepo := &MockTransactionRepository{}
+	accountRepo := &MockAccountRepository{
+		accountsToReturn: map[string]*entity.Account{
+			checking.ID: checking,
+			savings.ID:  savings,
+		},
+	}
+	txManager := &MockTransactionManager{}
+	service := NewTransferService(transactionRepo, accountRepo, txManager, newTestConverter())
+
+	transfer, err := service.CreateTransfer(
+		context.Background(),
+		checking.ID,
+		savings.ID,
+		money.MustParse("150.00", "USD"),
+		"Monthly savings",
+		time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+	)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if transfer.Debit.TransferID != transfer.ID || transfer.Credit.TransferID != transfer.ID {
+		t.Error("expected both legs to share the transfer ID")
+	}
+
+	if transfer.Debit.AccountID != checking.ID || transfer.Debit.TransferDirection != constant.TransferDirectionOut {
+		t.Errorf("expected debit leg on %q, got %q (%s)", checking.ID, transfer.Debit.AccountID, transfer.Debit.TransferDirection)
+	}
+
+	if transfer.Credit.AccountID != savings.ID || transfer.Credit.TransferDirection != constant.TransferDirectionIn {
+		t.Errorf("expected credit leg on %q, got %q (%s)", savings.ID, transfer.Credit.AccountID, transfer.Credit.TransferDirection)
+	}
+
+	if checking.Balance != money.MustParse("850.00", "USD") {
+		t.Errorf("expected source balance 850.00, got %s", checking.Balance)
+	}
+
+	if savings.Balance != money.MustParse("350.00", "USD") {
+		t.Errorf("expected destination balance 350.00, got %s", savings.Balance)
+	}
+
+	if len(transactionRepo.created) != 2 {
+		t.Errorf("expected 2 persisted legs, got %d", len(transactionRepo.created))
+	}
+
+	if txManager.commits != 1 {
+		t.Errorf("expected 1 commit, got %d", txManager.commits)
+	}
+}
+
+func TestCreateTransferSameAccount(t *testing.T) {
+	service := NewTransferService(&MockTransactionRepository{}, &MockAccountRepository{}, &MockTransactionManager{}, newTestConverter())
+
+	_, err := service.CreateTransfer(
+		context.Background(),
+		"test-account-123",
+		"test-account-123",
+		money.MustParse("10.00", "USD"),
+		"",
+		time.Now(),
+	)
+
+	var invalidErr *domainerrors.ErrInvalidInput
+	if !errors.As(err, &invalidErr) {
+		t.Errorf("expected ErrInvalidInput, got %T", err)
+	}
+}
+
+func TestCreateTransferDifferentOwners(t *testing.T) {
+	checking, savings := newTestTransferAccounts()
+	savings.UserID = "another-user"
+	transactionRepo := &MockTransactionRepository{}
+	accountRepo := &MockAccountRepository{
+		accountsToReturn: map[string]*entity.Account{
+			checking.ID: checking,
+			savings.ID:  savings,
+		},
+	}
+	service := NewTransferService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter())
+
+	_, err := service.CreateTransfer(context.Background(), checking.ID, savings.ID, money.MustParse("10.00", "USD"), "", time.Now())
+
+	var invalidErr *domainerrors.ErrInvalidInput
+	if !errors.As(err, &invalidErr) {
+		t.Errorf("expected ErrInvalidInput, got %T", err)
+	}
+
+	if len(transactionRepo.created) != 0 {
+		t.Errorf("expected no persisted legs, got %d", len(transactionRepo.created))
+	}
+}
+
+func TestCreateTransferFailureRollsBackBothLegs(t *testing.T) {
+	checking, savings := newTestTransferAccounts()
+	transactionRepo := &MockTransactionRepository{}
+	accountRepo := &MockAccountRepository{
+		accountsToReturn: map[string]*entity.Account{
+			checking.ID: checking,
+			savings.ID:  savings,
+		},
+		lastUpdateErr: errors.New("connection reset"),
+	}
+	txManager := &MockTransactionManager{}
+	service := NewTransferService(transactionRepo, accountRepo, txManager, newTestConverter())
+
+	_, err := service.CreateTransfer(context.Background(), checking.ID, savings.ID, money.MustParse("10.00", "USD"), "", time.Now())
+
+	if err == nil {
+		t.Fatal("expected error when balance update fails")
+	}
+
+	if txManager.rollbacks != 1 {
+		t.Errorf("expected 1 rollback, got %d", txManager.rollbacks)
+	}
+
+	if len(transactionRepo.created) != 0 {
+		t.Errorf("expected no orphan legs, got %d", len(transactionRepo.created))
+	}
+}
+
+func TestGetTransferNotFound(t *testing.T) {
+	service := NewTransferService(&MockTransactionRepository{}, &MockAccountRepository{}, &MockTransactionManager{}, newTestConverter())
+
+	transfer, err := service.GetTransfer(context.Background(), "missing-transfer")
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if transfer != nil {
+		t.Error("expected nil transfer when not found")
+	}
+}
+
+// newTestTransferLegs returns the legs of a 100.00 transfer from checking to savings
+func newTestTransferLegs() (*entity.Transaction, *entity.Transaction) {
+	debit := newTransferLeg("test-transfer-1", "test-account-123", constant.TransferDirectionOut, money.MustParse("100.00", "USD"), "Savings", time.Now())
+	debit.ID = "test-leg-out"
+	credit := newTransferLeg("test-transfer-1", "test-account-456", constant.TransferDirectionIn, money.MustParse("100.00", "USD"), "Savings", time.Now())
+	credit.ID = "test-leg-in"
+	return debit, credit
+}
+
+func TestUpdateTransferLegKeepsPairConsistent(t *testing.T) {
+	checking, savings := newTestTransferAccounts()
+	debit, credit := newTestTransferLegs()
+	transactionRepo := &MockTransactionRepository{
+		transactionsToReturn: map[string]*entity.Transaction{
+			debit.ID:  debit,
+			credit.ID: credit,
+		},
+	}
+	accountRepo := &MockAccountRepository{
+		accountsToReturn: map[string]*entity.Account{
+			checking.ID: checking,
+			savings.ID:  savings,
+		},
+	}
+	service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+
+	_, err := service.UpdateTransaction(context.Background(), credit.ID, "", money.MustParse("250.00", "USD"), "", "", "", nil, nil, "", time.Time{})
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if debit.Amount != money.MustParse("250.00", "USD") {
+		t.Errorf("expected other leg amount 250.00, got %s", debit.Amount)
+	}
+
+	if checking.Balance != money.MustParse("850.00", "USD") {
+		t.Errorf("expected source balance 850.00, got %s", checking.Balance)
+	}
+
+	if savings.Balance != money.MustParse("350.00", "USD") {
+		t.Errorf("expected destination balance 350.00, got %s", savings.Balance)
+	}
+
+	if transactionRepo.updateCalls != 2 {
+		t.Errorf("expected both legs to be updated, got %d updates", transactionRepo.updateCalls)
+	}
+}
+
+func TestUpdateTransferLegToAnotherUsersAccountRejected(t *testing.T) {
+	checking, savings := newTestTransferAccounts()
+	other := NewTestAccount()
+	other.ID = "other-account-789"
+	other.UserID = "other-user-456"
+	debit, credit := newTestTransferLegs()
+	transactionRepo := &MockTransactionRepository{
+		transactionsToReturn: map[string]*entity.Transaction{
+			debit.ID:  debit,
+			credit.ID: credit,
+		},
+	}
+	accountRepo := &MockAccountRepository{
+		accountsToReturn: map[string]*entity.Account{
+			checking.ID: checking,
+			savings.ID:  savings,
+			other.ID:    other,
+		},
+	}
+	service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+
+	_, err := service.UpdateTransaction(context.Background(), credit.ID, other.ID, money.Money{}, "", "", "", nil, nil, "", time.Time{})
+
+	var invalidErr *domainerrors.ErrInvalidInput
+	if !errors.As(err, &invalidErr) || invalidErr.Field != "account_id" {
+		t.Fatalf("expected invalid account_id, got %v", err)
+	}
+	if other.Balance != money.MustParse("1000.00", "USD") || savings.Balance != money.MustParse("200.00", "USD") {
+		t.Errorf("expected the balances unchanged, got %s and %s", other.Balance, savings.Balance)
+	}
+	if transactionRepo.updateCalls != 0 || accountRepo.updateCalls != 0 {
+		t.Errorf("expected nothing to be saved, got %d transaction and %d account updates", transactionRepo.updateCalls, accountRepo.updateCalls)
+	}
+}
+
+func TestUpdateTransferLegTypeChangeRejected(t *testing.T) {
+	debit, credit := newTestTransferLegs()
+	transactionRepo := &MockTransactionRepository{
+		transactionsToReturn: map[string]*entity.Transaction{
+			debit.ID:  debit,
+			credit.ID: credit,
+		},
+	}
+	service := NewTransactionService(transactionRepo, &MockAccountRepository{}, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+
+	_, err := service.UpdateTransaction(context.Background(), debit.ID, "", money.Money{}, "", "", "", nil, nil, constant.TransactionTypeExpense, time.Time{})
+
+	var invalidErr *domainerrors.ErrInvalidInput
+	if !errors.As(err, &invalidErr) {
+		t.Errorf("expected ErrInvalidInput, got %T", err)
+	}
+}
+
+func TestUpdateTransferLegCategoryRejected(t *testing.T) {
+	debit, credit := newTestTransferLegs()
+	transactionRepo := &MockTransactionRepository{
+		transactionsToReturn: map[string]*entity.Transaction{
+			debit.ID:  debit,
+			credit.ID: credit,
+		},
+	}
+	service := NewTransactionService(transactionRepo, &MockAccountRepository{}, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+
+	_, err := service.UpdateTransaction(context.Background(), debit.ID, "", money.Money{}, "", "", "category-savings", nil, nil, "", time.Time{})
+
+	var invalidErr *domainerrors.ErrInvalidInput
+	if !errors.As(err, &invalidErr) || invalidErr.Field != "category_id" {
+		t.Errorf("expected invalid category_id, got %v", err)
+	}
+}
+
+func TestDeleteTransferLegDeletesBothLegs(t *testing.T) {
+	checking, savings := newTestTransferAccounts()
+	debit, credit := newTestTransferLegs()
+	transactionRepo := &MockTransactionRepository{
+		transactionsToReturn: map[string]*entity.Transaction{
+			debit.ID:  debit,
+			credit.ID: credit,
+		},
+	}
+	accountRepo := &MockAccountRepository{
+		accountsToReturn: map[string]*entity.Account{
+			checking.ID: checking,
+			savings.ID:  savings,
+		},
+	}
+	service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+
+	err := service.DeleteTransaction(context.Background(), debit.ID)
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if transactionRepo.deleteCalls != 2 {
+		t.Errorf("expected 2 delete calls, got %d", transactionRepo.deleteCalls)
+	}
+
+	if checking.Balance != money.MustParse("1100.00", "USD") {
+		t.Errorf("expected source balance 1100.00, got %s", checking.Balance)
+	}
+
+	if savings.Balance != money.MustParse("100.00", "USD") {
+		t.Errorf("expected destination balance 100.00, got %s", savings.Balance)
+	}
+}
+
+func TestCreateTransactionRejectsTransferType(t *testing.T) {
+	accountRepo := &MockAccountRepository{accountToReturn: NewTestAccount()}
+	transactionRepo := &MockTransactionRepository{}
+	service := NewTransactionService(transactionRepo, accountRepo, &MockTransactionManager{}, newTestConverter(), newTestCategories(), &MockCategoryRuleRepository{}, &MockPayeeRepository{})
+
+	_, err := service.CreateTransaction(context.Background(), "test-account-123", money.MustParse("10.00", "USD"), "", "", "", nil, nil, constant.TransactionTypeTransfer, time.Now(), false)
+
+	var invalidErr *domainerrors.ErrInvalidInput
+	if !errors.As(err, &invalidErr) {
+		t.Errorf("expected ErrInvalidInput, got %T", err)
+	}
+
+	if len(transactionRepo.created) != 0 {
+		t.Errorf("expected no transaction to be created, got %d", len(transactionRepo.created))
+	}
+}
@@ -11,6 +11,9 @@ import (
 	"github.com/google/uuid"
 )
 
+// DefaultBaseCurrency is the base currency of users created without one.
+const DefaultBaseCurrency = "USD"
+
 type UserService struct {
 	repo interfaces.UserRepository
 }
@@ -19,13 +22,19 @@ func NewUserService(repo interfaces.UserRepository) *UserService {
 	return &UserService{repo: repo}
 }
 
-func (s *UserService) CreateUser(ctx context.Context, name, email string) (*entity.User, error) {
+func (s *UserService) CreateUser(ctx context.Context, name, email, baseCurrency string) (*entity.User, error) {
 	if email == "" {
 		return nil, domainerrors.NewErrInvalidInput("email", "email is required")
 	}
 	if name == "" {
 		return nil, domainerrors.NewErrInvalidInput("name", "name is required")
 	}
+	if baseCurrency == "" {
+		baseCurrency = DefaultBaseCurrency
+	}
+	if err := validateCurrency("base_currency", baseCurrency); err != nil {
+		return nil, err
+	}
 
 	// Check if user already exists
 	existingUser, err := s.repo.GetByEmail(ctx, email)
@@ -37,9 +46,10 @@ func (s *UserService) CreateUser(ctx context.Context, name, email string) (*enti
 	}
 
 	user := &entity.User{
-		ID:    uuid.New().String(),
-		Name:  name,
-		Email: email,
+		ID:           uuid.New().String(),
+		Name:         name,
+		Email:        email,
+		BaseCurrency: baseCurrency,
 	}
 
 	if err := s.repo.Create(ctx, user); err != nil {
@@ -57,7 +67,13 @@ func (s *UserService) GetUserByEmail(ctx context.Context, email string) (*entity
 	return s.repo.GetByEmail(ctx, email)
 }
 
-func (s *UserService) UpdateUser(ctx context.Context, id, name, email string) (*entity.User, error) {
+func (s *UserService) UpdateUser(ctx context.Context, id, name, email, baseCurrency string) (*entity.User, error) {
+	if baseCurrency != "" {
+		if err := validateCurrency("base_currency", baseCurrency); err != nil {
+			return nil, err
+		}
+	}
+
 	user, err := s.repo.GetByID(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("getting user: %w", err)
@@ -72,6 +88,9 @@ func (s *UserService) UpdateUser(ctx context.Context, id, name, email string) (*
 	if email != "" {
 		user.Email = email
 	}
+	if baseCurrency != "" {
+		user.BaseCurrency = baseCurrency
+	}
 
 	if err := s.repo.Update(ctx, user); err != nil {
 		return nil, fmt.Errorf("updating user: %w", err)
@@ -12,7 +12,7 @@ func TestCreateUserSuccess(t *testing.T) {
 	repo := &MockUserRepository{}
 	service := NewUserService(repo)
 
-	user, err := service.CreateUser(context.Background(), "John Doe", "john@example.com")
+	user, err := service.CreateUser(context.Background(), "John Doe", "john@example.com", "")
 
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
@@ -34,6 +34,10 @@ func TestCreateUserSuccess(t *testing.T) {
 		t.Error("expected user ID to be set")
 	}
 
+	if user.BaseCurrency != DefaultBaseCurrency {
+		t.Errorf("expected base currency %q, got %q", DefaultBaseCurrency, user.BaseCurrency)
+	}
+
 	if repo.getByEmailCalls != 1 {
 		t.Errorf("expected 1 getByEmail call, got %d", repo.getByEmailCalls)
 	}
@@ -43,6 +47,37 @@ func TestCreateUserSuccess(t *testing.T) {
 	}
 }
 
+func TestCreateUserBaseCurrency(t *testing.T) {
+	repo := &MockUserRepository{}
+	service := NewUserService(repo)
+
+	user, err := service.CreateUser(context.Background(), "John Doe", "john@example.com", "EUR")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if user.BaseCurrency != "EUR" {
+		t.Errorf("expected base currency EUR, got %q", user.BaseCurrency)
+	}
+}
+
+func TestCreateUserUnknownBaseCurrency(t *testing.T) {
+	repo := &MockUserRepository{}
+	service := NewUserService(repo)
+
+	user, err := service.CreateUser(context.Background(), "John Doe", "john@example.com", "XYZ")
+
+	if user != nil {
+		t.Error("expected nil user for unknown base currency")
+	}
+	var invalidErr *domainerrors.ErrInvalidInput
+	if !errors.As(err, &invalidErr) {
+		t.Errorf("expected ErrInvalidInput, got %v", err)
+	}
+	if repo.createCalls != 0 {
+		t.Errorf("expected no create call, got %d", repo.createCalls)
+	}
+}
+
 func TestCreateUserDuplicateEmail(t *testing.T) {
 	existingUser := NewTestUser()
 	repo := &MockUserRepository{
@@ -50,7 +85,7 @@ func TestCreateUserDuplicateEmail(t *testing.T) {
 	}
 	service := NewUserService(repo)
 
-	user, err := service.CreateUser(context.Background(), "Jane Doe", "test@example.com")
+	user, err := service.CreateUser(context.Background(), "Jane Doe", "test@example.com", "")
 
 	if user != nil {
 		t.Error("expected nil user for duplicate email")
@@ -66,7 +101,7 @@ func TestCreateUserInvalidEmail(t *testing.T) {
 	repo := &MockUserRepository{}
 	service := NewUserService(repo)
 
-	user, err := service.CreateUser(context.Background(), "John Doe", "")
+	user, err := service.CreateUser(context.Background(), "John Doe", "", "")
 
 	if user != nil {
 		t.Error("expected nil user for empty email")
@@ -82,7 +117,7 @@ func TestCreateUserInvalidName(t *testing.T) {
 	repo := &MockUserRepository{}
 	service := NewUserService(repo)
 
-	user, err := service.CreateUser(context.Background(), "", "john@example.com")
+	user, err := service.CreateUser(context.Background(), "", "john@example.com", "")
 
 	if user != nil {
 		t.Error("expected nil user for empty name")
@@ -101,7 +136,7 @@ func TestCreateUserRepositoryError(t *testing.T) {
 	}
 	service := NewUserService(repo)
 
-	user, err := service.CreateUser(context.Background(), "John Doe", "john@example.com")
+	user, err := service.CreateUser(context.Background(), "John Doe", "john@example.com", "")
 
 	if user != nil {
 		t.Error("expected nil user on repository error")
@@ -178,7 +213,7 @@ func TestUpdateUserSuccess(t *testing.T) {
 	}
 	service := NewUserService(repo)
 
-	updatedUser, err := service.UpdateUser(context.Background(), "test-user-123", "Jane Doe", "jane@example.com")
+	updatedUser, err := service.UpdateUser(context.Background(), "test-user-123", "Jane Doe", "jane@example.com", "")
 
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
@@ -212,7 +247,7 @@ func TestUpdateUserPartial(t *testing.T) {
 	}
 	service := NewUserService(repo)
 
-	updatedUser, err := service.UpdateUser(context.Background(), "test-user-123", "Jane Doe", "")
+	updatedUser, err := service.UpdateUser(context.Background(), "test-user-123", "Jane Doe", "", "")
 
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
@@ -227,11 +262,31 @@ func TestUpdateUserPartial(t *testing.T) {
 	}
 }
 
+func TestUpdateUserBaseCurrency(t *testing.T) {
+	testUser := NewTestUser()
+	repo := &MockUserRepository{
+		userToReturn: testUser,
+	}
+	service := NewUserService(repo)
+
+	updatedUser, err := service.UpdateUser(context.Background(), "test-user-123", "", "", "GBP")
+
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if updatedUser.BaseCurrency != "GBP" {
+		t.Errorf("expected base currency GBP, got %q", updatedUser.BaseCurrency)
+	}
+	if updatedUser.Name != testUser.Name {
+		t.Errorf("expected name to remain %q, got %q", testUser.Name, updatedUser.Name)
+	}
+}
+
 func TestUpdateUserNotFound(t *testing.T) {
 	repo := &MockUserRepository{}
 	service := NewUserService(repo)
 
-	updatedUser, err := service.UpdateUser(context.Background(), "nonexistent-id", "Jane Doe", "jane@example.com")
+	updatedUser, err := service.UpdateUser(context.Background(), "nonexistent-id", "Jane Doe", "jane@example.com", "")
 
 	if updatedUser != nil {
 		t.Error("expected nil user when not found")
@@ -252,7 +307,7 @@ func TestUpdateUserRepositoryError(t *testing.T) {
 	}
 	service := NewUserService(repo)
 
-	updatedUser, err := service.UpdateUser(context.Background(), "test-user-123", "Jane Doe", "jane@example.com")
+	updatedUser, err := service.UpdateUser(context.Background(), "test-user-123", "Jane Doe", "jane@example.com", "")
 
 	if updatedUser != nil {
 		t.Error("expected nil user on repository error")
@@ -0,0 +1,11 @@
+-- Transfer legs cannot be represented without the transfer columns
+DELETE FROM transactions WHERE type = 'TRANSFER';
+
+DROP INDEX IF EXISTS idx_transactions_transfer_leg;
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS transactions_transfer_check;
+ALTER TABLE transactions DROP COLUMN IF EXISTS transfer_direction;
+ALTER TABLE transactions DROP COLUMN IF EXISTS transfer_id;
+
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS transactions_type_check;
+ALTER TABLE transactions ADD CONSTRAINT transactions_type_check
+    CHECK (type IN ('INCOME', 'EXPENSE'));
@@ -0,0 +1,16 @@
+-- Allow TRANSFER transactions and link the two legs of a transfer
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS transactions_type_check;
+ALTER TABLE transactions ADD CONSTRAINT transactions_type_check
+    CHECK (type IN ('INCOME', 'EXPENSE', 'TRANSFER'));
+
+ALTER TABLE transactions ADD COLUMN transfer_id UUID;
+ALTER TABLE transactions ADD COLUMN transfer_direction VARCHAR(3)
+    CHECK (transfer_direction IN ('OUT', 'IN'));
+
+-- Transfer legs carry both a transfer ID and a direction; other types carry neither
+ALTER TABLE transactions ADD CONSTRAINT transactions_transfer_check
+    CHECK ((type = 'TRANSFER') = (transfer_id IS NOT NULL AND transfer_direction IS NOT NULL));
+
+-- Each transfer has exactly one outgoing and one incoming leg
+CREATE UNIQUE INDEX idx_transactions_transfer_leg ON transactions(transfer_id, transfer_direction)
+    WHERE transfer_id IS NOT NULL;
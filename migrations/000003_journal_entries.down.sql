@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS journal_postings;
+DROP TABLE IF EXISTS journal_entries;
+
+-- Category accounts cannot be represented with the original account types
+DELETE FROM accounts WHERE type IN ('INCOME', 'EXPENSE');
+
+ALTER TABLE accounts DROP CONSTRAINT IF EXISTS accounts_type_check;
+ALTER TABLE accounts ADD CONSTRAINT accounts_type_check
+    CHECK (type IN ('CHECKING', 'SAVINGS', 'CREDIT_CARD', 'CASH', 'INVESTMENT'));
@@ -0,0 +1,35 @@
+-- Allow income and expense category accounts as journal counterparts
+ALTER TABLE accounts DROP CONSTRAINT IF EXISTS accounts_type_check;
+ALTER TABLE accounts ADD CONSTRAINT accounts_type_check
+    CHECK (type IN ('CHECKING', 'SAVINGS', 'CREDIT_CARD', 'CASH', 'INVESTMENT', 'INCOME', 'EXPENSE'));
+
+-- Create journal entries table
+CREATE TABLE IF NOT EXISTS journal_entries (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    date TIMESTAMP NOT NULL,
+    description TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_journal_entries_user_id ON journal_entries(user_id);
+CREATE INDEX idx_journal_entries_date ON journal_entries(date);
+
+-- Create journal postings table; the service guarantees each entry balances per currency
+CREATE TABLE IF NOT EXISTS journal_postings (
+    id UUID PRIMARY KEY,
+    entry_id UUID NOT NULL,
+    line_no INTEGER NOT NULL,
+    account_id UUID NOT NULL,
+    direction VARCHAR(6) NOT NULL CHECK (direction IN ('DEBIT', 'CREDIT')),
+    amount DECIMAL(15, 2) NOT NULL CHECK (amount > 0),
+    currency CHAR(3) NOT NULL,
+    memo TEXT,
+    FOREIGN KEY (entry_id) REFERENCES journal_entries(id) ON DELETE CASCADE,
+    FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE CASCADE,
+    UNIQUE (entry_id, line_no)
+);
+
+CREATE INDEX idx_journal_postings_account_id ON journal_postings(account_id);
@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS idx_accounts_user_code;
+DROP INDEX IF EXISTS idx_accounts_parent_id;
+ALTER TABLE accounts DROP COLUMN IF EXISTS code;
+ALTER TABLE accounts DROP COLUMN IF EXISTS parent_id;
+
+-- Equity accounts cannot be represented without the EQUITY type
+DELETE FROM accounts WHERE type = 'EQUITY';
+
+ALTER TABLE accounts DROP CONSTRAINT IF EXISTS accounts_type_check;
+ALTER TABLE accounts ADD CONSTRAINT accounts_type_check
+    CHECK (type IN ('CHECKING', 'SAVINGS', 'CREDIT_CARD', 'CASH', 'INVESTMENT', 'INCOME', 'EXPENSE'));
@@ -0,0 +1,11 @@
+-- Allow equity accounts
+ALTER TABLE accounts DROP CONSTRAINT IF EXISTS accounts_type_check;
+ALTER TABLE accounts ADD CONSTRAINT accounts_type_check
+    CHECK (type IN ('CHECKING', 'SAVINGS', 'CREDIT_CARD', 'CASH', 'INVESTMENT', 'INCOME', 'EXPENSE', 'EQUITY'));
+
+-- Chart of accounts hierarchy and codes
+ALTER TABLE accounts ADD COLUMN parent_id UUID REFERENCES accounts(id) ON DELETE RESTRICT;
+ALTER TABLE accounts ADD COLUMN code VARCHAR(20);
+
+CREATE INDEX idx_accounts_parent_id ON accounts(parent_id);
+CREATE UNIQUE INDEX idx_accounts_user_code ON accounts(user_id, code) WHERE code IS NOT NULL;
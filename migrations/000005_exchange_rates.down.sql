@@ -0,0 +1,6 @@
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS transactions_conversion_check;
+ALTER TABLE transactions DROP COLUMN IF EXISTS exchange_rate;
+ALTER TABLE transactions DROP COLUMN IF EXISTS original_currency;
+ALTER TABLE transactions DROP COLUMN IF EXISTS original_amount;
+
+DROP TABLE IF EXISTS exchange_rates;
@@ -0,0 +1,20 @@
+-- Dated exchange rates: one unit of base_currency buys rate units of quote_currency
+CREATE TABLE IF NOT EXISTS exchange_rates (
+    base_currency CHAR(3) NOT NULL,
+    quote_currency CHAR(3) NOT NULL,
+    date DATE NOT NULL,
+    rate NUMERIC(20, 10) NOT NULL CHECK (rate > 0),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (base_currency, quote_currency, date),
+    CHECK (base_currency <> quote_currency)
+);
+
+-- Transactions entered in another currency keep the original amount and the rate used
+ALTER TABLE transactions ADD COLUMN original_amount DECIMAL(15, 2);
+ALTER TABLE transactions ADD COLUMN original_currency CHAR(3);
+ALTER TABLE transactions ADD COLUMN exchange_rate NUMERIC(20, 10);
+
+ALTER TABLE transactions ADD CONSTRAINT transactions_conversion_check
+    CHECK ((original_amount IS NULL) = (original_currency IS NULL)
+       AND (original_amount IS NULL) = (exchange_rate IS NULL));
@@ -0,0 +1,5 @@
+-- Amounts in three-decimal currencies are rounded to two decimal places
+ALTER TABLE journal_postings ALTER COLUMN amount TYPE DECIMAL(15, 2);
+ALTER TABLE transactions ALTER COLUMN original_amount TYPE DECIMAL(15, 2);
+ALTER TABLE transactions ALTER COLUMN amount TYPE DECIMAL(15, 2);
+ALTER TABLE accounts ALTER COLUMN balance TYPE DECIMAL(15, 2);
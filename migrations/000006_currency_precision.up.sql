@@ -0,0 +1,6 @@
+-- Store amounts with up to three decimal places so currencies such as KWD or
+-- BHD keep their minor units; two-decimal and zero-decimal currencies are unaffected
+ALTER TABLE accounts ALTER COLUMN balance TYPE DECIMAL(18, 3);
+ALTER TABLE transactions ALTER COLUMN amount TYPE DECIMAL(18, 3);
+ALTER TABLE transactions ALTER COLUMN original_amount TYPE DECIMAL(18, 3);
+ALTER TABLE journal_postings ALTER COLUMN amount TYPE DECIMAL(18, 3);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_transactions_account_page;
+DROP INDEX IF EXISTS idx_accounts_user_page;
+
+ALTER TABLE transactions DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE transactions DROP COLUMN IF EXISTS created_at;
+ALTER TABLE accounts DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE accounts DROP COLUMN IF EXISTS created_at;
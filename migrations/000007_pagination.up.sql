@@ -0,0 +1,10 @@
+-- Accounts and transactions record when they were inserted. Listings order by
+-- created_at, and pagination cursors need it to break ties between rows
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP;
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP;
+
+-- Keyset pagination indexes matching the listing sort orders
+CREATE INDEX idx_accounts_user_page ON accounts(user_id, created_at DESC, id DESC);
+CREATE INDEX idx_transactions_account_page ON transactions(account_id, date DESC, created_at DESC, id DESC);
@@ -0,0 +1,9 @@
+DROP TRIGGER IF EXISTS accounts_invalidate_snapshots ON accounts;
+DROP TRIGGER IF EXISTS journal_entries_invalidate_snapshots ON journal_entries;
+DROP TRIGGER IF EXISTS journal_postings_invalidate_snapshots ON journal_postings;
+DROP TRIGGER IF EXISTS transactions_invalidate_snapshots ON transactions;
+DROP FUNCTION IF EXISTS invalidate_account_snapshots();
+DROP FUNCTION IF EXISTS invalidate_entry_snapshots();
+DROP FUNCTION IF EXISTS invalidate_posting_snapshots();
+DROP FUNCTION IF EXISTS invalidate_transaction_snapshots();
+DROP TABLE IF EXISTS balance_snapshots;
@@ -0,0 +1,82 @@
+-- End-of-day balances cached so historical balances need not replay the whole history
+CREATE TABLE IF NOT EXISTS balance_snapshots (
+    account_id UUID NOT NULL,
+    date DATE NOT NULL,
+    balance DECIMAL(18, 3) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (account_id, date),
+    FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE CASCADE
+);
+
+-- A change dated on or before a snapshot makes it stale. Every write path goes
+-- through these triggers, so snapshots can never disagree with the history.
+CREATE OR REPLACE FUNCTION invalidate_transaction_snapshots() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        DELETE FROM balance_snapshots WHERE account_id = OLD.account_id AND date >= OLD.date::date;
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        DELETE FROM balance_snapshots WHERE account_id = NEW.account_id AND date >= NEW.date::date;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER transactions_invalidate_snapshots
+    AFTER INSERT OR UPDATE OR DELETE ON transactions
+    FOR EACH ROW EXECUTE FUNCTION invalidate_transaction_snapshots();
+
+-- Postings carry no date of their own; when the entry is already gone (a
+-- cascading delete) every snapshot of the account is dropped
+CREATE OR REPLACE FUNCTION invalidate_posting_snapshots() RETURNS trigger AS $$
+DECLARE
+    posting RECORD;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        posting := OLD;
+    ELSE
+        posting := NEW;
+    END IF;
+    DELETE FROM balance_snapshots
+    WHERE account_id = posting.account_id
+      AND date >= COALESCE((SELECT e.date::date FROM journal_entries e WHERE e.id = posting.entry_id), '-infinity'::date);
+    IF TG_OP = 'UPDATE' AND OLD.account_id <> NEW.account_id THEN
+        DELETE FROM balance_snapshots
+        WHERE account_id = OLD.account_id
+          AND date >= COALESCE((SELECT e.date::date FROM journal_entries e WHERE e.id = OLD.entry_id), '-infinity'::date);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER journal_postings_invalidate_snapshots
+    AFTER INSERT OR UPDATE OR DELETE ON journal_postings
+    FOR EACH ROW EXECUTE FUNCTION invalidate_posting_snapshots();
+
+CREATE OR REPLACE FUNCTION invalidate_entry_snapshots() RETURNS trigger AS $$
+BEGIN
+    DELETE FROM balance_snapshots s
+    USING journal_postings p
+    WHERE p.entry_id = NEW.id
+      AND s.account_id = p.account_id
+      AND s.date >= LEAST(OLD.date, NEW.date)::date;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER journal_entries_invalidate_snapshots
+    AFTER UPDATE OF date ON journal_entries
+    FOR EACH ROW EXECUTE FUNCTION invalidate_entry_snapshots();
+
+-- Snapshots are denominated in the account currency
+CREATE OR REPLACE FUNCTION invalidate_account_snapshots() RETURNS trigger AS $$
+BEGIN
+    DELETE FROM balance_snapshots WHERE account_id = NEW.id;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER accounts_invalidate_snapshots
+    AFTER UPDATE OF currency ON accounts
+    FOR EACH ROW WHEN (OLD.currency IS DISTINCT FROM NEW.currency)
+    EXECUTE FUNCTION invalidate_account_snapshots();
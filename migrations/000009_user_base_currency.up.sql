@@ -0,0 +1,2 @@
+-- Users report totals such as net worth in their base currency
+ALTER TABLE users ADD COLUMN IF NOT EXISTS base_currency CHAR(3) NOT NULL DEFAULT 'USD';
@@ -0,0 +1,21 @@
+-- Saved column mappings for importing bank statements in CSV format
+CREATE TABLE IF NOT EXISTS import_profiles (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    delimiter VARCHAR(1) NOT NULL DEFAULT ',',
+    skip_rows INTEGER NOT NULL DEFAULT 0 CHECK (skip_rows >= 0),
+    date_column VARCHAR(255) NOT NULL,
+    date_format VARCHAR(50) NOT NULL,
+    amount_sign VARCHAR(20) NOT NULL CHECK (amount_sign IN ('SIGNED', 'INVERTED', 'DEBIT_CREDIT')),
+    amount_column VARCHAR(255) NOT NULL DEFAULT '',
+    debit_column VARCHAR(255) NOT NULL DEFAULT '',
+    credit_column VARCHAR(255) NOT NULL DEFAULT '',
+    description_column VARCHAR(255) NOT NULL DEFAULT '',
+    category_column VARCHAR(255) NOT NULL DEFAULT '',
+    decimal_separator VARCHAR(1) NOT NULL DEFAULT '.' CHECK (decimal_separator IN ('.', ',')),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    UNIQUE (user_id, name)
+);
@@ -0,0 +1,6 @@
+-- Imported transactions keep the identifier the bank gave them, such as an OFX
+-- FITID, so that importing the same statement again does not duplicate them
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS external_id VARCHAR(255);
+
+CREATE UNIQUE INDEX idx_transactions_external_id ON transactions(account_id, external_id)
+    WHERE external_id IS NOT NULL;
@@ -0,0 +1,23 @@
+-- Rules that categorize a user's transactions automatically; a transaction
+-- takes the actions of the first rule it matches, by priority then name
+CREATE TABLE IF NOT EXISTS category_rules (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    priority INTEGER NOT NULL DEFAULT 0,
+    description_contains VARCHAR(255) NOT NULL DEFAULT '',
+    description_pattern VARCHAR(255) NOT NULL DEFAULT '',
+    min_amount DECIMAL(18, 3),
+    max_amount DECIMAL(18, 3),
+    amount_currency CHAR(3),
+    account_id UUID,
+    type VARCHAR(50) NOT NULL DEFAULT '' CHECK (type IN ('', 'INCOME', 'EXPENSE')),
+    set_category VARCHAR(100) NOT NULL DEFAULT '',
+    set_description VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+    FOREIGN KEY (account_id) REFERENCES accounts(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_category_rules_user_id ON category_rules(user_id, priority, name);
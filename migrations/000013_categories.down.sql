@@ -0,0 +1,24 @@
+ALTER TABLE category_rules ADD COLUMN IF NOT EXISTS set_category VARCHAR(100) NOT NULL DEFAULT '';
+
+UPDATE category_rules r
+SET set_category = c.name
+FROM categories c
+WHERE c.id = r.set_category_id;
+
+ALTER TABLE category_rules DROP COLUMN IF EXISTS set_category_id;
+
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS category VARCHAR(100) NOT NULL DEFAULT '';
+
+UPDATE transactions t
+SET category = c.name
+FROM categories c
+WHERE c.id = t.category_id;
+
+UPDATE transactions SET category = 'Transfer' WHERE type = 'TRANSFER';
+
+ALTER TABLE transactions ALTER COLUMN category DROP DEFAULT;
+DROP INDEX IF EXISTS idx_transactions_category_id;
+ALTER TABLE transactions DROP COLUMN IF EXISTS category_id;
+CREATE INDEX IF NOT EXISTS idx_transactions_category ON transactions(category);
+
+DROP TABLE IF EXISTS categories;
@@ -0,0 +1,75 @@
+-- Categories are managed per user instead of being free text on each
+-- transaction, and may be nested under a parent category
+CREATE TABLE IF NOT EXISTS categories (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    parent_id UUID REFERENCES categories(id) ON DELETE RESTRICT,
+    name VARCHAR(100) NOT NULL,
+    type VARCHAR(50) NOT NULL DEFAULT '' CHECK (type IN ('', 'INCOME', 'EXPENSE')),
+    archived BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Names differing only in case would name the same category
+CREATE UNIQUE INDEX idx_categories_user_name ON categories(user_id, LOWER(name));
+CREATE INDEX idx_categories_parent_id ON categories(parent_id);
+
+-- Every category name in use becomes a category of its user. Spellings that
+-- differ only in case or surrounding spaces are merged under the most used
+-- one, and a category only ever used for income or for expenses keeps that type
+WITH used AS (
+    SELECT a.user_id, BTRIM(t.category) AS name, t.type
+    FROM transactions t
+    JOIN accounts a ON a.id = t.account_id
+    WHERE t.type IN ('INCOME', 'EXPENSE')
+    UNION ALL
+    SELECT user_id, BTRIM(set_category), NULLIF(type, '')
+    FROM category_rules
+)
+INSERT INTO categories (id, user_id, name, type)
+SELECT gen_random_uuid(),
+       user_id,
+       MODE() WITHIN GROUP (ORDER BY name),
+       CASE
+           WHEN BOOL_AND(type = 'INCOME') THEN 'INCOME'
+           WHEN BOOL_AND(type = 'EXPENSE') THEN 'EXPENSE'
+           ELSE ''
+       END
+FROM used
+WHERE name <> ''
+GROUP BY user_id, LOWER(name);
+
+-- Transactions reference their category; transfer legs have none
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS category_id UUID REFERENCES categories(id) ON DELETE SET NULL;
+
+UPDATE transactions t
+SET category_id = c.id
+FROM accounts a, categories c
+WHERE a.id = t.account_id
+  AND c.user_id = a.user_id
+  AND LOWER(c.name) = LOWER(BTRIM(t.category))
+  AND t.type IN ('INCOME', 'EXPENSE');
+
+DROP INDEX IF EXISTS idx_transactions_category;
+ALTER TABLE transactions DROP COLUMN IF EXISTS category;
+CREATE INDEX idx_transactions_category_id ON transactions(category_id);
+
+-- Rules set a category by reference too
+ALTER TABLE category_rules ADD COLUMN IF NOT EXISTS set_category_id UUID REFERENCES categories(id) ON DELETE RESTRICT;
+
+UPDATE category_rules r
+SET set_category_id = c.id
+FROM categories c
+WHERE c.user_id = r.user_id
+  AND LOWER(c.name) = LOWER(BTRIM(r.set_category));
+
+-- A rule only matches transactions its category allows
+UPDATE category_rules r
+SET type = c.type
+FROM categories c
+WHERE c.id = r.set_category_id
+  AND r.type = '';
+
+ALTER TABLE category_rules DROP COLUMN IF EXISTS set_category;
@@ -0,0 +1,15 @@
+-- Splits divide a transaction between categories; the service guarantees
+-- that the splits of a transaction add up to its amount
+CREATE TABLE IF NOT EXISTS transaction_splits (
+    id UUID PRIMARY KEY,
+    transaction_id UUID NOT NULL,
+    line_no INTEGER NOT NULL,
+    amount DECIMAL(18, 3) NOT NULL CHECK (amount > 0),
+    currency CHAR(3) NOT NULL,
+    category_id UUID REFERENCES categories(id) ON DELETE SET NULL,
+    memo VARCHAR(255) NOT NULL DEFAULT '',
+    FOREIGN KEY (transaction_id) REFERENCES transactions(id) ON DELETE CASCADE,
+    UNIQUE (transaction_id, line_no)
+);
+
+CREATE INDEX idx_transaction_splits_category_id ON transaction_splits(category_id);
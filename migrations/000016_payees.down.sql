@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_transactions_payee_id;
+ALTER TABLE transactions DROP COLUMN IF EXISTS payee_id;
+
+DROP TABLE IF EXISTS payees;
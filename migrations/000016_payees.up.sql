@@ -0,0 +1,20 @@
+-- Payees are who a user pays or is paid by. Aliases are the spellings banks
+-- use for the payee in transaction descriptions, and transactions of a payee
+-- without a category of their own get its default category
+CREATE TABLE IF NOT EXISTS payees (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    aliases TEXT[] NOT NULL DEFAULT '{}',
+    default_category_id UUID REFERENCES categories(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+);
+
+-- Names differing only in case would name the same payee
+CREATE UNIQUE INDEX idx_payees_user_name ON payees(user_id, LOWER(name));
+
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS payee_id UUID REFERENCES payees(id) ON DELETE SET NULL;
+
+CREATE INDEX idx_transactions_payee_id ON transactions(payee_id);
@@ -0,0 +1 @@
+ALTER TABLE category_rules DROP COLUMN IF EXISTS set_tags;
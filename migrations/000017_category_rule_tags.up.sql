@@ -0,0 +1,3 @@
+-- Rules may also tag the transactions they match; the tags are added to
+-- those the transaction has
+ALTER TABLE category_rules ADD COLUMN IF NOT EXISTS set_tags TEXT[] NOT NULL DEFAULT '{}';
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_categories_user_name;
+CREATE UNIQUE INDEX idx_categories_user_name ON categories(user_id, LOWER(name));
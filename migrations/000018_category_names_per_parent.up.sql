@@ -0,0 +1,4 @@
+-- Category names only need to be unique among the categories sharing a
+-- parent, so that for instance both "Car" and "Home" may have "Insurance"
+DROP INDEX IF EXISTS idx_categories_user_name;
+CREATE UNIQUE INDEX idx_categories_user_name ON categories(user_id, COALESCE(parent_id::text, ''), LOWER(name));